@@ -0,0 +1,63 @@
+package cmd
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	serverservice "go.hollow.sh/serverservice/pkg/api/v1"
+)
+
+// attributesSetArgs is the number of arguments of attributes set
+const attributesSetArgs = 3
+
+var attributesCmd = &cobra.Command{
+	Use:   "attributes",
+	Short: "manage server attributes through the server service API",
+}
+
+var attributesSetCmd = &cobra.Command{
+	Use:   "set SERVER_UUID NAMESPACE FILE",
+	Short: "set the attributes of a server in a namespace to the JSON document in FILE, - reads stdin",
+	Args:  cobra.ExactArgs(attributesSetArgs),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		id, err := uuid.Parse(args[0])
+		if err != nil {
+			return err
+		}
+
+		data, err := readInput(cmd, args[2])
+		if err != nil {
+			return err
+		}
+
+		if !json.Valid(data) {
+			return errors.Wrap(errInvalidInput, "attributes data is not valid JSON")
+		}
+
+		client, err := newClient(cmd.Context())
+		if err != nil {
+			return err
+		}
+
+		ns := args[1]
+
+		// update the namespace if it exists, otherwise create it
+		_, err = client.UpdateAttributes(cmd.Context(), id, ns, data)
+
+		var se serverservice.ServerError
+		if errors.As(err, &se) && se.StatusCode == http.StatusNotFound {
+			_, err = client.CreateAttributes(cmd.Context(), id, serverservice.Attributes{Namespace: ns, Data: data})
+		}
+
+		return err
+	},
+}
+
+func init() {
+	addClientCommand(attributesCmd)
+	attributesCmd.AddCommand(attributesSetCmd)
+}
@@ -0,0 +1,46 @@
+package cmd
+
+import (
+	"encoding/json"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	serverservice "go.hollow.sh/serverservice/pkg/api/v1"
+)
+
+var bomCmd = &cobra.Command{
+	Use:   "bom",
+	Short: "manage bills of materials through the server service API",
+}
+
+var bomUploadCmd = &cobra.Command{
+	Use:   "upload FILE",
+	Short: "upload the bills of materials in FILE, a JSON list, - reads stdin",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		data, err := readInput(cmd, args[0])
+		if err != nil {
+			return err
+		}
+
+		var boms []serverservice.Bom
+		if err := json.Unmarshal(data, &boms); err != nil {
+			return errors.Wrap(errInvalidInput, "bills of materials must be a JSON list: "+err.Error())
+		}
+
+		client, err := newClient(cmd.Context())
+		if err != nil {
+			return err
+		}
+
+		_, err = client.BillOfMaterialsBatchUpload(cmd.Context(), boms)
+
+		return err
+	},
+}
+
+func init() {
+	addClientCommand(bomCmd)
+	bomCmd.AddCommand(bomUploadCmd)
+}
@@ -0,0 +1,249 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"gopkg.in/yaml.v3"
+
+	serverservice "go.hollow.sh/serverservice/pkg/api/v1"
+)
+
+// Output formats of the client commands
+const (
+	outputTable = "table"
+	outputJSON  = "json"
+	outputYAML  = "yaml"
+)
+
+var (
+	clientHTTPTimeout = 30 * time.Second
+
+	errClientConfig       = errors.New("client configuration error")
+	errUnexpectedResponse = errors.New("unexpected response")
+	errInvalidInput       = errors.New("invalid input")
+)
+
+// clientFlags maps the flags shared by the client command groups to their
+// config keys
+var clientFlags = map[string]string{
+	"api-url":                "client.url",
+	"api-token":              "client.token",
+	"api-oidc-issuer":        "client.oidc.issuer",
+	"api-oidc-client-id":     "client.oidc.client_id",
+	"api-oidc-client-secret": "client.oidc.client_secret",
+	"api-oidc-audience":      "client.oidc.audience",
+	"api-oidc-scopes":        "client.oidc.scopes",
+	"output":                 "client.output",
+}
+
+// addClientCommand adds a group of commands talking to a running server
+// service to the root command, with the client flags
+func addClientCommand(cmd *cobra.Command) {
+	rootCmd.AddCommand(cmd)
+
+	flags := cmd.PersistentFlags()
+
+	flags.String("api-url", "http://localhost:8000", "URL of the server service API")
+	flags.String("api-token", "", "bearer token for the server service API, SERVERSERVICE_CLIENT_TOKEN may be set instead")
+	flags.String("api-oidc-issuer", "", "OIDC issuer to get a token from with the client credentials grant when no token is given")
+	flags.String("api-oidc-client-id", "", "OIDC client ID for the client credentials grant")
+	flags.String("api-oidc-client-secret", "", "OIDC client secret for the client credentials grant")
+	flags.String("api-oidc-audience", "", "audience to request for the OIDC token")
+	flags.StringSlice("api-oidc-scopes", []string{}, "scopes to request for the OIDC token")
+	flags.StringP("output", "o", outputTable, "output format of the client commands: table, json or yaml")
+
+	cmd.PersistentPreRunE = clientPreRun
+}
+
+// clientPreRun binds the client flags of the command being run, every group
+// has its own flags so they can't be bound when they're registered. The usage
+// isn't printed on errors once the arguments are valid.
+func clientPreRun(cmd *cobra.Command, args []string) error {
+	for name, key := range clientFlags {
+		if err := viper.BindPFlag(key, cmd.Flags().Lookup(name)); err != nil {
+			return err
+		}
+	}
+
+	silenceUsage(cmd, args)
+
+	return nil
+}
+
+// newClient returns a server service client authenticated with the configured
+// token, or with one obtained from the OIDC issuer.
+func newClient(ctx context.Context) (*serverservice.Client, error) {
+	httpClient := &http.Client{Timeout: clientHTTPTimeout}
+
+	token := viper.GetString("client.token")
+	if token == "" {
+		var err error
+
+		token, err = clientCredentialsToken(ctx, httpClient)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return serverservice.NewClientWithToken(token, viper.GetString("client.url"), httpClient)
+}
+
+// clientCredentialsToken requests an access token from the token endpoint of
+// the configured OIDC issuer using the client credentials grant.
+func clientCredentialsToken(ctx context.Context, httpClient *http.Client) (string, error) {
+	issuer := strings.TrimSuffix(viper.GetString("client.oidc.issuer"), "/")
+	if issuer == "" || viper.GetString("client.oidc.client_id") == "" {
+		return "", errors.Wrap(errClientConfig, "an API token or an OIDC issuer and client ID are required")
+	}
+
+	var discovery struct {
+		TokenEndpoint string `json:"token_endpoint"`
+	}
+
+	if err := getJSON(ctx, httpClient, issuer+"/.well-known/openid-configuration", &discovery); err != nil {
+		return "", errors.Wrap(err, "OIDC discovery")
+	}
+
+	form := url.Values{}
+	form.Set("grant_type", "client_credentials")
+
+	if scopes := viper.GetStringSlice("client.oidc.scopes"); len(scopes) > 0 {
+		form.Set("scope", strings.Join(scopes, " "))
+	}
+
+	if aud := viper.GetString("client.oidc.audience"); aud != "" {
+		form.Set("audience", aud)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, discovery.TokenEndpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", err
+	}
+
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.SetBasicAuth(url.QueryEscape(viper.GetString("client.oidc.client_id")), url.QueryEscape(viper.GetString("client.oidc.client_secret")))
+
+	var token struct {
+		AccessToken string `json:"access_token"`
+	}
+
+	if err := doJSON(httpClient, req, &token); err != nil {
+		return "", errors.Wrap(err, "OIDC token request")
+	}
+
+	if token.AccessToken == "" {
+		return "", errors.Wrap(errClientConfig, "OIDC token response has no access token")
+	}
+
+	return token.AccessToken, nil
+}
+
+func getJSON(ctx context.Context, httpClient *http.Client, uri string, v interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return err
+	}
+
+	return doJSON(httpClient, req, v)
+}
+
+func doJSON(httpClient *http.Client, req *http.Request, v interface{}) error {
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return errors.Wrapf(errUnexpectedResponse, "response code %d: %s", resp.StatusCode, bytes.TrimSpace(body))
+	}
+
+	return json.Unmarshal(body, v)
+}
+
+// printOutput writes v in the configured output format, table writes the
+// rows of the table format.
+func printOutput(cmd *cobra.Command, v interface{}, header []string, rows func(add func(...interface{}))) error {
+	w := cmd.OutOrStdout()
+
+	switch format := viper.GetString("client.output"); format {
+	case outputJSON:
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+
+		return enc.Encode(v)
+	case outputYAML:
+		// go through JSON so the keys match the API documents
+		data, err := json.Marshal(v)
+		if err != nil {
+			return err
+		}
+
+		var doc interface{}
+		if err := json.Unmarshal(data, &doc); err != nil {
+			return err
+		}
+
+		return yaml.NewEncoder(w).Encode(doc)
+	case outputTable, "":
+		tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+
+		fmt.Fprintln(tw, strings.Join(header, "\t"))
+
+		rows(func(cols ...interface{}) {
+			s := make([]string, len(cols))
+			for i, col := range cols {
+				s[i] = fmt.Sprint(col)
+			}
+
+			fmt.Fprintln(tw, strings.Join(s, "\t"))
+		})
+
+		return tw.Flush()
+	default:
+		return errors.Wrap(errClientConfig, "unsupported output format: "+format)
+	}
+}
+
+// readInput returns the contents of the file at path, or of stdin when the
+// path is "-"
+func readInput(cmd *cobra.Command, path string) ([]byte, error) {
+	if path == "-" {
+		return io.ReadAll(cmd.InOrStdin())
+	}
+
+	return os.ReadFile(path)
+}
+
+func formatTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+
+	return t.Format(time.RFC3339)
+}
+
+// silenceUsage keeps the usage from being printed on errors returned by the
+// client commands once their arguments are valid
+func silenceUsage(cmd *cobra.Command, args []string) {
+	cmd.SilenceUsage = true
+}
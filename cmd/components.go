@@ -0,0 +1,44 @@
+package cmd
+
+import (
+	"github.com/google/uuid"
+	"github.com/spf13/cobra"
+)
+
+var componentsCmd = &cobra.Command{
+	Use:   "components",
+	Short: "inspect server components through the server service API",
+}
+
+var componentsGetCmd = &cobra.Command{
+	Use:   "get SERVER_UUID",
+	Short: "show the components of a server",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		id, err := uuid.Parse(args[0])
+		if err != nil {
+			return err
+		}
+
+		client, err := newClient(cmd.Context())
+		if err != nil {
+			return err
+		}
+
+		components, _, err := client.GetComponents(cmd.Context(), id, nil)
+		if err != nil {
+			return err
+		}
+
+		return printOutput(cmd, components, []string{"UUID", "TYPE", "NAME", "VENDOR", "MODEL", "SERIAL"}, func(add func(...interface{})) {
+			for _, sc := range components {
+				add(sc.UUID, sc.ComponentTypeSlug, sc.Name, sc.Vendor, sc.Model, sc.Serial)
+			}
+		})
+	},
+}
+
+func init() {
+	addClientCommand(componentsCmd)
+	componentsCmd.AddCommand(componentsGetCmd)
+}
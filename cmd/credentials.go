@@ -0,0 +1,47 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"go.infratographer.com/x/viperx"
+
+	"go.hollow.sh/serverservice/internal/dbtools"
+)
+
+var credentialsRekeyBatchSize = 100
+
+var credentialsCmd = &cobra.Command{
+	Use:   "credentials",
+	Short: "maintain the server credentials stored in the database",
+}
+
+// credentialsRekeyCmd re-encrypts the stored credentials with the current
+// encryption key, after which the previous key can be removed
+var credentialsRekeyCmd = &cobra.Command{
+	Use:   "rekey",
+	Short: "re-encrypt the server credentials with the current encryption key",
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx := cmd.Context()
+
+		db := initDB()
+		defer db.Close()
+
+		keyring := openKeyring(ctx)
+		defer keyring.Close()
+
+		rekeyed, err := dbtools.RekeyCredentials(ctx, db, keyring, viper.GetInt("credentials.rekey.batch_size"))
+		if err != nil {
+			logger.Fatalw("failed to rekey server credentials", "rekeyed", rekeyed, "error", err)
+		}
+
+		logger.Infow("rekeyed server credentials", "rekeyed", rekeyed, "key_id", keyring.CurrentKeyID())
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(credentialsCmd)
+	credentialsCmd.AddCommand(credentialsRekeyCmd)
+
+	credentialsRekeyCmd.Flags().Int("batch-size", credentialsRekeyBatchSize, "number of credentials re-encrypted per transaction")
+	viperx.MustBindFlag(viper.GetViper(), "credentials.rekey.batch_size", credentialsRekeyCmd.Flags().Lookup("batch-size"))
+}
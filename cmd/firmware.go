@@ -0,0 +1,69 @@
+package cmd
+
+import (
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	serverservice "go.hollow.sh/serverservice/pkg/api/v1"
+)
+
+var firmwareCmd = &cobra.Command{
+	Use:   "firmware",
+	Short: "manage component firmware through the server service API",
+}
+
+var firmwareListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "list component firmware, optionally filtered",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		flags := cmd.Flags()
+
+		vendor, _ := flags.GetString("vendor")
+		model, _ := flags.GetStringSlice("model")
+		version, _ := flags.GetString("version")
+		filename, _ := flags.GetString("filename")
+		checksum, _ := flags.GetString("checksum")
+		limit, _ := flags.GetInt("limit")
+		page, _ := flags.GetInt("page")
+
+		params := &serverservice.ComponentFirmwareVersionListParams{
+			Vendor:     vendor,
+			Model:      model,
+			Version:    version,
+			Filename:   filename,
+			Checksum:   checksum,
+			Pagination: &serverservice.PaginationParams{Limit: limit, Page: page},
+		}
+
+		client, err := newClient(cmd.Context())
+		if err != nil {
+			return err
+		}
+
+		firmwares, _, err := client.ListServerComponentFirmware(cmd.Context(), params)
+		if err != nil {
+			return err
+		}
+
+		return printOutput(cmd, firmwares, []string{"UUID", "COMPONENT", "VENDOR", "MODEL", "VERSION", "FILENAME"}, func(add func(...interface{})) {
+			for _, f := range firmwares {
+				add(f.UUID, f.Component, f.Vendor, strings.Join(f.Model, ","), f.Version, f.Filename)
+			}
+		})
+	},
+}
+
+func init() {
+	addClientCommand(firmwareCmd)
+	firmwareCmd.AddCommand(firmwareListCmd)
+
+	firmwareListCmd.Flags().String("vendor", "", "only list firmware of the vendor")
+	firmwareListCmd.Flags().StringSlice("model", []string{}, "only list firmware of the models")
+	firmwareListCmd.Flags().String("version", "", "only list firmware of the version")
+	firmwareListCmd.Flags().String("filename", "", "only list firmware with the filename")
+	firmwareListCmd.Flags().String("checksum", "", "only list firmware with the checksum")
+	firmwareListCmd.Flags().Int("limit", 0, "number of firmware per page, the server default when 0")
+	firmwareListCmd.Flags().Int("page", 0, "page of firmware to list")
+}
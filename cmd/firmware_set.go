@@ -0,0 +1,67 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	serverservice "go.hollow.sh/serverservice/pkg/api/v1"
+)
+
+var firmwareSetCmd = &cobra.Command{
+	Use:   "firmware-set",
+	Short: "manage component firmware sets through the server service API",
+}
+
+var firmwareSetCreateCmd = &cobra.Command{
+	Use:   "create",
+	Short: "create a firmware set from existing firmware",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		flags := cmd.Flags()
+
+		name, _ := flags.GetString("name")
+		firmwareIDs, _ := flags.GetStringSlice("firmware")
+		attrs, _ := flags.GetStringArray("attribute")
+
+		req := serverservice.ComponentFirmwareSetRequest{
+			Name:                   name,
+			ComponentFirmwareUUIDs: firmwareIDs,
+		}
+
+		for _, a := range attrs {
+			ns, data, ok := strings.Cut(a, "=")
+			if !ok || !json.Valid([]byte(data)) {
+				return errors.Wrap(errInvalidInput, "attribute must be given as namespace=JSON: "+a)
+			}
+
+			req.Attributes = append(req.Attributes, serverservice.Attributes{Namespace: ns, Data: json.RawMessage(data)})
+		}
+
+		client, err := newClient(cmd.Context())
+		if err != nil {
+			return err
+		}
+
+		id, _, err := client.CreateServerComponentFirmwareSet(cmd.Context(), req)
+		if err != nil {
+			return err
+		}
+
+		fmt.Fprintln(cmd.OutOrStdout(), id)
+
+		return nil
+	},
+}
+
+func init() {
+	addClientCommand(firmwareSetCmd)
+	firmwareSetCmd.AddCommand(firmwareSetCreateCmd)
+
+	firmwareSetCreateCmd.Flags().String("name", "", "name of the firmware set")
+	firmwareSetCreateCmd.Flags().StringSlice("firmware", []string{}, "UUIDs of the firmware in the set")
+	firmwareSetCreateCmd.Flags().StringArray("attribute", []string{}, "attributes of the set as namespace=JSON, may be repeated")
+}
@@ -27,6 +27,8 @@ var (
 var rootCmd = &cobra.Command{
 	Use:   "serverservice",
 	Short: "Server Service for Hollow ecosystem",
+	// errors are printed by Execute
+	SilenceErrors: true,
 }
 
 // Execute adds all child commands to the root command and sets flags appropriately.
@@ -12,7 +12,7 @@ import (
 	"go.infratographer.com/x/crdbx"
 	"go.infratographer.com/x/otelx"
 	"go.infratographer.com/x/viperx"
-	"gocloud.dev/secrets"
+	"go.uber.org/zap"
 
 	// import gocdk secret drivers
 	_ "gocloud.dev/secrets/localsecrets"
@@ -20,11 +20,18 @@ import (
 	"go.hollow.sh/serverservice/internal/config"
 	"go.hollow.sh/serverservice/internal/dbtools"
 	"go.hollow.sh/serverservice/internal/httpsrv"
+	"go.hollow.sh/serverservice/internal/leases"
+	"go.hollow.sh/serverservice/internal/maintenance"
+	"go.hollow.sh/serverservice/internal/outbox"
+	serverservice "go.hollow.sh/serverservice/pkg/api/v1"
 )
 
 var (
-	apiDefaultListen   = "0.0.0.0:8000"
-	natsConnectTimeout = 100 * time.Millisecond
+	apiDefaultListen    = "0.0.0.0:8000"
+	natsConnectTimeout  = 100 * time.Millisecond
+	outboxRelayInterval = 1 * time.Second
+	leaseReapInterval   = 1 * time.Minute
+	maintenanceInterval = 30 * time.Second
 )
 
 // serveCmd represents the serve command
@@ -57,9 +64,13 @@ func init() {
 	viperx.MustBindFlag(viper.GetViper(), "oidc.claims.roles", serveCmd.Flags().Lookup("oidc-roles-claim"))
 	serveCmd.Flags().String("oidc-username-claim", "", "additional fields to output in logs from the JWT token, ex (email)")
 	viperx.MustBindFlag(viper.GetViper(), "oidc.claims.username", serveCmd.Flags().Lookup("oidc-username-claim"))
-	// DB Flags
-	serveCmd.Flags().String("db-encryption-driver", "", "encryption driver uri; 32 byte base64 encoded string, (example: base64key://your-encoded-secret-key)")
-	viperx.MustBindFlag(viper.GetViper(), "db.encryption_driver", serveCmd.Flags().Lookup("db-encryption-driver"))
+	// DB Flags, shared with the credentials commands
+	rootCmd.PersistentFlags().String("db-encryption-driver", "", "encryption driver uri; 32 byte base64 encoded string, (example: base64key://your-encoded-secret-key)")
+	viperx.MustBindFlag(viper.GetViper(), "db.encryption_driver", rootCmd.PersistentFlags().Lookup("db-encryption-driver"))
+	rootCmd.PersistentFlags().String("db-encryption-key-id", "default", "ID of the encryption driver key, stored with the values it encrypts")
+	viperx.MustBindFlag(viper.GetViper(), "db.encryption_key_id", rootCmd.PersistentFlags().Lookup("db-encryption-key-id"))
+	rootCmd.PersistentFlags().StringSlice("db-encryption-previous-drivers", []string{}, "keys that were rotated out and are only used to decrypt, as key-id=driver-uri")
+	viperx.MustBindFlag(viper.GetViper(), "db.encryption_previous_drivers", rootCmd.PersistentFlags().Lookup("db-encryption-previous-drivers"))
 
 	// NATs Flags
 	rootCmd.PersistentFlags().String("nats-url", "", "NATS server connection url")
@@ -88,6 +99,18 @@ func init() {
 
 	rootCmd.PersistentFlags().Duration("nats-connect-timeout", natsConnectTimeout, "Timeout when connecting to NATs")
 	viperx.MustBindFlag(viper.GetViper(), "nats.connect.timeout", rootCmd.PersistentFlags().Lookup("nats-connect-timeout"))
+
+	serveCmd.Flags().Duration("outbox-relay-interval", outboxRelayInterval, "how often pending events are published from the event outbox")
+	viperx.MustBindFlag(viper.GetViper(), "outbox.relay.interval", serveCmd.Flags().Lookup("outbox-relay-interval"))
+
+	serveCmd.Flags().Duration("lease-reap-interval", leaseReapInterval, "how often expired server leases are removed")
+	viperx.MustBindFlag(viper.GetViper(), "leases.reap.interval", serveCmd.Flags().Lookup("lease-reap-interval"))
+
+	serveCmd.Flags().Duration("maintenance-notify-interval", maintenanceInterval, "how often maintenance windows are checked for the events of their start and end")
+	viperx.MustBindFlag(viper.GetViper(), "maintenance.notify.interval", serveCmd.Flags().Lookup("maintenance-notify-interval"))
+
+	serveCmd.Flags().Bool("record-history", false, "keep a change history of servers, attributes and components to allow viewing them as of a point in time")
+	viperx.MustBindFlag(viper.GetViper(), "history.enabled", serveCmd.Flags().Lookup("record-history"))
 }
 
 func serve(ctx context.Context) {
@@ -100,11 +123,12 @@ func serve(ctx context.Context) {
 
 	dbtools.RegisterHooks()
 
-	keeper, err := secrets.OpenKeeper(ctx, viper.GetString("db.encryption_driver"))
-	if err != nil {
-		logger.Fatalw("failed to open secrets keeper", "error", err)
-	}
-	defer keeper.Close()
+	keyring := openKeyring(ctx)
+	defer keyring.Close()
+
+	// events are written to the outbox whenever NATS is configured, even
+	// while it's unreachable, the relay publishes them once it's back
+	eventsEnabled := viper.GetString("nats.url") != ""
 
 	logger.Infow("starting server",
 		"address", viper.GetString("listen"),
@@ -115,7 +139,10 @@ func serve(ctx context.Context) {
 		Listen:        viper.GetString("listen"),
 		Debug:         config.AppConfig.Logging.Debug,
 		DB:            db,
-		SecretsKeeper: keeper,
+		Keyring:       keyring,
+		RecordHistory: viper.GetBool("history.enabled"),
+		Lifecycle:     serverLifecycle(),
+		EventsEnabled: eventsEnabled,
 		AuthConfig: ginjwt.AuthConfig{
 			Enabled:       viper.GetBool("oidc.enabled"),
 			Audience:      viper.GetString("oidc.audience"),
@@ -127,11 +154,41 @@ func serve(ctx context.Context) {
 		},
 	}
 
-	// init event stream - for now, only when nats.url is specified
-	eventstream := initStream()
-	if eventstream != nil {
-		hs.EventStream = eventstream
-		defer hs.EventStream.Close()
+	reaperCtx, cancelReaper := context.WithCancel(ctx)
+	defer cancelReaper()
+
+	reaper := &leases.Reaper{
+		DB:       db,
+		Logger:   logger.Desugar().With(zap.String("component", "leases")),
+		Interval: viper.GetDuration("leases.reap.interval"),
+	}
+
+	go reaper.Run(reaperCtx)
+
+	notifierCtx, cancelNotifier := context.WithCancel(ctx)
+	defer cancelNotifier()
+
+	notifier := &maintenance.Notifier{
+		DB:            db,
+		Logger:        logger.Desugar().With(zap.String("component", "maintenance")),
+		Interval:      viper.GetDuration("maintenance.notify.interval"),
+		EventsEnabled: eventsEnabled,
+	}
+
+	go notifier.Run(notifierCtx)
+
+	if eventsEnabled {
+		relayCtx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		relay := &outbox.Relay{
+			DB:         db,
+			OpenStream: openStream,
+			Logger:     logger.Desugar().With(zap.String("component", "outbox")),
+			Interval:   viper.GetDuration("outbox.relay.interval"),
+		}
+
+		go relay.Run(relayCtx)
 	}
 
 	if err := hs.Run(); err != nil {
@@ -139,26 +196,18 @@ func serve(ctx context.Context) {
 	}
 }
 
-func initStream() events.Stream {
-	streamURL := viper.GetString("nats.url")
-	if streamURL == "" {
-		return nil
-	}
-
-	stream, err := events.NewStream(natsOptions(appName, streamURL))
+// openStream connects to the configured NATS stream
+func openStream() (events.Stream, error) {
+	stream, err := events.NewStream(natsOptions(appName, viper.GetString("nats.url")))
 	if err != nil {
-		logger.Warnw("error in event stream configuration", "error", err.Error())
-
-		return nil
+		return nil, err
 	}
 
 	if err := stream.Open(); err != nil {
-		logger.Warnw("error in event stream configuration", "error", err.Error())
-
-		return nil
+		return nil, err
 	}
 
-	return stream
+	return stream, nil
 }
 
 func natsOptions(appName, serverURL string) events.NatsOptions {
@@ -190,3 +239,37 @@ func initDB() *sqlx.DB {
 
 	return db
 }
+
+func openKeyring(ctx context.Context) *dbtools.Keyring {
+	keyring, err := dbtools.OpenKeyring(
+		ctx,
+		viper.GetString("db.encryption_key_id"),
+		viper.GetString("db.encryption_driver"),
+		viper.GetStringSlice("db.encryption_previous_drivers"),
+	)
+	if err != nil {
+		logger.Fatalw("failed to open secrets keeper", "error", err)
+	}
+
+	return keyring
+}
+
+// serverLifecycle returns the server lifecycle set in the config file under
+// "lifecycle", with an initial_state and the transitions allowed from every
+// state, or nil to use the default one
+func serverLifecycle() *serverservice.ServerLifecycle {
+	if !viper.IsSet("lifecycle") {
+		return nil
+	}
+
+	l := &serverservice.ServerLifecycle{}
+	if err := viper.UnmarshalKey("lifecycle", l); err != nil {
+		logger.Fatalw("failed to parse the server lifecycle", "error", err)
+	}
+
+	if err := l.Validate(); err != nil {
+		logger.Fatalw("invalid server lifecycle", "error", err)
+	}
+
+	return l
+}
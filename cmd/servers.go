@@ -0,0 +1,172 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/spf13/cobra"
+
+	serverservice "go.hollow.sh/serverservice/pkg/api/v1"
+)
+
+var serversCmd = &cobra.Command{
+	Use:   "servers",
+	Short: "manage servers through the server service API",
+}
+
+var serversListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "list servers, optionally filtered by facility and attributes",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		flags := cmd.Flags()
+
+		facility, _ := flags.GetString("facility")
+		attrs, _ := flags.GetStringArray("attr")
+		verAttrs, _ := flags.GetStringArray("ver-attr")
+		includeDeleted, _ := flags.GetBool("include-deleted")
+		limit, _ := flags.GetInt("limit")
+		page, _ := flags.GetInt("page")
+
+		params := &serverservice.ServerListParams{
+			FacilityCode:                 facility,
+			AttributeListParams:          serverservice.ParseAttributeListParams(attrs),
+			VersionedAttributeListParams: serverservice.ParseAttributeListParams(verAttrs),
+			IncludeDeleted:               includeDeleted,
+			// all the pages are followed by cursor unless one is asked for
+			PaginationParams: &serverservice.PaginationParams{Limit: limit, Page: page, Keyset: page == 0},
+		}
+
+		client, err := newClient(cmd.Context())
+		if err != nil {
+			return err
+		}
+
+		servers, resp, err := client.List(cmd.Context(), params)
+		if err != nil {
+			return err
+		}
+
+		for page == 0 && resp.HasNextPage() {
+			next := []serverservice.Server{}
+
+			resp, err = client.NextPage(cmd.Context(), *resp, &next)
+			if err != nil {
+				return err
+			}
+
+			servers = append(servers, next...)
+		}
+
+		return printServers(cmd, servers)
+	},
+}
+
+var serversGetCmd = &cobra.Command{
+	Use:   "get UUID",
+	Short: "show a server with its attributes and components",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		id, err := uuid.Parse(args[0])
+		if err != nil {
+			return err
+		}
+
+		client, err := newClient(cmd.Context())
+		if err != nil {
+			return err
+		}
+
+		srv, _, err := client.Get(cmd.Context(), id)
+		if err != nil {
+			return err
+		}
+
+		return printOutput(cmd, srv, []string{"UUID", "NAME", "FACILITY", "COMPONENTS", "ATTRIBUTES", "CREATED", "UPDATED"}, func(add func(...interface{})) {
+			add(srv.UUID, srv.Name, srv.FacilityCode, len(srv.Components), len(srv.Attributes), formatTime(srv.CreatedAt), formatTime(srv.UpdatedAt))
+		})
+	},
+}
+
+var serversCreateCmd = &cobra.Command{
+	Use:   "create",
+	Short: "create a server",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		flags := cmd.Flags()
+
+		name, _ := flags.GetString("name")
+		facility, _ := flags.GetString("facility")
+		id, _ := flags.GetString("uuid")
+
+		srv := serverservice.Server{Name: name, FacilityCode: facility}
+
+		if id != "" {
+			u, err := uuid.Parse(id)
+			if err != nil {
+				return err
+			}
+
+			srv.UUID = u
+		}
+
+		client, err := newClient(cmd.Context())
+		if err != nil {
+			return err
+		}
+
+		created, _, err := client.Create(cmd.Context(), srv)
+		if err != nil {
+			return err
+		}
+
+		fmt.Fprintln(cmd.OutOrStdout(), created)
+
+		return nil
+	},
+}
+
+var serversDeleteCmd = &cobra.Command{
+	Use:   "delete UUID",
+	Short: "delete a server",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		id, err := uuid.Parse(args[0])
+		if err != nil {
+			return err
+		}
+
+		client, err := newClient(cmd.Context())
+		if err != nil {
+			return err
+		}
+
+		_, err = client.Delete(cmd.Context(), serverservice.Server{UUID: id})
+
+		return err
+	},
+}
+
+func init() {
+	addClientCommand(serversCmd)
+	serversCmd.AddCommand(serversListCmd, serversGetCmd, serversCreateCmd, serversDeleteCmd)
+
+	serversListCmd.Flags().String("facility", "", "only list servers in the facility")
+	serversListCmd.Flags().StringArray("attr", []string{}, "attribute filter, ns~keys.dot.seperated~operation~value, may be repeated")
+	serversListCmd.Flags().StringArray("ver-attr", []string{}, "versioned attribute filter in the same format as --attr, may be repeated")
+	serversListCmd.Flags().Bool("include-deleted", false, "include deleted servers")
+	serversListCmd.Flags().Int("limit", 0, "number of servers per page, the server default when 0")
+	serversListCmd.Flags().Int("page", 0, "only list the page of servers, all the pages are listed when 0")
+
+	serversCreateCmd.Flags().String("name", "", "name of the server")
+	serversCreateCmd.Flags().String("facility", "", "facility code of the server")
+	serversCreateCmd.Flags().String("uuid", "", "UUID of the server, generated when not given")
+}
+
+func printServers(cmd *cobra.Command, servers []serverservice.Server) error {
+	return printOutput(cmd, servers, []string{"UUID", "NAME", "FACILITY", "CREATED"}, func(add func(...interface{})) {
+		for _, srv := range servers {
+			add(srv.UUID, srv.Name, srv.FacilityCode, formatTime(srv.CreatedAt))
+		}
+	})
+}
@@ -0,0 +1,25 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- event_outbox holds events written in the same transaction as the change they
+-- describe, the outbox relay publishes them to the event stream and removes them.
+CREATE TABLE event_outbox (
+  id UUID PRIMARY KEY NOT NULL DEFAULT gen_random_uuid(),
+  seq INT8 NOT NULL DEFAULT unique_rowid(),
+  subject STRING NOT NULL,
+  partition_key STRING NOT NULL,
+  payload BYTES NOT NULL,
+  attempts INT8 NOT NULL DEFAULT 0,
+  last_error STRING NULL,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  INDEX idx_event_outbox_seq (seq)
+);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP TABLE event_outbox;
+
+-- +goose StatementEnd
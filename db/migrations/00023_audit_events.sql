@@ -0,0 +1,32 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- audit_events records every mutating API call and every credential read along
+-- with the identity that made it.
+CREATE TABLE audit_events (
+  id UUID PRIMARY KEY NOT NULL DEFAULT gen_random_uuid(),
+  actor_subject STRING NULL,
+  actor_user STRING NULL,
+  method STRING NOT NULL,
+  route STRING NOT NULL,
+  action STRING NOT NULL,
+  resource_type STRING NOT NULL,
+  resource_id STRING NULL,
+  server_id UUID NULL,
+  before JSONB NULL,
+  after JSONB NULL,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  INDEX idx_audit_events_created_at (created_at),
+  INDEX idx_audit_events_actor (actor_subject, created_at),
+  INDEX idx_audit_events_resource (resource_type, resource_id, created_at),
+  INDEX idx_audit_events_server (server_id, created_at)
+);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP TABLE audit_events;
+
+-- +goose StatementEnd
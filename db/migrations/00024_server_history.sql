@@ -0,0 +1,26 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- server_history keeps a snapshot of a server, its attributes and components
+-- each time they change so a server can be viewed as it was at a point in
+-- time. A NULL snapshot marks the entity as removed.
+CREATE TABLE server_history (
+  id UUID PRIMARY KEY NOT NULL DEFAULT gen_random_uuid(),
+  seq INT8 NOT NULL DEFAULT unique_rowid(),
+  server_id UUID NOT NULL,
+  entity STRING NOT NULL,
+  entity_id UUID NOT NULL,
+  snapshot JSONB NULL,
+  recorded_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  INDEX idx_server_history_server (server_id, recorded_at, seq),
+  INDEX idx_server_history_entity (entity, entity_id)
+);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP TABLE server_history;
+
+-- +goose StatementEnd
@@ -0,0 +1,27 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- firmware_set_assignments assign a firmware set to a server directly, when
+-- server_id is set, or to every server matching the facility code and
+-- attribute selectors.
+CREATE TABLE firmware_set_assignments (
+  id UUID PRIMARY KEY NOT NULL DEFAULT gen_random_uuid(),
+  firmware_set_id UUID NOT NULL REFERENCES component_firmware_set(id) ON DELETE CASCADE,
+  server_id UUID NULL REFERENCES servers(id) ON DELETE CASCADE,
+  facility_code STRING NULL,
+  attributes JSONB NULL,
+  priority INT8 NOT NULL DEFAULT 0,
+  created_at TIMESTAMPTZ NULL,
+  updated_at TIMESTAMPTZ NULL,
+  UNIQUE INDEX idx_firmware_set_assignments_server (server_id),
+  INDEX idx_firmware_set_assignments_firmware_set (firmware_set_id)
+);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP TABLE firmware_set_assignments;
+
+-- +goose StatementEnd
@@ -0,0 +1,29 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- server_credential_versions keeps every version of a server credential, the
+-- current one included, so a rotation can be rolled back. The number of
+-- previous versions kept is set per credential type.
+ALTER TABLE server_credentials ADD COLUMN version INT8 NOT NULL DEFAULT 1;
+ALTER TABLE server_credential_types ADD COLUMN version_retention INT8 NOT NULL DEFAULT 5;
+
+CREATE TABLE server_credential_versions (
+  id UUID PRIMARY KEY NOT NULL DEFAULT gen_random_uuid(),
+  server_credential_id UUID NOT NULL REFERENCES server_credentials(id) ON DELETE CASCADE,
+  version INT8 NOT NULL,
+  username STRING NOT NULL,
+  password STRING NOT NULL,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  UNIQUE INDEX idx_server_credential_versions_version (server_credential_id, version)
+);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP TABLE server_credential_versions;
+ALTER TABLE server_credential_types DROP COLUMN version_retention;
+ALTER TABLE server_credentials DROP COLUMN version;
+
+-- +goose StatementEnd
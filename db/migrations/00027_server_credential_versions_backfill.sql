@@ -0,0 +1,17 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- The existing credentials are recorded as their first version in their own
+-- migration, CockroachDB can't write to the version column added by migration
+-- 00026 in the transaction that added it.
+INSERT INTO server_credential_versions (server_credential_id, version, username, password, created_at)
+  SELECT id, version, username, password, updated_at FROM server_credentials;
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DELETE FROM server_credential_versions;
+
+-- +goose StatementEnd
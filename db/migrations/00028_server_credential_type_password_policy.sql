@@ -0,0 +1,15 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- password_policy sets the passwords the service generates for credentials
+-- of the type, the default policy is used when it's null.
+ALTER TABLE server_credential_types ADD COLUMN password_policy JSONB;
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+ALTER TABLE server_credential_types DROP COLUMN password_policy;
+
+-- +goose StatementEnd
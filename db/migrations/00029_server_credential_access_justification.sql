@@ -0,0 +1,17 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- credential types can require a justification header on reads, the
+-- justification given is kept with the audit event of the read.
+ALTER TABLE server_credential_types ADD COLUMN require_justification BOOL NOT NULL DEFAULT false;
+ALTER TABLE audit_events ADD COLUMN justification STRING;
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+ALTER TABLE audit_events DROP COLUMN justification;
+ALTER TABLE server_credential_types DROP COLUMN require_justification;
+
+-- +goose StatementEnd
@@ -0,0 +1,21 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- credential and component types can be described, and deprecated to retire
+-- them without removing the credentials and components that still use them.
+ALTER TABLE server_credential_types ADD COLUMN description STRING;
+ALTER TABLE server_credential_types ADD COLUMN deprecated BOOL NOT NULL DEFAULT false;
+ALTER TABLE server_component_types ADD COLUMN description STRING;
+ALTER TABLE server_component_types ADD COLUMN deprecated BOOL NOT NULL DEFAULT false;
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+ALTER TABLE server_component_types DROP COLUMN deprecated;
+ALTER TABLE server_component_types DROP COLUMN description;
+ALTER TABLE server_credential_types DROP COLUMN deprecated;
+ALTER TABLE server_credential_types DROP COLUMN description;
+
+-- +goose StatementEnd
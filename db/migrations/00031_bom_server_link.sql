@@ -0,0 +1,17 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- a bom is linked to the server it was delivered as, matched by the chassis
+-- serial or BMC MAC address observed in the server's components.
+ALTER TABLE bom_info ADD COLUMN server_id UUID NULL REFERENCES servers(id) ON DELETE SET NULL;
+CREATE UNIQUE INDEX idx_bom_info_server_id ON bom_info (server_id) WHERE server_id IS NOT NULL;
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP INDEX bom_info@idx_bom_info_server_id;
+ALTER TABLE bom_info DROP COLUMN server_id;
+
+-- +goose StatementEnd
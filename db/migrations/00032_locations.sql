@@ -0,0 +1,69 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- facilities contain rooms, rooms contain rows and rows contain racks. Names
+-- are unique within their parent, facility codes are unique.
+CREATE TABLE facilities (
+  id UUID PRIMARY KEY NOT NULL DEFAULT gen_random_uuid(),
+  code STRING NOT NULL,
+  name STRING NULL,
+  created_at TIMESTAMPTZ NULL,
+  updated_at TIMESTAMPTZ NULL,
+  UNIQUE INDEX idx_facilities_code (code)
+);
+
+CREATE TABLE rooms (
+  id UUID PRIMARY KEY NOT NULL DEFAULT gen_random_uuid(),
+  facility_id UUID NOT NULL REFERENCES facilities(id),
+  name STRING NOT NULL,
+  created_at TIMESTAMPTZ NULL,
+  updated_at TIMESTAMPTZ NULL,
+  UNIQUE INDEX idx_rooms_facility_name (facility_id, name)
+);
+
+CREATE TABLE rack_rows (
+  id UUID PRIMARY KEY NOT NULL DEFAULT gen_random_uuid(),
+  room_id UUID NOT NULL REFERENCES rooms(id),
+  name STRING NOT NULL,
+  created_at TIMESTAMPTZ NULL,
+  updated_at TIMESTAMPTZ NULL,
+  UNIQUE INDEX idx_rack_rows_room_name (room_id, name)
+);
+
+CREATE TABLE racks (
+  id UUID PRIMARY KEY NOT NULL DEFAULT gen_random_uuid(),
+  row_id UUID NOT NULL REFERENCES rack_rows(id),
+  name STRING NOT NULL,
+  height_u INT8 NOT NULL DEFAULT 42,
+  created_at TIMESTAMPTZ NULL,
+  updated_at TIMESTAMPTZ NULL,
+  UNIQUE INDEX idx_racks_row_name (row_id, name)
+);
+
+-- a server occupies height_u units of a rack from position_u up. Overlapping
+-- placements are rejected by the API, the rack row is locked while they're
+-- checked.
+CREATE TABLE server_placements (
+  id UUID PRIMARY KEY NOT NULL DEFAULT gen_random_uuid(),
+  server_id UUID NOT NULL REFERENCES servers(id) ON DELETE CASCADE,
+  rack_id UUID NOT NULL REFERENCES racks(id),
+  position_u INT8 NOT NULL,
+  height_u INT8 NOT NULL DEFAULT 1,
+  created_at TIMESTAMPTZ NULL,
+  updated_at TIMESTAMPTZ NULL,
+  UNIQUE INDEX idx_server_placements_server (server_id),
+  INDEX idx_server_placements_rack (rack_id, position_u)
+);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP TABLE server_placements;
+DROP TABLE racks;
+DROP TABLE rack_rows;
+DROP TABLE rooms;
+DROP TABLE facilities;
+
+-- +goose StatementEnd
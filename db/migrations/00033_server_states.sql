@@ -0,0 +1,30 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- servers that exist before lifecycle states were introduced are taken to be
+-- in service, new servers start in the configured initial state.
+ALTER TABLE servers ADD COLUMN state STRING NOT NULL DEFAULT 'in-service';
+CREATE INDEX idx_servers_state ON servers (state);
+
+CREATE TABLE server_state_transitions (
+  id UUID PRIMARY KEY NOT NULL DEFAULT gen_random_uuid(),
+  server_id UUID NOT NULL REFERENCES servers(id) ON DELETE CASCADE,
+  from_state STRING NOT NULL,
+  to_state STRING NOT NULL,
+  reason STRING NOT NULL,
+  actor_subject STRING NULL,
+  actor_user STRING NULL,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  INDEX idx_server_state_transitions_server (server_id, created_at)
+);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP TABLE server_state_transitions;
+DROP INDEX servers@idx_servers_state;
+ALTER TABLE servers DROP COLUMN state;
+
+-- +goose StatementEnd
@@ -0,0 +1,25 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- a lease gives a single holder, usually a controller, exclusive use of a
+-- server until it expires. Expired leases are ignored and reaped by the
+-- service.
+CREATE TABLE server_leases (
+  id UUID PRIMARY KEY NOT NULL DEFAULT gen_random_uuid(),
+  server_id UUID NOT NULL REFERENCES servers(id) ON DELETE CASCADE,
+  holder STRING NOT NULL,
+  expires_at TIMESTAMPTZ NOT NULL,
+  created_at TIMESTAMPTZ NULL,
+  updated_at TIMESTAMPTZ NULL,
+  UNIQUE INDEX idx_server_leases_server (server_id),
+  INDEX idx_server_leases_expires_at (expires_at)
+);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP TABLE server_leases;
+
+-- +goose StatementEnd
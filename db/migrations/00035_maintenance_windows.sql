@@ -0,0 +1,41 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- a maintenance window applies to the servers listed in
+-- maintenance_window_servers, or when there are none to the servers matching
+-- its facility code and attribute selectors. started_event_at and
+-- ended_event_at record when the events for the start and end of the window
+-- were enqueued so each is sent once.
+CREATE TABLE maintenance_windows (
+  id UUID PRIMARY KEY NOT NULL DEFAULT gen_random_uuid(),
+  reason STRING NOT NULL,
+  starts_at TIMESTAMPTZ NOT NULL,
+  ends_at TIMESTAMPTZ NOT NULL,
+  facility_code STRING NULL,
+  attributes JSONB NULL,
+  started_event_at TIMESTAMPTZ NULL,
+  ended_event_at TIMESTAMPTZ NULL,
+  created_at TIMESTAMPTZ NULL,
+  updated_at TIMESTAMPTZ NULL,
+  INDEX idx_maintenance_windows_starts_at (starts_at),
+  INDEX idx_maintenance_windows_ends_at (ends_at),
+  CONSTRAINT check_maintenance_windows_ends_after_start CHECK (ends_at > starts_at)
+);
+
+CREATE TABLE maintenance_window_servers (
+  id UUID PRIMARY KEY NOT NULL DEFAULT gen_random_uuid(),
+  maintenance_window_id UUID NOT NULL REFERENCES maintenance_windows(id) ON DELETE CASCADE,
+  server_id UUID NOT NULL REFERENCES servers(id) ON DELETE CASCADE,
+  UNIQUE INDEX idx_maintenance_window_servers_window_server (maintenance_window_id, server_id),
+  INDEX idx_maintenance_window_servers_server (server_id)
+);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP TABLE maintenance_window_servers;
+DROP TABLE maintenance_windows;
+
+-- +goose StatementEnd
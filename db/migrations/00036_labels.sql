@@ -0,0 +1,22 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- labels are flat key/value pairs used to group servers and components, the
+-- inverted indexes serve label selector lookups.
+ALTER TABLE servers ADD COLUMN labels JSONB NOT NULL DEFAULT '{}';
+CREATE INVERTED INDEX idx_servers_labels ON servers (labels);
+
+ALTER TABLE server_components ADD COLUMN labels JSONB NOT NULL DEFAULT '{}';
+CREATE INVERTED INDEX idx_server_components_labels ON server_components (labels);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP INDEX server_components@idx_server_components_labels;
+ALTER TABLE server_components DROP COLUMN labels;
+DROP INDEX servers@idx_servers_labels;
+ALTER TABLE servers DROP COLUMN labels;
+
+-- +goose StatementEnd
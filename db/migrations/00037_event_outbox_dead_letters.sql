@@ -0,0 +1,32 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- events that fail to publish are retried after retry_at, the partition they
+-- belong to is held back until then. Events that keep failing are moved to
+-- event_outbox_dead_letters so they no longer hold back their partition.
+ALTER TABLE event_outbox ADD COLUMN retry_at TIMESTAMPTZ NULL;
+CREATE INDEX idx_event_outbox_retry_at ON event_outbox (retry_at) STORING (partition_key);
+
+CREATE TABLE event_outbox_dead_letters (
+  id UUID PRIMARY KEY NOT NULL,
+  seq INT8 NOT NULL,
+  subject STRING NOT NULL,
+  partition_key STRING NOT NULL,
+  payload BYTES NOT NULL,
+  attempts INT8 NOT NULL,
+  last_error STRING NULL,
+  created_at TIMESTAMPTZ NOT NULL,
+  dead_lettered_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  INDEX idx_event_outbox_dead_letters_partition_key (partition_key, seq)
+);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP TABLE event_outbox_dead_letters;
+DROP INDEX event_outbox@idx_event_outbox_retry_at;
+ALTER TABLE event_outbox DROP COLUMN retry_at;
+
+-- +goose StatementEnd
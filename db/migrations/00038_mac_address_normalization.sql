@@ -0,0 +1,80 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- MAC addresses are rewritten in the canonical form the API writes and looks
+-- them up in, lowercase hex pairs separated by colons. Values that aren't a MAC
+-- address are left as they are, and an address already stored in its
+-- canonical form keeps its owner.
+INSERT INTO aoc_mac_address (aoc_mac_address, serial_num)
+  SELECT DISTINCT ON (mac) mac, serial_num FROM (
+    SELECT
+      regexp_replace(lower(translate(btrim(aoc_mac_address), ':-.', '')), '^(..)(..)(..)(..)(..)(..)$', '\1:\2:\3:\4:\5:\6') AS mac,
+      aoc_mac_address AS stored,
+      serial_num
+    FROM aoc_mac_address
+    WHERE lower(translate(btrim(aoc_mac_address), ':-.', '')) ~ '^[0-9a-f]{12}$'
+  ) AS m
+  WHERE mac <> stored
+  ON CONFLICT (aoc_mac_address) DO NOTHING;
+
+DELETE FROM aoc_mac_address
+  WHERE lower(translate(btrim(aoc_mac_address), ':-.', '')) ~ '^[0-9a-f]{12}$'
+  AND aoc_mac_address <> regexp_replace(lower(translate(btrim(aoc_mac_address), ':-.', '')), '^(..)(..)(..)(..)(..)(..)$', '\1:\2:\3:\4:\5:\6');
+
+INSERT INTO bmc_mac_address (bmc_mac_address, serial_num)
+  SELECT DISTINCT ON (mac) mac, serial_num FROM (
+    SELECT
+      regexp_replace(lower(translate(btrim(bmc_mac_address), ':-.', '')), '^(..)(..)(..)(..)(..)(..)$', '\1:\2:\3:\4:\5:\6') AS mac,
+      bmc_mac_address AS stored,
+      serial_num
+    FROM bmc_mac_address
+    WHERE lower(translate(btrim(bmc_mac_address), ':-.', '')) ~ '^[0-9a-f]{12}$'
+  ) AS m
+  WHERE mac <> stored
+  ON CONFLICT (bmc_mac_address) DO NOTHING;
+
+DELETE FROM bmc_mac_address
+  WHERE lower(translate(btrim(bmc_mac_address), ':-.', '')) ~ '^[0-9a-f]{12}$'
+  AND bmc_mac_address <> regexp_replace(lower(translate(btrim(bmc_mac_address), ':-.', '')), '^(..)(..)(..)(..)(..)(..)$', '\1:\2:\3:\4:\5:\6');
+
+-- the lists on the bom are rewritten address by address, keeping their order
+UPDATE bom_info SET aoc_mac_address = (
+  SELECT string_agg(
+    CASE WHEN lower(translate(btrim(v), ':-.', '')) ~ '^[0-9a-f]{12}$'
+      THEN regexp_replace(lower(translate(btrim(v), ':-.', '')), '^(..)(..)(..)(..)(..)(..)$', '\1:\2:\3:\4:\5:\6')
+      ELSE btrim(v)
+    END, ',' ORDER BY n)
+  FROM unnest(string_to_array(bom_info.aoc_mac_address, ',')) WITH ORDINALITY AS l(v, n)
+  WHERE btrim(v) <> ''
+) WHERE aoc_mac_address IS NOT NULL;
+
+UPDATE bom_info SET bmc_mac_address = (
+  SELECT string_agg(
+    CASE WHEN lower(translate(btrim(v), ':-.', '')) ~ '^[0-9a-f]{12}$'
+      THEN regexp_replace(lower(translate(btrim(v), ':-.', '')), '^(..)(..)(..)(..)(..)(..)$', '\1:\2:\3:\4:\5:\6')
+      ELSE btrim(v)
+    END, ',' ORDER BY n)
+  FROM unnest(string_to_array(bom_info.bmc_mac_address, ',')) WITH ORDINALITY AS l(v, n)
+  WHERE btrim(v) <> ''
+) WHERE bmc_mac_address IS NOT NULL;
+
+-- the serial of a bmc or nic component is its MAC address, a component whose
+-- canonical serial is already taken on the same server is left as it is
+UPDATE server_components AS sc
+  SET serial = regexp_replace(lower(translate(btrim(sc.serial), ':-.', '')), '^(..)(..)(..)(..)(..)(..)$', '\1:\2:\3:\4:\5:\6')
+  WHERE sc.server_component_type_id IN (SELECT id FROM server_component_types WHERE slug IN ('bmc', 'nic'))
+  AND lower(translate(btrim(sc.serial), ':-.', '')) ~ '^[0-9a-f]{12}$'
+  AND sc.serial <> regexp_replace(lower(translate(btrim(sc.serial), ':-.', '')), '^(..)(..)(..)(..)(..)(..)$', '\1:\2:\3:\4:\5:\6')
+  AND NOT EXISTS (
+    SELECT 1 FROM server_components AS o
+    WHERE o.server_id = sc.server_id
+    AND o.server_component_type_id = sc.server_component_type_id
+    AND o.serial = regexp_replace(lower(translate(btrim(sc.serial), ':-.', '')), '^(..)(..)(..)(..)(..)(..)$', '\1:\2:\3:\4:\5:\6')
+  );
+
+-- +goose StatementEnd
+
+-- +goose Down
+
+-- the format the MAC addresses were written in isn't kept, there is nothing to
+-- revert
@@ -0,0 +1,17 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- servers were created with free-text facility codes before facilities were
+-- recorded, every code in use becomes a facility so servers can still be
+-- created in and moved to it
+INSERT INTO facilities (code, created_at, updated_at)
+  SELECT DISTINCT facility_code, now(), now() FROM servers
+  WHERE facility_code IS NOT NULL AND facility_code <> ''
+  ON CONFLICT (code) DO NOTHING;
+
+-- +goose StatementEnd
+
+-- +goose Down
+
+-- the seeded facilities can't be told apart from the ones created since,
+-- there is nothing to revert
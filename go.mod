@@ -1,6 +1,6 @@
 module go.hollow.sh/serverservice
 
-go 1.19
+go 1.20
 
 require (
 	github.com/XSAM/otelsql v0.23.0 // indirect
@@ -128,7 +128,7 @@ require (
 	google.golang.org/grpc v1.57.0 // indirect
 	google.golang.org/protobuf v1.31.0 // indirect
 	gopkg.in/ini.v1 v1.67.0 // indirect
-	gopkg.in/yaml.v3 v3.0.1 // indirect
+	gopkg.in/yaml.v3 v3.0.1
 )
 
 replace github.com/gin-contrib/zap => github.com/thinkgos/zap v0.0.2-0.20210226022008-5b2cf0c4d297
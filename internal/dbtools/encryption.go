@@ -3,30 +3,186 @@ package dbtools
 import (
 	"context"
 	"encoding/base64"
+	"regexp"
+	"strings"
 
+	"github.com/pkg/errors"
 	"gocloud.dev/secrets"
 )
 
-// Encrypt provides a wrapper to handle encrypting a string with the secrets keeper
-// and returns it already base64 encoded
-func Encrypt(ctx context.Context, keeper *secrets.Keeper, str string) (string, error) {
-	cipher, err := keeper.Encrypt(ctx, []byte(str))
+var (
+	// ErrInvalidKeyID is returned when a key ID can't be stored with a ciphertext
+	ErrInvalidKeyID = errors.New("invalid encryption key ID")
+	// ErrUnknownKeyID is returned when a ciphertext was encrypted with a key
+	// that isn't in the keyring
+	ErrUnknownKeyID = errors.New("unknown encryption key ID")
+
+	keyIDPattern = regexp.MustCompile(`^[A-Za-z0-9_.-]+$`)
+)
+
+// keyIDSeparator separates the key ID from the base64 ciphertext, it isn't
+// part of the base64 alphabet so values written before key IDs were stored
+// can still be told apart.
+const keyIDSeparator = ":"
+
+// Keyring holds the secrets keeper new values are encrypted with and the
+// previous keepers that are kept around to decrypt values encrypted before the
+// key was rotated. Each keeper is identified by a key ID that is stored along
+// with the values it encrypts.
+type Keyring struct {
+	currentID string
+	keepers   map[string]*secrets.Keeper
+	// ids has the current key ID first, followed by the previous ones in the
+	// order they were added
+	ids []string
+}
+
+// NewKeyring returns a keyring that encrypts with the current keeper
+func NewKeyring(currentID string, current *secrets.Keeper) (*Keyring, error) {
+	if !keyIDPattern.MatchString(currentID) {
+		return nil, errors.Wrap(ErrInvalidKeyID, currentID)
+	}
+
+	return &Keyring{
+		currentID: currentID,
+		keepers:   map[string]*secrets.Keeper{currentID: current},
+		ids:       []string{currentID},
+	}, nil
+}
+
+// OpenKeyring opens the current keeper from its driver URL, and each of the
+// previous keepers from a "keyID=driverURL" string
+func OpenKeyring(ctx context.Context, currentID, currentURL string, previous []string) (*Keyring, error) {
+	current, err := secrets.OpenKeeper(ctx, currentURL)
+	if err != nil {
+		return nil, err
+	}
+
+	k, err := NewKeyring(currentID, current)
+	if err != nil {
+		current.Close()
+		return nil, err
+	}
+
+	for _, p := range previous {
+		id, url, ok := strings.Cut(p, "=")
+		if !ok {
+			k.Close()
+			return nil, errors.Wrap(ErrInvalidKeyID, "previous key must be given as keyID=driverURL")
+		}
+
+		keeper, err := secrets.OpenKeeper(ctx, url)
+		if err != nil {
+			k.Close()
+			return nil, err
+		}
+
+		if err := k.AddPrevious(id, keeper); err != nil {
+			keeper.Close()
+			k.Close()
+
+			return nil, err
+		}
+	}
+
+	return k, nil
+}
+
+// AddPrevious adds a keeper that is only used to decrypt
+func (k *Keyring) AddPrevious(id string, keeper *secrets.Keeper) error {
+	if !keyIDPattern.MatchString(id) {
+		return errors.Wrap(ErrInvalidKeyID, id)
+	}
+
+	if _, ok := k.keepers[id]; ok {
+		return errors.Wrap(ErrInvalidKeyID, "duplicate key ID "+id)
+	}
+
+	k.keepers[id] = keeper
+	k.ids = append(k.ids, id)
+
+	return nil
+}
+
+// CurrentKeyID returns the ID of the key new values are encrypted with
+func (k *Keyring) CurrentKeyID() string {
+	return k.currentID
+}
+
+// Close closes all the keepers in the keyring
+func (k *Keyring) Close() error {
+	var err error
+
+	for _, id := range k.ids {
+		if cerr := k.keepers[id].Close(); cerr != nil && err == nil {
+			err = cerr
+		}
+	}
+
+	return err
+}
+
+// Encrypt encrypts str with the current key of the keyring, see Encrypt
+func (k *Keyring) Encrypt(ctx context.Context, str string) (string, error) {
+	return Encrypt(ctx, k, str)
+}
+
+// Decrypt decrypts a value returned by Encrypt, see Decrypt
+func (k *Keyring) Decrypt(ctx context.Context, ciphertext string) (string, error) {
+	return Decrypt(ctx, k, ciphertext)
+}
+
+// KeyID returns the ID of the key a value returned by Encrypt was encrypted
+// with, values encrypted before key IDs were stored have none.
+func KeyID(ciphertext string) string {
+	id, _, ok := strings.Cut(ciphertext, keyIDSeparator)
+	if !ok {
+		return ""
+	}
+
+	return id
+}
+
+// Encrypt provides a wrapper to handle encrypting a string with the current
+// key of the keyring and returns it base64 encoded, prefixed by the key ID
+func Encrypt(ctx context.Context, keyring *Keyring, str string) (string, error) {
+	cipher, err := keyring.keepers[keyring.currentID].Encrypt(ctx, []byte(str))
 	if err != nil {
 		return "", err
 	}
 
-	return base64.StdEncoding.EncodeToString(cipher), nil
+	return keyring.currentID + keyIDSeparator + base64.StdEncoding.EncodeToString(cipher), nil
 }
 
-// Decrypt provides a wrapper to handle decrypting a base64 encoded string with
-// the secrets keeper
-func Decrypt(ctx context.Context, keeper *secrets.Keeper, base64str string) (string, error) {
+// Decrypt provides a wrapper to handle decrypting a string returned by Encrypt
+// with the key it was encrypted with. Values without a key ID are tried with
+// each key of the keyring, the current one first.
+func Decrypt(ctx context.Context, keyring *Keyring, ciphertext string) (string, error) {
+	ids := keyring.ids
+	base64str := ciphertext
+
+	if id, rest, ok := strings.Cut(ciphertext, keyIDSeparator); ok {
+		if _, known := keyring.keepers[id]; !known {
+			return "", errors.Wrap(ErrUnknownKeyID, id)
+		}
+
+		ids = []string{id}
+		base64str = rest
+	}
+
 	plain, err := base64.StdEncoding.DecodeString(base64str)
 	if err != nil {
 		return "", err
 	}
 
-	decrypted, err := keeper.Decrypt(ctx, plain)
+	for _, id := range ids {
+		var decrypted []byte
+
+		decrypted, err = keyring.keepers[id].Decrypt(ctx, plain)
+		if err == nil {
+			return string(decrypted), nil
+		}
+	}
 
-	return string(decrypted), err
+	return "", err
 }
@@ -2,24 +2,82 @@ package dbtools_test
 
 import (
 	"context"
+	"encoding/base64"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gocloud.dev/secrets"
 
 	"go.hollow.sh/serverservice/internal/dbtools"
 )
 
 func TestEncryptandDecrypt(t *testing.T) {
 	ctx := context.TODO()
-	keeper := dbtools.TestSecretKeeper(t)
+	keyring := dbtools.TestKeyring(t)
 
 	secretKey := "NotARealPassword"
 
-	encrypted, err := dbtools.Encrypt(ctx, keeper, secretKey)
+	encrypted, err := dbtools.Encrypt(ctx, keyring, secretKey)
 	assert.NoError(t, err)
 	assert.NotEqual(t, secretKey, encrypted)
+	assert.Equal(t, keyring.CurrentKeyID(), dbtools.KeyID(encrypted))
 
-	decrypted, err := dbtools.Decrypt(ctx, keeper, encrypted)
+	decrypted, err := dbtools.Decrypt(ctx, keyring, encrypted)
 	assert.NoError(t, err)
 	assert.Equal(t, secretKey, decrypted)
 }
+
+func TestKeyringRotation(t *testing.T) {
+	ctx := context.TODO()
+
+	oldKeeper := newTestKeeper(t)
+	newKeeper := newTestKeeper(t)
+
+	oldRing, err := dbtools.NewKeyring("old", oldKeeper)
+	require.NoError(t, err)
+
+	oldValue, err := dbtools.Encrypt(ctx, oldRing, "old-password")
+	require.NoError(t, err)
+
+	// written before key IDs were stored with the ciphertext
+	legacyCipher, err := oldKeeper.Encrypt(ctx, []byte("legacy-password"))
+	require.NoError(t, err)
+
+	legacyValue := base64.StdEncoding.EncodeToString(legacyCipher)
+	assert.Empty(t, dbtools.KeyID(legacyValue))
+
+	newRing, err := dbtools.NewKeyring("new", newKeeper)
+	require.NoError(t, err)
+	require.NoError(t, newRing.AddPrevious("old", oldKeeper))
+
+	decrypted, err := dbtools.Decrypt(ctx, newRing, oldValue)
+	require.NoError(t, err)
+	assert.Equal(t, "old-password", decrypted)
+
+	decrypted, err = dbtools.Decrypt(ctx, newRing, legacyValue)
+	require.NoError(t, err)
+	assert.Equal(t, "legacy-password", decrypted)
+
+	newValue, err := dbtools.Encrypt(ctx, newRing, "new-password")
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(newValue, "new:"))
+
+	_, err = dbtools.Decrypt(ctx, oldRing, newValue)
+	assert.ErrorIs(t, err, dbtools.ErrUnknownKeyID)
+
+	assert.ErrorIs(t, newRing.AddPrevious("old", oldKeeper), dbtools.ErrInvalidKeyID)
+
+	_, err = dbtools.NewKeyring("not:valid", newKeeper)
+	assert.ErrorIs(t, err, dbtools.ErrInvalidKeyID)
+}
+
+func newTestKeeper(t *testing.T) *secrets.Keeper {
+	keeper, err := secrets.OpenKeeper(context.TODO(), "base64key://")
+	require.NoError(t, err)
+
+	t.Cleanup(func() { keeper.Close() })
+
+	return keeper
+}
@@ -27,6 +27,11 @@ var (
 	// Server Component Types
 	FixtureFinType *models.ServerComponentType
 
+	// Facilities the fixture servers are in
+	FixtureFacilitySydney   *models.Facility
+	FixtureFacilityOcean    *models.Facility
+	FixtureFacilityAquarium *models.Facility
+
 	FixtureNemo                  *models.Server
 	FixtureNemoMetadata          *models.Attribute
 	FixtureNemoOtherdata         *models.Attribute
@@ -97,6 +102,10 @@ func addFixtures(t *testing.T) error {
 		return err
 	}
 
+	if err := setupFacilities(ctx, testDB); err != nil {
+		return err
+	}
+
 	if err := setupNemo(ctx, testDB, t); err != nil {
 		return err
 	}
@@ -149,6 +158,20 @@ func addFixtures(t *testing.T) error {
 	return nil
 }
 
+func setupFacilities(ctx context.Context, db *sqlx.DB) error {
+	FixtureFacilitySydney = &models.Facility{Code: "Sydney", Name: null.StringFrom("Sydney Harbour")}
+	FixtureFacilityOcean = &models.Facility{Code: "Ocean", Name: null.StringFrom("Pacific Ocean")}
+	FixtureFacilityAquarium = &models.Facility{Code: "Aquarium", Name: null.StringFrom("Dentist's Aquarium")}
+
+	for _, f := range []*models.Facility{FixtureFacilitySydney, FixtureFacilityOcean, FixtureFacilityAquarium} {
+		if err := f.Insert(ctx, db, boil.Infer()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func setupNemo(ctx context.Context, db *sqlx.DB, t *testing.T) error {
 	FixtureNemo = &models.Server{
 		Name:         null.StringFrom("Nemo"),
@@ -164,9 +187,9 @@ func setupNemo(ctx context.Context, db *sqlx.DB, t *testing.T) error {
 		return err
 	}
 
-	keeper := TestSecretKeeper(t)
+	keyring := TestKeyring(t)
 
-	value, err := Encrypt(ctx, keeper, "super-secret-bmc-password")
+	value, err := Encrypt(ctx, keyring, "super-secret-bmc-password")
 	if err != nil {
 		return err
 	}
@@ -180,6 +203,13 @@ func setupNemo(ctx context.Context, db *sqlx.DB, t *testing.T) error {
 		return err
 	}
 
+	if err := FixtureNemoBMCSecret.AddServerCredentialVersions(ctx, db, true, &models.ServerCredentialVersion{
+		Version:  FixtureNemoBMCSecret.Version,
+		Password: value,
+	}); err != nil {
+		return err
+	}
+
 	FixtureNemoMetadata = &models.Attribute{
 		Namespace: FixtureNamespaceMetadata,
 		Data:      types.JSON([]byte(`{"age":6,"location":"Fishbowl"}`)),
@@ -0,0 +1,157 @@
+package dbtools
+
+import (
+	"context"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+
+	"go.hollow.sh/serverservice/internal/models"
+)
+
+// defaultRekeyBatchSize is used when RekeyCredentials isn't given a batch size
+var defaultRekeyBatchSize = 100
+
+// RekeyCredentials re-encrypts the server credentials and their previous
+// versions that aren't encrypted with the current key of the keyring,
+// batchSize credentials per transaction, and returns the number of values that
+// were re-encrypted. It can be run again after a failure, credentials already
+// under the current key are left as they are.
+func RekeyCredentials(ctx context.Context, db *sqlx.DB, keyring *Keyring, batchSize int) (int, error) {
+	if batchSize <= 0 {
+		batchSize = defaultRekeyBatchSize
+	}
+
+	rekeyed := 0
+	lastID := ""
+
+	for {
+		n, next, err := rekeyCredentialsBatch(ctx, db, keyring, lastID, batchSize)
+		rekeyed += n
+
+		if err != nil {
+			return rekeyed, err
+		}
+
+		if next == "" {
+			return rekeyed, nil
+		}
+
+		lastID = next
+	}
+}
+
+// rekeyCredentialsBatch re-encrypts the batch of credentials following
+// lastID, and returns the ID to continue after or "" when it was the last batch.
+func rekeyCredentialsBatch(ctx context.Context, db *sqlx.DB, keyring *Keyring, lastID string, batchSize int) (int, string, error) {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, "", err
+	}
+
+	// rollback is a no-op when the transaction is successful
+	// nolint:errcheck // TODO(joel): log error
+	defer tx.Rollback()
+
+	mods := []qm.QueryMod{
+		qm.OrderBy(models.ServerCredentialColumns.ID),
+		qm.Limit(batchSize),
+		qm.For("UPDATE"),
+	}
+
+	if lastID != "" {
+		mods = append(mods, models.ServerCredentialWhere.ID.GT(lastID))
+	}
+
+	creds, err := models.ServerCredentials(mods...).All(ctx, tx)
+	if err != nil {
+		return 0, "", err
+	}
+
+	rekeyed := 0
+
+	for _, cred := range creds {
+		value, changed, err := rekeyValue(ctx, keyring, cred.Password)
+		if err != nil {
+			return 0, "", err
+		}
+
+		if !changed {
+			continue
+		}
+
+		cred.Password = value
+
+		// only the ciphertext is written, updated_at keeps recording when the
+		// credential itself last changed
+		if _, err := cred.Update(ctx, tx, boil.Whitelist(models.ServerCredentialColumns.Password)); err != nil {
+			return 0, "", err
+		}
+
+		rekeyed++
+	}
+
+	// previous versions of the credentials are kept encrypted as well, they
+	// are rekeyed under the lock held on their credential
+	if len(creds) > 0 {
+		ids := make([]string, 0, len(creds))
+		for _, cred := range creds {
+			ids = append(ids, cred.ID)
+		}
+
+		versions, err := models.ServerCredentialVersions(models.ServerCredentialVersionWhere.ServerCredentialID.IN(ids)).All(ctx, tx)
+		if err != nil {
+			return 0, "", err
+		}
+
+		for _, v := range versions {
+			value, changed, err := rekeyValue(ctx, keyring, v.Password)
+			if err != nil {
+				return 0, "", err
+			}
+
+			if !changed {
+				continue
+			}
+
+			v.Password = value
+
+			if _, err := v.Update(ctx, tx, boil.Whitelist(models.ServerCredentialVersionColumns.Password)); err != nil {
+				return 0, "", err
+			}
+
+			rekeyed++
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, "", err
+	}
+
+	if len(creds) < batchSize {
+		return rekeyed, "", nil
+	}
+
+	return rekeyed, creds[len(creds)-1].ID, nil
+}
+
+// rekeyValue re-encrypts ciphertext with the current key of the keyring,
+// reporting whether it had to be re-encrypted.
+func rekeyValue(ctx context.Context, keyring *Keyring, ciphertext string) (string, bool, error) {
+	if KeyID(ciphertext) == keyring.CurrentKeyID() {
+		return ciphertext, false, nil
+	}
+
+	plain, err := Decrypt(ctx, keyring, ciphertext)
+	if err != nil {
+		return "", false, err
+	}
+
+	value, err := Encrypt(ctx, keyring, plain)
+	if err != nil {
+		return "", false, err
+	}
+
+	return value, true, nil
+}
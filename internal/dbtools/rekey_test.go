@@ -0,0 +1,82 @@
+package dbtools_test
+
+import (
+	"context"
+	"encoding/base64"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+
+	"go.hollow.sh/serverservice/internal/dbtools"
+	"go.hollow.sh/serverservice/internal/models"
+)
+
+func TestRekeyCredentials(t *testing.T) {
+	db := dbtools.DatabaseTest(t)
+	ctx := context.TODO()
+
+	oldKeeper := newTestKeeper(t)
+
+	oldRing, err := dbtools.NewKeyring("old", oldKeeper)
+	require.NoError(t, err)
+
+	_, err = models.ServerCredentials().DeleteAll(ctx, db)
+	require.NoError(t, err)
+
+	versioned, err := dbtools.Encrypt(ctx, oldRing, "nemo-password")
+	require.NoError(t, err)
+
+	legacyCipher, err := oldKeeper.Encrypt(ctx, []byte("dory-password"))
+	require.NoError(t, err)
+
+	typeID := dbtools.FixtureNemoBMCSecret.ServerCredentialTypeID
+	creds := map[string]string{
+		dbtools.FixtureNemo.ID: "nemo-password",
+		dbtools.FixtureDory.ID: "dory-password",
+	}
+
+	for srvID, password := range map[string]string{
+		dbtools.FixtureNemo.ID: versioned,
+		dbtools.FixtureDory.ID: base64.StdEncoding.EncodeToString(legacyCipher),
+	} {
+		cred := &models.ServerCredential{ServerID: srvID, ServerCredentialTypeID: typeID, Username: "root", Password: password, Version: 1}
+		require.NoError(t, cred.Insert(ctx, db, boil.Infer()))
+
+		version := &models.ServerCredentialVersion{ServerCredentialID: cred.ID, Version: 1, Username: "root", Password: password}
+		require.NoError(t, version.Insert(ctx, db, boil.Infer()))
+	}
+
+	newRing, err := dbtools.NewKeyring("new", newTestKeeper(t))
+	require.NoError(t, err)
+	require.NoError(t, newRing.AddPrevious("old", oldKeeper))
+
+	rekeyed, err := dbtools.RekeyCredentials(ctx, db, newRing, 1)
+	require.NoError(t, err)
+	assert.Equal(t, 4, rekeyed)
+
+	rekeyed, err = dbtools.RekeyCredentials(ctx, db, newRing, 1)
+	require.NoError(t, err)
+	assert.Zero(t, rekeyed)
+
+	dbCreds, err := models.ServerCredentials().All(ctx, db)
+	require.NoError(t, err)
+	require.Len(t, dbCreds, 2)
+
+	for _, cred := range dbCreds {
+		assert.Equal(t, "new", dbtools.KeyID(cred.Password))
+
+		password, err := dbtools.Decrypt(ctx, newRing, cred.Password)
+		require.NoError(t, err)
+		assert.Equal(t, creds[cred.ServerID], password)
+	}
+
+	dbVersions, err := models.ServerCredentialVersions().All(ctx, db)
+	require.NoError(t, err)
+	require.Len(t, dbVersions, 2)
+
+	for _, v := range dbVersions {
+		assert.Equal(t, "new", dbtools.KeyID(v.Password))
+	}
+}
@@ -29,7 +29,7 @@ import (
 // TestDBURI is the URI for the test database
 var TestDBURI = os.Getenv("SERVERSERVICE_CRDB_URI")
 var testDB *sqlx.DB
-var testKeeper *secrets.Keeper
+var testKeyring *Keyring
 
 func testDatastore(t *testing.T) error {
 	// don't setup the datastore if we already have one
@@ -54,19 +54,22 @@ func testDatastore(t *testing.T) error {
 	return addFixtures(t)
 }
 
-// TestSecretKeeper will return the secret keeper we are using for this test run. This allows
+// TestKeyring will return the keyring we are using for this test run. This allows
 // use to use the same one for the entire test run so the secrets are able to be decrypted.
-func TestSecretKeeper(t *testing.T) *secrets.Keeper {
-	if testKeeper != nil {
-		return testKeeper
+func TestKeyring(t *testing.T) *Keyring {
+	if testKeyring != nil {
+		return testKeyring
 	}
 
 	keeper, err := secrets.OpenKeeper(context.TODO(), "base64key://")
 	require.NoError(t, err)
 
-	testKeeper = keeper
+	keyring, err := NewKeyring("test", keeper)
+	require.NoError(t, err)
+
+	testKeyring = keyring
 
-	return keeper
+	return keyring
 }
 
 // DatabaseTest allows you to run tests that interact with the database
@@ -109,6 +112,7 @@ func cleanDB(t *testing.T) {
 	if _, err := models.Servers(qm.WithDeleted()).DeleteAll(ctx, testDB, true); err != nil {
 		t.Error(errors.Wrap(err, "table: model.Servers"))
 	}
+	deleteFixture(ctx, t, models.FirmwareSetAssignments())
 	deleteFixture(ctx, t, models.AttributesFirmwareSets())
 	deleteFixture(ctx, t, models.ComponentFirmwareSets())
 	deleteFixture(ctx, t, models.ComponentFirmwareSetMaps())
@@ -119,6 +123,18 @@ func cleanDB(t *testing.T) {
 	deleteFixture(ctx, t, models.AocMacAddresses())
 	deleteFixture(ctx, t, models.BMCMacAddresses())
 	deleteFixture(ctx, t, models.BomInfos())
+	deleteFixture(ctx, t, models.EventOutboxes())
+	deleteFixture(ctx, t, models.EventOutboxDeadLetters())
+	deleteFixture(ctx, t, models.AuditEvents())
+	deleteFixture(ctx, t, models.ServerHistories())
+	deleteFixture(ctx, t, models.ServerLeases())
+	deleteFixture(ctx, t, models.MaintenanceWindowServers())
+	deleteFixture(ctx, t, models.MaintenanceWindows())
+	deleteFixture(ctx, t, models.ServerPlacements())
+	deleteFixture(ctx, t, models.Racks())
+	deleteFixture(ctx, t, models.RackRows())
+	deleteFixture(ctx, t, models.Rooms())
+	deleteFixture(ctx, t, models.Facilities())
 
 	testDB.Exec("SET sql_safe_updates = true;")
 }
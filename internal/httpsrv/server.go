@@ -10,14 +10,13 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/jmoiron/sqlx"
 	ginprometheus "github.com/zsais/go-gin-prometheus"
-	"go.hollow.sh/toolbox/events"
 	"go.hollow.sh/toolbox/ginjwt"
 	"go.infratographer.com/x/versionx"
 	"go.opentelemetry.io/contrib/instrumentation/github.com/gin-gonic/gin/otelgin"
 	"go.opentelemetry.io/otel"
 	"go.uber.org/zap"
-	"gocloud.dev/secrets"
 
+	"go.hollow.sh/serverservice/internal/dbtools"
 	v1api "go.hollow.sh/serverservice/pkg/api/v1"
 )
 
@@ -28,8 +27,14 @@ type Server struct {
 	Debug         bool
 	DB            *sqlx.DB
 	AuthConfig    ginjwt.AuthConfig
-	SecretsKeeper *secrets.Keeper
-	EventStream   events.Stream
+	Keyring       *dbtools.Keyring
+	RecordHistory bool
+	// Lifecycle is the graph of states servers move through, nil uses the
+	// default lifecycle
+	Lifecycle *v1api.ServerLifecycle
+	// EventsEnabled writes events to the outbox, set when NATS is
+	// configured whether or not it's reachable
+	EventsEnabled bool
 }
 
 var (
@@ -54,7 +59,7 @@ func (s *Server) setup() *gin.Engine {
 
 	r.Use(cors.New(cors.Config{
 		AllowMethods:     []string{"GET", "POST", "PUT", "PATCH", "DELETE", "HEAD"},
-		AllowHeaders:     []string{"Origin", "Content-Length", "Content-Type", "Authorization"},
+		AllowHeaders:     []string{"Origin", "Content-Length", "Content-Type", "Authorization", v1api.AccessJustificationHeader, v1api.ServerLeaseHolderHeader},
 		AllowAllOrigins:  true,
 		AllowCredentials: true,
 		MaxAge:           corsMaxAge,
@@ -65,9 +70,11 @@ func (s *Server) setup() *gin.Engine {
 	v1Rtr := v1api.Router{
 		DB:            s.DB,
 		AuthMW:        authMW,
-		SecretsKeeper: s.SecretsKeeper,
+		Keyring:       s.Keyring,
+		RecordHistory: s.RecordHistory,
+		Lifecycle:     s.Lifecycle,
+		EventsEnabled: s.EventsEnabled,
 		Logger:        s.Logger,
-		EventStream:   s.EventStream,
 	}
 
 	// Remove any params from the URL string to keep the number of labels down
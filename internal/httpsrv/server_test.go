@@ -32,6 +32,23 @@ func TestUnknownRoute(t *testing.T) {
 	assert.Equal(t, `{"message":"invalid request - route not found"}`, w.Body.String())
 }
 
+func TestCORSPreflightHeaders(t *testing.T) {
+	hs := httpsrv.Server{Logger: zap.NewNop(), AuthConfig: serverAuthConfig}
+	s := hs.NewServer()
+	router := s.Handler
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequestWithContext(context.TODO(), "OPTIONS", "/api/v1/servers", nil)
+	req.Header.Set("Origin", "https://hollow.example.com")
+	req.Header.Set("Access-Control-Request-Method", "PUT")
+	req.Header.Set("Access-Control-Request-Headers", "X-Access-Justification, X-Server-Lease-Holder")
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusNoContent, w.Code)
+	assert.Contains(t, w.Header().Get("Access-Control-Allow-Headers"), "X-Access-Justification")
+	assert.Contains(t, w.Header().Get("Access-Control-Allow-Headers"), "X-Server-Lease-Holder")
+}
+
 func TestHealthzRoute(t *testing.T) {
 	hs := httpsrv.Server{Logger: zap.NewNop(), AuthConfig: serverAuthConfig}
 	s := hs.NewServer()
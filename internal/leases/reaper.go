@@ -0,0 +1,56 @@
+// Package leases removes expired server leases. The API ignores a lease once
+// it expires, the Reaper deletes those leases so they don't pile up for
+// servers nobody acquires again.
+package leases // import "go.hollow.sh/serverservice/internal/leases"
+
+import (
+	"context"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"go.uber.org/zap"
+
+	"go.hollow.sh/serverservice/internal/models"
+)
+
+var defaultInterval = 1 * time.Minute
+
+// Reaper periodically deletes expired server leases
+type Reaper struct {
+	DB       *sqlx.DB
+	Logger   *zap.Logger
+	Interval time.Duration
+}
+
+// Run reaps expired leases every interval until the context is canceled
+func (r *Reaper) Run(ctx context.Context) {
+	interval := r.Interval
+	if interval <= 0 {
+		interval = defaultInterval
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		reaped, err := r.Reap(ctx)
+		if err != nil && ctx.Err() == nil {
+			r.Logger.With(zap.Error(err)).Error("unable to reap expired server leases")
+		}
+
+		if reaped > 0 {
+			r.Logger.Debug("reaped expired server leases", zap.Int64("count", reaped))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Reap deletes the leases that expired and returns how many were deleted
+func (r *Reaper) Reap(ctx context.Context) (int64, error) {
+	return models.ServerLeases(models.ServerLeaseWhere.ExpiresAt.LTE(time.Now())).DeleteAll(ctx, r.DB)
+}
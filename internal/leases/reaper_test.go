@@ -0,0 +1,44 @@
+package leases_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"go.uber.org/zap"
+
+	"go.hollow.sh/serverservice/internal/dbtools"
+	"go.hollow.sh/serverservice/internal/leases"
+	"go.hollow.sh/serverservice/internal/models"
+)
+
+func TestIntegrationReaperReap(t *testing.T) {
+	db := dbtools.DatabaseTest(t)
+	ctx := context.TODO()
+
+	lease := func(serverID, holder string, expiresAt time.Time) {
+		l := &models.ServerLease{ServerID: serverID, Holder: holder, ExpiresAt: expiresAt}
+		require.NoError(t, l.Insert(ctx, db, boil.Infer()))
+	}
+
+	lease(dbtools.FixtureNemo.ID, "firmware-installer", time.Now().Add(-time.Minute))
+	lease(dbtools.FixtureDory.ID, "provisioner", time.Now().Add(time.Hour))
+
+	reaper := &leases.Reaper{DB: db, Logger: zap.NewNop()}
+
+	reaped, err := reaper.Reap(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), reaped)
+
+	remaining, err := models.ServerLeases().All(ctx, db)
+	require.NoError(t, err)
+	require.Len(t, remaining, 1)
+	assert.Equal(t, dbtools.FixtureDory.ID, remaining[0].ServerID)
+
+	reaped, err = reaper.Reap(ctx)
+	require.NoError(t, err)
+	assert.Zero(t, reaped)
+}
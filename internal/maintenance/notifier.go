@@ -0,0 +1,129 @@
+// Package maintenance sends the events for the start and end of maintenance
+// windows. The events are written to the event outbox when a window starts or
+// ends and the window records it so each event is sent once, even when several
+// instances of the service are running.
+package maintenance // import "go.hollow.sh/serverservice/internal/maintenance"
+
+import (
+	"context"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"go.uber.org/zap"
+
+	"go.hollow.sh/serverservice/internal/models"
+	"go.hollow.sh/serverservice/internal/outbox"
+	serverservice "go.hollow.sh/serverservice/pkg/api/v1"
+)
+
+var defaultInterval = 30 * time.Second
+
+// Notifier periodically enqueues the events for the maintenance windows that
+// started or ended since it last ran. Without EventsEnabled nothing is done,
+// the windows are left unmarked so their events are sent once events are
+// configured.
+type Notifier struct {
+	DB            *sqlx.DB
+	Logger        *zap.Logger
+	Interval      time.Duration
+	EventsEnabled bool
+}
+
+// Run enqueues events every interval until the context is canceled
+func (n *Notifier) Run(ctx context.Context) {
+	interval := n.Interval
+	if interval <= 0 {
+		interval = defaultInterval
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if err := n.Notify(ctx); err != nil && ctx.Err() == nil {
+			n.Logger.With(zap.Error(err)).Error("unable to enqueue maintenance window events")
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Notify enqueues the events for the windows that started or ended. A window
+// that started and ended since the last run gets both events, in order.
+func (n *Notifier) Notify(ctx context.Context) error {
+	if !n.EventsEnabled {
+		return nil
+	}
+
+	now := time.Now()
+
+	if err := n.notify(ctx, serverservice.SubjectMaintenanceWindowStart, models.MaintenanceWindowColumns.StartedEventAt, now,
+		models.MaintenanceWindowWhere.StartedEventAt.IsNull(),
+		models.MaintenanceWindowWhere.StartsAt.LTE(now),
+	); err != nil {
+		return err
+	}
+
+	return n.notify(ctx, serverservice.SubjectMaintenanceWindowEnd, models.MaintenanceWindowColumns.EndedEventAt, now,
+		models.MaintenanceWindowWhere.StartedEventAt.IsNotNull(),
+		models.MaintenanceWindowWhere.EndedEventAt.IsNull(),
+		models.MaintenanceWindowWhere.EndsAt.LTE(now),
+	)
+}
+
+// notify enqueues an event with the subject for each of the windows matching
+// mods and records it in column. The windows are locked so concurrent runs
+// don't enqueue the same event twice.
+func (n *Notifier) notify(ctx context.Context, subject, column string, now time.Time, mods ...qm.QueryMod) error {
+	tx, err := n.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	// nolint:errcheck // rollback is a no-op when the transaction is successful
+	defer tx.Rollback()
+
+	mods = append(mods,
+		qm.Load(models.MaintenanceWindowRels.MaintenanceWindowServers),
+		qm.OrderBy(models.MaintenanceWindowColumns.StartsAt+" ASC"),
+		qm.For("UPDATE"),
+	)
+
+	windows, err := models.MaintenanceWindows(mods...).All(ctx, tx)
+	if err != nil || len(windows) == 0 {
+		return err
+	}
+
+	if err := enqueue(ctx, tx, subject, windows); err != nil {
+		return err
+	}
+
+	if _, err := windows.UpdateAll(ctx, tx, models.M{column: now}); err != nil {
+		return err
+	}
+
+	n.Logger.Debug("enqueued maintenance window events", zap.String("subject", subject), zap.Int("count", len(windows)))
+
+	return tx.Commit()
+}
+
+func enqueue(ctx context.Context, exec boil.ContextExecutor, subject string, windows models.MaintenanceWindowSlice) error {
+	for _, w := range windows {
+		payload, err := serverservice.NewMaintenanceWindowMessage(w)
+		if err != nil {
+			return err
+		}
+
+		if err := outbox.Enqueue(ctx, exec, subject, w.ID, payload); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,97 @@
+package maintenance_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"go.uber.org/zap"
+
+	"go.hollow.sh/serverservice/internal/dbtools"
+	"go.hollow.sh/serverservice/internal/maintenance"
+	"go.hollow.sh/serverservice/internal/models"
+	serverservice "go.hollow.sh/serverservice/pkg/api/v1"
+)
+
+func TestIntegrationNotifierNotify(t *testing.T) {
+	db := dbtools.DatabaseTest(t)
+	ctx := context.TODO()
+
+	window := func(startsAt, endsAt time.Time) *models.MaintenanceWindow {
+		w := &models.MaintenanceWindow{
+			Reason:       "BMC update",
+			StartsAt:     startsAt,
+			EndsAt:       endsAt,
+			FacilityCode: null.StringFrom("Ocean"),
+		}
+		require.NoError(t, w.Insert(ctx, db, boil.Infer()))
+
+		return w
+	}
+
+	ended := window(time.Now().Add(-2*time.Hour), time.Now().Add(-time.Hour))
+	active := window(time.Now().Add(-time.Minute), time.Now().Add(time.Hour))
+	upcoming := window(time.Now().Add(time.Hour), time.Now().Add(2*time.Hour))
+
+	notifier := &maintenance.Notifier{DB: db, Logger: zap.NewNop(), EventsEnabled: true}
+
+	// a second pass doesn't send the events again
+	require.NoError(t, notifier.Notify(ctx))
+	require.NoError(t, notifier.Notify(ctx))
+
+	subjects := func(w *models.MaintenanceWindow) []string {
+		evts, err := models.EventOutboxes(models.EventOutboxWhere.PartitionKey.EQ(w.ID), qm.OrderBy("seq ASC")).All(ctx, db)
+		require.NoError(t, err)
+
+		s := []string{}
+		for _, e := range evts {
+			s = append(s, e.Subject)
+		}
+
+		return s
+	}
+
+	assert.Equal(t, []string{serverservice.SubjectMaintenanceWindowStart, serverservice.SubjectMaintenanceWindowEnd}, subjects(ended))
+	assert.Equal(t, []string{serverservice.SubjectMaintenanceWindowStart}, subjects(active))
+	assert.Empty(t, subjects(upcoming))
+
+	require.NoError(t, active.Reload(ctx, db))
+	assert.True(t, active.StartedEventAt.Valid)
+	assert.False(t, active.EndedEventAt.Valid)
+}
+
+func TestIntegrationNotifierNotifyEventsDisabled(t *testing.T) {
+	db := dbtools.DatabaseTest(t)
+	ctx := context.TODO()
+
+	w := &models.MaintenanceWindow{
+		Reason:       "BMC update",
+		StartsAt:     time.Now().Add(-time.Minute),
+		EndsAt:       time.Now().Add(time.Hour),
+		FacilityCode: null.StringFrom("Ocean"),
+	}
+	require.NoError(t, w.Insert(ctx, db, boil.Infer()))
+
+	notifier := &maintenance.Notifier{DB: db, Logger: zap.NewNop()}
+	require.NoError(t, notifier.Notify(ctx))
+
+	count, err := models.EventOutboxes().Count(ctx, db)
+	require.NoError(t, err)
+	assert.Zero(t, count)
+
+	// the window isn't marked so its start is sent once events are enabled
+	require.NoError(t, w.Reload(ctx, db))
+	assert.False(t, w.StartedEventAt.Valid)
+
+	notifier.EventsEnabled = true
+	require.NoError(t, notifier.Notify(ctx))
+
+	count, err = models.EventOutboxes(models.EventOutboxWhere.Subject.EQ(serverservice.SubjectMaintenanceWindowStart)).Count(ctx, db)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), count)
+}
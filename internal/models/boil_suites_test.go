@@ -15,15 +15,30 @@ func TestParent(t *testing.T) {
 	t.Run("AocMacAddresses", testAocMacAddresses)
 	t.Run("Attributes", testAttributes)
 	t.Run("AttributesFirmwareSets", testAttributesFirmwareSets)
+	t.Run("AuditEvents", testAuditEvents)
 	t.Run("BMCMacAddresses", testBMCMacAddresses)
 	t.Run("BomInfos", testBomInfos)
 	t.Run("ComponentFirmwareSets", testComponentFirmwareSets)
 	t.Run("ComponentFirmwareSetMaps", testComponentFirmwareSetMaps)
 	t.Run("ComponentFirmwareVersions", testComponentFirmwareVersions)
+	t.Run("EventOutboxes", testEventOutboxes)
+	t.Run("EventOutboxDeadLetters", testEventOutboxDeadLetters)
+	t.Run("Facilities", testFacilities)
+	t.Run("FirmwareSetAssignments", testFirmwareSetAssignments)
+	t.Run("MaintenanceWindowServers", testMaintenanceWindowServers)
+	t.Run("MaintenanceWindows", testMaintenanceWindows)
+	t.Run("RackRows", testRackRows)
+	t.Run("Racks", testRacks)
+	t.Run("Rooms", testRooms)
 	t.Run("ServerComponentTypes", testServerComponentTypes)
 	t.Run("ServerComponents", testServerComponents)
 	t.Run("ServerCredentialTypes", testServerCredentialTypes)
+	t.Run("ServerCredentialVersions", testServerCredentialVersions)
 	t.Run("ServerCredentials", testServerCredentials)
+	t.Run("ServerHistories", testServerHistories)
+	t.Run("ServerLeases", testServerLeases)
+	t.Run("ServerPlacements", testServerPlacements)
+	t.Run("ServerStateTransitions", testServerStateTransitions)
 	t.Run("Servers", testServers)
 	t.Run("VersionedAttributes", testVersionedAttributes)
 }
@@ -44,15 +59,30 @@ func TestDelete(t *testing.T) {
 	t.Run("AocMacAddresses", testAocMacAddressesDelete)
 	t.Run("Attributes", testAttributesDelete)
 	t.Run("AttributesFirmwareSets", testAttributesFirmwareSetsDelete)
+	t.Run("AuditEvents", testAuditEventsDelete)
 	t.Run("BMCMacAddresses", testBMCMacAddressesDelete)
 	t.Run("BomInfos", testBomInfosDelete)
 	t.Run("ComponentFirmwareSets", testComponentFirmwareSetsDelete)
 	t.Run("ComponentFirmwareSetMaps", testComponentFirmwareSetMapsDelete)
 	t.Run("ComponentFirmwareVersions", testComponentFirmwareVersionsDelete)
+	t.Run("EventOutboxes", testEventOutboxesDelete)
+	t.Run("EventOutboxDeadLetters", testEventOutboxDeadLettersDelete)
+	t.Run("Facilities", testFacilitiesDelete)
+	t.Run("FirmwareSetAssignments", testFirmwareSetAssignmentsDelete)
+	t.Run("MaintenanceWindowServers", testMaintenanceWindowServersDelete)
+	t.Run("MaintenanceWindows", testMaintenanceWindowsDelete)
+	t.Run("RackRows", testRackRowsDelete)
+	t.Run("Racks", testRacksDelete)
+	t.Run("Rooms", testRoomsDelete)
 	t.Run("ServerComponentTypes", testServerComponentTypesDelete)
 	t.Run("ServerComponents", testServerComponentsDelete)
 	t.Run("ServerCredentialTypes", testServerCredentialTypesDelete)
+	t.Run("ServerCredentialVersions", testServerCredentialVersionsDelete)
 	t.Run("ServerCredentials", testServerCredentialsDelete)
+	t.Run("ServerHistories", testServerHistoriesDelete)
+	t.Run("ServerLeases", testServerLeasesDelete)
+	t.Run("ServerPlacements", testServerPlacementsDelete)
+	t.Run("ServerStateTransitions", testServerStateTransitionsDelete)
 	t.Run("Servers", testServersDelete)
 	t.Run("VersionedAttributes", testVersionedAttributesDelete)
 }
@@ -61,15 +91,30 @@ func TestQueryDeleteAll(t *testing.T) {
 	t.Run("AocMacAddresses", testAocMacAddressesQueryDeleteAll)
 	t.Run("Attributes", testAttributesQueryDeleteAll)
 	t.Run("AttributesFirmwareSets", testAttributesFirmwareSetsQueryDeleteAll)
+	t.Run("AuditEvents", testAuditEventsQueryDeleteAll)
 	t.Run("BMCMacAddresses", testBMCMacAddressesQueryDeleteAll)
 	t.Run("BomInfos", testBomInfosQueryDeleteAll)
 	t.Run("ComponentFirmwareSets", testComponentFirmwareSetsQueryDeleteAll)
 	t.Run("ComponentFirmwareSetMaps", testComponentFirmwareSetMapsQueryDeleteAll)
 	t.Run("ComponentFirmwareVersions", testComponentFirmwareVersionsQueryDeleteAll)
+	t.Run("EventOutboxes", testEventOutboxesQueryDeleteAll)
+	t.Run("EventOutboxDeadLetters", testEventOutboxDeadLettersQueryDeleteAll)
+	t.Run("Facilities", testFacilitiesQueryDeleteAll)
+	t.Run("FirmwareSetAssignments", testFirmwareSetAssignmentsQueryDeleteAll)
+	t.Run("MaintenanceWindowServers", testMaintenanceWindowServersQueryDeleteAll)
+	t.Run("MaintenanceWindows", testMaintenanceWindowsQueryDeleteAll)
+	t.Run("RackRows", testRackRowsQueryDeleteAll)
+	t.Run("Racks", testRacksQueryDeleteAll)
+	t.Run("Rooms", testRoomsQueryDeleteAll)
 	t.Run("ServerComponentTypes", testServerComponentTypesQueryDeleteAll)
 	t.Run("ServerComponents", testServerComponentsQueryDeleteAll)
 	t.Run("ServerCredentialTypes", testServerCredentialTypesQueryDeleteAll)
+	t.Run("ServerCredentialVersions", testServerCredentialVersionsQueryDeleteAll)
 	t.Run("ServerCredentials", testServerCredentialsQueryDeleteAll)
+	t.Run("ServerHistories", testServerHistoriesQueryDeleteAll)
+	t.Run("ServerLeases", testServerLeasesQueryDeleteAll)
+	t.Run("ServerPlacements", testServerPlacementsQueryDeleteAll)
+	t.Run("ServerStateTransitions", testServerStateTransitionsQueryDeleteAll)
 	t.Run("Servers", testServersQueryDeleteAll)
 	t.Run("VersionedAttributes", testVersionedAttributesQueryDeleteAll)
 }
@@ -78,15 +123,30 @@ func TestSliceDeleteAll(t *testing.T) {
 	t.Run("AocMacAddresses", testAocMacAddressesSliceDeleteAll)
 	t.Run("Attributes", testAttributesSliceDeleteAll)
 	t.Run("AttributesFirmwareSets", testAttributesFirmwareSetsSliceDeleteAll)
+	t.Run("AuditEvents", testAuditEventsSliceDeleteAll)
 	t.Run("BMCMacAddresses", testBMCMacAddressesSliceDeleteAll)
 	t.Run("BomInfos", testBomInfosSliceDeleteAll)
 	t.Run("ComponentFirmwareSets", testComponentFirmwareSetsSliceDeleteAll)
 	t.Run("ComponentFirmwareSetMaps", testComponentFirmwareSetMapsSliceDeleteAll)
 	t.Run("ComponentFirmwareVersions", testComponentFirmwareVersionsSliceDeleteAll)
+	t.Run("EventOutboxes", testEventOutboxesSliceDeleteAll)
+	t.Run("EventOutboxDeadLetters", testEventOutboxDeadLettersSliceDeleteAll)
+	t.Run("Facilities", testFacilitiesSliceDeleteAll)
+	t.Run("FirmwareSetAssignments", testFirmwareSetAssignmentsSliceDeleteAll)
+	t.Run("MaintenanceWindowServers", testMaintenanceWindowServersSliceDeleteAll)
+	t.Run("MaintenanceWindows", testMaintenanceWindowsSliceDeleteAll)
+	t.Run("RackRows", testRackRowsSliceDeleteAll)
+	t.Run("Racks", testRacksSliceDeleteAll)
+	t.Run("Rooms", testRoomsSliceDeleteAll)
 	t.Run("ServerComponentTypes", testServerComponentTypesSliceDeleteAll)
 	t.Run("ServerComponents", testServerComponentsSliceDeleteAll)
 	t.Run("ServerCredentialTypes", testServerCredentialTypesSliceDeleteAll)
+	t.Run("ServerCredentialVersions", testServerCredentialVersionsSliceDeleteAll)
 	t.Run("ServerCredentials", testServerCredentialsSliceDeleteAll)
+	t.Run("ServerHistories", testServerHistoriesSliceDeleteAll)
+	t.Run("ServerLeases", testServerLeasesSliceDeleteAll)
+	t.Run("ServerPlacements", testServerPlacementsSliceDeleteAll)
+	t.Run("ServerStateTransitions", testServerStateTransitionsSliceDeleteAll)
 	t.Run("Servers", testServersSliceDeleteAll)
 	t.Run("VersionedAttributes", testVersionedAttributesSliceDeleteAll)
 }
@@ -95,15 +155,30 @@ func TestExists(t *testing.T) {
 	t.Run("AocMacAddresses", testAocMacAddressesExists)
 	t.Run("Attributes", testAttributesExists)
 	t.Run("AttributesFirmwareSets", testAttributesFirmwareSetsExists)
+	t.Run("AuditEvents", testAuditEventsExists)
 	t.Run("BMCMacAddresses", testBMCMacAddressesExists)
 	t.Run("BomInfos", testBomInfosExists)
 	t.Run("ComponentFirmwareSets", testComponentFirmwareSetsExists)
 	t.Run("ComponentFirmwareSetMaps", testComponentFirmwareSetMapsExists)
 	t.Run("ComponentFirmwareVersions", testComponentFirmwareVersionsExists)
+	t.Run("EventOutboxes", testEventOutboxesExists)
+	t.Run("EventOutboxDeadLetters", testEventOutboxDeadLettersExists)
+	t.Run("Facilities", testFacilitiesExists)
+	t.Run("FirmwareSetAssignments", testFirmwareSetAssignmentsExists)
+	t.Run("MaintenanceWindowServers", testMaintenanceWindowServersExists)
+	t.Run("MaintenanceWindows", testMaintenanceWindowsExists)
+	t.Run("RackRows", testRackRowsExists)
+	t.Run("Racks", testRacksExists)
+	t.Run("Rooms", testRoomsExists)
 	t.Run("ServerComponentTypes", testServerComponentTypesExists)
 	t.Run("ServerComponents", testServerComponentsExists)
 	t.Run("ServerCredentialTypes", testServerCredentialTypesExists)
+	t.Run("ServerCredentialVersions", testServerCredentialVersionsExists)
 	t.Run("ServerCredentials", testServerCredentialsExists)
+	t.Run("ServerHistories", testServerHistoriesExists)
+	t.Run("ServerLeases", testServerLeasesExists)
+	t.Run("ServerPlacements", testServerPlacementsExists)
+	t.Run("ServerStateTransitions", testServerStateTransitionsExists)
 	t.Run("Servers", testServersExists)
 	t.Run("VersionedAttributes", testVersionedAttributesExists)
 }
@@ -112,15 +187,30 @@ func TestFind(t *testing.T) {
 	t.Run("AocMacAddresses", testAocMacAddressesFind)
 	t.Run("Attributes", testAttributesFind)
 	t.Run("AttributesFirmwareSets", testAttributesFirmwareSetsFind)
+	t.Run("AuditEvents", testAuditEventsFind)
 	t.Run("BMCMacAddresses", testBMCMacAddressesFind)
 	t.Run("BomInfos", testBomInfosFind)
 	t.Run("ComponentFirmwareSets", testComponentFirmwareSetsFind)
 	t.Run("ComponentFirmwareSetMaps", testComponentFirmwareSetMapsFind)
 	t.Run("ComponentFirmwareVersions", testComponentFirmwareVersionsFind)
+	t.Run("EventOutboxes", testEventOutboxesFind)
+	t.Run("EventOutboxDeadLetters", testEventOutboxDeadLettersFind)
+	t.Run("Facilities", testFacilitiesFind)
+	t.Run("FirmwareSetAssignments", testFirmwareSetAssignmentsFind)
+	t.Run("MaintenanceWindowServers", testMaintenanceWindowServersFind)
+	t.Run("MaintenanceWindows", testMaintenanceWindowsFind)
+	t.Run("RackRows", testRackRowsFind)
+	t.Run("Racks", testRacksFind)
+	t.Run("Rooms", testRoomsFind)
 	t.Run("ServerComponentTypes", testServerComponentTypesFind)
 	t.Run("ServerComponents", testServerComponentsFind)
 	t.Run("ServerCredentialTypes", testServerCredentialTypesFind)
+	t.Run("ServerCredentialVersions", testServerCredentialVersionsFind)
 	t.Run("ServerCredentials", testServerCredentialsFind)
+	t.Run("ServerHistories", testServerHistoriesFind)
+	t.Run("ServerLeases", testServerLeasesFind)
+	t.Run("ServerPlacements", testServerPlacementsFind)
+	t.Run("ServerStateTransitions", testServerStateTransitionsFind)
 	t.Run("Servers", testServersFind)
 	t.Run("VersionedAttributes", testVersionedAttributesFind)
 }
@@ -129,15 +219,30 @@ func TestBind(t *testing.T) {
 	t.Run("AocMacAddresses", testAocMacAddressesBind)
 	t.Run("Attributes", testAttributesBind)
 	t.Run("AttributesFirmwareSets", testAttributesFirmwareSetsBind)
+	t.Run("AuditEvents", testAuditEventsBind)
 	t.Run("BMCMacAddresses", testBMCMacAddressesBind)
 	t.Run("BomInfos", testBomInfosBind)
 	t.Run("ComponentFirmwareSets", testComponentFirmwareSetsBind)
 	t.Run("ComponentFirmwareSetMaps", testComponentFirmwareSetMapsBind)
 	t.Run("ComponentFirmwareVersions", testComponentFirmwareVersionsBind)
+	t.Run("EventOutboxes", testEventOutboxesBind)
+	t.Run("EventOutboxDeadLetters", testEventOutboxDeadLettersBind)
+	t.Run("Facilities", testFacilitiesBind)
+	t.Run("FirmwareSetAssignments", testFirmwareSetAssignmentsBind)
+	t.Run("MaintenanceWindowServers", testMaintenanceWindowServersBind)
+	t.Run("MaintenanceWindows", testMaintenanceWindowsBind)
+	t.Run("RackRows", testRackRowsBind)
+	t.Run("Racks", testRacksBind)
+	t.Run("Rooms", testRoomsBind)
 	t.Run("ServerComponentTypes", testServerComponentTypesBind)
 	t.Run("ServerComponents", testServerComponentsBind)
 	t.Run("ServerCredentialTypes", testServerCredentialTypesBind)
+	t.Run("ServerCredentialVersions", testServerCredentialVersionsBind)
 	t.Run("ServerCredentials", testServerCredentialsBind)
+	t.Run("ServerHistories", testServerHistoriesBind)
+	t.Run("ServerLeases", testServerLeasesBind)
+	t.Run("ServerPlacements", testServerPlacementsBind)
+	t.Run("ServerStateTransitions", testServerStateTransitionsBind)
 	t.Run("Servers", testServersBind)
 	t.Run("VersionedAttributes", testVersionedAttributesBind)
 }
@@ -146,15 +251,30 @@ func TestOne(t *testing.T) {
 	t.Run("AocMacAddresses", testAocMacAddressesOne)
 	t.Run("Attributes", testAttributesOne)
 	t.Run("AttributesFirmwareSets", testAttributesFirmwareSetsOne)
+	t.Run("AuditEvents", testAuditEventsOne)
 	t.Run("BMCMacAddresses", testBMCMacAddressesOne)
 	t.Run("BomInfos", testBomInfosOne)
 	t.Run("ComponentFirmwareSets", testComponentFirmwareSetsOne)
 	t.Run("ComponentFirmwareSetMaps", testComponentFirmwareSetMapsOne)
 	t.Run("ComponentFirmwareVersions", testComponentFirmwareVersionsOne)
+	t.Run("EventOutboxes", testEventOutboxesOne)
+	t.Run("EventOutboxDeadLetters", testEventOutboxDeadLettersOne)
+	t.Run("Facilities", testFacilitiesOne)
+	t.Run("FirmwareSetAssignments", testFirmwareSetAssignmentsOne)
+	t.Run("MaintenanceWindowServers", testMaintenanceWindowServersOne)
+	t.Run("MaintenanceWindows", testMaintenanceWindowsOne)
+	t.Run("RackRows", testRackRowsOne)
+	t.Run("Racks", testRacksOne)
+	t.Run("Rooms", testRoomsOne)
 	t.Run("ServerComponentTypes", testServerComponentTypesOne)
 	t.Run("ServerComponents", testServerComponentsOne)
 	t.Run("ServerCredentialTypes", testServerCredentialTypesOne)
+	t.Run("ServerCredentialVersions", testServerCredentialVersionsOne)
 	t.Run("ServerCredentials", testServerCredentialsOne)
+	t.Run("ServerHistories", testServerHistoriesOne)
+	t.Run("ServerLeases", testServerLeasesOne)
+	t.Run("ServerPlacements", testServerPlacementsOne)
+	t.Run("ServerStateTransitions", testServerStateTransitionsOne)
 	t.Run("Servers", testServersOne)
 	t.Run("VersionedAttributes", testVersionedAttributesOne)
 }
@@ -163,15 +283,30 @@ func TestAll(t *testing.T) {
 	t.Run("AocMacAddresses", testAocMacAddressesAll)
 	t.Run("Attributes", testAttributesAll)
 	t.Run("AttributesFirmwareSets", testAttributesFirmwareSetsAll)
+	t.Run("AuditEvents", testAuditEventsAll)
 	t.Run("BMCMacAddresses", testBMCMacAddressesAll)
 	t.Run("BomInfos", testBomInfosAll)
 	t.Run("ComponentFirmwareSets", testComponentFirmwareSetsAll)
 	t.Run("ComponentFirmwareSetMaps", testComponentFirmwareSetMapsAll)
 	t.Run("ComponentFirmwareVersions", testComponentFirmwareVersionsAll)
+	t.Run("EventOutboxes", testEventOutboxesAll)
+	t.Run("EventOutboxDeadLetters", testEventOutboxDeadLettersAll)
+	t.Run("Facilities", testFacilitiesAll)
+	t.Run("FirmwareSetAssignments", testFirmwareSetAssignmentsAll)
+	t.Run("MaintenanceWindowServers", testMaintenanceWindowServersAll)
+	t.Run("MaintenanceWindows", testMaintenanceWindowsAll)
+	t.Run("RackRows", testRackRowsAll)
+	t.Run("Racks", testRacksAll)
+	t.Run("Rooms", testRoomsAll)
 	t.Run("ServerComponentTypes", testServerComponentTypesAll)
 	t.Run("ServerComponents", testServerComponentsAll)
 	t.Run("ServerCredentialTypes", testServerCredentialTypesAll)
+	t.Run("ServerCredentialVersions", testServerCredentialVersionsAll)
 	t.Run("ServerCredentials", testServerCredentialsAll)
+	t.Run("ServerHistories", testServerHistoriesAll)
+	t.Run("ServerLeases", testServerLeasesAll)
+	t.Run("ServerPlacements", testServerPlacementsAll)
+	t.Run("ServerStateTransitions", testServerStateTransitionsAll)
 	t.Run("Servers", testServersAll)
 	t.Run("VersionedAttributes", testVersionedAttributesAll)
 }
@@ -180,15 +315,30 @@ func TestCount(t *testing.T) {
 	t.Run("AocMacAddresses", testAocMacAddressesCount)
 	t.Run("Attributes", testAttributesCount)
 	t.Run("AttributesFirmwareSets", testAttributesFirmwareSetsCount)
+	t.Run("AuditEvents", testAuditEventsCount)
 	t.Run("BMCMacAddresses", testBMCMacAddressesCount)
 	t.Run("BomInfos", testBomInfosCount)
 	t.Run("ComponentFirmwareSets", testComponentFirmwareSetsCount)
 	t.Run("ComponentFirmwareSetMaps", testComponentFirmwareSetMapsCount)
 	t.Run("ComponentFirmwareVersions", testComponentFirmwareVersionsCount)
+	t.Run("EventOutboxes", testEventOutboxesCount)
+	t.Run("EventOutboxDeadLetters", testEventOutboxDeadLettersCount)
+	t.Run("Facilities", testFacilitiesCount)
+	t.Run("FirmwareSetAssignments", testFirmwareSetAssignmentsCount)
+	t.Run("MaintenanceWindowServers", testMaintenanceWindowServersCount)
+	t.Run("MaintenanceWindows", testMaintenanceWindowsCount)
+	t.Run("RackRows", testRackRowsCount)
+	t.Run("Racks", testRacksCount)
+	t.Run("Rooms", testRoomsCount)
 	t.Run("ServerComponentTypes", testServerComponentTypesCount)
 	t.Run("ServerComponents", testServerComponentsCount)
 	t.Run("ServerCredentialTypes", testServerCredentialTypesCount)
+	t.Run("ServerCredentialVersions", testServerCredentialVersionsCount)
 	t.Run("ServerCredentials", testServerCredentialsCount)
+	t.Run("ServerHistories", testServerHistoriesCount)
+	t.Run("ServerLeases", testServerLeasesCount)
+	t.Run("ServerPlacements", testServerPlacementsCount)
+	t.Run("ServerStateTransitions", testServerStateTransitionsCount)
 	t.Run("Servers", testServersCount)
 	t.Run("VersionedAttributes", testVersionedAttributesCount)
 }
@@ -197,15 +347,30 @@ func TestHooks(t *testing.T) {
 	t.Run("AocMacAddresses", testAocMacAddressesHooks)
 	t.Run("Attributes", testAttributesHooks)
 	t.Run("AttributesFirmwareSets", testAttributesFirmwareSetsHooks)
+	t.Run("AuditEvents", testAuditEventsHooks)
 	t.Run("BMCMacAddresses", testBMCMacAddressesHooks)
 	t.Run("BomInfos", testBomInfosHooks)
 	t.Run("ComponentFirmwareSets", testComponentFirmwareSetsHooks)
 	t.Run("ComponentFirmwareSetMaps", testComponentFirmwareSetMapsHooks)
 	t.Run("ComponentFirmwareVersions", testComponentFirmwareVersionsHooks)
+	t.Run("EventOutboxes", testEventOutboxesHooks)
+	t.Run("EventOutboxDeadLetters", testEventOutboxDeadLettersHooks)
+	t.Run("Facilities", testFacilitiesHooks)
+	t.Run("FirmwareSetAssignments", testFirmwareSetAssignmentsHooks)
+	t.Run("MaintenanceWindowServers", testMaintenanceWindowServersHooks)
+	t.Run("MaintenanceWindows", testMaintenanceWindowsHooks)
+	t.Run("RackRows", testRackRowsHooks)
+	t.Run("Racks", testRacksHooks)
+	t.Run("Rooms", testRoomsHooks)
 	t.Run("ServerComponentTypes", testServerComponentTypesHooks)
 	t.Run("ServerComponents", testServerComponentsHooks)
 	t.Run("ServerCredentialTypes", testServerCredentialTypesHooks)
+	t.Run("ServerCredentialVersions", testServerCredentialVersionsHooks)
 	t.Run("ServerCredentials", testServerCredentialsHooks)
+	t.Run("ServerHistories", testServerHistoriesHooks)
+	t.Run("ServerLeases", testServerLeasesHooks)
+	t.Run("ServerPlacements", testServerPlacementsHooks)
+	t.Run("ServerStateTransitions", testServerStateTransitionsHooks)
 	t.Run("Servers", testServersHooks)
 	t.Run("VersionedAttributes", testVersionedAttributesHooks)
 }
@@ -217,6 +382,8 @@ func TestInsert(t *testing.T) {
 	t.Run("Attributes", testAttributesInsertWhitelist)
 	t.Run("AttributesFirmwareSets", testAttributesFirmwareSetsInsert)
 	t.Run("AttributesFirmwareSets", testAttributesFirmwareSetsInsertWhitelist)
+	t.Run("AuditEvents", testAuditEventsInsert)
+	t.Run("AuditEvents", testAuditEventsInsertWhitelist)
 	t.Run("BMCMacAddresses", testBMCMacAddressesInsert)
 	t.Run("BMCMacAddresses", testBMCMacAddressesInsertWhitelist)
 	t.Run("BomInfos", testBomInfosInsert)
@@ -227,14 +394,42 @@ func TestInsert(t *testing.T) {
 	t.Run("ComponentFirmwareSetMaps", testComponentFirmwareSetMapsInsertWhitelist)
 	t.Run("ComponentFirmwareVersions", testComponentFirmwareVersionsInsert)
 	t.Run("ComponentFirmwareVersions", testComponentFirmwareVersionsInsertWhitelist)
+	t.Run("EventOutboxes", testEventOutboxesInsert)
+	t.Run("EventOutboxes", testEventOutboxesInsertWhitelist)
+	t.Run("EventOutboxDeadLetters", testEventOutboxDeadLettersInsert)
+	t.Run("EventOutboxDeadLetters", testEventOutboxDeadLettersInsertWhitelist)
+	t.Run("Facilities", testFacilitiesInsert)
+	t.Run("Facilities", testFacilitiesInsertWhitelist)
+	t.Run("FirmwareSetAssignments", testFirmwareSetAssignmentsInsert)
+	t.Run("FirmwareSetAssignments", testFirmwareSetAssignmentsInsertWhitelist)
+	t.Run("MaintenanceWindowServers", testMaintenanceWindowServersInsert)
+	t.Run("MaintenanceWindowServers", testMaintenanceWindowServersInsertWhitelist)
+	t.Run("MaintenanceWindows", testMaintenanceWindowsInsert)
+	t.Run("MaintenanceWindows", testMaintenanceWindowsInsertWhitelist)
+	t.Run("RackRows", testRackRowsInsert)
+	t.Run("RackRows", testRackRowsInsertWhitelist)
+	t.Run("Racks", testRacksInsert)
+	t.Run("Racks", testRacksInsertWhitelist)
+	t.Run("Rooms", testRoomsInsert)
+	t.Run("Rooms", testRoomsInsertWhitelist)
 	t.Run("ServerComponentTypes", testServerComponentTypesInsert)
 	t.Run("ServerComponentTypes", testServerComponentTypesInsertWhitelist)
 	t.Run("ServerComponents", testServerComponentsInsert)
 	t.Run("ServerComponents", testServerComponentsInsertWhitelist)
 	t.Run("ServerCredentialTypes", testServerCredentialTypesInsert)
 	t.Run("ServerCredentialTypes", testServerCredentialTypesInsertWhitelist)
+	t.Run("ServerCredentialVersions", testServerCredentialVersionsInsert)
+	t.Run("ServerCredentialVersions", testServerCredentialVersionsInsertWhitelist)
 	t.Run("ServerCredentials", testServerCredentialsInsert)
 	t.Run("ServerCredentials", testServerCredentialsInsertWhitelist)
+	t.Run("ServerHistories", testServerHistoriesInsert)
+	t.Run("ServerHistories", testServerHistoriesInsertWhitelist)
+	t.Run("ServerLeases", testServerLeasesInsert)
+	t.Run("ServerLeases", testServerLeasesInsertWhitelist)
+	t.Run("ServerPlacements", testServerPlacementsInsert)
+	t.Run("ServerPlacements", testServerPlacementsInsertWhitelist)
+	t.Run("ServerStateTransitions", testServerStateTransitionsInsert)
+	t.Run("ServerStateTransitions", testServerStateTransitionsInsertWhitelist)
 	t.Run("Servers", testServersInsert)
 	t.Run("Servers", testServersInsertWhitelist)
 	t.Run("VersionedAttributes", testVersionedAttributesInsert)
@@ -249,19 +444,37 @@ func TestToOne(t *testing.T) {
 	t.Run("AttributeToServerComponentUsingServerComponent", testAttributeToOneServerComponentUsingServerComponent)
 	t.Run("AttributesFirmwareSetToComponentFirmwareSetUsingFirmwareSet", testAttributesFirmwareSetToOneComponentFirmwareSetUsingFirmwareSet)
 	t.Run("BMCMacAddressToBomInfoUsingSerialNumBomInfo", testBMCMacAddressToOneBomInfoUsingSerialNumBomInfo)
+	t.Run("BomInfoToServerUsingServer", testBomInfoToOneServerUsingServer)
 	t.Run("ComponentFirmwareSetMapToComponentFirmwareSetUsingFirmwareSet", testComponentFirmwareSetMapToOneComponentFirmwareSetUsingFirmwareSet)
 	t.Run("ComponentFirmwareSetMapToComponentFirmwareVersionUsingFirmware", testComponentFirmwareSetMapToOneComponentFirmwareVersionUsingFirmware)
+	t.Run("FirmwareSetAssignmentToComponentFirmwareSetUsingFirmwareSet", testFirmwareSetAssignmentToOneComponentFirmwareSetUsingFirmwareSet)
+	t.Run("FirmwareSetAssignmentToServerUsingServer", testFirmwareSetAssignmentToOneServerUsingServer)
+	t.Run("MaintenanceWindowServerToMaintenanceWindowUsingMaintenanceWindow", testMaintenanceWindowServerToOneMaintenanceWindowUsingMaintenanceWindow)
+	t.Run("MaintenanceWindowServerToServerUsingServer", testMaintenanceWindowServerToOneServerUsingServer)
+	t.Run("RackRowToRoomUsingRoom", testRackRowToOneRoomUsingRoom)
+	t.Run("RackToRackRowUsingRow", testRackToOneRackRowUsingRow)
+	t.Run("RoomToFacilityUsingFacility", testRoomToOneFacilityUsingFacility)
 	t.Run("ServerComponentToServerUsingServer", testServerComponentToOneServerUsingServer)
 	t.Run("ServerComponentToServerComponentTypeUsingServerComponentType", testServerComponentToOneServerComponentTypeUsingServerComponentType)
+	t.Run("ServerCredentialVersionToServerCredentialUsingServerCredential", testServerCredentialVersionToOneServerCredentialUsingServerCredential)
 	t.Run("ServerCredentialToServerCredentialTypeUsingServerCredentialType", testServerCredentialToOneServerCredentialTypeUsingServerCredentialType)
 	t.Run("ServerCredentialToServerUsingServer", testServerCredentialToOneServerUsingServer)
+	t.Run("ServerLeaseToServerUsingServer", testServerLeaseToOneServerUsingServer)
+	t.Run("ServerPlacementToServerUsingServer", testServerPlacementToOneServerUsingServer)
+	t.Run("ServerPlacementToRackUsingRack", testServerPlacementToOneRackUsingRack)
+	t.Run("ServerStateTransitionToServerUsingServer", testServerStateTransitionToOneServerUsingServer)
 	t.Run("VersionedAttributeToServerUsingServer", testVersionedAttributeToOneServerUsingServer)
 	t.Run("VersionedAttributeToServerComponentUsingServerComponent", testVersionedAttributeToOneServerComponentUsingServerComponent)
 }
 
 // TestOneToOne tests cannot be run in parallel
 // or deadlocks can occur.
-func TestOneToOne(t *testing.T) {}
+func TestOneToOne(t *testing.T) {
+	t.Run("ServerToBomInfoUsingBomInfo", testServerOneToOneBomInfoUsingBomInfo)
+	t.Run("ServerToFirmwareSetAssignmentUsingFirmwareSetAssignment", testServerOneToOneFirmwareSetAssignmentUsingFirmwareSetAssignment)
+	t.Run("ServerToServerLeaseUsingServerLease", testServerOneToOneServerLeaseUsingServerLease)
+	t.Run("ServerToServerPlacementUsingServerPlacement", testServerOneToOneServerPlacementUsingServerPlacement)
+}
 
 // TestToMany tests cannot be run in parallel
 // or deadlocks can occur.
@@ -270,14 +483,23 @@ func TestToMany(t *testing.T) {
 	t.Run("BomInfoToSerialNumBMCMacAddresses", testBomInfoToManySerialNumBMCMacAddresses)
 	t.Run("ComponentFirmwareSetToFirmwareSetAttributesFirmwareSets", testComponentFirmwareSetToManyFirmwareSetAttributesFirmwareSets)
 	t.Run("ComponentFirmwareSetToFirmwareSetComponentFirmwareSetMaps", testComponentFirmwareSetToManyFirmwareSetComponentFirmwareSetMaps)
+	t.Run("ComponentFirmwareSetToFirmwareSetFirmwareSetAssignments", testComponentFirmwareSetToManyFirmwareSetFirmwareSetAssignments)
 	t.Run("ComponentFirmwareVersionToFirmwareComponentFirmwareSetMaps", testComponentFirmwareVersionToManyFirmwareComponentFirmwareSetMaps)
+	t.Run("FacilityToRooms", testFacilityToManyRooms)
+	t.Run("MaintenanceWindowToMaintenanceWindowServers", testMaintenanceWindowToManyMaintenanceWindowServers)
+	t.Run("RackRowToRowRacks", testRackRowToManyRowRacks)
+	t.Run("RackToServerPlacements", testRackToManyServerPlacements)
+	t.Run("RoomToRackRows", testRoomToManyRackRows)
 	t.Run("ServerComponentTypeToServerComponents", testServerComponentTypeToManyServerComponents)
 	t.Run("ServerComponentToAttributes", testServerComponentToManyAttributes)
 	t.Run("ServerComponentToVersionedAttributes", testServerComponentToManyVersionedAttributes)
 	t.Run("ServerCredentialTypeToServerCredentials", testServerCredentialTypeToManyServerCredentials)
+	t.Run("ServerCredentialToServerCredentialVersions", testServerCredentialToManyServerCredentialVersions)
 	t.Run("ServerToAttributes", testServerToManyAttributes)
+	t.Run("ServerToMaintenanceWindowServers", testServerToManyMaintenanceWindowServers)
 	t.Run("ServerToServerComponents", testServerToManyServerComponents)
 	t.Run("ServerToServerCredentials", testServerToManyServerCredentials)
+	t.Run("ServerToServerStateTransitions", testServerToManyServerStateTransitions)
 	t.Run("ServerToVersionedAttributes", testServerToManyVersionedAttributes)
 }
 
@@ -289,12 +511,25 @@ func TestToOneSet(t *testing.T) {
 	t.Run("AttributeToServerComponentUsingAttributes", testAttributeToOneSetOpServerComponentUsingServerComponent)
 	t.Run("AttributesFirmwareSetToComponentFirmwareSetUsingFirmwareSetAttributesFirmwareSets", testAttributesFirmwareSetToOneSetOpComponentFirmwareSetUsingFirmwareSet)
 	t.Run("BMCMacAddressToBomInfoUsingSerialNumBMCMacAddresses", testBMCMacAddressToOneSetOpBomInfoUsingSerialNumBomInfo)
+	t.Run("BomInfoToServerUsingBomInfo", testBomInfoToOneSetOpServerUsingServer)
 	t.Run("ComponentFirmwareSetMapToComponentFirmwareSetUsingFirmwareSetComponentFirmwareSetMaps", testComponentFirmwareSetMapToOneSetOpComponentFirmwareSetUsingFirmwareSet)
 	t.Run("ComponentFirmwareSetMapToComponentFirmwareVersionUsingFirmwareComponentFirmwareSetMaps", testComponentFirmwareSetMapToOneSetOpComponentFirmwareVersionUsingFirmware)
+	t.Run("FirmwareSetAssignmentToComponentFirmwareSetUsingFirmwareSetFirmwareSetAssignments", testFirmwareSetAssignmentToOneSetOpComponentFirmwareSetUsingFirmwareSet)
+	t.Run("FirmwareSetAssignmentToServerUsingFirmwareSetAssignment", testFirmwareSetAssignmentToOneSetOpServerUsingServer)
+	t.Run("MaintenanceWindowServerToMaintenanceWindowUsingMaintenanceWindowServers", testMaintenanceWindowServerToOneSetOpMaintenanceWindowUsingMaintenanceWindow)
+	t.Run("MaintenanceWindowServerToServerUsingMaintenanceWindowServers", testMaintenanceWindowServerToOneSetOpServerUsingServer)
+	t.Run("RackRowToRoomUsingRackRows", testRackRowToOneSetOpRoomUsingRoom)
+	t.Run("RackToRackRowUsingRowRacks", testRackToOneSetOpRackRowUsingRow)
+	t.Run("RoomToFacilityUsingRooms", testRoomToOneSetOpFacilityUsingFacility)
 	t.Run("ServerComponentToServerUsingServerComponents", testServerComponentToOneSetOpServerUsingServer)
 	t.Run("ServerComponentToServerComponentTypeUsingServerComponents", testServerComponentToOneSetOpServerComponentTypeUsingServerComponentType)
+	t.Run("ServerCredentialVersionToServerCredentialUsingServerCredentialVersions", testServerCredentialVersionToOneSetOpServerCredentialUsingServerCredential)
 	t.Run("ServerCredentialToServerCredentialTypeUsingServerCredentials", testServerCredentialToOneSetOpServerCredentialTypeUsingServerCredentialType)
 	t.Run("ServerCredentialToServerUsingServerCredentials", testServerCredentialToOneSetOpServerUsingServer)
+	t.Run("ServerLeaseToServerUsingServerLease", testServerLeaseToOneSetOpServerUsingServer)
+	t.Run("ServerPlacementToServerUsingServerPlacement", testServerPlacementToOneSetOpServerUsingServer)
+	t.Run("ServerPlacementToRackUsingServerPlacements", testServerPlacementToOneSetOpRackUsingRack)
+	t.Run("ServerStateTransitionToServerUsingServerStateTransitions", testServerStateTransitionToOneSetOpServerUsingServer)
 	t.Run("VersionedAttributeToServerUsingVersionedAttributes", testVersionedAttributeToOneSetOpServerUsingServer)
 	t.Run("VersionedAttributeToServerComponentUsingVersionedAttributes", testVersionedAttributeToOneSetOpServerComponentUsingServerComponent)
 }
@@ -305,17 +540,27 @@ func TestToOneRemove(t *testing.T) {
 	t.Run("AttributeToServerUsingAttributes", testAttributeToOneRemoveOpServerUsingServer)
 	t.Run("AttributeToServerComponentUsingAttributes", testAttributeToOneRemoveOpServerComponentUsingServerComponent)
 	t.Run("AttributesFirmwareSetToComponentFirmwareSetUsingFirmwareSetAttributesFirmwareSets", testAttributesFirmwareSetToOneRemoveOpComponentFirmwareSetUsingFirmwareSet)
+	t.Run("BomInfoToServerUsingBomInfo", testBomInfoToOneRemoveOpServerUsingServer)
+	t.Run("FirmwareSetAssignmentToServerUsingFirmwareSetAssignment", testFirmwareSetAssignmentToOneRemoveOpServerUsingServer)
 	t.Run("VersionedAttributeToServerUsingVersionedAttributes", testVersionedAttributeToOneRemoveOpServerUsingServer)
 	t.Run("VersionedAttributeToServerComponentUsingVersionedAttributes", testVersionedAttributeToOneRemoveOpServerComponentUsingServerComponent)
 }
 
 // TestOneToOneSet tests cannot be run in parallel
 // or deadlocks can occur.
-func TestOneToOneSet(t *testing.T) {}
+func TestOneToOneSet(t *testing.T) {
+	t.Run("ServerToBomInfoUsingBomInfo", testServerOneToOneSetOpBomInfoUsingBomInfo)
+	t.Run("ServerToFirmwareSetAssignmentUsingFirmwareSetAssignment", testServerOneToOneSetOpFirmwareSetAssignmentUsingFirmwareSetAssignment)
+	t.Run("ServerToServerLeaseUsingServerLease", testServerOneToOneSetOpServerLeaseUsingServerLease)
+	t.Run("ServerToServerPlacementUsingServerPlacement", testServerOneToOneSetOpServerPlacementUsingServerPlacement)
+}
 
 // TestOneToOneRemove tests cannot be run in parallel
 // or deadlocks can occur.
-func TestOneToOneRemove(t *testing.T) {}
+func TestOneToOneRemove(t *testing.T) {
+	t.Run("ServerToBomInfoUsingBomInfo", testServerOneToOneRemoveOpBomInfoUsingBomInfo)
+	t.Run("ServerToFirmwareSetAssignmentUsingFirmwareSetAssignment", testServerOneToOneRemoveOpFirmwareSetAssignmentUsingFirmwareSetAssignment)
+}
 
 // TestToManyAdd tests cannot be run in parallel
 // or deadlocks can occur.
@@ -324,14 +569,23 @@ func TestToManyAdd(t *testing.T) {
 	t.Run("BomInfoToSerialNumBMCMacAddresses", testBomInfoToManyAddOpSerialNumBMCMacAddresses)
 	t.Run("ComponentFirmwareSetToFirmwareSetAttributesFirmwareSets", testComponentFirmwareSetToManyAddOpFirmwareSetAttributesFirmwareSets)
 	t.Run("ComponentFirmwareSetToFirmwareSetComponentFirmwareSetMaps", testComponentFirmwareSetToManyAddOpFirmwareSetComponentFirmwareSetMaps)
+	t.Run("ComponentFirmwareSetToFirmwareSetFirmwareSetAssignments", testComponentFirmwareSetToManyAddOpFirmwareSetFirmwareSetAssignments)
 	t.Run("ComponentFirmwareVersionToFirmwareComponentFirmwareSetMaps", testComponentFirmwareVersionToManyAddOpFirmwareComponentFirmwareSetMaps)
+	t.Run("FacilityToRooms", testFacilityToManyAddOpRooms)
+	t.Run("MaintenanceWindowToMaintenanceWindowServers", testMaintenanceWindowToManyAddOpMaintenanceWindowServers)
+	t.Run("RackRowToRowRacks", testRackRowToManyAddOpRowRacks)
+	t.Run("RackToServerPlacements", testRackToManyAddOpServerPlacements)
+	t.Run("RoomToRackRows", testRoomToManyAddOpRackRows)
 	t.Run("ServerComponentTypeToServerComponents", testServerComponentTypeToManyAddOpServerComponents)
 	t.Run("ServerComponentToAttributes", testServerComponentToManyAddOpAttributes)
 	t.Run("ServerComponentToVersionedAttributes", testServerComponentToManyAddOpVersionedAttributes)
 	t.Run("ServerCredentialTypeToServerCredentials", testServerCredentialTypeToManyAddOpServerCredentials)
+	t.Run("ServerCredentialToServerCredentialVersions", testServerCredentialToManyAddOpServerCredentialVersions)
 	t.Run("ServerToAttributes", testServerToManyAddOpAttributes)
+	t.Run("ServerToMaintenanceWindowServers", testServerToManyAddOpMaintenanceWindowServers)
 	t.Run("ServerToServerComponents", testServerToManyAddOpServerComponents)
 	t.Run("ServerToServerCredentials", testServerToManyAddOpServerCredentials)
+	t.Run("ServerToServerStateTransitions", testServerToManyAddOpServerStateTransitions)
 	t.Run("ServerToVersionedAttributes", testServerToManyAddOpVersionedAttributes)
 }
 
@@ -359,15 +613,30 @@ func TestReload(t *testing.T) {
 	t.Run("AocMacAddresses", testAocMacAddressesReload)
 	t.Run("Attributes", testAttributesReload)
 	t.Run("AttributesFirmwareSets", testAttributesFirmwareSetsReload)
+	t.Run("AuditEvents", testAuditEventsReload)
 	t.Run("BMCMacAddresses", testBMCMacAddressesReload)
 	t.Run("BomInfos", testBomInfosReload)
 	t.Run("ComponentFirmwareSets", testComponentFirmwareSetsReload)
 	t.Run("ComponentFirmwareSetMaps", testComponentFirmwareSetMapsReload)
 	t.Run("ComponentFirmwareVersions", testComponentFirmwareVersionsReload)
+	t.Run("EventOutboxes", testEventOutboxesReload)
+	t.Run("EventOutboxDeadLetters", testEventOutboxDeadLettersReload)
+	t.Run("Facilities", testFacilitiesReload)
+	t.Run("FirmwareSetAssignments", testFirmwareSetAssignmentsReload)
+	t.Run("MaintenanceWindowServers", testMaintenanceWindowServersReload)
+	t.Run("MaintenanceWindows", testMaintenanceWindowsReload)
+	t.Run("RackRows", testRackRowsReload)
+	t.Run("Racks", testRacksReload)
+	t.Run("Rooms", testRoomsReload)
 	t.Run("ServerComponentTypes", testServerComponentTypesReload)
 	t.Run("ServerComponents", testServerComponentsReload)
 	t.Run("ServerCredentialTypes", testServerCredentialTypesReload)
+	t.Run("ServerCredentialVersions", testServerCredentialVersionsReload)
 	t.Run("ServerCredentials", testServerCredentialsReload)
+	t.Run("ServerHistories", testServerHistoriesReload)
+	t.Run("ServerLeases", testServerLeasesReload)
+	t.Run("ServerPlacements", testServerPlacementsReload)
+	t.Run("ServerStateTransitions", testServerStateTransitionsReload)
 	t.Run("Servers", testServersReload)
 	t.Run("VersionedAttributes", testVersionedAttributesReload)
 }
@@ -376,15 +645,30 @@ func TestReloadAll(t *testing.T) {
 	t.Run("AocMacAddresses", testAocMacAddressesReloadAll)
 	t.Run("Attributes", testAttributesReloadAll)
 	t.Run("AttributesFirmwareSets", testAttributesFirmwareSetsReloadAll)
+	t.Run("AuditEvents", testAuditEventsReloadAll)
 	t.Run("BMCMacAddresses", testBMCMacAddressesReloadAll)
 	t.Run("BomInfos", testBomInfosReloadAll)
 	t.Run("ComponentFirmwareSets", testComponentFirmwareSetsReloadAll)
 	t.Run("ComponentFirmwareSetMaps", testComponentFirmwareSetMapsReloadAll)
 	t.Run("ComponentFirmwareVersions", testComponentFirmwareVersionsReloadAll)
+	t.Run("EventOutboxes", testEventOutboxesReloadAll)
+	t.Run("EventOutboxDeadLetters", testEventOutboxDeadLettersReloadAll)
+	t.Run("Facilities", testFacilitiesReloadAll)
+	t.Run("FirmwareSetAssignments", testFirmwareSetAssignmentsReloadAll)
+	t.Run("MaintenanceWindowServers", testMaintenanceWindowServersReloadAll)
+	t.Run("MaintenanceWindows", testMaintenanceWindowsReloadAll)
+	t.Run("RackRows", testRackRowsReloadAll)
+	t.Run("Racks", testRacksReloadAll)
+	t.Run("Rooms", testRoomsReloadAll)
 	t.Run("ServerComponentTypes", testServerComponentTypesReloadAll)
 	t.Run("ServerComponents", testServerComponentsReloadAll)
 	t.Run("ServerCredentialTypes", testServerCredentialTypesReloadAll)
+	t.Run("ServerCredentialVersions", testServerCredentialVersionsReloadAll)
 	t.Run("ServerCredentials", testServerCredentialsReloadAll)
+	t.Run("ServerHistories", testServerHistoriesReloadAll)
+	t.Run("ServerLeases", testServerLeasesReloadAll)
+	t.Run("ServerPlacements", testServerPlacementsReloadAll)
+	t.Run("ServerStateTransitions", testServerStateTransitionsReloadAll)
 	t.Run("Servers", testServersReloadAll)
 	t.Run("VersionedAttributes", testVersionedAttributesReloadAll)
 }
@@ -393,15 +677,30 @@ func TestSelect(t *testing.T) {
 	t.Run("AocMacAddresses", testAocMacAddressesSelect)
 	t.Run("Attributes", testAttributesSelect)
 	t.Run("AttributesFirmwareSets", testAttributesFirmwareSetsSelect)
+	t.Run("AuditEvents", testAuditEventsSelect)
 	t.Run("BMCMacAddresses", testBMCMacAddressesSelect)
 	t.Run("BomInfos", testBomInfosSelect)
 	t.Run("ComponentFirmwareSets", testComponentFirmwareSetsSelect)
 	t.Run("ComponentFirmwareSetMaps", testComponentFirmwareSetMapsSelect)
 	t.Run("ComponentFirmwareVersions", testComponentFirmwareVersionsSelect)
+	t.Run("EventOutboxes", testEventOutboxesSelect)
+	t.Run("EventOutboxDeadLetters", testEventOutboxDeadLettersSelect)
+	t.Run("Facilities", testFacilitiesSelect)
+	t.Run("FirmwareSetAssignments", testFirmwareSetAssignmentsSelect)
+	t.Run("MaintenanceWindowServers", testMaintenanceWindowServersSelect)
+	t.Run("MaintenanceWindows", testMaintenanceWindowsSelect)
+	t.Run("RackRows", testRackRowsSelect)
+	t.Run("Racks", testRacksSelect)
+	t.Run("Rooms", testRoomsSelect)
 	t.Run("ServerComponentTypes", testServerComponentTypesSelect)
 	t.Run("ServerComponents", testServerComponentsSelect)
 	t.Run("ServerCredentialTypes", testServerCredentialTypesSelect)
+	t.Run("ServerCredentialVersions", testServerCredentialVersionsSelect)
 	t.Run("ServerCredentials", testServerCredentialsSelect)
+	t.Run("ServerHistories", testServerHistoriesSelect)
+	t.Run("ServerLeases", testServerLeasesSelect)
+	t.Run("ServerPlacements", testServerPlacementsSelect)
+	t.Run("ServerStateTransitions", testServerStateTransitionsSelect)
 	t.Run("Servers", testServersSelect)
 	t.Run("VersionedAttributes", testVersionedAttributesSelect)
 }
@@ -410,15 +709,30 @@ func TestUpdate(t *testing.T) {
 	t.Run("AocMacAddresses", testAocMacAddressesUpdate)
 	t.Run("Attributes", testAttributesUpdate)
 	t.Run("AttributesFirmwareSets", testAttributesFirmwareSetsUpdate)
+	t.Run("AuditEvents", testAuditEventsUpdate)
 	t.Run("BMCMacAddresses", testBMCMacAddressesUpdate)
 	t.Run("BomInfos", testBomInfosUpdate)
 	t.Run("ComponentFirmwareSets", testComponentFirmwareSetsUpdate)
 	t.Run("ComponentFirmwareSetMaps", testComponentFirmwareSetMapsUpdate)
 	t.Run("ComponentFirmwareVersions", testComponentFirmwareVersionsUpdate)
+	t.Run("EventOutboxes", testEventOutboxesUpdate)
+	t.Run("EventOutboxDeadLetters", testEventOutboxDeadLettersUpdate)
+	t.Run("Facilities", testFacilitiesUpdate)
+	t.Run("FirmwareSetAssignments", testFirmwareSetAssignmentsUpdate)
+	t.Run("MaintenanceWindowServers", testMaintenanceWindowServersUpdate)
+	t.Run("MaintenanceWindows", testMaintenanceWindowsUpdate)
+	t.Run("RackRows", testRackRowsUpdate)
+	t.Run("Racks", testRacksUpdate)
+	t.Run("Rooms", testRoomsUpdate)
 	t.Run("ServerComponentTypes", testServerComponentTypesUpdate)
 	t.Run("ServerComponents", testServerComponentsUpdate)
 	t.Run("ServerCredentialTypes", testServerCredentialTypesUpdate)
+	t.Run("ServerCredentialVersions", testServerCredentialVersionsUpdate)
 	t.Run("ServerCredentials", testServerCredentialsUpdate)
+	t.Run("ServerHistories", testServerHistoriesUpdate)
+	t.Run("ServerLeases", testServerLeasesUpdate)
+	t.Run("ServerPlacements", testServerPlacementsUpdate)
+	t.Run("ServerStateTransitions", testServerStateTransitionsUpdate)
 	t.Run("Servers", testServersUpdate)
 	t.Run("VersionedAttributes", testVersionedAttributesUpdate)
 }
@@ -427,15 +741,30 @@ func TestSliceUpdateAll(t *testing.T) {
 	t.Run("AocMacAddresses", testAocMacAddressesSliceUpdateAll)
 	t.Run("Attributes", testAttributesSliceUpdateAll)
 	t.Run("AttributesFirmwareSets", testAttributesFirmwareSetsSliceUpdateAll)
+	t.Run("AuditEvents", testAuditEventsSliceUpdateAll)
 	t.Run("BMCMacAddresses", testBMCMacAddressesSliceUpdateAll)
 	t.Run("BomInfos", testBomInfosSliceUpdateAll)
 	t.Run("ComponentFirmwareSets", testComponentFirmwareSetsSliceUpdateAll)
 	t.Run("ComponentFirmwareSetMaps", testComponentFirmwareSetMapsSliceUpdateAll)
 	t.Run("ComponentFirmwareVersions", testComponentFirmwareVersionsSliceUpdateAll)
+	t.Run("EventOutboxes", testEventOutboxesSliceUpdateAll)
+	t.Run("EventOutboxDeadLetters", testEventOutboxDeadLettersSliceUpdateAll)
+	t.Run("Facilities", testFacilitiesSliceUpdateAll)
+	t.Run("FirmwareSetAssignments", testFirmwareSetAssignmentsSliceUpdateAll)
+	t.Run("MaintenanceWindowServers", testMaintenanceWindowServersSliceUpdateAll)
+	t.Run("MaintenanceWindows", testMaintenanceWindowsSliceUpdateAll)
+	t.Run("RackRows", testRackRowsSliceUpdateAll)
+	t.Run("Racks", testRacksSliceUpdateAll)
+	t.Run("Rooms", testRoomsSliceUpdateAll)
 	t.Run("ServerComponentTypes", testServerComponentTypesSliceUpdateAll)
 	t.Run("ServerComponents", testServerComponentsSliceUpdateAll)
 	t.Run("ServerCredentialTypes", testServerCredentialTypesSliceUpdateAll)
+	t.Run("ServerCredentialVersions", testServerCredentialVersionsSliceUpdateAll)
 	t.Run("ServerCredentials", testServerCredentialsSliceUpdateAll)
+	t.Run("ServerHistories", testServerHistoriesSliceUpdateAll)
+	t.Run("ServerLeases", testServerLeasesSliceUpdateAll)
+	t.Run("ServerPlacements", testServerPlacementsSliceUpdateAll)
+	t.Run("ServerStateTransitions", testServerStateTransitionsSliceUpdateAll)
 	t.Run("Servers", testServersSliceUpdateAll)
 	t.Run("VersionedAttributes", testVersionedAttributesSliceUpdateAll)
 }
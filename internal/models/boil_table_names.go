@@ -7,30 +7,60 @@ var TableNames = struct {
 	AocMacAddress            string
 	Attributes               string
 	AttributesFirmwareSet    string
+	AuditEvents              string
 	BMCMacAddress            string
 	BomInfo                  string
 	ComponentFirmwareSet     string
 	ComponentFirmwareSetMap  string
 	ComponentFirmwareVersion string
+	EventOutbox              string
+	EventOutboxDeadLetters   string
+	Facilities               string
+	FirmwareSetAssignments   string
+	MaintenanceWindowServers string
+	MaintenanceWindows       string
+	RackRows                 string
+	Racks                    string
+	Rooms                    string
 	ServerComponentTypes     string
 	ServerComponents         string
 	ServerCredentialTypes    string
+	ServerCredentialVersions string
 	ServerCredentials        string
+	ServerHistory            string
+	ServerLeases             string
+	ServerPlacements         string
+	ServerStateTransitions   string
 	Servers                  string
 	VersionedAttributes      string
 }{
 	AocMacAddress:            "aoc_mac_address",
 	Attributes:               "attributes",
 	AttributesFirmwareSet:    "attributes_firmware_set",
+	AuditEvents:              "audit_events",
 	BMCMacAddress:            "bmc_mac_address",
 	BomInfo:                  "bom_info",
 	ComponentFirmwareSet:     "component_firmware_set",
 	ComponentFirmwareSetMap:  "component_firmware_set_map",
 	ComponentFirmwareVersion: "component_firmware_version",
+	EventOutbox:              "event_outbox",
+	EventOutboxDeadLetters:   "event_outbox_dead_letters",
+	Facilities:               "facilities",
+	FirmwareSetAssignments:   "firmware_set_assignments",
+	MaintenanceWindowServers: "maintenance_window_servers",
+	MaintenanceWindows:       "maintenance_windows",
+	RackRows:                 "rack_rows",
+	Racks:                    "racks",
+	Rooms:                    "rooms",
 	ServerComponentTypes:     "server_component_types",
 	ServerComponents:         "server_components",
 	ServerCredentialTypes:    "server_credential_types",
+	ServerCredentialVersions: "server_credential_versions",
 	ServerCredentials:        "server_credentials",
+	ServerHistory:            "server_history",
+	ServerLeases:             "server_leases",
+	ServerPlacements:         "server_placements",
+	ServerStateTransitions:   "server_state_transitions",
 	Servers:                  "servers",
 	VersionedAttributes:      "versioned_attributes",
 }
@@ -30,6 +30,7 @@ type BomInfo struct {
 	NumDefiPmi    null.String `boil:"num_defi_pmi" json:"num_defi_pmi,omitempty" toml:"num_defi_pmi" yaml:"num_defi_pmi,omitempty"`
 	NumDefPWD     null.String `boil:"num_def_pwd" json:"num_def_pwd,omitempty" toml:"num_def_pwd" yaml:"num_def_pwd,omitempty"`
 	Metro         null.String `boil:"metro" json:"metro,omitempty" toml:"metro" yaml:"metro,omitempty"`
+	ServerID      null.String `boil:"server_id" json:"server_id,omitempty" toml:"server_id" yaml:"server_id,omitempty"`
 
 	R *bomInfoR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L bomInfoL  `boil:"-" json:"-" toml:"-" yaml:"-"`
@@ -42,6 +43,7 @@ var BomInfoColumns = struct {
 	NumDefiPmi    string
 	NumDefPWD     string
 	Metro         string
+	ServerID      string
 }{
 	SerialNum:     "serial_num",
 	AocMacAddress: "aoc_mac_address",
@@ -49,6 +51,7 @@ var BomInfoColumns = struct {
 	NumDefiPmi:    "num_defi_pmi",
 	NumDefPWD:     "num_def_pwd",
 	Metro:         "metro",
+	ServerID:      "server_id",
 }
 
 var BomInfoTableColumns = struct {
@@ -58,6 +61,7 @@ var BomInfoTableColumns = struct {
 	NumDefiPmi    string
 	NumDefPWD     string
 	Metro         string
+	ServerID      string
 }{
 	SerialNum:     "bom_info.serial_num",
 	AocMacAddress: "bom_info.aoc_mac_address",
@@ -65,6 +69,7 @@ var BomInfoTableColumns = struct {
 	NumDefiPmi:    "bom_info.num_defi_pmi",
 	NumDefPWD:     "bom_info.num_def_pwd",
 	Metro:         "bom_info.metro",
+	ServerID:      "bom_info.server_id",
 }
 
 // Generated where
@@ -76,6 +81,7 @@ var BomInfoWhere = struct {
 	NumDefiPmi    whereHelpernull_String
 	NumDefPWD     whereHelpernull_String
 	Metro         whereHelpernull_String
+	ServerID      whereHelpernull_String
 }{
 	SerialNum:     whereHelperstring{field: "\"bom_info\".\"serial_num\""},
 	AocMacAddress: whereHelpernull_String{field: "\"bom_info\".\"aoc_mac_address\""},
@@ -83,19 +89,23 @@ var BomInfoWhere = struct {
 	NumDefiPmi:    whereHelpernull_String{field: "\"bom_info\".\"num_defi_pmi\""},
 	NumDefPWD:     whereHelpernull_String{field: "\"bom_info\".\"num_def_pwd\""},
 	Metro:         whereHelpernull_String{field: "\"bom_info\".\"metro\""},
+	ServerID:      whereHelpernull_String{field: "\"bom_info\".\"server_id\""},
 }
 
 // BomInfoRels is where relationship names are stored.
 var BomInfoRels = struct {
+	Server                   string
 	SerialNumAocMacAddresses string
 	SerialNumBMCMacAddresses string
 }{
+	Server:                   "Server",
 	SerialNumAocMacAddresses: "SerialNumAocMacAddresses",
 	SerialNumBMCMacAddresses: "SerialNumBMCMacAddresses",
 }
 
 // bomInfoR is where relationships are stored.
 type bomInfoR struct {
+	Server                   *Server            `boil:"Server" json:"Server" toml:"Server" yaml:"Server"`
 	SerialNumAocMacAddresses AocMacAddressSlice `boil:"SerialNumAocMacAddresses" json:"SerialNumAocMacAddresses" toml:"SerialNumAocMacAddresses" yaml:"SerialNumAocMacAddresses"`
 	SerialNumBMCMacAddresses BMCMacAddressSlice `boil:"SerialNumBMCMacAddresses" json:"SerialNumBMCMacAddresses" toml:"SerialNumBMCMacAddresses" yaml:"SerialNumBMCMacAddresses"`
 }
@@ -105,6 +115,13 @@ func (*bomInfoR) NewStruct() *bomInfoR {
 	return &bomInfoR{}
 }
 
+func (r *bomInfoR) GetServer() *Server {
+	if r == nil {
+		return nil
+	}
+	return r.Server
+}
+
 func (r *bomInfoR) GetSerialNumAocMacAddresses() AocMacAddressSlice {
 	if r == nil {
 		return nil
@@ -123,9 +140,9 @@ func (r *bomInfoR) GetSerialNumBMCMacAddresses() BMCMacAddressSlice {
 type bomInfoL struct{}
 
 var (
-	bomInfoAllColumns            = []string{"serial_num", "aoc_mac_address", "bmc_mac_address", "num_defi_pmi", "num_def_pwd", "metro"}
+	bomInfoAllColumns            = []string{"serial_num", "aoc_mac_address", "bmc_mac_address", "num_defi_pmi", "num_def_pwd", "metro", "server_id"}
 	bomInfoColumnsWithoutDefault = []string{"serial_num"}
-	bomInfoColumnsWithDefault    = []string{"aoc_mac_address", "bmc_mac_address", "num_defi_pmi", "num_def_pwd", "metro"}
+	bomInfoColumnsWithDefault    = []string{"aoc_mac_address", "bmc_mac_address", "num_defi_pmi", "num_def_pwd", "metro", "server_id"}
 	bomInfoPrimaryKeyColumns     = []string{"serial_num"}
 	bomInfoGeneratedColumns      = []string{}
 )
@@ -408,6 +425,17 @@ func (q bomInfoQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bo
 	return count > 0, nil
 }
 
+// Server pointed to by the foreign key.
+func (o *BomInfo) Server(mods ...qm.QueryMod) serverQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.ServerID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Servers(queryMods...)
+}
+
 // SerialNumAocMacAddresses retrieves all the aoc_mac_address's AocMacAddresses with an executor via serial_num column.
 func (o *BomInfo) SerialNumAocMacAddresses(mods ...qm.QueryMod) aocMacAddressQuery {
 	var queryMods []qm.QueryMod
@@ -436,6 +464,115 @@ func (o *BomInfo) SerialNumBMCMacAddresses(mods ...qm.QueryMod) bmcMacAddressQue
 	return BMCMacAddresses(queryMods...)
 }
 
+// LoadServer allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (bomInfoL) LoadServer(ctx context.Context, e boil.ContextExecutor, singular bool, maybeBomInfo interface{}, mods queries.Applicator) error {
+	var slice []*BomInfo
+	var object *BomInfo
+
+	if singular {
+		object = maybeBomInfo.(*BomInfo)
+	} else {
+		slice = *maybeBomInfo.(*[]*BomInfo)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &bomInfoR{}
+		}
+		if !queries.IsNil(object.ServerID) {
+			args = append(args, object.ServerID)
+		}
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &bomInfoR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.ServerID) {
+					continue Outer
+				}
+			}
+
+			if !queries.IsNil(obj.ServerID) {
+				args = append(args, obj.ServerID)
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`servers`),
+		qm.WhereIn(`servers.id in ?`, args...),
+		qmhelper.WhereIsNull(`servers.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Server")
+	}
+
+	var resultSlice []*Server
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Server")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for servers")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for servers")
+	}
+
+	if len(bomInfoAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Server = foreign
+		if foreign.R == nil {
+			foreign.R = &serverR{}
+		}
+		foreign.R.BomInfo = object
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.ServerID, foreign.ID) {
+				local.R.Server = foreign
+				if foreign.R == nil {
+					foreign.R = &serverR{}
+				}
+				foreign.R.BomInfo = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
 // LoadSerialNumAocMacAddresses allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
 func (bomInfoL) LoadSerialNumAocMacAddresses(ctx context.Context, e boil.ContextExecutor, singular bool, maybeBomInfo interface{}, mods queries.Applicator) error {
@@ -632,6 +769,75 @@ func (bomInfoL) LoadSerialNumBMCMacAddresses(ctx context.Context, e boil.Context
 	return nil
 }
 
+// SetServer of the bomInfo to the related item.
+// Sets o.R.Server to related.
+// Adds o to related.R.BomInfo.
+func (o *BomInfo) SetServer(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Server) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"bom_info\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"server_id"}),
+		strmangle.WhereClause("\"", "\"", 2, bomInfoPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.SerialNum}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.ServerID, related.ID)
+	if o.R == nil {
+		o.R = &bomInfoR{
+			Server: related,
+		}
+	} else {
+		o.R.Server = related
+	}
+
+	if related.R == nil {
+		related.R = &serverR{
+			BomInfo: o,
+		}
+	} else {
+		related.R.BomInfo = o
+	}
+
+	return nil
+}
+
+// RemoveServer relationship.
+// Sets o.R.Server to nil.
+// Removes o from all passed in related items' relationships struct.
+func (o *BomInfo) RemoveServer(ctx context.Context, exec boil.ContextExecutor, related *Server) error {
+	var err error
+
+	queries.SetScanner(&o.ServerID, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("server_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.Server = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	related.R.BomInfo = nil
+	return nil
+}
+
 // AddSerialNumAocMacAddresses adds the given related objects to the existing relationships
 // of the bom_info, optionally inserting them as new records.
 // Appends related to o.R.SerialNumAocMacAddresses.
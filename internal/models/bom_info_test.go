@@ -848,6 +848,166 @@ func testBomInfoToManyAddOpSerialNumBMCMacAddresses(t *testing.T) {
 		}
 	}
 }
+func testBomInfoToOneServerUsingServer(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local BomInfo
+	var foreign Server
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, bomInfoDBTypes, true, bomInfoColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize BomInfo struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, serverDBTypes, false, serverColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Server struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&local.ServerID, foreign.ID)
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.Server().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !queries.Equal(check.ID, foreign.ID) {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := BomInfoSlice{&local}
+	if err = local.L.LoadServer(ctx, tx, false, (*[]*BomInfo)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Server == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.Server = nil
+	if err = local.L.LoadServer(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Server == nil {
+		t.Error("struct should have been eager loaded")
+	}
+}
+
+func testBomInfoToOneSetOpServerUsingServer(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a BomInfo
+	var b, c Server
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, bomInfoDBTypes, false, strmangle.SetComplement(bomInfoPrimaryKeyColumns, bomInfoColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, serverDBTypes, false, strmangle.SetComplement(serverPrimaryKeyColumns, serverColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, serverDBTypes, false, strmangle.SetComplement(serverPrimaryKeyColumns, serverColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*Server{&b, &c} {
+		err = a.SetServer(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.Server != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.BomInfo != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if !queries.Equal(a.ServerID, x.ID) {
+			t.Error("foreign key was wrong value", a.ServerID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.ServerID))
+		reflect.Indirect(reflect.ValueOf(&a.ServerID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if !queries.Equal(a.ServerID, x.ID) {
+			t.Error("foreign key was wrong value", a.ServerID, x.ID)
+		}
+	}
+}
+
+func testBomInfoToOneRemoveOpServerUsingServer(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a BomInfo
+	var b Server
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, bomInfoDBTypes, false, strmangle.SetComplement(bomInfoPrimaryKeyColumns, bomInfoColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, serverDBTypes, false, strmangle.SetComplement(serverPrimaryKeyColumns, serverColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.SetServer(ctx, tx, true, &b); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.RemoveServer(ctx, tx, &b); err != nil {
+		t.Error("failed to remove relationship")
+	}
+
+	count, err := a.Server().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 0 {
+		t.Error("want no relationships remaining")
+	}
+
+	if a.R.Server != nil {
+		t.Error("R struct entry should be nil")
+	}
+
+	if !queries.IsValuerNil(a.ServerID) {
+		t.Error("foreign key value should be nil")
+	}
+
+	if b.R.BomInfo != nil {
+		t.Error("failed to remove a from b's relationships")
+	}
+
+}
 
 func testBomInfosReload(t *testing.T) {
 	t.Parallel()
@@ -923,7 +1083,7 @@ func testBomInfosSelect(t *testing.T) {
 }
 
 var (
-	bomInfoDBTypes = map[string]string{`SerialNum`: `string`, `AocMacAddress`: `string`, `BMCMacAddress`: `string`, `NumDefiPmi`: `string`, `NumDefPWD`: `string`, `Metro`: `string`}
+	bomInfoDBTypes = map[string]string{`SerialNum`: `string`, `AocMacAddress`: `string`, `BMCMacAddress`: `string`, `NumDefiPmi`: `string`, `NumDefPWD`: `string`, `Metro`: `string`, `ServerID`: `uuid`}
 	_              = bytes.MinRead
 )
 
@@ -75,15 +75,18 @@ var ComponentFirmwareSetWhere = struct {
 var ComponentFirmwareSetRels = struct {
 	FirmwareSetAttributesFirmwareSets   string
 	FirmwareSetComponentFirmwareSetMaps string
+	FirmwareSetFirmwareSetAssignments   string
 }{
 	FirmwareSetAttributesFirmwareSets:   "FirmwareSetAttributesFirmwareSets",
 	FirmwareSetComponentFirmwareSetMaps: "FirmwareSetComponentFirmwareSetMaps",
+	FirmwareSetFirmwareSetAssignments:   "FirmwareSetFirmwareSetAssignments",
 }
 
 // componentFirmwareSetR is where relationships are stored.
 type componentFirmwareSetR struct {
 	FirmwareSetAttributesFirmwareSets   AttributesFirmwareSetSlice   `boil:"FirmwareSetAttributesFirmwareSets" json:"FirmwareSetAttributesFirmwareSets" toml:"FirmwareSetAttributesFirmwareSets" yaml:"FirmwareSetAttributesFirmwareSets"`
 	FirmwareSetComponentFirmwareSetMaps ComponentFirmwareSetMapSlice `boil:"FirmwareSetComponentFirmwareSetMaps" json:"FirmwareSetComponentFirmwareSetMaps" toml:"FirmwareSetComponentFirmwareSetMaps" yaml:"FirmwareSetComponentFirmwareSetMaps"`
+	FirmwareSetFirmwareSetAssignments   FirmwareSetAssignmentSlice   `boil:"FirmwareSetFirmwareSetAssignments" json:"FirmwareSetFirmwareSetAssignments" toml:"FirmwareSetFirmwareSetAssignments" yaml:"FirmwareSetFirmwareSetAssignments"`
 }
 
 // NewStruct creates a new relationship struct
@@ -105,6 +108,13 @@ func (r *componentFirmwareSetR) GetFirmwareSetComponentFirmwareSetMaps() Compone
 	return r.FirmwareSetComponentFirmwareSetMaps
 }
 
+func (r *componentFirmwareSetR) GetFirmwareSetFirmwareSetAssignments() FirmwareSetAssignmentSlice {
+	if r == nil {
+		return nil
+	}
+	return r.FirmwareSetFirmwareSetAssignments
+}
+
 // componentFirmwareSetL is where Load methods for each relationship are stored.
 type componentFirmwareSetL struct{}
 
@@ -422,6 +432,20 @@ func (o *ComponentFirmwareSet) FirmwareSetComponentFirmwareSetMaps(mods ...qm.Qu
 	return ComponentFirmwareSetMaps(queryMods...)
 }
 
+// FirmwareSetFirmwareSetAssignments retrieves all the firmware_set_assignment's FirmwareSetAssignments with an executor via firmware_set_id column.
+func (o *ComponentFirmwareSet) FirmwareSetFirmwareSetAssignments(mods ...qm.QueryMod) firmwareSetAssignmentQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"firmware_set_assignments\".\"firmware_set_id\"=?", o.ID),
+	)
+
+	return FirmwareSetAssignments(queryMods...)
+}
+
 // LoadFirmwareSetAttributesFirmwareSets allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
 func (componentFirmwareSetL) LoadFirmwareSetAttributesFirmwareSets(ctx context.Context, e boil.ContextExecutor, singular bool, maybeComponentFirmwareSet interface{}, mods queries.Applicator) error {
@@ -618,6 +642,104 @@ func (componentFirmwareSetL) LoadFirmwareSetComponentFirmwareSetMaps(ctx context
 	return nil
 }
 
+// LoadFirmwareSetFirmwareSetAssignments allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (componentFirmwareSetL) LoadFirmwareSetFirmwareSetAssignments(ctx context.Context, e boil.ContextExecutor, singular bool, maybeComponentFirmwareSet interface{}, mods queries.Applicator) error {
+	var slice []*ComponentFirmwareSet
+	var object *ComponentFirmwareSet
+
+	if singular {
+		object = maybeComponentFirmwareSet.(*ComponentFirmwareSet)
+	} else {
+		slice = *maybeComponentFirmwareSet.(*[]*ComponentFirmwareSet)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &componentFirmwareSetR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &componentFirmwareSetR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`firmware_set_assignments`),
+		qm.WhereIn(`firmware_set_assignments.firmware_set_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load firmware_set_assignments")
+	}
+
+	var resultSlice []*FirmwareSetAssignment
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice firmware_set_assignments")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on firmware_set_assignments")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for firmware_set_assignments")
+	}
+
+	if len(firmwareSetAssignmentAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.FirmwareSetFirmwareSetAssignments = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &firmwareSetAssignmentR{}
+			}
+			foreign.R.FirmwareSet = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.FirmwareSetID {
+				local.R.FirmwareSetFirmwareSetAssignments = append(local.R.FirmwareSetFirmwareSetAssignments, foreign)
+				if foreign.R == nil {
+					foreign.R = &firmwareSetAssignmentR{}
+				}
+				foreign.R.FirmwareSet = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
 // AddFirmwareSetAttributesFirmwareSets adds the given related objects to the existing relationships
 // of the component_firmware_set, optionally inserting them as new records.
 // Appends related to o.R.FirmwareSetAttributesFirmwareSets.
@@ -798,6 +920,59 @@ func (o *ComponentFirmwareSet) AddFirmwareSetComponentFirmwareSetMaps(ctx contex
 	return nil
 }
 
+// AddFirmwareSetFirmwareSetAssignments adds the given related objects to the existing relationships
+// of the component_firmware_set, optionally inserting them as new records.
+// Appends related to o.R.FirmwareSetFirmwareSetAssignments.
+// Sets related.R.FirmwareSet appropriately.
+func (o *ComponentFirmwareSet) AddFirmwareSetFirmwareSetAssignments(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*FirmwareSetAssignment) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.FirmwareSetID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"firmware_set_assignments\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"firmware_set_id"}),
+				strmangle.WhereClause("\"", "\"", 2, firmwareSetAssignmentPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.FirmwareSetID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &componentFirmwareSetR{
+			FirmwareSetFirmwareSetAssignments: related,
+		}
+	} else {
+		o.R.FirmwareSetFirmwareSetAssignments = append(o.R.FirmwareSetFirmwareSetAssignments, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &firmwareSetAssignmentR{
+				FirmwareSet: o,
+			}
+		} else {
+			rel.R.FirmwareSet = o
+		}
+	}
+	return nil
+}
+
 // ComponentFirmwareSets retrieves all the records using an executor.
 func ComponentFirmwareSets(mods ...qm.QueryMod) componentFirmwareSetQuery {
 	mods = append(mods, qm.From("\"component_firmware_set\""))
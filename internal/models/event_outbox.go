@@ -0,0 +1,985 @@
+// Code generated by SQLBoiler 4.11.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// EventOutbox is an object representing the database table.
+type EventOutbox struct {
+	ID           string      `boil:"id" json:"id" toml:"id" yaml:"id"`
+	Seq          int64       `boil:"seq" json:"seq" toml:"seq" yaml:"seq"`
+	Subject      string      `boil:"subject" json:"subject" toml:"subject" yaml:"subject"`
+	PartitionKey string      `boil:"partition_key" json:"partition_key" toml:"partition_key" yaml:"partition_key"`
+	Payload      []byte      `boil:"payload" json:"payload" toml:"payload" yaml:"payload"`
+	Attempts     int64       `boil:"attempts" json:"attempts" toml:"attempts" yaml:"attempts"`
+	LastError    null.String `boil:"last_error" json:"last_error,omitempty" toml:"last_error" yaml:"last_error,omitempty"`
+	CreatedAt    time.Time   `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	RetryAt      null.Time   `boil:"retry_at" json:"retry_at,omitempty" toml:"retry_at" yaml:"retry_at,omitempty"`
+
+	R *eventOutboxR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L eventOutboxL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var EventOutboxColumns = struct {
+	ID           string
+	Seq          string
+	Subject      string
+	PartitionKey string
+	Payload      string
+	Attempts     string
+	LastError    string
+	CreatedAt    string
+	RetryAt      string
+}{
+	ID:           "id",
+	Seq:          "seq",
+	Subject:      "subject",
+	PartitionKey: "partition_key",
+	Payload:      "payload",
+	Attempts:     "attempts",
+	LastError:    "last_error",
+	CreatedAt:    "created_at",
+	RetryAt:      "retry_at",
+}
+
+var EventOutboxTableColumns = struct {
+	ID           string
+	Seq          string
+	Subject      string
+	PartitionKey string
+	Payload      string
+	Attempts     string
+	LastError    string
+	CreatedAt    string
+	RetryAt      string
+}{
+	ID:           "event_outbox.id",
+	Seq:          "event_outbox.seq",
+	Subject:      "event_outbox.subject",
+	PartitionKey: "event_outbox.partition_key",
+	Payload:      "event_outbox.payload",
+	Attempts:     "event_outbox.attempts",
+	LastError:    "event_outbox.last_error",
+	CreatedAt:    "event_outbox.created_at",
+	RetryAt:      "event_outbox.retry_at",
+}
+
+// Generated where
+
+type whereHelperint64 struct{ field string }
+
+func (w whereHelperint64) EQ(x int64) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.EQ, x) }
+func (w whereHelperint64) NEQ(x int64) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
+func (w whereHelperint64) LT(x int64) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.LT, x) }
+func (w whereHelperint64) LTE(x int64) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.LTE, x) }
+func (w whereHelperint64) GT(x int64) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.GT, x) }
+func (w whereHelperint64) GTE(x int64) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GTE, x) }
+func (w whereHelperint64) IN(slice []int64) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+}
+func (w whereHelperint64) NIN(slice []int64) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+}
+
+type whereHelper__byte struct{ field string }
+
+func (w whereHelper__byte) EQ(x []byte) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.EQ, x) }
+func (w whereHelper__byte) NEQ(x []byte) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
+func (w whereHelper__byte) LT(x []byte) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.LT, x) }
+func (w whereHelper__byte) LTE(x []byte) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.LTE, x) }
+func (w whereHelper__byte) GT(x []byte) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.GT, x) }
+func (w whereHelper__byte) GTE(x []byte) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GTE, x) }
+
+var EventOutboxWhere = struct {
+	ID           whereHelperstring
+	Seq          whereHelperint64
+	Subject      whereHelperstring
+	PartitionKey whereHelperstring
+	Payload      whereHelper__byte
+	Attempts     whereHelperint64
+	LastError    whereHelpernull_String
+	CreatedAt    whereHelpertime_Time
+	RetryAt      whereHelpernull_Time
+}{
+	ID:           whereHelperstring{field: "\"event_outbox\".\"id\""},
+	Seq:          whereHelperint64{field: "\"event_outbox\".\"seq\""},
+	Subject:      whereHelperstring{field: "\"event_outbox\".\"subject\""},
+	PartitionKey: whereHelperstring{field: "\"event_outbox\".\"partition_key\""},
+	Payload:      whereHelper__byte{field: "\"event_outbox\".\"payload\""},
+	Attempts:     whereHelperint64{field: "\"event_outbox\".\"attempts\""},
+	LastError:    whereHelpernull_String{field: "\"event_outbox\".\"last_error\""},
+	CreatedAt:    whereHelpertime_Time{field: "\"event_outbox\".\"created_at\""},
+	RetryAt:      whereHelpernull_Time{field: "\"event_outbox\".\"retry_at\""},
+}
+
+// EventOutboxRels is where relationship names are stored.
+var EventOutboxRels = struct {
+}{}
+
+// eventOutboxR is where relationships are stored.
+type eventOutboxR struct {
+}
+
+// NewStruct creates a new relationship struct
+func (*eventOutboxR) NewStruct() *eventOutboxR {
+	return &eventOutboxR{}
+}
+
+// eventOutboxL is where Load methods for each relationship are stored.
+type eventOutboxL struct{}
+
+var (
+	eventOutboxAllColumns            = []string{"id", "seq", "subject", "partition_key", "payload", "attempts", "last_error", "created_at", "retry_at"}
+	eventOutboxColumnsWithoutDefault = []string{"subject", "partition_key", "payload"}
+	eventOutboxColumnsWithDefault    = []string{"id", "seq", "attempts", "last_error", "created_at", "retry_at"}
+	eventOutboxPrimaryKeyColumns     = []string{"id"}
+	eventOutboxGeneratedColumns      = []string{}
+)
+
+type (
+	// EventOutboxSlice is an alias for a slice of pointers to EventOutbox.
+	// This should almost always be used instead of []EventOutbox.
+	EventOutboxSlice []*EventOutbox
+	// EventOutboxHook is the signature for custom EventOutbox hook methods
+	EventOutboxHook func(context.Context, boil.ContextExecutor, *EventOutbox) error
+
+	eventOutboxQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	eventOutboxType                 = reflect.TypeOf(&EventOutbox{})
+	eventOutboxMapping              = queries.MakeStructMapping(eventOutboxType)
+	eventOutboxPrimaryKeyMapping, _ = queries.BindMapping(eventOutboxType, eventOutboxMapping, eventOutboxPrimaryKeyColumns)
+	eventOutboxInsertCacheMut       sync.RWMutex
+	eventOutboxInsertCache          = make(map[string]insertCache)
+	eventOutboxUpdateCacheMut       sync.RWMutex
+	eventOutboxUpdateCache          = make(map[string]updateCache)
+	eventOutboxUpsertCacheMut       sync.RWMutex
+	eventOutboxUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var eventOutboxAfterSelectHooks []EventOutboxHook
+
+var eventOutboxBeforeInsertHooks []EventOutboxHook
+var eventOutboxAfterInsertHooks []EventOutboxHook
+
+var eventOutboxBeforeUpdateHooks []EventOutboxHook
+var eventOutboxAfterUpdateHooks []EventOutboxHook
+
+var eventOutboxBeforeDeleteHooks []EventOutboxHook
+var eventOutboxAfterDeleteHooks []EventOutboxHook
+
+var eventOutboxBeforeUpsertHooks []EventOutboxHook
+var eventOutboxAfterUpsertHooks []EventOutboxHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *EventOutbox) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range eventOutboxAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *EventOutbox) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range eventOutboxBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *EventOutbox) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range eventOutboxAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *EventOutbox) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range eventOutboxBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *EventOutbox) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range eventOutboxAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *EventOutbox) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range eventOutboxBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *EventOutbox) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range eventOutboxAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *EventOutbox) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range eventOutboxBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *EventOutbox) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range eventOutboxAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddEventOutboxHook registers your hook function for all future operations.
+func AddEventOutboxHook(hookPoint boil.HookPoint, eventOutboxHook EventOutboxHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		eventOutboxAfterSelectHooks = append(eventOutboxAfterSelectHooks, eventOutboxHook)
+	case boil.BeforeInsertHook:
+		eventOutboxBeforeInsertHooks = append(eventOutboxBeforeInsertHooks, eventOutboxHook)
+	case boil.AfterInsertHook:
+		eventOutboxAfterInsertHooks = append(eventOutboxAfterInsertHooks, eventOutboxHook)
+	case boil.BeforeUpdateHook:
+		eventOutboxBeforeUpdateHooks = append(eventOutboxBeforeUpdateHooks, eventOutboxHook)
+	case boil.AfterUpdateHook:
+		eventOutboxAfterUpdateHooks = append(eventOutboxAfterUpdateHooks, eventOutboxHook)
+	case boil.BeforeDeleteHook:
+		eventOutboxBeforeDeleteHooks = append(eventOutboxBeforeDeleteHooks, eventOutboxHook)
+	case boil.AfterDeleteHook:
+		eventOutboxAfterDeleteHooks = append(eventOutboxAfterDeleteHooks, eventOutboxHook)
+	case boil.BeforeUpsertHook:
+		eventOutboxBeforeUpsertHooks = append(eventOutboxBeforeUpsertHooks, eventOutboxHook)
+	case boil.AfterUpsertHook:
+		eventOutboxAfterUpsertHooks = append(eventOutboxAfterUpsertHooks, eventOutboxHook)
+	}
+}
+
+// One returns a single eventOutbox record from the query.
+func (q eventOutboxQuery) One(ctx context.Context, exec boil.ContextExecutor) (*EventOutbox, error) {
+	o := &EventOutbox{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for event_outbox")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all EventOutbox records from the query.
+func (q eventOutboxQuery) All(ctx context.Context, exec boil.ContextExecutor) (EventOutboxSlice, error) {
+	var o []*EventOutbox
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to EventOutbox slice")
+	}
+
+	if len(eventOutboxAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all EventOutbox records in the query.
+func (q eventOutboxQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count event_outbox rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q eventOutboxQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if event_outbox exists")
+	}
+
+	return count > 0, nil
+}
+
+// EventOutboxes retrieves all the records using an executor.
+func EventOutboxes(mods ...qm.QueryMod) eventOutboxQuery {
+	mods = append(mods, qm.From("\"event_outbox\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"event_outbox\".*"})
+	}
+
+	return eventOutboxQuery{q}
+}
+
+// FindEventOutbox retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindEventOutbox(ctx context.Context, exec boil.ContextExecutor, iD string, selectCols ...string) (*EventOutbox, error) {
+	eventOutboxObj := &EventOutbox{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"event_outbox\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, eventOutboxObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from event_outbox")
+	}
+
+	if err = eventOutboxObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return eventOutboxObj, err
+	}
+
+	return eventOutboxObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *EventOutbox) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no event_outbox provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(eventOutboxColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	eventOutboxInsertCacheMut.RLock()
+	cache, cached := eventOutboxInsertCache[key]
+	eventOutboxInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			eventOutboxAllColumns,
+			eventOutboxColumnsWithDefault,
+			eventOutboxColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(eventOutboxType, eventOutboxMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(eventOutboxType, eventOutboxMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"event_outbox\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"event_outbox\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into event_outbox")
+	}
+
+	if !cached {
+		eventOutboxInsertCacheMut.Lock()
+		eventOutboxInsertCache[key] = cache
+		eventOutboxInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the EventOutbox.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *EventOutbox) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	eventOutboxUpdateCacheMut.RLock()
+	cache, cached := eventOutboxUpdateCache[key]
+	eventOutboxUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			eventOutboxAllColumns,
+			eventOutboxPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update event_outbox, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"event_outbox\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, eventOutboxPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(eventOutboxType, eventOutboxMapping, append(wl, eventOutboxPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update event_outbox row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for event_outbox")
+	}
+
+	if !cached {
+		eventOutboxUpdateCacheMut.Lock()
+		eventOutboxUpdateCache[key] = cache
+		eventOutboxUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q eventOutboxQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for event_outbox")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for event_outbox")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o EventOutboxSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), eventOutboxPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"event_outbox\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, eventOutboxPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in eventOutbox slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all eventOutbox")
+	}
+	return rowsAff, nil
+}
+
+// Delete deletes a single EventOutbox record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *EventOutbox) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no EventOutbox provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), eventOutboxPrimaryKeyMapping)
+	sql := "DELETE FROM \"event_outbox\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from event_outbox")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for event_outbox")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q eventOutboxQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no eventOutboxQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from event_outbox")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for event_outbox")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o EventOutboxSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(eventOutboxBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), eventOutboxPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"event_outbox\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, eventOutboxPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from eventOutbox slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for event_outbox")
+	}
+
+	if len(eventOutboxAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *EventOutbox) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindEventOutbox(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *EventOutboxSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := EventOutboxSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), eventOutboxPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"event_outbox\".* FROM \"event_outbox\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, eventOutboxPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in EventOutboxSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// EventOutboxExists checks if the EventOutbox row exists.
+func EventOutboxExists(ctx context.Context, exec boil.ContextExecutor, iD string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"event_outbox\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if event_outbox exists")
+	}
+
+	return exists, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *EventOutbox) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no event_outbox provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(eventOutboxColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	eventOutboxUpsertCacheMut.RLock()
+	cache, cached := eventOutboxUpsertCache[key]
+	eventOutboxUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			eventOutboxAllColumns,
+			eventOutboxColumnsWithDefault,
+			eventOutboxColumnsWithoutDefault,
+			nzDefaults,
+		)
+		update := updateColumns.UpdateColumnSet(
+			eventOutboxAllColumns,
+			eventOutboxPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert event_outbox, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(eventOutboxPrimaryKeyColumns))
+			copy(conflict, eventOutboxPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryCockroachDB(dialect, "\"event_outbox\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(eventOutboxType, eventOutboxMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(eventOutboxType, eventOutboxMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.DebugMode {
+		_, _ = fmt.Fprintln(boil.DebugWriter, cache.query)
+		_, _ = fmt.Fprintln(boil.DebugWriter, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if err == sql.ErrNoRows {
+			err = nil // CockcorachDB doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert event_outbox")
+	}
+
+	if !cached {
+		eventOutboxUpsertCacheMut.Lock()
+		eventOutboxUpsertCache[key] = cache
+		eventOutboxUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
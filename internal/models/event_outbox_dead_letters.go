@@ -0,0 +1,953 @@
+// Code generated by SQLBoiler 4.11.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// EventOutboxDeadLetter is an object representing the database table.
+type EventOutboxDeadLetter struct {
+	ID             string      `boil:"id" json:"id" toml:"id" yaml:"id"`
+	Seq            int64       `boil:"seq" json:"seq" toml:"seq" yaml:"seq"`
+	Subject        string      `boil:"subject" json:"subject" toml:"subject" yaml:"subject"`
+	PartitionKey   string      `boil:"partition_key" json:"partition_key" toml:"partition_key" yaml:"partition_key"`
+	Payload        []byte      `boil:"payload" json:"payload" toml:"payload" yaml:"payload"`
+	Attempts       int64       `boil:"attempts" json:"attempts" toml:"attempts" yaml:"attempts"`
+	LastError      null.String `boil:"last_error" json:"last_error,omitempty" toml:"last_error" yaml:"last_error,omitempty"`
+	CreatedAt      time.Time   `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	DeadLetteredAt time.Time   `boil:"dead_lettered_at" json:"dead_lettered_at" toml:"dead_lettered_at" yaml:"dead_lettered_at"`
+
+	R *eventOutboxDeadLetterR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L eventOutboxDeadLetterL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var EventOutboxDeadLetterColumns = struct {
+	ID             string
+	Seq            string
+	Subject        string
+	PartitionKey   string
+	Payload        string
+	Attempts       string
+	LastError      string
+	CreatedAt      string
+	DeadLetteredAt string
+}{
+	ID:             "id",
+	Seq:            "seq",
+	Subject:        "subject",
+	PartitionKey:   "partition_key",
+	Payload:        "payload",
+	Attempts:       "attempts",
+	LastError:      "last_error",
+	CreatedAt:      "created_at",
+	DeadLetteredAt: "dead_lettered_at",
+}
+
+var EventOutboxDeadLetterTableColumns = struct {
+	ID             string
+	Seq            string
+	Subject        string
+	PartitionKey   string
+	Payload        string
+	Attempts       string
+	LastError      string
+	CreatedAt      string
+	DeadLetteredAt string
+}{
+	ID:             "event_outbox_dead_letters.id",
+	Seq:            "event_outbox_dead_letters.seq",
+	Subject:        "event_outbox_dead_letters.subject",
+	PartitionKey:   "event_outbox_dead_letters.partition_key",
+	Payload:        "event_outbox_dead_letters.payload",
+	Attempts:       "event_outbox_dead_letters.attempts",
+	LastError:      "event_outbox_dead_letters.last_error",
+	CreatedAt:      "event_outbox_dead_letters.created_at",
+	DeadLetteredAt: "event_outbox_dead_letters.dead_lettered_at",
+}
+
+// Generated where
+
+var EventOutboxDeadLetterWhere = struct {
+	ID             whereHelperstring
+	Seq            whereHelperint64
+	Subject        whereHelperstring
+	PartitionKey   whereHelperstring
+	Payload        whereHelper__byte
+	Attempts       whereHelperint64
+	LastError      whereHelpernull_String
+	CreatedAt      whereHelpertime_Time
+	DeadLetteredAt whereHelpertime_Time
+}{
+	ID:             whereHelperstring{field: "\"event_outbox_dead_letters\".\"id\""},
+	Seq:            whereHelperint64{field: "\"event_outbox_dead_letters\".\"seq\""},
+	Subject:        whereHelperstring{field: "\"event_outbox_dead_letters\".\"subject\""},
+	PartitionKey:   whereHelperstring{field: "\"event_outbox_dead_letters\".\"partition_key\""},
+	Payload:        whereHelper__byte{field: "\"event_outbox_dead_letters\".\"payload\""},
+	Attempts:       whereHelperint64{field: "\"event_outbox_dead_letters\".\"attempts\""},
+	LastError:      whereHelpernull_String{field: "\"event_outbox_dead_letters\".\"last_error\""},
+	CreatedAt:      whereHelpertime_Time{field: "\"event_outbox_dead_letters\".\"created_at\""},
+	DeadLetteredAt: whereHelpertime_Time{field: "\"event_outbox_dead_letters\".\"dead_lettered_at\""},
+}
+
+// EventOutboxDeadLetterRels is where relationship names are stored.
+var EventOutboxDeadLetterRels = struct {
+}{}
+
+// eventOutboxDeadLetterR is where relationships are stored.
+type eventOutboxDeadLetterR struct {
+}
+
+// NewStruct creates a new relationship struct
+func (*eventOutboxDeadLetterR) NewStruct() *eventOutboxDeadLetterR {
+	return &eventOutboxDeadLetterR{}
+}
+
+// eventOutboxDeadLetterL is where Load methods for each relationship are stored.
+type eventOutboxDeadLetterL struct{}
+
+var (
+	eventOutboxDeadLetterAllColumns            = []string{"id", "seq", "subject", "partition_key", "payload", "attempts", "last_error", "created_at", "dead_lettered_at"}
+	eventOutboxDeadLetterColumnsWithoutDefault = []string{"id", "seq", "subject", "partition_key", "payload", "attempts", "created_at"}
+	eventOutboxDeadLetterColumnsWithDefault    = []string{"last_error", "dead_lettered_at"}
+	eventOutboxDeadLetterPrimaryKeyColumns     = []string{"id"}
+	eventOutboxDeadLetterGeneratedColumns      = []string{}
+)
+
+type (
+	// EventOutboxDeadLetterSlice is an alias for a slice of pointers to EventOutboxDeadLetter.
+	// This should almost always be used instead of []EventOutboxDeadLetter.
+	EventOutboxDeadLetterSlice []*EventOutboxDeadLetter
+	// EventOutboxDeadLetterHook is the signature for custom EventOutboxDeadLetter hook methods
+	EventOutboxDeadLetterHook func(context.Context, boil.ContextExecutor, *EventOutboxDeadLetter) error
+
+	eventOutboxDeadLetterQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	eventOutboxDeadLetterType                 = reflect.TypeOf(&EventOutboxDeadLetter{})
+	eventOutboxDeadLetterMapping              = queries.MakeStructMapping(eventOutboxDeadLetterType)
+	eventOutboxDeadLetterPrimaryKeyMapping, _ = queries.BindMapping(eventOutboxDeadLetterType, eventOutboxDeadLetterMapping, eventOutboxDeadLetterPrimaryKeyColumns)
+	eventOutboxDeadLetterInsertCacheMut       sync.RWMutex
+	eventOutboxDeadLetterInsertCache          = make(map[string]insertCache)
+	eventOutboxDeadLetterUpdateCacheMut       sync.RWMutex
+	eventOutboxDeadLetterUpdateCache          = make(map[string]updateCache)
+	eventOutboxDeadLetterUpsertCacheMut       sync.RWMutex
+	eventOutboxDeadLetterUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var eventOutboxDeadLetterAfterSelectHooks []EventOutboxDeadLetterHook
+
+var eventOutboxDeadLetterBeforeInsertHooks []EventOutboxDeadLetterHook
+var eventOutboxDeadLetterAfterInsertHooks []EventOutboxDeadLetterHook
+
+var eventOutboxDeadLetterBeforeUpdateHooks []EventOutboxDeadLetterHook
+var eventOutboxDeadLetterAfterUpdateHooks []EventOutboxDeadLetterHook
+
+var eventOutboxDeadLetterBeforeDeleteHooks []EventOutboxDeadLetterHook
+var eventOutboxDeadLetterAfterDeleteHooks []EventOutboxDeadLetterHook
+
+var eventOutboxDeadLetterBeforeUpsertHooks []EventOutboxDeadLetterHook
+var eventOutboxDeadLetterAfterUpsertHooks []EventOutboxDeadLetterHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *EventOutboxDeadLetter) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range eventOutboxDeadLetterAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *EventOutboxDeadLetter) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range eventOutboxDeadLetterBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *EventOutboxDeadLetter) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range eventOutboxDeadLetterAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *EventOutboxDeadLetter) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range eventOutboxDeadLetterBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *EventOutboxDeadLetter) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range eventOutboxDeadLetterAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *EventOutboxDeadLetter) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range eventOutboxDeadLetterBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *EventOutboxDeadLetter) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range eventOutboxDeadLetterAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *EventOutboxDeadLetter) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range eventOutboxDeadLetterBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *EventOutboxDeadLetter) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range eventOutboxDeadLetterAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddEventOutboxDeadLetterHook registers your hook function for all future operations.
+func AddEventOutboxDeadLetterHook(hookPoint boil.HookPoint, eventOutboxDeadLetterHook EventOutboxDeadLetterHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		eventOutboxDeadLetterAfterSelectHooks = append(eventOutboxDeadLetterAfterSelectHooks, eventOutboxDeadLetterHook)
+	case boil.BeforeInsertHook:
+		eventOutboxDeadLetterBeforeInsertHooks = append(eventOutboxDeadLetterBeforeInsertHooks, eventOutboxDeadLetterHook)
+	case boil.AfterInsertHook:
+		eventOutboxDeadLetterAfterInsertHooks = append(eventOutboxDeadLetterAfterInsertHooks, eventOutboxDeadLetterHook)
+	case boil.BeforeUpdateHook:
+		eventOutboxDeadLetterBeforeUpdateHooks = append(eventOutboxDeadLetterBeforeUpdateHooks, eventOutboxDeadLetterHook)
+	case boil.AfterUpdateHook:
+		eventOutboxDeadLetterAfterUpdateHooks = append(eventOutboxDeadLetterAfterUpdateHooks, eventOutboxDeadLetterHook)
+	case boil.BeforeDeleteHook:
+		eventOutboxDeadLetterBeforeDeleteHooks = append(eventOutboxDeadLetterBeforeDeleteHooks, eventOutboxDeadLetterHook)
+	case boil.AfterDeleteHook:
+		eventOutboxDeadLetterAfterDeleteHooks = append(eventOutboxDeadLetterAfterDeleteHooks, eventOutboxDeadLetterHook)
+	case boil.BeforeUpsertHook:
+		eventOutboxDeadLetterBeforeUpsertHooks = append(eventOutboxDeadLetterBeforeUpsertHooks, eventOutboxDeadLetterHook)
+	case boil.AfterUpsertHook:
+		eventOutboxDeadLetterAfterUpsertHooks = append(eventOutboxDeadLetterAfterUpsertHooks, eventOutboxDeadLetterHook)
+	}
+}
+
+// One returns a single eventOutboxDeadLetter record from the query.
+func (q eventOutboxDeadLetterQuery) One(ctx context.Context, exec boil.ContextExecutor) (*EventOutboxDeadLetter, error) {
+	o := &EventOutboxDeadLetter{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for event_outbox_dead_letters")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all EventOutboxDeadLetter records from the query.
+func (q eventOutboxDeadLetterQuery) All(ctx context.Context, exec boil.ContextExecutor) (EventOutboxDeadLetterSlice, error) {
+	var o []*EventOutboxDeadLetter
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to EventOutboxDeadLetter slice")
+	}
+
+	if len(eventOutboxDeadLetterAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all EventOutboxDeadLetter records in the query.
+func (q eventOutboxDeadLetterQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count event_outbox_dead_letters rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q eventOutboxDeadLetterQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if event_outbox_dead_letters exists")
+	}
+
+	return count > 0, nil
+}
+
+// EventOutboxDeadLetters retrieves all the records using an executor.
+func EventOutboxDeadLetters(mods ...qm.QueryMod) eventOutboxDeadLetterQuery {
+	mods = append(mods, qm.From("\"event_outbox_dead_letters\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"event_outbox_dead_letters\".*"})
+	}
+
+	return eventOutboxDeadLetterQuery{q}
+}
+
+// FindEventOutboxDeadLetter retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindEventOutboxDeadLetter(ctx context.Context, exec boil.ContextExecutor, iD string, selectCols ...string) (*EventOutboxDeadLetter, error) {
+	eventOutboxDeadLetterObj := &EventOutboxDeadLetter{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"event_outbox_dead_letters\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, eventOutboxDeadLetterObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from event_outbox_dead_letters")
+	}
+
+	if err = eventOutboxDeadLetterObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return eventOutboxDeadLetterObj, err
+	}
+
+	return eventOutboxDeadLetterObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *EventOutboxDeadLetter) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no event_outbox_dead_letters provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(eventOutboxDeadLetterColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	eventOutboxDeadLetterInsertCacheMut.RLock()
+	cache, cached := eventOutboxDeadLetterInsertCache[key]
+	eventOutboxDeadLetterInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			eventOutboxDeadLetterAllColumns,
+			eventOutboxDeadLetterColumnsWithDefault,
+			eventOutboxDeadLetterColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(eventOutboxDeadLetterType, eventOutboxDeadLetterMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(eventOutboxDeadLetterType, eventOutboxDeadLetterMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"event_outbox_dead_letters\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"event_outbox_dead_letters\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into event_outbox_dead_letters")
+	}
+
+	if !cached {
+		eventOutboxDeadLetterInsertCacheMut.Lock()
+		eventOutboxDeadLetterInsertCache[key] = cache
+		eventOutboxDeadLetterInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the EventOutboxDeadLetter.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *EventOutboxDeadLetter) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	eventOutboxDeadLetterUpdateCacheMut.RLock()
+	cache, cached := eventOutboxDeadLetterUpdateCache[key]
+	eventOutboxDeadLetterUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			eventOutboxDeadLetterAllColumns,
+			eventOutboxDeadLetterPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update event_outbox_dead_letters, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"event_outbox_dead_letters\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, eventOutboxDeadLetterPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(eventOutboxDeadLetterType, eventOutboxDeadLetterMapping, append(wl, eventOutboxDeadLetterPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update event_outbox_dead_letters row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for event_outbox_dead_letters")
+	}
+
+	if !cached {
+		eventOutboxDeadLetterUpdateCacheMut.Lock()
+		eventOutboxDeadLetterUpdateCache[key] = cache
+		eventOutboxDeadLetterUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q eventOutboxDeadLetterQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for event_outbox_dead_letters")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for event_outbox_dead_letters")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o EventOutboxDeadLetterSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), eventOutboxDeadLetterPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"event_outbox_dead_letters\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, eventOutboxDeadLetterPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in eventOutboxDeadLetter slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all eventOutboxDeadLetter")
+	}
+	return rowsAff, nil
+}
+
+// Delete deletes a single EventOutboxDeadLetter record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *EventOutboxDeadLetter) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no EventOutboxDeadLetter provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), eventOutboxDeadLetterPrimaryKeyMapping)
+	sql := "DELETE FROM \"event_outbox_dead_letters\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from event_outbox_dead_letters")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for event_outbox_dead_letters")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q eventOutboxDeadLetterQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no eventOutboxDeadLetterQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from event_outbox_dead_letters")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for event_outbox_dead_letters")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o EventOutboxDeadLetterSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(eventOutboxDeadLetterBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), eventOutboxDeadLetterPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"event_outbox_dead_letters\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, eventOutboxDeadLetterPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from eventOutboxDeadLetter slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for event_outbox_dead_letters")
+	}
+
+	if len(eventOutboxDeadLetterAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *EventOutboxDeadLetter) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindEventOutboxDeadLetter(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *EventOutboxDeadLetterSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := EventOutboxDeadLetterSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), eventOutboxDeadLetterPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"event_outbox_dead_letters\".* FROM \"event_outbox_dead_letters\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, eventOutboxDeadLetterPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in EventOutboxDeadLetterSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// EventOutboxDeadLetterExists checks if the EventOutboxDeadLetter row exists.
+func EventOutboxDeadLetterExists(ctx context.Context, exec boil.ContextExecutor, iD string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"event_outbox_dead_letters\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if event_outbox_dead_letters exists")
+	}
+
+	return exists, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *EventOutboxDeadLetter) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no event_outbox_dead_letters provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(eventOutboxDeadLetterColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	eventOutboxDeadLetterUpsertCacheMut.RLock()
+	cache, cached := eventOutboxDeadLetterUpsertCache[key]
+	eventOutboxDeadLetterUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			eventOutboxDeadLetterAllColumns,
+			eventOutboxDeadLetterColumnsWithDefault,
+			eventOutboxDeadLetterColumnsWithoutDefault,
+			nzDefaults,
+		)
+		update := updateColumns.UpdateColumnSet(
+			eventOutboxDeadLetterAllColumns,
+			eventOutboxDeadLetterPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert event_outbox_dead_letters, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(eventOutboxDeadLetterPrimaryKeyColumns))
+			copy(conflict, eventOutboxDeadLetterPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryCockroachDB(dialect, "\"event_outbox_dead_letters\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(eventOutboxDeadLetterType, eventOutboxDeadLetterMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(eventOutboxDeadLetterType, eventOutboxDeadLetterMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.DebugMode {
+		_, _ = fmt.Fprintln(boil.DebugWriter, cache.query)
+		_, _ = fmt.Fprintln(boil.DebugWriter, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if err == sql.ErrNoRows {
+			err = nil // CockcorachDB doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert event_outbox_dead_letters")
+	}
+
+	if !cached {
+		eventOutboxDeadLetterUpsertCacheMut.Lock()
+		eventOutboxDeadLetterUpsertCache[key] = cache
+		eventOutboxDeadLetterUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
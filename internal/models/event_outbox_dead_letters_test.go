@@ -0,0 +1,732 @@
+// Code generated by SQLBoiler 4.11.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/volatiletech/randomize"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/strmangle"
+)
+
+func testEventOutboxDeadLettersUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(eventOutboxDeadLetterAllColumns) == len(eventOutboxDeadLetterPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := EventOutboxDeadLetter{}
+	if err = randomize.Struct(seed, &o, eventOutboxDeadLetterDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize EventOutboxDeadLetter struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert EventOutboxDeadLetter: %s", err)
+	}
+
+	count, err := EventOutboxDeadLetters().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, eventOutboxDeadLetterDBTypes, false, eventOutboxDeadLetterPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize EventOutboxDeadLetter struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert EventOutboxDeadLetter: %s", err)
+	}
+
+	count, err = EventOutboxDeadLetters().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testEventOutboxDeadLetters(t *testing.T) {
+	t.Parallel()
+
+	query := EventOutboxDeadLetters()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testEventOutboxDeadLettersDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &EventOutboxDeadLetter{}
+	if err = randomize.Struct(seed, o, eventOutboxDeadLetterDBTypes, true, eventOutboxDeadLetterColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize EventOutboxDeadLetter struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := EventOutboxDeadLetters().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testEventOutboxDeadLettersQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &EventOutboxDeadLetter{}
+	if err = randomize.Struct(seed, o, eventOutboxDeadLetterDBTypes, true, eventOutboxDeadLetterColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize EventOutboxDeadLetter struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := EventOutboxDeadLetters().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := EventOutboxDeadLetters().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testEventOutboxDeadLettersSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &EventOutboxDeadLetter{}
+	if err = randomize.Struct(seed, o, eventOutboxDeadLetterDBTypes, true, eventOutboxDeadLetterColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize EventOutboxDeadLetter struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := EventOutboxDeadLetterSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := EventOutboxDeadLetters().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testEventOutboxDeadLettersExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &EventOutboxDeadLetter{}
+	if err = randomize.Struct(seed, o, eventOutboxDeadLetterDBTypes, true, eventOutboxDeadLetterColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize EventOutboxDeadLetter struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := EventOutboxDeadLetterExists(ctx, tx, o.ID)
+	if err != nil {
+		t.Errorf("Unable to check if EventOutboxDeadLetter exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected EventOutboxDeadLetterExists to return true, but got false.")
+	}
+}
+
+func testEventOutboxDeadLettersFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &EventOutboxDeadLetter{}
+	if err = randomize.Struct(seed, o, eventOutboxDeadLetterDBTypes, true, eventOutboxDeadLetterColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize EventOutboxDeadLetter struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	eventOutboxDeadLetterFound, err := FindEventOutboxDeadLetter(ctx, tx, o.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if eventOutboxDeadLetterFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testEventOutboxDeadLettersBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &EventOutboxDeadLetter{}
+	if err = randomize.Struct(seed, o, eventOutboxDeadLetterDBTypes, true, eventOutboxDeadLetterColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize EventOutboxDeadLetter struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = EventOutboxDeadLetters().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testEventOutboxDeadLettersOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &EventOutboxDeadLetter{}
+	if err = randomize.Struct(seed, o, eventOutboxDeadLetterDBTypes, true, eventOutboxDeadLetterColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize EventOutboxDeadLetter struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := EventOutboxDeadLetters().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testEventOutboxDeadLettersAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	eventOutboxDeadLetterOne := &EventOutboxDeadLetter{}
+	eventOutboxDeadLetterTwo := &EventOutboxDeadLetter{}
+	if err = randomize.Struct(seed, eventOutboxDeadLetterOne, eventOutboxDeadLetterDBTypes, false, eventOutboxDeadLetterColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize EventOutboxDeadLetter struct: %s", err)
+	}
+	if err = randomize.Struct(seed, eventOutboxDeadLetterTwo, eventOutboxDeadLetterDBTypes, false, eventOutboxDeadLetterColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize EventOutboxDeadLetter struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = eventOutboxDeadLetterOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = eventOutboxDeadLetterTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := EventOutboxDeadLetters().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testEventOutboxDeadLettersCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	eventOutboxDeadLetterOne := &EventOutboxDeadLetter{}
+	eventOutboxDeadLetterTwo := &EventOutboxDeadLetter{}
+	if err = randomize.Struct(seed, eventOutboxDeadLetterOne, eventOutboxDeadLetterDBTypes, false, eventOutboxDeadLetterColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize EventOutboxDeadLetter struct: %s", err)
+	}
+	if err = randomize.Struct(seed, eventOutboxDeadLetterTwo, eventOutboxDeadLetterDBTypes, false, eventOutboxDeadLetterColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize EventOutboxDeadLetter struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = eventOutboxDeadLetterOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = eventOutboxDeadLetterTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := EventOutboxDeadLetters().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func eventOutboxDeadLetterBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *EventOutboxDeadLetter) error {
+	*o = EventOutboxDeadLetter{}
+	return nil
+}
+
+func eventOutboxDeadLetterAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *EventOutboxDeadLetter) error {
+	*o = EventOutboxDeadLetter{}
+	return nil
+}
+
+func eventOutboxDeadLetterAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *EventOutboxDeadLetter) error {
+	*o = EventOutboxDeadLetter{}
+	return nil
+}
+
+func eventOutboxDeadLetterBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *EventOutboxDeadLetter) error {
+	*o = EventOutboxDeadLetter{}
+	return nil
+}
+
+func eventOutboxDeadLetterAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *EventOutboxDeadLetter) error {
+	*o = EventOutboxDeadLetter{}
+	return nil
+}
+
+func eventOutboxDeadLetterBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *EventOutboxDeadLetter) error {
+	*o = EventOutboxDeadLetter{}
+	return nil
+}
+
+func eventOutboxDeadLetterAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *EventOutboxDeadLetter) error {
+	*o = EventOutboxDeadLetter{}
+	return nil
+}
+
+func eventOutboxDeadLetterBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *EventOutboxDeadLetter) error {
+	*o = EventOutboxDeadLetter{}
+	return nil
+}
+
+func eventOutboxDeadLetterAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *EventOutboxDeadLetter) error {
+	*o = EventOutboxDeadLetter{}
+	return nil
+}
+
+func testEventOutboxDeadLettersHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &EventOutboxDeadLetter{}
+	o := &EventOutboxDeadLetter{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, eventOutboxDeadLetterDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize EventOutboxDeadLetter object: %s", err)
+	}
+
+	AddEventOutboxDeadLetterHook(boil.BeforeInsertHook, eventOutboxDeadLetterBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	eventOutboxDeadLetterBeforeInsertHooks = []EventOutboxDeadLetterHook{}
+
+	AddEventOutboxDeadLetterHook(boil.AfterInsertHook, eventOutboxDeadLetterAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	eventOutboxDeadLetterAfterInsertHooks = []EventOutboxDeadLetterHook{}
+
+	AddEventOutboxDeadLetterHook(boil.AfterSelectHook, eventOutboxDeadLetterAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	eventOutboxDeadLetterAfterSelectHooks = []EventOutboxDeadLetterHook{}
+
+	AddEventOutboxDeadLetterHook(boil.BeforeUpdateHook, eventOutboxDeadLetterBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	eventOutboxDeadLetterBeforeUpdateHooks = []EventOutboxDeadLetterHook{}
+
+	AddEventOutboxDeadLetterHook(boil.AfterUpdateHook, eventOutboxDeadLetterAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	eventOutboxDeadLetterAfterUpdateHooks = []EventOutboxDeadLetterHook{}
+
+	AddEventOutboxDeadLetterHook(boil.BeforeDeleteHook, eventOutboxDeadLetterBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	eventOutboxDeadLetterBeforeDeleteHooks = []EventOutboxDeadLetterHook{}
+
+	AddEventOutboxDeadLetterHook(boil.AfterDeleteHook, eventOutboxDeadLetterAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	eventOutboxDeadLetterAfterDeleteHooks = []EventOutboxDeadLetterHook{}
+
+	AddEventOutboxDeadLetterHook(boil.BeforeUpsertHook, eventOutboxDeadLetterBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	eventOutboxDeadLetterBeforeUpsertHooks = []EventOutboxDeadLetterHook{}
+
+	AddEventOutboxDeadLetterHook(boil.AfterUpsertHook, eventOutboxDeadLetterAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	eventOutboxDeadLetterAfterUpsertHooks = []EventOutboxDeadLetterHook{}
+}
+
+func testEventOutboxDeadLettersInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &EventOutboxDeadLetter{}
+	if err = randomize.Struct(seed, o, eventOutboxDeadLetterDBTypes, true, eventOutboxDeadLetterColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize EventOutboxDeadLetter struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := EventOutboxDeadLetters().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testEventOutboxDeadLettersInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &EventOutboxDeadLetter{}
+	if err = randomize.Struct(seed, o, eventOutboxDeadLetterDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize EventOutboxDeadLetter struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(eventOutboxDeadLetterColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := EventOutboxDeadLetters().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testEventOutboxDeadLettersReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &EventOutboxDeadLetter{}
+	if err = randomize.Struct(seed, o, eventOutboxDeadLetterDBTypes, true, eventOutboxDeadLetterColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize EventOutboxDeadLetter struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testEventOutboxDeadLettersReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &EventOutboxDeadLetter{}
+	if err = randomize.Struct(seed, o, eventOutboxDeadLetterDBTypes, true, eventOutboxDeadLetterColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize EventOutboxDeadLetter struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := EventOutboxDeadLetterSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testEventOutboxDeadLettersSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &EventOutboxDeadLetter{}
+	if err = randomize.Struct(seed, o, eventOutboxDeadLetterDBTypes, true, eventOutboxDeadLetterColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize EventOutboxDeadLetter struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := EventOutboxDeadLetters().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	eventOutboxDeadLetterDBTypes = map[string]string{`ID`: `uuid`, `Seq`: `int8`, `Subject`: `string`, `PartitionKey`: `string`, `Payload`: `bytes`, `Attempts`: `int8`, `LastError`: `string`, `CreatedAt`: `timestamptz`, `DeadLetteredAt`: `timestamptz`}
+	_                            = bytes.MinRead
+)
+
+func testEventOutboxDeadLettersUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(eventOutboxDeadLetterPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(eventOutboxDeadLetterAllColumns) == len(eventOutboxDeadLetterPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &EventOutboxDeadLetter{}
+	if err = randomize.Struct(seed, o, eventOutboxDeadLetterDBTypes, true, eventOutboxDeadLetterColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize EventOutboxDeadLetter struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := EventOutboxDeadLetters().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, eventOutboxDeadLetterDBTypes, true, eventOutboxDeadLetterPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize EventOutboxDeadLetter struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testEventOutboxDeadLettersSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(eventOutboxDeadLetterAllColumns) == len(eventOutboxDeadLetterPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &EventOutboxDeadLetter{}
+	if err = randomize.Struct(seed, o, eventOutboxDeadLetterDBTypes, true, eventOutboxDeadLetterColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize EventOutboxDeadLetter struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := EventOutboxDeadLetters().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, eventOutboxDeadLetterDBTypes, true, eventOutboxDeadLetterPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize EventOutboxDeadLetter struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(eventOutboxDeadLetterAllColumns, eventOutboxDeadLetterPrimaryKeyColumns) {
+		fields = eventOutboxDeadLetterAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			eventOutboxDeadLetterAllColumns,
+			eventOutboxDeadLetterPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := EventOutboxDeadLetterSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
@@ -0,0 +1,732 @@
+// Code generated by SQLBoiler 4.11.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/volatiletech/randomize"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/strmangle"
+)
+
+func testEventOutboxesUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(eventOutboxAllColumns) == len(eventOutboxPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := EventOutbox{}
+	if err = randomize.Struct(seed, &o, eventOutboxDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize EventOutbox struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert EventOutbox: %s", err)
+	}
+
+	count, err := EventOutboxes().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, eventOutboxDBTypes, false, eventOutboxPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize EventOutbox struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert EventOutbox: %s", err)
+	}
+
+	count, err = EventOutboxes().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testEventOutboxes(t *testing.T) {
+	t.Parallel()
+
+	query := EventOutboxes()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testEventOutboxesDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &EventOutbox{}
+	if err = randomize.Struct(seed, o, eventOutboxDBTypes, true, eventOutboxColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize EventOutbox struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := EventOutboxes().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testEventOutboxesQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &EventOutbox{}
+	if err = randomize.Struct(seed, o, eventOutboxDBTypes, true, eventOutboxColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize EventOutbox struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := EventOutboxes().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := EventOutboxes().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testEventOutboxesSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &EventOutbox{}
+	if err = randomize.Struct(seed, o, eventOutboxDBTypes, true, eventOutboxColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize EventOutbox struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := EventOutboxSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := EventOutboxes().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testEventOutboxesExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &EventOutbox{}
+	if err = randomize.Struct(seed, o, eventOutboxDBTypes, true, eventOutboxColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize EventOutbox struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := EventOutboxExists(ctx, tx, o.ID)
+	if err != nil {
+		t.Errorf("Unable to check if EventOutbox exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected EventOutboxExists to return true, but got false.")
+	}
+}
+
+func testEventOutboxesFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &EventOutbox{}
+	if err = randomize.Struct(seed, o, eventOutboxDBTypes, true, eventOutboxColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize EventOutbox struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	eventOutboxFound, err := FindEventOutbox(ctx, tx, o.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if eventOutboxFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testEventOutboxesBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &EventOutbox{}
+	if err = randomize.Struct(seed, o, eventOutboxDBTypes, true, eventOutboxColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize EventOutbox struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = EventOutboxes().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testEventOutboxesOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &EventOutbox{}
+	if err = randomize.Struct(seed, o, eventOutboxDBTypes, true, eventOutboxColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize EventOutbox struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := EventOutboxes().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testEventOutboxesAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	eventOutboxOne := &EventOutbox{}
+	eventOutboxTwo := &EventOutbox{}
+	if err = randomize.Struct(seed, eventOutboxOne, eventOutboxDBTypes, false, eventOutboxColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize EventOutbox struct: %s", err)
+	}
+	if err = randomize.Struct(seed, eventOutboxTwo, eventOutboxDBTypes, false, eventOutboxColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize EventOutbox struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = eventOutboxOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = eventOutboxTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := EventOutboxes().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testEventOutboxesCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	eventOutboxOne := &EventOutbox{}
+	eventOutboxTwo := &EventOutbox{}
+	if err = randomize.Struct(seed, eventOutboxOne, eventOutboxDBTypes, false, eventOutboxColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize EventOutbox struct: %s", err)
+	}
+	if err = randomize.Struct(seed, eventOutboxTwo, eventOutboxDBTypes, false, eventOutboxColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize EventOutbox struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = eventOutboxOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = eventOutboxTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := EventOutboxes().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func eventOutboxBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *EventOutbox) error {
+	*o = EventOutbox{}
+	return nil
+}
+
+func eventOutboxAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *EventOutbox) error {
+	*o = EventOutbox{}
+	return nil
+}
+
+func eventOutboxAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *EventOutbox) error {
+	*o = EventOutbox{}
+	return nil
+}
+
+func eventOutboxBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *EventOutbox) error {
+	*o = EventOutbox{}
+	return nil
+}
+
+func eventOutboxAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *EventOutbox) error {
+	*o = EventOutbox{}
+	return nil
+}
+
+func eventOutboxBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *EventOutbox) error {
+	*o = EventOutbox{}
+	return nil
+}
+
+func eventOutboxAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *EventOutbox) error {
+	*o = EventOutbox{}
+	return nil
+}
+
+func eventOutboxBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *EventOutbox) error {
+	*o = EventOutbox{}
+	return nil
+}
+
+func eventOutboxAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *EventOutbox) error {
+	*o = EventOutbox{}
+	return nil
+}
+
+func testEventOutboxesHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &EventOutbox{}
+	o := &EventOutbox{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, eventOutboxDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize EventOutbox object: %s", err)
+	}
+
+	AddEventOutboxHook(boil.BeforeInsertHook, eventOutboxBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	eventOutboxBeforeInsertHooks = []EventOutboxHook{}
+
+	AddEventOutboxHook(boil.AfterInsertHook, eventOutboxAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	eventOutboxAfterInsertHooks = []EventOutboxHook{}
+
+	AddEventOutboxHook(boil.AfterSelectHook, eventOutboxAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	eventOutboxAfterSelectHooks = []EventOutboxHook{}
+
+	AddEventOutboxHook(boil.BeforeUpdateHook, eventOutboxBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	eventOutboxBeforeUpdateHooks = []EventOutboxHook{}
+
+	AddEventOutboxHook(boil.AfterUpdateHook, eventOutboxAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	eventOutboxAfterUpdateHooks = []EventOutboxHook{}
+
+	AddEventOutboxHook(boil.BeforeDeleteHook, eventOutboxBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	eventOutboxBeforeDeleteHooks = []EventOutboxHook{}
+
+	AddEventOutboxHook(boil.AfterDeleteHook, eventOutboxAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	eventOutboxAfterDeleteHooks = []EventOutboxHook{}
+
+	AddEventOutboxHook(boil.BeforeUpsertHook, eventOutboxBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	eventOutboxBeforeUpsertHooks = []EventOutboxHook{}
+
+	AddEventOutboxHook(boil.AfterUpsertHook, eventOutboxAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	eventOutboxAfterUpsertHooks = []EventOutboxHook{}
+}
+
+func testEventOutboxesInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &EventOutbox{}
+	if err = randomize.Struct(seed, o, eventOutboxDBTypes, true, eventOutboxColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize EventOutbox struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := EventOutboxes().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testEventOutboxesInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &EventOutbox{}
+	if err = randomize.Struct(seed, o, eventOutboxDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize EventOutbox struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(eventOutboxColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := EventOutboxes().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testEventOutboxesReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &EventOutbox{}
+	if err = randomize.Struct(seed, o, eventOutboxDBTypes, true, eventOutboxColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize EventOutbox struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testEventOutboxesReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &EventOutbox{}
+	if err = randomize.Struct(seed, o, eventOutboxDBTypes, true, eventOutboxColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize EventOutbox struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := EventOutboxSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testEventOutboxesSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &EventOutbox{}
+	if err = randomize.Struct(seed, o, eventOutboxDBTypes, true, eventOutboxColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize EventOutbox struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := EventOutboxes().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	eventOutboxDBTypes = map[string]string{`ID`: `uuid`, `Seq`: `int8`, `Subject`: `string`, `PartitionKey`: `string`, `Payload`: `bytes`, `Attempts`: `int8`, `LastError`: `string`, `CreatedAt`: `timestamptz`, `RetryAt`: `timestamptz`}
+	_                  = bytes.MinRead
+)
+
+func testEventOutboxesUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(eventOutboxPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(eventOutboxAllColumns) == len(eventOutboxPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &EventOutbox{}
+	if err = randomize.Struct(seed, o, eventOutboxDBTypes, true, eventOutboxColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize EventOutbox struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := EventOutboxes().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, eventOutboxDBTypes, true, eventOutboxPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize EventOutbox struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testEventOutboxesSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(eventOutboxAllColumns) == len(eventOutboxPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &EventOutbox{}
+	if err = randomize.Struct(seed, o, eventOutboxDBTypes, true, eventOutboxColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize EventOutbox struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := EventOutboxes().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, eventOutboxDBTypes, true, eventOutboxPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize EventOutbox struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(eventOutboxAllColumns, eventOutboxPrimaryKeyColumns) {
+		fields = eventOutboxAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			eventOutboxAllColumns,
+			eventOutboxPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := EventOutboxSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
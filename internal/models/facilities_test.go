@@ -0,0 +1,886 @@
+// Code generated by SQLBoiler 4.11.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/volatiletech/randomize"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/strmangle"
+)
+
+func testFacilitiesUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(facilityAllColumns) == len(facilityPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := Facility{}
+	if err = randomize.Struct(seed, &o, facilityDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize Facility struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert Facility: %s", err)
+	}
+
+	count, err := Facilities().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, facilityDBTypes, false, facilityPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize Facility struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert Facility: %s", err)
+	}
+
+	count, err = Facilities().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testFacilities(t *testing.T) {
+	t.Parallel()
+
+	query := Facilities()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testFacilitiesDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Facility{}
+	if err = randomize.Struct(seed, o, facilityDBTypes, true, facilityColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Facility struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := Facilities().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testFacilitiesQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Facility{}
+	if err = randomize.Struct(seed, o, facilityDBTypes, true, facilityColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Facility struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := Facilities().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := Facilities().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testFacilitiesSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Facility{}
+	if err = randomize.Struct(seed, o, facilityDBTypes, true, facilityColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Facility struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := FacilitySlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := Facilities().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testFacilitiesExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Facility{}
+	if err = randomize.Struct(seed, o, facilityDBTypes, true, facilityColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Facility struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := FacilityExists(ctx, tx, o.ID)
+	if err != nil {
+		t.Errorf("Unable to check if Facility exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected FacilityExists to return true, but got false.")
+	}
+}
+
+func testFacilitiesFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Facility{}
+	if err = randomize.Struct(seed, o, facilityDBTypes, true, facilityColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Facility struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	facilityFound, err := FindFacility(ctx, tx, o.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if facilityFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testFacilitiesBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Facility{}
+	if err = randomize.Struct(seed, o, facilityDBTypes, true, facilityColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Facility struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = Facilities().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testFacilitiesOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Facility{}
+	if err = randomize.Struct(seed, o, facilityDBTypes, true, facilityColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Facility struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := Facilities().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testFacilitiesAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	facilityOne := &Facility{}
+	facilityTwo := &Facility{}
+	if err = randomize.Struct(seed, facilityOne, facilityDBTypes, false, facilityColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Facility struct: %s", err)
+	}
+	if err = randomize.Struct(seed, facilityTwo, facilityDBTypes, false, facilityColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Facility struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = facilityOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = facilityTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := Facilities().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testFacilitiesCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	facilityOne := &Facility{}
+	facilityTwo := &Facility{}
+	if err = randomize.Struct(seed, facilityOne, facilityDBTypes, false, facilityColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Facility struct: %s", err)
+	}
+	if err = randomize.Struct(seed, facilityTwo, facilityDBTypes, false, facilityColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Facility struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = facilityOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = facilityTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Facilities().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func facilityBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *Facility) error {
+	*o = Facility{}
+	return nil
+}
+
+func facilityAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *Facility) error {
+	*o = Facility{}
+	return nil
+}
+
+func facilityAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *Facility) error {
+	*o = Facility{}
+	return nil
+}
+
+func facilityBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *Facility) error {
+	*o = Facility{}
+	return nil
+}
+
+func facilityAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *Facility) error {
+	*o = Facility{}
+	return nil
+}
+
+func facilityBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *Facility) error {
+	*o = Facility{}
+	return nil
+}
+
+func facilityAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *Facility) error {
+	*o = Facility{}
+	return nil
+}
+
+func facilityBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *Facility) error {
+	*o = Facility{}
+	return nil
+}
+
+func facilityAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *Facility) error {
+	*o = Facility{}
+	return nil
+}
+
+func testFacilitiesHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &Facility{}
+	o := &Facility{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, facilityDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize Facility object: %s", err)
+	}
+
+	AddFacilityHook(boil.BeforeInsertHook, facilityBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	facilityBeforeInsertHooks = []FacilityHook{}
+
+	AddFacilityHook(boil.AfterInsertHook, facilityAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	facilityAfterInsertHooks = []FacilityHook{}
+
+	AddFacilityHook(boil.AfterSelectHook, facilityAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	facilityAfterSelectHooks = []FacilityHook{}
+
+	AddFacilityHook(boil.BeforeUpdateHook, facilityBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	facilityBeforeUpdateHooks = []FacilityHook{}
+
+	AddFacilityHook(boil.AfterUpdateHook, facilityAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	facilityAfterUpdateHooks = []FacilityHook{}
+
+	AddFacilityHook(boil.BeforeDeleteHook, facilityBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	facilityBeforeDeleteHooks = []FacilityHook{}
+
+	AddFacilityHook(boil.AfterDeleteHook, facilityAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	facilityAfterDeleteHooks = []FacilityHook{}
+
+	AddFacilityHook(boil.BeforeUpsertHook, facilityBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	facilityBeforeUpsertHooks = []FacilityHook{}
+
+	AddFacilityHook(boil.AfterUpsertHook, facilityAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	facilityAfterUpsertHooks = []FacilityHook{}
+}
+
+func testFacilitiesInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Facility{}
+	if err = randomize.Struct(seed, o, facilityDBTypes, true, facilityColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Facility struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Facilities().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testFacilitiesInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Facility{}
+	if err = randomize.Struct(seed, o, facilityDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize Facility struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(facilityColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Facilities().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testFacilityToManyRooms(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Facility
+	var b, c Room
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, facilityDBTypes, true, facilityColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Facility struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, roomDBTypes, false, roomColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, roomDBTypes, false, roomColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	b.FacilityID = a.ID
+	c.FacilityID = a.ID
+
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.Rooms().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if v.FacilityID == b.FacilityID {
+			bFound = true
+		}
+		if v.FacilityID == c.FacilityID {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := FacilitySlice{&a}
+	if err = a.L.LoadRooms(ctx, tx, false, (*[]*Facility)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.Rooms); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.Rooms = nil
+	if err = a.L.LoadRooms(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.Rooms); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testFacilityToManyAddOpRooms(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Facility
+	var b, c, d, e Room
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, facilityDBTypes, false, strmangle.SetComplement(facilityPrimaryKeyColumns, facilityColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*Room{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, roomDBTypes, false, strmangle.SetComplement(roomPrimaryKeyColumns, roomColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*Room{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddRooms(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if a.ID != first.FacilityID {
+			t.Error("foreign key was wrong value", a.ID, first.FacilityID)
+		}
+		if a.ID != second.FacilityID {
+			t.Error("foreign key was wrong value", a.ID, second.FacilityID)
+		}
+
+		if first.R.Facility != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.Facility != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.Rooms[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.Rooms[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.Rooms().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+
+func testFacilitiesReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Facility{}
+	if err = randomize.Struct(seed, o, facilityDBTypes, true, facilityColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Facility struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testFacilitiesReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Facility{}
+	if err = randomize.Struct(seed, o, facilityDBTypes, true, facilityColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Facility struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := FacilitySlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testFacilitiesSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Facility{}
+	if err = randomize.Struct(seed, o, facilityDBTypes, true, facilityColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Facility struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := Facilities().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	facilityDBTypes = map[string]string{`ID`: `uuid`, `Code`: `string`, `Name`: `string`, `CreatedAt`: `timestamptz`, `UpdatedAt`: `timestamptz`}
+	_               = bytes.MinRead
+)
+
+func testFacilitiesUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(facilityPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(facilityAllColumns) == len(facilityPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Facility{}
+	if err = randomize.Struct(seed, o, facilityDBTypes, true, facilityColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Facility struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Facilities().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, facilityDBTypes, true, facilityPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize Facility struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testFacilitiesSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(facilityAllColumns) == len(facilityPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Facility{}
+	if err = randomize.Struct(seed, o, facilityDBTypes, true, facilityColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Facility struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Facilities().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, facilityDBTypes, true, facilityPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize Facility struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(facilityAllColumns, facilityPrimaryKeyColumns) {
+		fields = facilityAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			facilityAllColumns,
+			facilityPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := FacilitySlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
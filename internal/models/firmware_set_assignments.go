@@ -0,0 +1,1328 @@
+// Code generated by SQLBoiler 4.11.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// FirmwareSetAssignment is an object representing the database table.
+type FirmwareSetAssignment struct {
+	ID            string      `boil:"id" json:"id" toml:"id" yaml:"id"`
+	FirmwareSetID string      `boil:"firmware_set_id" json:"firmware_set_id" toml:"firmware_set_id" yaml:"firmware_set_id"`
+	ServerID      null.String `boil:"server_id" json:"server_id,omitempty" toml:"server_id" yaml:"server_id,omitempty"`
+	FacilityCode  null.String `boil:"facility_code" json:"facility_code,omitempty" toml:"facility_code" yaml:"facility_code,omitempty"`
+	Attributes    null.JSON   `boil:"attributes" json:"attributes,omitempty" toml:"attributes" yaml:"attributes,omitempty"`
+	Priority      int64       `boil:"priority" json:"priority" toml:"priority" yaml:"priority"`
+	CreatedAt     null.Time   `boil:"created_at" json:"created_at,omitempty" toml:"created_at" yaml:"created_at,omitempty"`
+	UpdatedAt     null.Time   `boil:"updated_at" json:"updated_at,omitempty" toml:"updated_at" yaml:"updated_at,omitempty"`
+
+	R *firmwareSetAssignmentR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L firmwareSetAssignmentL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var FirmwareSetAssignmentColumns = struct {
+	ID            string
+	FirmwareSetID string
+	ServerID      string
+	FacilityCode  string
+	Attributes    string
+	Priority      string
+	CreatedAt     string
+	UpdatedAt     string
+}{
+	ID:            "id",
+	FirmwareSetID: "firmware_set_id",
+	ServerID:      "server_id",
+	FacilityCode:  "facility_code",
+	Attributes:    "attributes",
+	Priority:      "priority",
+	CreatedAt:     "created_at",
+	UpdatedAt:     "updated_at",
+}
+
+var FirmwareSetAssignmentTableColumns = struct {
+	ID            string
+	FirmwareSetID string
+	ServerID      string
+	FacilityCode  string
+	Attributes    string
+	Priority      string
+	CreatedAt     string
+	UpdatedAt     string
+}{
+	ID:            "firmware_set_assignments.id",
+	FirmwareSetID: "firmware_set_assignments.firmware_set_id",
+	ServerID:      "firmware_set_assignments.server_id",
+	FacilityCode:  "firmware_set_assignments.facility_code",
+	Attributes:    "firmware_set_assignments.attributes",
+	Priority:      "firmware_set_assignments.priority",
+	CreatedAt:     "firmware_set_assignments.created_at",
+	UpdatedAt:     "firmware_set_assignments.updated_at",
+}
+
+// Generated where
+
+var FirmwareSetAssignmentWhere = struct {
+	ID            whereHelperstring
+	FirmwareSetID whereHelperstring
+	ServerID      whereHelpernull_String
+	FacilityCode  whereHelpernull_String
+	Attributes    whereHelpernull_JSON
+	Priority      whereHelperint64
+	CreatedAt     whereHelpernull_Time
+	UpdatedAt     whereHelpernull_Time
+}{
+	ID:            whereHelperstring{field: "\"firmware_set_assignments\".\"id\""},
+	FirmwareSetID: whereHelperstring{field: "\"firmware_set_assignments\".\"firmware_set_id\""},
+	ServerID:      whereHelpernull_String{field: "\"firmware_set_assignments\".\"server_id\""},
+	FacilityCode:  whereHelpernull_String{field: "\"firmware_set_assignments\".\"facility_code\""},
+	Attributes:    whereHelpernull_JSON{field: "\"firmware_set_assignments\".\"attributes\""},
+	Priority:      whereHelperint64{field: "\"firmware_set_assignments\".\"priority\""},
+	CreatedAt:     whereHelpernull_Time{field: "\"firmware_set_assignments\".\"created_at\""},
+	UpdatedAt:     whereHelpernull_Time{field: "\"firmware_set_assignments\".\"updated_at\""},
+}
+
+// FirmwareSetAssignmentRels is where relationship names are stored.
+var FirmwareSetAssignmentRels = struct {
+	FirmwareSet string
+	Server      string
+}{
+	FirmwareSet: "FirmwareSet",
+	Server:      "Server",
+}
+
+// firmwareSetAssignmentR is where relationships are stored.
+type firmwareSetAssignmentR struct {
+	FirmwareSet *ComponentFirmwareSet `boil:"FirmwareSet" json:"FirmwareSet" toml:"FirmwareSet" yaml:"FirmwareSet"`
+	Server      *Server               `boil:"Server" json:"Server" toml:"Server" yaml:"Server"`
+}
+
+// NewStruct creates a new relationship struct
+func (*firmwareSetAssignmentR) NewStruct() *firmwareSetAssignmentR {
+	return &firmwareSetAssignmentR{}
+}
+
+func (r *firmwareSetAssignmentR) GetFirmwareSet() *ComponentFirmwareSet {
+	if r == nil {
+		return nil
+	}
+	return r.FirmwareSet
+}
+
+func (r *firmwareSetAssignmentR) GetServer() *Server {
+	if r == nil {
+		return nil
+	}
+	return r.Server
+}
+
+// firmwareSetAssignmentL is where Load methods for each relationship are stored.
+type firmwareSetAssignmentL struct{}
+
+var (
+	firmwareSetAssignmentAllColumns            = []string{"id", "firmware_set_id", "server_id", "facility_code", "attributes", "priority", "created_at", "updated_at"}
+	firmwareSetAssignmentColumnsWithoutDefault = []string{"firmware_set_id"}
+	firmwareSetAssignmentColumnsWithDefault    = []string{"id", "server_id", "facility_code", "attributes", "priority", "created_at", "updated_at"}
+	firmwareSetAssignmentPrimaryKeyColumns     = []string{"id"}
+	firmwareSetAssignmentGeneratedColumns      = []string{}
+)
+
+type (
+	// FirmwareSetAssignmentSlice is an alias for a slice of pointers to FirmwareSetAssignment.
+	// This should almost always be used instead of []FirmwareSetAssignment.
+	FirmwareSetAssignmentSlice []*FirmwareSetAssignment
+	// FirmwareSetAssignmentHook is the signature for custom FirmwareSetAssignment hook methods
+	FirmwareSetAssignmentHook func(context.Context, boil.ContextExecutor, *FirmwareSetAssignment) error
+
+	firmwareSetAssignmentQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	firmwareSetAssignmentType                 = reflect.TypeOf(&FirmwareSetAssignment{})
+	firmwareSetAssignmentMapping              = queries.MakeStructMapping(firmwareSetAssignmentType)
+	firmwareSetAssignmentPrimaryKeyMapping, _ = queries.BindMapping(firmwareSetAssignmentType, firmwareSetAssignmentMapping, firmwareSetAssignmentPrimaryKeyColumns)
+	firmwareSetAssignmentInsertCacheMut       sync.RWMutex
+	firmwareSetAssignmentInsertCache          = make(map[string]insertCache)
+	firmwareSetAssignmentUpdateCacheMut       sync.RWMutex
+	firmwareSetAssignmentUpdateCache          = make(map[string]updateCache)
+	firmwareSetAssignmentUpsertCacheMut       sync.RWMutex
+	firmwareSetAssignmentUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var firmwareSetAssignmentAfterSelectHooks []FirmwareSetAssignmentHook
+
+var firmwareSetAssignmentBeforeInsertHooks []FirmwareSetAssignmentHook
+var firmwareSetAssignmentAfterInsertHooks []FirmwareSetAssignmentHook
+
+var firmwareSetAssignmentBeforeUpdateHooks []FirmwareSetAssignmentHook
+var firmwareSetAssignmentAfterUpdateHooks []FirmwareSetAssignmentHook
+
+var firmwareSetAssignmentBeforeDeleteHooks []FirmwareSetAssignmentHook
+var firmwareSetAssignmentAfterDeleteHooks []FirmwareSetAssignmentHook
+
+var firmwareSetAssignmentBeforeUpsertHooks []FirmwareSetAssignmentHook
+var firmwareSetAssignmentAfterUpsertHooks []FirmwareSetAssignmentHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *FirmwareSetAssignment) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range firmwareSetAssignmentAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *FirmwareSetAssignment) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range firmwareSetAssignmentBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *FirmwareSetAssignment) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range firmwareSetAssignmentAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *FirmwareSetAssignment) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range firmwareSetAssignmentBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *FirmwareSetAssignment) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range firmwareSetAssignmentAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *FirmwareSetAssignment) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range firmwareSetAssignmentBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *FirmwareSetAssignment) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range firmwareSetAssignmentAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *FirmwareSetAssignment) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range firmwareSetAssignmentBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *FirmwareSetAssignment) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range firmwareSetAssignmentAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddFirmwareSetAssignmentHook registers your hook function for all future operations.
+func AddFirmwareSetAssignmentHook(hookPoint boil.HookPoint, firmwareSetAssignmentHook FirmwareSetAssignmentHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		firmwareSetAssignmentAfterSelectHooks = append(firmwareSetAssignmentAfterSelectHooks, firmwareSetAssignmentHook)
+	case boil.BeforeInsertHook:
+		firmwareSetAssignmentBeforeInsertHooks = append(firmwareSetAssignmentBeforeInsertHooks, firmwareSetAssignmentHook)
+	case boil.AfterInsertHook:
+		firmwareSetAssignmentAfterInsertHooks = append(firmwareSetAssignmentAfterInsertHooks, firmwareSetAssignmentHook)
+	case boil.BeforeUpdateHook:
+		firmwareSetAssignmentBeforeUpdateHooks = append(firmwareSetAssignmentBeforeUpdateHooks, firmwareSetAssignmentHook)
+	case boil.AfterUpdateHook:
+		firmwareSetAssignmentAfterUpdateHooks = append(firmwareSetAssignmentAfterUpdateHooks, firmwareSetAssignmentHook)
+	case boil.BeforeDeleteHook:
+		firmwareSetAssignmentBeforeDeleteHooks = append(firmwareSetAssignmentBeforeDeleteHooks, firmwareSetAssignmentHook)
+	case boil.AfterDeleteHook:
+		firmwareSetAssignmentAfterDeleteHooks = append(firmwareSetAssignmentAfterDeleteHooks, firmwareSetAssignmentHook)
+	case boil.BeforeUpsertHook:
+		firmwareSetAssignmentBeforeUpsertHooks = append(firmwareSetAssignmentBeforeUpsertHooks, firmwareSetAssignmentHook)
+	case boil.AfterUpsertHook:
+		firmwareSetAssignmentAfterUpsertHooks = append(firmwareSetAssignmentAfterUpsertHooks, firmwareSetAssignmentHook)
+	}
+}
+
+// One returns a single firmwareSetAssignment record from the query.
+func (q firmwareSetAssignmentQuery) One(ctx context.Context, exec boil.ContextExecutor) (*FirmwareSetAssignment, error) {
+	o := &FirmwareSetAssignment{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for firmware_set_assignments")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all FirmwareSetAssignment records from the query.
+func (q firmwareSetAssignmentQuery) All(ctx context.Context, exec boil.ContextExecutor) (FirmwareSetAssignmentSlice, error) {
+	var o []*FirmwareSetAssignment
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to FirmwareSetAssignment slice")
+	}
+
+	if len(firmwareSetAssignmentAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all FirmwareSetAssignment records in the query.
+func (q firmwareSetAssignmentQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count firmware_set_assignments rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q firmwareSetAssignmentQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if firmware_set_assignments exists")
+	}
+
+	return count > 0, nil
+}
+
+// FirmwareSet pointed to by the foreign key.
+func (o *FirmwareSetAssignment) FirmwareSet(mods ...qm.QueryMod) componentFirmwareSetQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.FirmwareSetID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return ComponentFirmwareSets(queryMods...)
+}
+
+// Server pointed to by the foreign key.
+func (o *FirmwareSetAssignment) Server(mods ...qm.QueryMod) serverQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.ServerID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Servers(queryMods...)
+}
+
+// LoadFirmwareSet allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (firmwareSetAssignmentL) LoadFirmwareSet(ctx context.Context, e boil.ContextExecutor, singular bool, maybeFirmwareSetAssignment interface{}, mods queries.Applicator) error {
+	var slice []*FirmwareSetAssignment
+	var object *FirmwareSetAssignment
+
+	if singular {
+		object = maybeFirmwareSetAssignment.(*FirmwareSetAssignment)
+	} else {
+		slice = *maybeFirmwareSetAssignment.(*[]*FirmwareSetAssignment)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &firmwareSetAssignmentR{}
+		}
+		args = append(args, object.FirmwareSetID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &firmwareSetAssignmentR{}
+			}
+
+			for _, a := range args {
+				if a == obj.FirmwareSetID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.FirmwareSetID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`component_firmware_set`),
+		qm.WhereIn(`component_firmware_set.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load ComponentFirmwareSet")
+	}
+
+	var resultSlice []*ComponentFirmwareSet
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice ComponentFirmwareSet")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for component_firmware_set")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for component_firmware_set")
+	}
+
+	if len(firmwareSetAssignmentAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.FirmwareSet = foreign
+		if foreign.R == nil {
+			foreign.R = &componentFirmwareSetR{}
+		}
+		foreign.R.FirmwareSetFirmwareSetAssignments = append(foreign.R.FirmwareSetFirmwareSetAssignments, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.FirmwareSetID == foreign.ID {
+				local.R.FirmwareSet = foreign
+				if foreign.R == nil {
+					foreign.R = &componentFirmwareSetR{}
+				}
+				foreign.R.FirmwareSetFirmwareSetAssignments = append(foreign.R.FirmwareSetFirmwareSetAssignments, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadServer allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (firmwareSetAssignmentL) LoadServer(ctx context.Context, e boil.ContextExecutor, singular bool, maybeFirmwareSetAssignment interface{}, mods queries.Applicator) error {
+	var slice []*FirmwareSetAssignment
+	var object *FirmwareSetAssignment
+
+	if singular {
+		object = maybeFirmwareSetAssignment.(*FirmwareSetAssignment)
+	} else {
+		slice = *maybeFirmwareSetAssignment.(*[]*FirmwareSetAssignment)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &firmwareSetAssignmentR{}
+		}
+		if !queries.IsNil(object.ServerID) {
+			args = append(args, object.ServerID)
+		}
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &firmwareSetAssignmentR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.ServerID) {
+					continue Outer
+				}
+			}
+
+			if !queries.IsNil(obj.ServerID) {
+				args = append(args, obj.ServerID)
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`servers`),
+		qm.WhereIn(`servers.id in ?`, args...),
+		qmhelper.WhereIsNull(`servers.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Server")
+	}
+
+	var resultSlice []*Server
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Server")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for servers")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for servers")
+	}
+
+	if len(firmwareSetAssignmentAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Server = foreign
+		if foreign.R == nil {
+			foreign.R = &serverR{}
+		}
+		foreign.R.FirmwareSetAssignment = object
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.ServerID, foreign.ID) {
+				local.R.Server = foreign
+				if foreign.R == nil {
+					foreign.R = &serverR{}
+				}
+				foreign.R.FirmwareSetAssignment = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetFirmwareSet of the firmwareSetAssignment to the related item.
+// Sets o.R.FirmwareSet to related.
+// Adds o to related.R.FirmwareSetFirmwareSetAssignments.
+func (o *FirmwareSetAssignment) SetFirmwareSet(ctx context.Context, exec boil.ContextExecutor, insert bool, related *ComponentFirmwareSet) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"firmware_set_assignments\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"firmware_set_id"}),
+		strmangle.WhereClause("\"", "\"", 2, firmwareSetAssignmentPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.FirmwareSetID = related.ID
+	if o.R == nil {
+		o.R = &firmwareSetAssignmentR{
+			FirmwareSet: related,
+		}
+	} else {
+		o.R.FirmwareSet = related
+	}
+
+	if related.R == nil {
+		related.R = &componentFirmwareSetR{
+			FirmwareSetFirmwareSetAssignments: FirmwareSetAssignmentSlice{o},
+		}
+	} else {
+		related.R.FirmwareSetFirmwareSetAssignments = append(related.R.FirmwareSetFirmwareSetAssignments, o)
+	}
+
+	return nil
+}
+
+// SetServer of the firmwareSetAssignment to the related item.
+// Sets o.R.Server to related.
+// Adds o to related.R.FirmwareSetAssignment.
+func (o *FirmwareSetAssignment) SetServer(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Server) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"firmware_set_assignments\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"server_id"}),
+		strmangle.WhereClause("\"", "\"", 2, firmwareSetAssignmentPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.ServerID, related.ID)
+	if o.R == nil {
+		o.R = &firmwareSetAssignmentR{
+			Server: related,
+		}
+	} else {
+		o.R.Server = related
+	}
+
+	if related.R == nil {
+		related.R = &serverR{
+			FirmwareSetAssignment: o,
+		}
+	} else {
+		related.R.FirmwareSetAssignment = o
+	}
+
+	return nil
+}
+
+// RemoveServer relationship.
+// Sets o.R.Server to nil.
+// Removes o from all passed in related items' relationships struct.
+func (o *FirmwareSetAssignment) RemoveServer(ctx context.Context, exec boil.ContextExecutor, related *Server) error {
+	var err error
+
+	queries.SetScanner(&o.ServerID, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("server_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.Server = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	related.R.FirmwareSetAssignment = nil
+	return nil
+}
+
+// FirmwareSetAssignments retrieves all the records using an executor.
+func FirmwareSetAssignments(mods ...qm.QueryMod) firmwareSetAssignmentQuery {
+	mods = append(mods, qm.From("\"firmware_set_assignments\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"firmware_set_assignments\".*"})
+	}
+
+	return firmwareSetAssignmentQuery{q}
+}
+
+// FindFirmwareSetAssignment retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindFirmwareSetAssignment(ctx context.Context, exec boil.ContextExecutor, iD string, selectCols ...string) (*FirmwareSetAssignment, error) {
+	firmwareSetAssignmentObj := &FirmwareSetAssignment{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"firmware_set_assignments\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, firmwareSetAssignmentObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from firmware_set_assignments")
+	}
+
+	if err = firmwareSetAssignmentObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return firmwareSetAssignmentObj, err
+	}
+
+	return firmwareSetAssignmentObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *FirmwareSetAssignment) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no firmware_set_assignments provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if queries.MustTime(o.CreatedAt).IsZero() {
+			queries.SetScanner(&o.CreatedAt, currTime)
+		}
+		if queries.MustTime(o.UpdatedAt).IsZero() {
+			queries.SetScanner(&o.UpdatedAt, currTime)
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(firmwareSetAssignmentColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	firmwareSetAssignmentInsertCacheMut.RLock()
+	cache, cached := firmwareSetAssignmentInsertCache[key]
+	firmwareSetAssignmentInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			firmwareSetAssignmentAllColumns,
+			firmwareSetAssignmentColumnsWithDefault,
+			firmwareSetAssignmentColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(firmwareSetAssignmentType, firmwareSetAssignmentMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(firmwareSetAssignmentType, firmwareSetAssignmentMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"firmware_set_assignments\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"firmware_set_assignments\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into firmware_set_assignments")
+	}
+
+	if !cached {
+		firmwareSetAssignmentInsertCacheMut.Lock()
+		firmwareSetAssignmentInsertCache[key] = cache
+		firmwareSetAssignmentInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the FirmwareSetAssignment.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *FirmwareSetAssignment) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		queries.SetScanner(&o.UpdatedAt, currTime)
+	}
+
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	firmwareSetAssignmentUpdateCacheMut.RLock()
+	cache, cached := firmwareSetAssignmentUpdateCache[key]
+	firmwareSetAssignmentUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			firmwareSetAssignmentAllColumns,
+			firmwareSetAssignmentPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update firmware_set_assignments, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"firmware_set_assignments\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, firmwareSetAssignmentPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(firmwareSetAssignmentType, firmwareSetAssignmentMapping, append(wl, firmwareSetAssignmentPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update firmware_set_assignments row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for firmware_set_assignments")
+	}
+
+	if !cached {
+		firmwareSetAssignmentUpdateCacheMut.Lock()
+		firmwareSetAssignmentUpdateCache[key] = cache
+		firmwareSetAssignmentUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q firmwareSetAssignmentQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for firmware_set_assignments")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for firmware_set_assignments")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o FirmwareSetAssignmentSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), firmwareSetAssignmentPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"firmware_set_assignments\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, firmwareSetAssignmentPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in firmwareSetAssignment slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all firmwareSetAssignment")
+	}
+	return rowsAff, nil
+}
+
+// Delete deletes a single FirmwareSetAssignment record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *FirmwareSetAssignment) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no FirmwareSetAssignment provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), firmwareSetAssignmentPrimaryKeyMapping)
+	sql := "DELETE FROM \"firmware_set_assignments\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from firmware_set_assignments")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for firmware_set_assignments")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q firmwareSetAssignmentQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no firmwareSetAssignmentQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from firmware_set_assignments")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for firmware_set_assignments")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o FirmwareSetAssignmentSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(firmwareSetAssignmentBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), firmwareSetAssignmentPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"firmware_set_assignments\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, firmwareSetAssignmentPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from firmwareSetAssignment slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for firmware_set_assignments")
+	}
+
+	if len(firmwareSetAssignmentAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *FirmwareSetAssignment) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindFirmwareSetAssignment(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *FirmwareSetAssignmentSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := FirmwareSetAssignmentSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), firmwareSetAssignmentPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"firmware_set_assignments\".* FROM \"firmware_set_assignments\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, firmwareSetAssignmentPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in FirmwareSetAssignmentSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// FirmwareSetAssignmentExists checks if the FirmwareSetAssignment row exists.
+func FirmwareSetAssignmentExists(ctx context.Context, exec boil.ContextExecutor, iD string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"firmware_set_assignments\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if firmware_set_assignments exists")
+	}
+
+	return exists, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *FirmwareSetAssignment) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no firmware_set_assignments provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if queries.MustTime(o.CreatedAt).IsZero() {
+			queries.SetScanner(&o.CreatedAt, currTime)
+		}
+		queries.SetScanner(&o.UpdatedAt, currTime)
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(firmwareSetAssignmentColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	firmwareSetAssignmentUpsertCacheMut.RLock()
+	cache, cached := firmwareSetAssignmentUpsertCache[key]
+	firmwareSetAssignmentUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			firmwareSetAssignmentAllColumns,
+			firmwareSetAssignmentColumnsWithDefault,
+			firmwareSetAssignmentColumnsWithoutDefault,
+			nzDefaults,
+		)
+		update := updateColumns.UpdateColumnSet(
+			firmwareSetAssignmentAllColumns,
+			firmwareSetAssignmentPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert firmware_set_assignments, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(firmwareSetAssignmentPrimaryKeyColumns))
+			copy(conflict, firmwareSetAssignmentPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryCockroachDB(dialect, "\"firmware_set_assignments\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(firmwareSetAssignmentType, firmwareSetAssignmentMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(firmwareSetAssignmentType, firmwareSetAssignmentMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.DebugMode {
+		_, _ = fmt.Fprintln(boil.DebugWriter, cache.query)
+		_, _ = fmt.Fprintln(boil.DebugWriter, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if err == sql.ErrNoRows {
+			err = nil // CockcorachDB doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert firmware_set_assignments")
+	}
+
+	if !cached {
+		firmwareSetAssignmentUpsertCacheMut.Lock()
+		firmwareSetAssignmentUpsertCache[key] = cache
+		firmwareSetAssignmentUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
@@ -0,0 +1,1001 @@
+// Code generated by SQLBoiler 4.11.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/volatiletech/randomize"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/strmangle"
+)
+
+func testFirmwareSetAssignmentsUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(firmwareSetAssignmentAllColumns) == len(firmwareSetAssignmentPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := FirmwareSetAssignment{}
+	if err = randomize.Struct(seed, &o, firmwareSetAssignmentDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize FirmwareSetAssignment struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert FirmwareSetAssignment: %s", err)
+	}
+
+	count, err := FirmwareSetAssignments().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, firmwareSetAssignmentDBTypes, false, firmwareSetAssignmentPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize FirmwareSetAssignment struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert FirmwareSetAssignment: %s", err)
+	}
+
+	count, err = FirmwareSetAssignments().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testFirmwareSetAssignments(t *testing.T) {
+	t.Parallel()
+
+	query := FirmwareSetAssignments()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testFirmwareSetAssignmentsDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &FirmwareSetAssignment{}
+	if err = randomize.Struct(seed, o, firmwareSetAssignmentDBTypes, true, firmwareSetAssignmentColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FirmwareSetAssignment struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := FirmwareSetAssignments().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testFirmwareSetAssignmentsQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &FirmwareSetAssignment{}
+	if err = randomize.Struct(seed, o, firmwareSetAssignmentDBTypes, true, firmwareSetAssignmentColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FirmwareSetAssignment struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := FirmwareSetAssignments().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := FirmwareSetAssignments().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testFirmwareSetAssignmentsSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &FirmwareSetAssignment{}
+	if err = randomize.Struct(seed, o, firmwareSetAssignmentDBTypes, true, firmwareSetAssignmentColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FirmwareSetAssignment struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := FirmwareSetAssignmentSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := FirmwareSetAssignments().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testFirmwareSetAssignmentsExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &FirmwareSetAssignment{}
+	if err = randomize.Struct(seed, o, firmwareSetAssignmentDBTypes, true, firmwareSetAssignmentColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FirmwareSetAssignment struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := FirmwareSetAssignmentExists(ctx, tx, o.ID)
+	if err != nil {
+		t.Errorf("Unable to check if FirmwareSetAssignment exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected FirmwareSetAssignmentExists to return true, but got false.")
+	}
+}
+
+func testFirmwareSetAssignmentsFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &FirmwareSetAssignment{}
+	if err = randomize.Struct(seed, o, firmwareSetAssignmentDBTypes, true, firmwareSetAssignmentColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FirmwareSetAssignment struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	firmwareSetAssignmentFound, err := FindFirmwareSetAssignment(ctx, tx, o.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if firmwareSetAssignmentFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testFirmwareSetAssignmentsBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &FirmwareSetAssignment{}
+	if err = randomize.Struct(seed, o, firmwareSetAssignmentDBTypes, true, firmwareSetAssignmentColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FirmwareSetAssignment struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = FirmwareSetAssignments().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testFirmwareSetAssignmentsOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &FirmwareSetAssignment{}
+	if err = randomize.Struct(seed, o, firmwareSetAssignmentDBTypes, true, firmwareSetAssignmentColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FirmwareSetAssignment struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := FirmwareSetAssignments().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testFirmwareSetAssignmentsAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	firmwareSetAssignmentOne := &FirmwareSetAssignment{}
+	firmwareSetAssignmentTwo := &FirmwareSetAssignment{}
+	if err = randomize.Struct(seed, firmwareSetAssignmentOne, firmwareSetAssignmentDBTypes, false, firmwareSetAssignmentColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FirmwareSetAssignment struct: %s", err)
+	}
+	if err = randomize.Struct(seed, firmwareSetAssignmentTwo, firmwareSetAssignmentDBTypes, false, firmwareSetAssignmentColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FirmwareSetAssignment struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = firmwareSetAssignmentOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = firmwareSetAssignmentTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := FirmwareSetAssignments().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testFirmwareSetAssignmentsCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	firmwareSetAssignmentOne := &FirmwareSetAssignment{}
+	firmwareSetAssignmentTwo := &FirmwareSetAssignment{}
+	if err = randomize.Struct(seed, firmwareSetAssignmentOne, firmwareSetAssignmentDBTypes, false, firmwareSetAssignmentColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FirmwareSetAssignment struct: %s", err)
+	}
+	if err = randomize.Struct(seed, firmwareSetAssignmentTwo, firmwareSetAssignmentDBTypes, false, firmwareSetAssignmentColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FirmwareSetAssignment struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = firmwareSetAssignmentOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = firmwareSetAssignmentTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := FirmwareSetAssignments().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func firmwareSetAssignmentBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *FirmwareSetAssignment) error {
+	*o = FirmwareSetAssignment{}
+	return nil
+}
+
+func firmwareSetAssignmentAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *FirmwareSetAssignment) error {
+	*o = FirmwareSetAssignment{}
+	return nil
+}
+
+func firmwareSetAssignmentAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *FirmwareSetAssignment) error {
+	*o = FirmwareSetAssignment{}
+	return nil
+}
+
+func firmwareSetAssignmentBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *FirmwareSetAssignment) error {
+	*o = FirmwareSetAssignment{}
+	return nil
+}
+
+func firmwareSetAssignmentAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *FirmwareSetAssignment) error {
+	*o = FirmwareSetAssignment{}
+	return nil
+}
+
+func firmwareSetAssignmentBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *FirmwareSetAssignment) error {
+	*o = FirmwareSetAssignment{}
+	return nil
+}
+
+func firmwareSetAssignmentAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *FirmwareSetAssignment) error {
+	*o = FirmwareSetAssignment{}
+	return nil
+}
+
+func firmwareSetAssignmentBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *FirmwareSetAssignment) error {
+	*o = FirmwareSetAssignment{}
+	return nil
+}
+
+func firmwareSetAssignmentAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *FirmwareSetAssignment) error {
+	*o = FirmwareSetAssignment{}
+	return nil
+}
+
+func testFirmwareSetAssignmentsHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &FirmwareSetAssignment{}
+	o := &FirmwareSetAssignment{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, firmwareSetAssignmentDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize FirmwareSetAssignment object: %s", err)
+	}
+
+	AddFirmwareSetAssignmentHook(boil.BeforeInsertHook, firmwareSetAssignmentBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	firmwareSetAssignmentBeforeInsertHooks = []FirmwareSetAssignmentHook{}
+
+	AddFirmwareSetAssignmentHook(boil.AfterInsertHook, firmwareSetAssignmentAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	firmwareSetAssignmentAfterInsertHooks = []FirmwareSetAssignmentHook{}
+
+	AddFirmwareSetAssignmentHook(boil.AfterSelectHook, firmwareSetAssignmentAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	firmwareSetAssignmentAfterSelectHooks = []FirmwareSetAssignmentHook{}
+
+	AddFirmwareSetAssignmentHook(boil.BeforeUpdateHook, firmwareSetAssignmentBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	firmwareSetAssignmentBeforeUpdateHooks = []FirmwareSetAssignmentHook{}
+
+	AddFirmwareSetAssignmentHook(boil.AfterUpdateHook, firmwareSetAssignmentAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	firmwareSetAssignmentAfterUpdateHooks = []FirmwareSetAssignmentHook{}
+
+	AddFirmwareSetAssignmentHook(boil.BeforeDeleteHook, firmwareSetAssignmentBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	firmwareSetAssignmentBeforeDeleteHooks = []FirmwareSetAssignmentHook{}
+
+	AddFirmwareSetAssignmentHook(boil.AfterDeleteHook, firmwareSetAssignmentAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	firmwareSetAssignmentAfterDeleteHooks = []FirmwareSetAssignmentHook{}
+
+	AddFirmwareSetAssignmentHook(boil.BeforeUpsertHook, firmwareSetAssignmentBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	firmwareSetAssignmentBeforeUpsertHooks = []FirmwareSetAssignmentHook{}
+
+	AddFirmwareSetAssignmentHook(boil.AfterUpsertHook, firmwareSetAssignmentAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	firmwareSetAssignmentAfterUpsertHooks = []FirmwareSetAssignmentHook{}
+}
+
+func testFirmwareSetAssignmentsInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &FirmwareSetAssignment{}
+	if err = randomize.Struct(seed, o, firmwareSetAssignmentDBTypes, true, firmwareSetAssignmentColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FirmwareSetAssignment struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := FirmwareSetAssignments().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testFirmwareSetAssignmentsInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &FirmwareSetAssignment{}
+	if err = randomize.Struct(seed, o, firmwareSetAssignmentDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize FirmwareSetAssignment struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(firmwareSetAssignmentColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := FirmwareSetAssignments().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testFirmwareSetAssignmentToOneComponentFirmwareSetUsingFirmwareSet(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local FirmwareSetAssignment
+	var foreign ComponentFirmwareSet
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, firmwareSetAssignmentDBTypes, false, firmwareSetAssignmentColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FirmwareSetAssignment struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, componentFirmwareSetDBTypes, false, componentFirmwareSetColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ComponentFirmwareSet struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.FirmwareSetID = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.FirmwareSet().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := FirmwareSetAssignmentSlice{&local}
+	if err = local.L.LoadFirmwareSet(ctx, tx, false, (*[]*FirmwareSetAssignment)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.FirmwareSet == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.FirmwareSet = nil
+	if err = local.L.LoadFirmwareSet(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.FirmwareSet == nil {
+		t.Error("struct should have been eager loaded")
+	}
+}
+
+func testFirmwareSetAssignmentToOneServerUsingServer(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local FirmwareSetAssignment
+	var foreign Server
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, firmwareSetAssignmentDBTypes, true, firmwareSetAssignmentColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FirmwareSetAssignment struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, serverDBTypes, false, serverColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Server struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&local.ServerID, foreign.ID)
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.Server().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !queries.Equal(check.ID, foreign.ID) {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := FirmwareSetAssignmentSlice{&local}
+	if err = local.L.LoadServer(ctx, tx, false, (*[]*FirmwareSetAssignment)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Server == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.Server = nil
+	if err = local.L.LoadServer(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Server == nil {
+		t.Error("struct should have been eager loaded")
+	}
+}
+
+func testFirmwareSetAssignmentToOneSetOpComponentFirmwareSetUsingFirmwareSet(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a FirmwareSetAssignment
+	var b, c ComponentFirmwareSet
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, firmwareSetAssignmentDBTypes, false, strmangle.SetComplement(firmwareSetAssignmentPrimaryKeyColumns, firmwareSetAssignmentColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, componentFirmwareSetDBTypes, false, strmangle.SetComplement(componentFirmwareSetPrimaryKeyColumns, componentFirmwareSetColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, componentFirmwareSetDBTypes, false, strmangle.SetComplement(componentFirmwareSetPrimaryKeyColumns, componentFirmwareSetColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*ComponentFirmwareSet{&b, &c} {
+		err = a.SetFirmwareSet(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.FirmwareSet != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.FirmwareSetFirmwareSetAssignments[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.FirmwareSetID != x.ID {
+			t.Error("foreign key was wrong value", a.FirmwareSetID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.FirmwareSetID))
+		reflect.Indirect(reflect.ValueOf(&a.FirmwareSetID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if a.FirmwareSetID != x.ID {
+			t.Error("foreign key was wrong value", a.FirmwareSetID, x.ID)
+		}
+	}
+}
+func testFirmwareSetAssignmentToOneSetOpServerUsingServer(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a FirmwareSetAssignment
+	var b, c Server
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, firmwareSetAssignmentDBTypes, false, strmangle.SetComplement(firmwareSetAssignmentPrimaryKeyColumns, firmwareSetAssignmentColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, serverDBTypes, false, strmangle.SetComplement(serverPrimaryKeyColumns, serverColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, serverDBTypes, false, strmangle.SetComplement(serverPrimaryKeyColumns, serverColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*Server{&b, &c} {
+		err = a.SetServer(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.Server != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.FirmwareSetAssignment != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if !queries.Equal(a.ServerID, x.ID) {
+			t.Error("foreign key was wrong value", a.ServerID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.ServerID))
+		reflect.Indirect(reflect.ValueOf(&a.ServerID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if !queries.Equal(a.ServerID, x.ID) {
+			t.Error("foreign key was wrong value", a.ServerID, x.ID)
+		}
+	}
+}
+
+func testFirmwareSetAssignmentToOneRemoveOpServerUsingServer(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a FirmwareSetAssignment
+	var b Server
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, firmwareSetAssignmentDBTypes, false, strmangle.SetComplement(firmwareSetAssignmentPrimaryKeyColumns, firmwareSetAssignmentColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, serverDBTypes, false, strmangle.SetComplement(serverPrimaryKeyColumns, serverColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.SetServer(ctx, tx, true, &b); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.RemoveServer(ctx, tx, &b); err != nil {
+		t.Error("failed to remove relationship")
+	}
+
+	count, err := a.Server().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 0 {
+		t.Error("want no relationships remaining")
+	}
+
+	if a.R.Server != nil {
+		t.Error("R struct entry should be nil")
+	}
+
+	if !queries.IsValuerNil(a.ServerID) {
+		t.Error("foreign key value should be nil")
+	}
+
+	if b.R.FirmwareSetAssignment != nil {
+		t.Error("failed to remove a from b's relationships")
+	}
+
+}
+
+func testFirmwareSetAssignmentsReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &FirmwareSetAssignment{}
+	if err = randomize.Struct(seed, o, firmwareSetAssignmentDBTypes, true, firmwareSetAssignmentColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FirmwareSetAssignment struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testFirmwareSetAssignmentsReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &FirmwareSetAssignment{}
+	if err = randomize.Struct(seed, o, firmwareSetAssignmentDBTypes, true, firmwareSetAssignmentColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FirmwareSetAssignment struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := FirmwareSetAssignmentSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testFirmwareSetAssignmentsSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &FirmwareSetAssignment{}
+	if err = randomize.Struct(seed, o, firmwareSetAssignmentDBTypes, true, firmwareSetAssignmentColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FirmwareSetAssignment struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := FirmwareSetAssignments().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	firmwareSetAssignmentDBTypes = map[string]string{`ID`: `uuid`, `FirmwareSetID`: `uuid`, `ServerID`: `uuid`, `FacilityCode`: `string`, `Attributes`: `jsonb`, `Priority`: `int8`, `CreatedAt`: `timestamptz`, `UpdatedAt`: `timestamptz`}
+	_                            = bytes.MinRead
+)
+
+func testFirmwareSetAssignmentsUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(firmwareSetAssignmentPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(firmwareSetAssignmentAllColumns) == len(firmwareSetAssignmentPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &FirmwareSetAssignment{}
+	if err = randomize.Struct(seed, o, firmwareSetAssignmentDBTypes, true, firmwareSetAssignmentColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FirmwareSetAssignment struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := FirmwareSetAssignments().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, firmwareSetAssignmentDBTypes, true, firmwareSetAssignmentPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize FirmwareSetAssignment struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testFirmwareSetAssignmentsSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(firmwareSetAssignmentAllColumns) == len(firmwareSetAssignmentPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &FirmwareSetAssignment{}
+	if err = randomize.Struct(seed, o, firmwareSetAssignmentDBTypes, true, firmwareSetAssignmentColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FirmwareSetAssignment struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := FirmwareSetAssignments().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, firmwareSetAssignmentDBTypes, true, firmwareSetAssignmentPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize FirmwareSetAssignment struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(firmwareSetAssignmentAllColumns, firmwareSetAssignmentPrimaryKeyColumns) {
+		fields = firmwareSetAssignmentAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			firmwareSetAssignmentAllColumns,
+			firmwareSetAssignmentPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := FirmwareSetAssignmentSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
@@ -0,0 +1,1242 @@
+// Code generated by SQLBoiler 4.11.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// MaintenanceWindowServer is an object representing the database table.
+type MaintenanceWindowServer struct {
+	ID                  string `boil:"id" json:"id" toml:"id" yaml:"id"`
+	MaintenanceWindowID string `boil:"maintenance_window_id" json:"maintenance_window_id" toml:"maintenance_window_id" yaml:"maintenance_window_id"`
+	ServerID            string `boil:"server_id" json:"server_id" toml:"server_id" yaml:"server_id"`
+
+	R *maintenanceWindowServerR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L maintenanceWindowServerL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var MaintenanceWindowServerColumns = struct {
+	ID                  string
+	MaintenanceWindowID string
+	ServerID            string
+}{
+	ID:                  "id",
+	MaintenanceWindowID: "maintenance_window_id",
+	ServerID:            "server_id",
+}
+
+var MaintenanceWindowServerTableColumns = struct {
+	ID                  string
+	MaintenanceWindowID string
+	ServerID            string
+}{
+	ID:                  "maintenance_window_servers.id",
+	MaintenanceWindowID: "maintenance_window_servers.maintenance_window_id",
+	ServerID:            "maintenance_window_servers.server_id",
+}
+
+// Generated where
+
+var MaintenanceWindowServerWhere = struct {
+	ID                  whereHelperstring
+	MaintenanceWindowID whereHelperstring
+	ServerID            whereHelperstring
+}{
+	ID:                  whereHelperstring{field: "\"maintenance_window_servers\".\"id\""},
+	MaintenanceWindowID: whereHelperstring{field: "\"maintenance_window_servers\".\"maintenance_window_id\""},
+	ServerID:            whereHelperstring{field: "\"maintenance_window_servers\".\"server_id\""},
+}
+
+// MaintenanceWindowServerRels is where relationship names are stored.
+var MaintenanceWindowServerRels = struct {
+	MaintenanceWindow string
+	Server            string
+}{
+	MaintenanceWindow: "MaintenanceWindow",
+	Server:            "Server",
+}
+
+// maintenanceWindowServerR is where relationships are stored.
+type maintenanceWindowServerR struct {
+	MaintenanceWindow *MaintenanceWindow `boil:"MaintenanceWindow" json:"MaintenanceWindow" toml:"MaintenanceWindow" yaml:"MaintenanceWindow"`
+	Server            *Server            `boil:"Server" json:"Server" toml:"Server" yaml:"Server"`
+}
+
+// NewStruct creates a new relationship struct
+func (*maintenanceWindowServerR) NewStruct() *maintenanceWindowServerR {
+	return &maintenanceWindowServerR{}
+}
+
+func (r *maintenanceWindowServerR) GetMaintenanceWindow() *MaintenanceWindow {
+	if r == nil {
+		return nil
+	}
+	return r.MaintenanceWindow
+}
+
+func (r *maintenanceWindowServerR) GetServer() *Server {
+	if r == nil {
+		return nil
+	}
+	return r.Server
+}
+
+// maintenanceWindowServerL is where Load methods for each relationship are stored.
+type maintenanceWindowServerL struct{}
+
+var (
+	maintenanceWindowServerAllColumns            = []string{"id", "maintenance_window_id", "server_id"}
+	maintenanceWindowServerColumnsWithoutDefault = []string{"maintenance_window_id", "server_id"}
+	maintenanceWindowServerColumnsWithDefault    = []string{"id"}
+	maintenanceWindowServerPrimaryKeyColumns     = []string{"id"}
+	maintenanceWindowServerGeneratedColumns      = []string{}
+)
+
+type (
+	// MaintenanceWindowServerSlice is an alias for a slice of pointers to MaintenanceWindowServer.
+	// This should almost always be used instead of []MaintenanceWindowServer.
+	MaintenanceWindowServerSlice []*MaintenanceWindowServer
+	// MaintenanceWindowServerHook is the signature for custom MaintenanceWindowServer hook methods
+	MaintenanceWindowServerHook func(context.Context, boil.ContextExecutor, *MaintenanceWindowServer) error
+
+	maintenanceWindowServerQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	maintenanceWindowServerType                 = reflect.TypeOf(&MaintenanceWindowServer{})
+	maintenanceWindowServerMapping              = queries.MakeStructMapping(maintenanceWindowServerType)
+	maintenanceWindowServerPrimaryKeyMapping, _ = queries.BindMapping(maintenanceWindowServerType, maintenanceWindowServerMapping, maintenanceWindowServerPrimaryKeyColumns)
+	maintenanceWindowServerInsertCacheMut       sync.RWMutex
+	maintenanceWindowServerInsertCache          = make(map[string]insertCache)
+	maintenanceWindowServerUpdateCacheMut       sync.RWMutex
+	maintenanceWindowServerUpdateCache          = make(map[string]updateCache)
+	maintenanceWindowServerUpsertCacheMut       sync.RWMutex
+	maintenanceWindowServerUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var maintenanceWindowServerAfterSelectHooks []MaintenanceWindowServerHook
+
+var maintenanceWindowServerBeforeInsertHooks []MaintenanceWindowServerHook
+var maintenanceWindowServerAfterInsertHooks []MaintenanceWindowServerHook
+
+var maintenanceWindowServerBeforeUpdateHooks []MaintenanceWindowServerHook
+var maintenanceWindowServerAfterUpdateHooks []MaintenanceWindowServerHook
+
+var maintenanceWindowServerBeforeDeleteHooks []MaintenanceWindowServerHook
+var maintenanceWindowServerAfterDeleteHooks []MaintenanceWindowServerHook
+
+var maintenanceWindowServerBeforeUpsertHooks []MaintenanceWindowServerHook
+var maintenanceWindowServerAfterUpsertHooks []MaintenanceWindowServerHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *MaintenanceWindowServer) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range maintenanceWindowServerAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *MaintenanceWindowServer) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range maintenanceWindowServerBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *MaintenanceWindowServer) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range maintenanceWindowServerAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *MaintenanceWindowServer) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range maintenanceWindowServerBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *MaintenanceWindowServer) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range maintenanceWindowServerAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *MaintenanceWindowServer) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range maintenanceWindowServerBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *MaintenanceWindowServer) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range maintenanceWindowServerAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *MaintenanceWindowServer) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range maintenanceWindowServerBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *MaintenanceWindowServer) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range maintenanceWindowServerAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddMaintenanceWindowServerHook registers your hook function for all future operations.
+func AddMaintenanceWindowServerHook(hookPoint boil.HookPoint, maintenanceWindowServerHook MaintenanceWindowServerHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		maintenanceWindowServerAfterSelectHooks = append(maintenanceWindowServerAfterSelectHooks, maintenanceWindowServerHook)
+	case boil.BeforeInsertHook:
+		maintenanceWindowServerBeforeInsertHooks = append(maintenanceWindowServerBeforeInsertHooks, maintenanceWindowServerHook)
+	case boil.AfterInsertHook:
+		maintenanceWindowServerAfterInsertHooks = append(maintenanceWindowServerAfterInsertHooks, maintenanceWindowServerHook)
+	case boil.BeforeUpdateHook:
+		maintenanceWindowServerBeforeUpdateHooks = append(maintenanceWindowServerBeforeUpdateHooks, maintenanceWindowServerHook)
+	case boil.AfterUpdateHook:
+		maintenanceWindowServerAfterUpdateHooks = append(maintenanceWindowServerAfterUpdateHooks, maintenanceWindowServerHook)
+	case boil.BeforeDeleteHook:
+		maintenanceWindowServerBeforeDeleteHooks = append(maintenanceWindowServerBeforeDeleteHooks, maintenanceWindowServerHook)
+	case boil.AfterDeleteHook:
+		maintenanceWindowServerAfterDeleteHooks = append(maintenanceWindowServerAfterDeleteHooks, maintenanceWindowServerHook)
+	case boil.BeforeUpsertHook:
+		maintenanceWindowServerBeforeUpsertHooks = append(maintenanceWindowServerBeforeUpsertHooks, maintenanceWindowServerHook)
+	case boil.AfterUpsertHook:
+		maintenanceWindowServerAfterUpsertHooks = append(maintenanceWindowServerAfterUpsertHooks, maintenanceWindowServerHook)
+	}
+}
+
+// One returns a single maintenanceWindowServer record from the query.
+func (q maintenanceWindowServerQuery) One(ctx context.Context, exec boil.ContextExecutor) (*MaintenanceWindowServer, error) {
+	o := &MaintenanceWindowServer{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for maintenance_window_servers")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all MaintenanceWindowServer records from the query.
+func (q maintenanceWindowServerQuery) All(ctx context.Context, exec boil.ContextExecutor) (MaintenanceWindowServerSlice, error) {
+	var o []*MaintenanceWindowServer
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to MaintenanceWindowServer slice")
+	}
+
+	if len(maintenanceWindowServerAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all MaintenanceWindowServer records in the query.
+func (q maintenanceWindowServerQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count maintenance_window_servers rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q maintenanceWindowServerQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if maintenance_window_servers exists")
+	}
+
+	return count > 0, nil
+}
+
+// MaintenanceWindow pointed to by the foreign key.
+func (o *MaintenanceWindowServer) MaintenanceWindow(mods ...qm.QueryMod) maintenanceWindowQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.MaintenanceWindowID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return MaintenanceWindows(queryMods...)
+}
+
+// Server pointed to by the foreign key.
+func (o *MaintenanceWindowServer) Server(mods ...qm.QueryMod) serverQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.ServerID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Servers(queryMods...)
+}
+
+// LoadMaintenanceWindow allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (maintenanceWindowServerL) LoadMaintenanceWindow(ctx context.Context, e boil.ContextExecutor, singular bool, maybeMaintenanceWindowServer interface{}, mods queries.Applicator) error {
+	var slice []*MaintenanceWindowServer
+	var object *MaintenanceWindowServer
+
+	if singular {
+		object = maybeMaintenanceWindowServer.(*MaintenanceWindowServer)
+	} else {
+		slice = *maybeMaintenanceWindowServer.(*[]*MaintenanceWindowServer)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &maintenanceWindowServerR{}
+		}
+		args = append(args, object.MaintenanceWindowID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &maintenanceWindowServerR{}
+			}
+
+			for _, a := range args {
+				if a == obj.MaintenanceWindowID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.MaintenanceWindowID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`maintenance_windows`),
+		qm.WhereIn(`maintenance_windows.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load MaintenanceWindow")
+	}
+
+	var resultSlice []*MaintenanceWindow
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice MaintenanceWindow")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for maintenance_windows")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for maintenance_windows")
+	}
+
+	if len(maintenanceWindowServerAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.MaintenanceWindow = foreign
+		if foreign.R == nil {
+			foreign.R = &maintenanceWindowR{}
+		}
+		foreign.R.MaintenanceWindowServers = append(foreign.R.MaintenanceWindowServers, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.MaintenanceWindowID == foreign.ID {
+				local.R.MaintenanceWindow = foreign
+				if foreign.R == nil {
+					foreign.R = &maintenanceWindowR{}
+				}
+				foreign.R.MaintenanceWindowServers = append(foreign.R.MaintenanceWindowServers, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadServer allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (maintenanceWindowServerL) LoadServer(ctx context.Context, e boil.ContextExecutor, singular bool, maybeMaintenanceWindowServer interface{}, mods queries.Applicator) error {
+	var slice []*MaintenanceWindowServer
+	var object *MaintenanceWindowServer
+
+	if singular {
+		object = maybeMaintenanceWindowServer.(*MaintenanceWindowServer)
+	} else {
+		slice = *maybeMaintenanceWindowServer.(*[]*MaintenanceWindowServer)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &maintenanceWindowServerR{}
+		}
+		args = append(args, object.ServerID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &maintenanceWindowServerR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ServerID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ServerID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`servers`),
+		qm.WhereIn(`servers.id in ?`, args...),
+		qmhelper.WhereIsNull(`servers.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Server")
+	}
+
+	var resultSlice []*Server
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Server")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for servers")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for servers")
+	}
+
+	if len(maintenanceWindowServerAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Server = foreign
+		if foreign.R == nil {
+			foreign.R = &serverR{}
+		}
+		foreign.R.MaintenanceWindowServers = append(foreign.R.MaintenanceWindowServers, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ServerID == foreign.ID {
+				local.R.Server = foreign
+				if foreign.R == nil {
+					foreign.R = &serverR{}
+				}
+				foreign.R.MaintenanceWindowServers = append(foreign.R.MaintenanceWindowServers, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetMaintenanceWindow of the maintenanceWindowServer to the related item.
+// Sets o.R.MaintenanceWindow to related.
+// Adds o to related.R.MaintenanceWindowServers.
+func (o *MaintenanceWindowServer) SetMaintenanceWindow(ctx context.Context, exec boil.ContextExecutor, insert bool, related *MaintenanceWindow) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"maintenance_window_servers\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"maintenance_window_id"}),
+		strmangle.WhereClause("\"", "\"", 2, maintenanceWindowServerPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.MaintenanceWindowID = related.ID
+	if o.R == nil {
+		o.R = &maintenanceWindowServerR{
+			MaintenanceWindow: related,
+		}
+	} else {
+		o.R.MaintenanceWindow = related
+	}
+
+	if related.R == nil {
+		related.R = &maintenanceWindowR{
+			MaintenanceWindowServers: MaintenanceWindowServerSlice{o},
+		}
+	} else {
+		related.R.MaintenanceWindowServers = append(related.R.MaintenanceWindowServers, o)
+	}
+
+	return nil
+}
+
+// SetServer of the maintenanceWindowServer to the related item.
+// Sets o.R.Server to related.
+// Adds o to related.R.MaintenanceWindowServers.
+func (o *MaintenanceWindowServer) SetServer(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Server) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"maintenance_window_servers\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"server_id"}),
+		strmangle.WhereClause("\"", "\"", 2, maintenanceWindowServerPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.ServerID = related.ID
+	if o.R == nil {
+		o.R = &maintenanceWindowServerR{
+			Server: related,
+		}
+	} else {
+		o.R.Server = related
+	}
+
+	if related.R == nil {
+		related.R = &serverR{
+			MaintenanceWindowServers: MaintenanceWindowServerSlice{o},
+		}
+	} else {
+		related.R.MaintenanceWindowServers = append(related.R.MaintenanceWindowServers, o)
+	}
+
+	return nil
+}
+
+// MaintenanceWindowServers retrieves all the records using an executor.
+func MaintenanceWindowServers(mods ...qm.QueryMod) maintenanceWindowServerQuery {
+	mods = append(mods, qm.From("\"maintenance_window_servers\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"maintenance_window_servers\".*"})
+	}
+
+	return maintenanceWindowServerQuery{q}
+}
+
+// FindMaintenanceWindowServer retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindMaintenanceWindowServer(ctx context.Context, exec boil.ContextExecutor, iD string, selectCols ...string) (*MaintenanceWindowServer, error) {
+	maintenanceWindowServerObj := &MaintenanceWindowServer{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"maintenance_window_servers\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, maintenanceWindowServerObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from maintenance_window_servers")
+	}
+
+	if err = maintenanceWindowServerObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return maintenanceWindowServerObj, err
+	}
+
+	return maintenanceWindowServerObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *MaintenanceWindowServer) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no maintenance_window_servers provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(maintenanceWindowServerColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	maintenanceWindowServerInsertCacheMut.RLock()
+	cache, cached := maintenanceWindowServerInsertCache[key]
+	maintenanceWindowServerInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			maintenanceWindowServerAllColumns,
+			maintenanceWindowServerColumnsWithDefault,
+			maintenanceWindowServerColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(maintenanceWindowServerType, maintenanceWindowServerMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(maintenanceWindowServerType, maintenanceWindowServerMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"maintenance_window_servers\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"maintenance_window_servers\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into maintenance_window_servers")
+	}
+
+	if !cached {
+		maintenanceWindowServerInsertCacheMut.Lock()
+		maintenanceWindowServerInsertCache[key] = cache
+		maintenanceWindowServerInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the MaintenanceWindowServer.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *MaintenanceWindowServer) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	maintenanceWindowServerUpdateCacheMut.RLock()
+	cache, cached := maintenanceWindowServerUpdateCache[key]
+	maintenanceWindowServerUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			maintenanceWindowServerAllColumns,
+			maintenanceWindowServerPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update maintenance_window_servers, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"maintenance_window_servers\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, maintenanceWindowServerPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(maintenanceWindowServerType, maintenanceWindowServerMapping, append(wl, maintenanceWindowServerPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update maintenance_window_servers row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for maintenance_window_servers")
+	}
+
+	if !cached {
+		maintenanceWindowServerUpdateCacheMut.Lock()
+		maintenanceWindowServerUpdateCache[key] = cache
+		maintenanceWindowServerUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q maintenanceWindowServerQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for maintenance_window_servers")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for maintenance_window_servers")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o MaintenanceWindowServerSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), maintenanceWindowServerPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"maintenance_window_servers\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, maintenanceWindowServerPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in maintenanceWindowServer slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all maintenanceWindowServer")
+	}
+	return rowsAff, nil
+}
+
+// Delete deletes a single MaintenanceWindowServer record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *MaintenanceWindowServer) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no MaintenanceWindowServer provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), maintenanceWindowServerPrimaryKeyMapping)
+	sql := "DELETE FROM \"maintenance_window_servers\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from maintenance_window_servers")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for maintenance_window_servers")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q maintenanceWindowServerQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no maintenanceWindowServerQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from maintenance_window_servers")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for maintenance_window_servers")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o MaintenanceWindowServerSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(maintenanceWindowServerBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), maintenanceWindowServerPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"maintenance_window_servers\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, maintenanceWindowServerPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from maintenanceWindowServer slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for maintenance_window_servers")
+	}
+
+	if len(maintenanceWindowServerAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *MaintenanceWindowServer) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindMaintenanceWindowServer(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *MaintenanceWindowServerSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := MaintenanceWindowServerSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), maintenanceWindowServerPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"maintenance_window_servers\".* FROM \"maintenance_window_servers\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, maintenanceWindowServerPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in MaintenanceWindowServerSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// MaintenanceWindowServerExists checks if the MaintenanceWindowServer row exists.
+func MaintenanceWindowServerExists(ctx context.Context, exec boil.ContextExecutor, iD string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"maintenance_window_servers\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if maintenance_window_servers exists")
+	}
+
+	return exists, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *MaintenanceWindowServer) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no maintenance_window_servers provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(maintenanceWindowServerColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	maintenanceWindowServerUpsertCacheMut.RLock()
+	cache, cached := maintenanceWindowServerUpsertCache[key]
+	maintenanceWindowServerUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			maintenanceWindowServerAllColumns,
+			maintenanceWindowServerColumnsWithDefault,
+			maintenanceWindowServerColumnsWithoutDefault,
+			nzDefaults,
+		)
+		update := updateColumns.UpdateColumnSet(
+			maintenanceWindowServerAllColumns,
+			maintenanceWindowServerPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert maintenance_window_servers, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(maintenanceWindowServerPrimaryKeyColumns))
+			copy(conflict, maintenanceWindowServerPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryCockroachDB(dialect, "\"maintenance_window_servers\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(maintenanceWindowServerType, maintenanceWindowServerMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(maintenanceWindowServerType, maintenanceWindowServerMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.DebugMode {
+		_, _ = fmt.Fprintln(boil.DebugWriter, cache.query)
+		_, _ = fmt.Fprintln(boil.DebugWriter, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if err == sql.ErrNoRows {
+			err = nil // CockcorachDB doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert maintenance_window_servers")
+	}
+
+	if !cached {
+		maintenanceWindowServerUpsertCacheMut.Lock()
+		maintenanceWindowServerUpsertCache[key] = cache
+		maintenanceWindowServerUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
@@ -0,0 +1,949 @@
+// Code generated by SQLBoiler 4.11.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/volatiletech/randomize"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/strmangle"
+)
+
+func testMaintenanceWindowServersUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(maintenanceWindowServerAllColumns) == len(maintenanceWindowServerPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := MaintenanceWindowServer{}
+	if err = randomize.Struct(seed, &o, maintenanceWindowServerDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize MaintenanceWindowServer struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert MaintenanceWindowServer: %s", err)
+	}
+
+	count, err := MaintenanceWindowServers().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, maintenanceWindowServerDBTypes, false, maintenanceWindowServerPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize MaintenanceWindowServer struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert MaintenanceWindowServer: %s", err)
+	}
+
+	count, err = MaintenanceWindowServers().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testMaintenanceWindowServers(t *testing.T) {
+	t.Parallel()
+
+	query := MaintenanceWindowServers()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testMaintenanceWindowServersDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &MaintenanceWindowServer{}
+	if err = randomize.Struct(seed, o, maintenanceWindowServerDBTypes, true, maintenanceWindowServerColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize MaintenanceWindowServer struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := MaintenanceWindowServers().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testMaintenanceWindowServersQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &MaintenanceWindowServer{}
+	if err = randomize.Struct(seed, o, maintenanceWindowServerDBTypes, true, maintenanceWindowServerColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize MaintenanceWindowServer struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := MaintenanceWindowServers().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := MaintenanceWindowServers().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testMaintenanceWindowServersSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &MaintenanceWindowServer{}
+	if err = randomize.Struct(seed, o, maintenanceWindowServerDBTypes, true, maintenanceWindowServerColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize MaintenanceWindowServer struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := MaintenanceWindowServerSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := MaintenanceWindowServers().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testMaintenanceWindowServersExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &MaintenanceWindowServer{}
+	if err = randomize.Struct(seed, o, maintenanceWindowServerDBTypes, true, maintenanceWindowServerColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize MaintenanceWindowServer struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := MaintenanceWindowServerExists(ctx, tx, o.ID)
+	if err != nil {
+		t.Errorf("Unable to check if MaintenanceWindowServer exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected MaintenanceWindowServerExists to return true, but got false.")
+	}
+}
+
+func testMaintenanceWindowServersFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &MaintenanceWindowServer{}
+	if err = randomize.Struct(seed, o, maintenanceWindowServerDBTypes, true, maintenanceWindowServerColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize MaintenanceWindowServer struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	maintenanceWindowServerFound, err := FindMaintenanceWindowServer(ctx, tx, o.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if maintenanceWindowServerFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testMaintenanceWindowServersBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &MaintenanceWindowServer{}
+	if err = randomize.Struct(seed, o, maintenanceWindowServerDBTypes, true, maintenanceWindowServerColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize MaintenanceWindowServer struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = MaintenanceWindowServers().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testMaintenanceWindowServersOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &MaintenanceWindowServer{}
+	if err = randomize.Struct(seed, o, maintenanceWindowServerDBTypes, true, maintenanceWindowServerColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize MaintenanceWindowServer struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := MaintenanceWindowServers().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testMaintenanceWindowServersAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	maintenanceWindowServerOne := &MaintenanceWindowServer{}
+	maintenanceWindowServerTwo := &MaintenanceWindowServer{}
+	if err = randomize.Struct(seed, maintenanceWindowServerOne, maintenanceWindowServerDBTypes, false, maintenanceWindowServerColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize MaintenanceWindowServer struct: %s", err)
+	}
+	if err = randomize.Struct(seed, maintenanceWindowServerTwo, maintenanceWindowServerDBTypes, false, maintenanceWindowServerColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize MaintenanceWindowServer struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = maintenanceWindowServerOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = maintenanceWindowServerTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := MaintenanceWindowServers().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testMaintenanceWindowServersCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	maintenanceWindowServerOne := &MaintenanceWindowServer{}
+	maintenanceWindowServerTwo := &MaintenanceWindowServer{}
+	if err = randomize.Struct(seed, maintenanceWindowServerOne, maintenanceWindowServerDBTypes, false, maintenanceWindowServerColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize MaintenanceWindowServer struct: %s", err)
+	}
+	if err = randomize.Struct(seed, maintenanceWindowServerTwo, maintenanceWindowServerDBTypes, false, maintenanceWindowServerColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize MaintenanceWindowServer struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = maintenanceWindowServerOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = maintenanceWindowServerTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := MaintenanceWindowServers().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func maintenanceWindowServerBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *MaintenanceWindowServer) error {
+	*o = MaintenanceWindowServer{}
+	return nil
+}
+
+func maintenanceWindowServerAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *MaintenanceWindowServer) error {
+	*o = MaintenanceWindowServer{}
+	return nil
+}
+
+func maintenanceWindowServerAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *MaintenanceWindowServer) error {
+	*o = MaintenanceWindowServer{}
+	return nil
+}
+
+func maintenanceWindowServerBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *MaintenanceWindowServer) error {
+	*o = MaintenanceWindowServer{}
+	return nil
+}
+
+func maintenanceWindowServerAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *MaintenanceWindowServer) error {
+	*o = MaintenanceWindowServer{}
+	return nil
+}
+
+func maintenanceWindowServerBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *MaintenanceWindowServer) error {
+	*o = MaintenanceWindowServer{}
+	return nil
+}
+
+func maintenanceWindowServerAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *MaintenanceWindowServer) error {
+	*o = MaintenanceWindowServer{}
+	return nil
+}
+
+func maintenanceWindowServerBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *MaintenanceWindowServer) error {
+	*o = MaintenanceWindowServer{}
+	return nil
+}
+
+func maintenanceWindowServerAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *MaintenanceWindowServer) error {
+	*o = MaintenanceWindowServer{}
+	return nil
+}
+
+func testMaintenanceWindowServersHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &MaintenanceWindowServer{}
+	o := &MaintenanceWindowServer{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, maintenanceWindowServerDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize MaintenanceWindowServer object: %s", err)
+	}
+
+	AddMaintenanceWindowServerHook(boil.BeforeInsertHook, maintenanceWindowServerBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	maintenanceWindowServerBeforeInsertHooks = []MaintenanceWindowServerHook{}
+
+	AddMaintenanceWindowServerHook(boil.AfterInsertHook, maintenanceWindowServerAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	maintenanceWindowServerAfterInsertHooks = []MaintenanceWindowServerHook{}
+
+	AddMaintenanceWindowServerHook(boil.AfterSelectHook, maintenanceWindowServerAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	maintenanceWindowServerAfterSelectHooks = []MaintenanceWindowServerHook{}
+
+	AddMaintenanceWindowServerHook(boil.BeforeUpdateHook, maintenanceWindowServerBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	maintenanceWindowServerBeforeUpdateHooks = []MaintenanceWindowServerHook{}
+
+	AddMaintenanceWindowServerHook(boil.AfterUpdateHook, maintenanceWindowServerAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	maintenanceWindowServerAfterUpdateHooks = []MaintenanceWindowServerHook{}
+
+	AddMaintenanceWindowServerHook(boil.BeforeDeleteHook, maintenanceWindowServerBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	maintenanceWindowServerBeforeDeleteHooks = []MaintenanceWindowServerHook{}
+
+	AddMaintenanceWindowServerHook(boil.AfterDeleteHook, maintenanceWindowServerAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	maintenanceWindowServerAfterDeleteHooks = []MaintenanceWindowServerHook{}
+
+	AddMaintenanceWindowServerHook(boil.BeforeUpsertHook, maintenanceWindowServerBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	maintenanceWindowServerBeforeUpsertHooks = []MaintenanceWindowServerHook{}
+
+	AddMaintenanceWindowServerHook(boil.AfterUpsertHook, maintenanceWindowServerAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	maintenanceWindowServerAfterUpsertHooks = []MaintenanceWindowServerHook{}
+}
+
+func testMaintenanceWindowServersInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &MaintenanceWindowServer{}
+	if err = randomize.Struct(seed, o, maintenanceWindowServerDBTypes, true, maintenanceWindowServerColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize MaintenanceWindowServer struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := MaintenanceWindowServers().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testMaintenanceWindowServersInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &MaintenanceWindowServer{}
+	if err = randomize.Struct(seed, o, maintenanceWindowServerDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize MaintenanceWindowServer struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(maintenanceWindowServerColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := MaintenanceWindowServers().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testMaintenanceWindowServerToOneMaintenanceWindowUsingMaintenanceWindow(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local MaintenanceWindowServer
+	var foreign MaintenanceWindow
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, maintenanceWindowServerDBTypes, false, maintenanceWindowServerColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize MaintenanceWindowServer struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, maintenanceWindowDBTypes, false, maintenanceWindowColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize MaintenanceWindow struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.MaintenanceWindowID = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.MaintenanceWindow().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := MaintenanceWindowServerSlice{&local}
+	if err = local.L.LoadMaintenanceWindow(ctx, tx, false, (*[]*MaintenanceWindowServer)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.MaintenanceWindow == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.MaintenanceWindow = nil
+	if err = local.L.LoadMaintenanceWindow(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.MaintenanceWindow == nil {
+		t.Error("struct should have been eager loaded")
+	}
+}
+
+func testMaintenanceWindowServerToOneServerUsingServer(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local MaintenanceWindowServer
+	var foreign Server
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, maintenanceWindowServerDBTypes, false, maintenanceWindowServerColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize MaintenanceWindowServer struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, serverDBTypes, false, serverColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Server struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.ServerID = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.Server().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := MaintenanceWindowServerSlice{&local}
+	if err = local.L.LoadServer(ctx, tx, false, (*[]*MaintenanceWindowServer)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Server == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.Server = nil
+	if err = local.L.LoadServer(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Server == nil {
+		t.Error("struct should have been eager loaded")
+	}
+}
+
+func testMaintenanceWindowServerToOneSetOpMaintenanceWindowUsingMaintenanceWindow(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a MaintenanceWindowServer
+	var b, c MaintenanceWindow
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, maintenanceWindowServerDBTypes, false, strmangle.SetComplement(maintenanceWindowServerPrimaryKeyColumns, maintenanceWindowServerColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, maintenanceWindowDBTypes, false, strmangle.SetComplement(maintenanceWindowPrimaryKeyColumns, maintenanceWindowColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, maintenanceWindowDBTypes, false, strmangle.SetComplement(maintenanceWindowPrimaryKeyColumns, maintenanceWindowColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*MaintenanceWindow{&b, &c} {
+		err = a.SetMaintenanceWindow(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.MaintenanceWindow != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.MaintenanceWindowServers[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.MaintenanceWindowID != x.ID {
+			t.Error("foreign key was wrong value", a.MaintenanceWindowID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.MaintenanceWindowID))
+		reflect.Indirect(reflect.ValueOf(&a.MaintenanceWindowID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if a.MaintenanceWindowID != x.ID {
+			t.Error("foreign key was wrong value", a.MaintenanceWindowID, x.ID)
+		}
+	}
+}
+func testMaintenanceWindowServerToOneSetOpServerUsingServer(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a MaintenanceWindowServer
+	var b, c Server
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, maintenanceWindowServerDBTypes, false, strmangle.SetComplement(maintenanceWindowServerPrimaryKeyColumns, maintenanceWindowServerColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, serverDBTypes, false, strmangle.SetComplement(serverPrimaryKeyColumns, serverColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, serverDBTypes, false, strmangle.SetComplement(serverPrimaryKeyColumns, serverColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*Server{&b, &c} {
+		err = a.SetServer(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.Server != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.MaintenanceWindowServers[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.ServerID != x.ID {
+			t.Error("foreign key was wrong value", a.ServerID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.ServerID))
+		reflect.Indirect(reflect.ValueOf(&a.ServerID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if a.ServerID != x.ID {
+			t.Error("foreign key was wrong value", a.ServerID, x.ID)
+		}
+	}
+}
+
+func testMaintenanceWindowServersReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &MaintenanceWindowServer{}
+	if err = randomize.Struct(seed, o, maintenanceWindowServerDBTypes, true, maintenanceWindowServerColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize MaintenanceWindowServer struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testMaintenanceWindowServersReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &MaintenanceWindowServer{}
+	if err = randomize.Struct(seed, o, maintenanceWindowServerDBTypes, true, maintenanceWindowServerColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize MaintenanceWindowServer struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := MaintenanceWindowServerSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testMaintenanceWindowServersSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &MaintenanceWindowServer{}
+	if err = randomize.Struct(seed, o, maintenanceWindowServerDBTypes, true, maintenanceWindowServerColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize MaintenanceWindowServer struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := MaintenanceWindowServers().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	maintenanceWindowServerDBTypes = map[string]string{`ID`: `uuid`, `MaintenanceWindowID`: `uuid`, `ServerID`: `uuid`}
+	_                              = bytes.MinRead
+)
+
+func testMaintenanceWindowServersUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(maintenanceWindowServerPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(maintenanceWindowServerAllColumns) == len(maintenanceWindowServerPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &MaintenanceWindowServer{}
+	if err = randomize.Struct(seed, o, maintenanceWindowServerDBTypes, true, maintenanceWindowServerColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize MaintenanceWindowServer struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := MaintenanceWindowServers().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, maintenanceWindowServerDBTypes, true, maintenanceWindowServerPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize MaintenanceWindowServer struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testMaintenanceWindowServersSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(maintenanceWindowServerAllColumns) == len(maintenanceWindowServerPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &MaintenanceWindowServer{}
+	if err = randomize.Struct(seed, o, maintenanceWindowServerDBTypes, true, maintenanceWindowServerColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize MaintenanceWindowServer struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := MaintenanceWindowServers().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, maintenanceWindowServerDBTypes, true, maintenanceWindowServerPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize MaintenanceWindowServer struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(maintenanceWindowServerAllColumns, maintenanceWindowServerPrimaryKeyColumns) {
+		fields = maintenanceWindowServerAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			maintenanceWindowServerAllColumns,
+			maintenanceWindowServerPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := MaintenanceWindowServerSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
@@ -0,0 +1,1146 @@
+// Code generated by SQLBoiler 4.11.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// MaintenanceWindow is an object representing the database table.
+type MaintenanceWindow struct {
+	ID             string      `boil:"id" json:"id" toml:"id" yaml:"id"`
+	Reason         string      `boil:"reason" json:"reason" toml:"reason" yaml:"reason"`
+	StartsAt       time.Time   `boil:"starts_at" json:"starts_at" toml:"starts_at" yaml:"starts_at"`
+	EndsAt         time.Time   `boil:"ends_at" json:"ends_at" toml:"ends_at" yaml:"ends_at"`
+	FacilityCode   null.String `boil:"facility_code" json:"facility_code,omitempty" toml:"facility_code" yaml:"facility_code,omitempty"`
+	Attributes     null.JSON   `boil:"attributes" json:"attributes,omitempty" toml:"attributes" yaml:"attributes,omitempty"`
+	StartedEventAt null.Time   `boil:"started_event_at" json:"started_event_at,omitempty" toml:"started_event_at" yaml:"started_event_at,omitempty"`
+	EndedEventAt   null.Time   `boil:"ended_event_at" json:"ended_event_at,omitempty" toml:"ended_event_at" yaml:"ended_event_at,omitempty"`
+	CreatedAt      null.Time   `boil:"created_at" json:"created_at,omitempty" toml:"created_at" yaml:"created_at,omitempty"`
+	UpdatedAt      null.Time   `boil:"updated_at" json:"updated_at,omitempty" toml:"updated_at" yaml:"updated_at,omitempty"`
+
+	R *maintenanceWindowR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L maintenanceWindowL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var MaintenanceWindowColumns = struct {
+	ID             string
+	Reason         string
+	StartsAt       string
+	EndsAt         string
+	FacilityCode   string
+	Attributes     string
+	StartedEventAt string
+	EndedEventAt   string
+	CreatedAt      string
+	UpdatedAt      string
+}{
+	ID:             "id",
+	Reason:         "reason",
+	StartsAt:       "starts_at",
+	EndsAt:         "ends_at",
+	FacilityCode:   "facility_code",
+	Attributes:     "attributes",
+	StartedEventAt: "started_event_at",
+	EndedEventAt:   "ended_event_at",
+	CreatedAt:      "created_at",
+	UpdatedAt:      "updated_at",
+}
+
+var MaintenanceWindowTableColumns = struct {
+	ID             string
+	Reason         string
+	StartsAt       string
+	EndsAt         string
+	FacilityCode   string
+	Attributes     string
+	StartedEventAt string
+	EndedEventAt   string
+	CreatedAt      string
+	UpdatedAt      string
+}{
+	ID:             "maintenance_windows.id",
+	Reason:         "maintenance_windows.reason",
+	StartsAt:       "maintenance_windows.starts_at",
+	EndsAt:         "maintenance_windows.ends_at",
+	FacilityCode:   "maintenance_windows.facility_code",
+	Attributes:     "maintenance_windows.attributes",
+	StartedEventAt: "maintenance_windows.started_event_at",
+	EndedEventAt:   "maintenance_windows.ended_event_at",
+	CreatedAt:      "maintenance_windows.created_at",
+	UpdatedAt:      "maintenance_windows.updated_at",
+}
+
+// Generated where
+
+var MaintenanceWindowWhere = struct {
+	ID             whereHelperstring
+	Reason         whereHelperstring
+	StartsAt       whereHelpertime_Time
+	EndsAt         whereHelpertime_Time
+	FacilityCode   whereHelpernull_String
+	Attributes     whereHelpernull_JSON
+	StartedEventAt whereHelpernull_Time
+	EndedEventAt   whereHelpernull_Time
+	CreatedAt      whereHelpernull_Time
+	UpdatedAt      whereHelpernull_Time
+}{
+	ID:             whereHelperstring{field: "\"maintenance_windows\".\"id\""},
+	Reason:         whereHelperstring{field: "\"maintenance_windows\".\"reason\""},
+	StartsAt:       whereHelpertime_Time{field: "\"maintenance_windows\".\"starts_at\""},
+	EndsAt:         whereHelpertime_Time{field: "\"maintenance_windows\".\"ends_at\""},
+	FacilityCode:   whereHelpernull_String{field: "\"maintenance_windows\".\"facility_code\""},
+	Attributes:     whereHelpernull_JSON{field: "\"maintenance_windows\".\"attributes\""},
+	StartedEventAt: whereHelpernull_Time{field: "\"maintenance_windows\".\"started_event_at\""},
+	EndedEventAt:   whereHelpernull_Time{field: "\"maintenance_windows\".\"ended_event_at\""},
+	CreatedAt:      whereHelpernull_Time{field: "\"maintenance_windows\".\"created_at\""},
+	UpdatedAt:      whereHelpernull_Time{field: "\"maintenance_windows\".\"updated_at\""},
+}
+
+// MaintenanceWindowRels is where relationship names are stored.
+var MaintenanceWindowRels = struct {
+	MaintenanceWindowServers string
+}{
+	MaintenanceWindowServers: "MaintenanceWindowServers",
+}
+
+// maintenanceWindowR is where relationships are stored.
+type maintenanceWindowR struct {
+	MaintenanceWindowServers MaintenanceWindowServerSlice `boil:"MaintenanceWindowServers" json:"MaintenanceWindowServers" toml:"MaintenanceWindowServers" yaml:"MaintenanceWindowServers"`
+}
+
+// NewStruct creates a new relationship struct
+func (*maintenanceWindowR) NewStruct() *maintenanceWindowR {
+	return &maintenanceWindowR{}
+}
+
+func (r *maintenanceWindowR) GetMaintenanceWindowServers() MaintenanceWindowServerSlice {
+	if r == nil {
+		return nil
+	}
+	return r.MaintenanceWindowServers
+}
+
+// maintenanceWindowL is where Load methods for each relationship are stored.
+type maintenanceWindowL struct{}
+
+var (
+	maintenanceWindowAllColumns            = []string{"id", "reason", "starts_at", "ends_at", "facility_code", "attributes", "started_event_at", "ended_event_at", "created_at", "updated_at"}
+	maintenanceWindowColumnsWithoutDefault = []string{"reason", "starts_at", "ends_at"}
+	maintenanceWindowColumnsWithDefault    = []string{"id", "facility_code", "attributes", "started_event_at", "ended_event_at", "created_at", "updated_at"}
+	maintenanceWindowPrimaryKeyColumns     = []string{"id"}
+	maintenanceWindowGeneratedColumns      = []string{}
+)
+
+type (
+	// MaintenanceWindowSlice is an alias for a slice of pointers to MaintenanceWindow.
+	// This should almost always be used instead of []MaintenanceWindow.
+	MaintenanceWindowSlice []*MaintenanceWindow
+	// MaintenanceWindowHook is the signature for custom MaintenanceWindow hook methods
+	MaintenanceWindowHook func(context.Context, boil.ContextExecutor, *MaintenanceWindow) error
+
+	maintenanceWindowQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	maintenanceWindowType                 = reflect.TypeOf(&MaintenanceWindow{})
+	maintenanceWindowMapping              = queries.MakeStructMapping(maintenanceWindowType)
+	maintenanceWindowPrimaryKeyMapping, _ = queries.BindMapping(maintenanceWindowType, maintenanceWindowMapping, maintenanceWindowPrimaryKeyColumns)
+	maintenanceWindowInsertCacheMut       sync.RWMutex
+	maintenanceWindowInsertCache          = make(map[string]insertCache)
+	maintenanceWindowUpdateCacheMut       sync.RWMutex
+	maintenanceWindowUpdateCache          = make(map[string]updateCache)
+	maintenanceWindowUpsertCacheMut       sync.RWMutex
+	maintenanceWindowUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var maintenanceWindowAfterSelectHooks []MaintenanceWindowHook
+
+var maintenanceWindowBeforeInsertHooks []MaintenanceWindowHook
+var maintenanceWindowAfterInsertHooks []MaintenanceWindowHook
+
+var maintenanceWindowBeforeUpdateHooks []MaintenanceWindowHook
+var maintenanceWindowAfterUpdateHooks []MaintenanceWindowHook
+
+var maintenanceWindowBeforeDeleteHooks []MaintenanceWindowHook
+var maintenanceWindowAfterDeleteHooks []MaintenanceWindowHook
+
+var maintenanceWindowBeforeUpsertHooks []MaintenanceWindowHook
+var maintenanceWindowAfterUpsertHooks []MaintenanceWindowHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *MaintenanceWindow) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range maintenanceWindowAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *MaintenanceWindow) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range maintenanceWindowBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *MaintenanceWindow) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range maintenanceWindowAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *MaintenanceWindow) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range maintenanceWindowBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *MaintenanceWindow) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range maintenanceWindowAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *MaintenanceWindow) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range maintenanceWindowBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *MaintenanceWindow) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range maintenanceWindowAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *MaintenanceWindow) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range maintenanceWindowBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *MaintenanceWindow) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range maintenanceWindowAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddMaintenanceWindowHook registers your hook function for all future operations.
+func AddMaintenanceWindowHook(hookPoint boil.HookPoint, maintenanceWindowHook MaintenanceWindowHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		maintenanceWindowAfterSelectHooks = append(maintenanceWindowAfterSelectHooks, maintenanceWindowHook)
+	case boil.BeforeInsertHook:
+		maintenanceWindowBeforeInsertHooks = append(maintenanceWindowBeforeInsertHooks, maintenanceWindowHook)
+	case boil.AfterInsertHook:
+		maintenanceWindowAfterInsertHooks = append(maintenanceWindowAfterInsertHooks, maintenanceWindowHook)
+	case boil.BeforeUpdateHook:
+		maintenanceWindowBeforeUpdateHooks = append(maintenanceWindowBeforeUpdateHooks, maintenanceWindowHook)
+	case boil.AfterUpdateHook:
+		maintenanceWindowAfterUpdateHooks = append(maintenanceWindowAfterUpdateHooks, maintenanceWindowHook)
+	case boil.BeforeDeleteHook:
+		maintenanceWindowBeforeDeleteHooks = append(maintenanceWindowBeforeDeleteHooks, maintenanceWindowHook)
+	case boil.AfterDeleteHook:
+		maintenanceWindowAfterDeleteHooks = append(maintenanceWindowAfterDeleteHooks, maintenanceWindowHook)
+	case boil.BeforeUpsertHook:
+		maintenanceWindowBeforeUpsertHooks = append(maintenanceWindowBeforeUpsertHooks, maintenanceWindowHook)
+	case boil.AfterUpsertHook:
+		maintenanceWindowAfterUpsertHooks = append(maintenanceWindowAfterUpsertHooks, maintenanceWindowHook)
+	}
+}
+
+// One returns a single maintenanceWindow record from the query.
+func (q maintenanceWindowQuery) One(ctx context.Context, exec boil.ContextExecutor) (*MaintenanceWindow, error) {
+	o := &MaintenanceWindow{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for maintenance_windows")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all MaintenanceWindow records from the query.
+func (q maintenanceWindowQuery) All(ctx context.Context, exec boil.ContextExecutor) (MaintenanceWindowSlice, error) {
+	var o []*MaintenanceWindow
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to MaintenanceWindow slice")
+	}
+
+	if len(maintenanceWindowAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all MaintenanceWindow records in the query.
+func (q maintenanceWindowQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count maintenance_windows rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q maintenanceWindowQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if maintenance_windows exists")
+	}
+
+	return count > 0, nil
+}
+
+// MaintenanceWindowServers retrieves all the maintenance_window_server's MaintenanceWindowServers with an executor.
+func (o *MaintenanceWindow) MaintenanceWindowServers(mods ...qm.QueryMod) maintenanceWindowServerQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"maintenance_window_servers\".\"maintenance_window_id\"=?", o.ID),
+	)
+
+	return MaintenanceWindowServers(queryMods...)
+}
+
+// LoadMaintenanceWindowServers allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (maintenanceWindowL) LoadMaintenanceWindowServers(ctx context.Context, e boil.ContextExecutor, singular bool, maybeMaintenanceWindow interface{}, mods queries.Applicator) error {
+	var slice []*MaintenanceWindow
+	var object *MaintenanceWindow
+
+	if singular {
+		object = maybeMaintenanceWindow.(*MaintenanceWindow)
+	} else {
+		slice = *maybeMaintenanceWindow.(*[]*MaintenanceWindow)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &maintenanceWindowR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &maintenanceWindowR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`maintenance_window_servers`),
+		qm.WhereIn(`maintenance_window_servers.maintenance_window_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load maintenance_window_servers")
+	}
+
+	var resultSlice []*MaintenanceWindowServer
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice maintenance_window_servers")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on maintenance_window_servers")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for maintenance_window_servers")
+	}
+
+	if len(maintenanceWindowServerAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.MaintenanceWindowServers = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &maintenanceWindowServerR{}
+			}
+			foreign.R.MaintenanceWindow = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.MaintenanceWindowID {
+				local.R.MaintenanceWindowServers = append(local.R.MaintenanceWindowServers, foreign)
+				if foreign.R == nil {
+					foreign.R = &maintenanceWindowServerR{}
+				}
+				foreign.R.MaintenanceWindow = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// AddMaintenanceWindowServers adds the given related objects to the existing relationships
+// of the maintenance_window, optionally inserting them as new records.
+// Appends related to o.R.MaintenanceWindowServers.
+// Sets related.R.MaintenanceWindow appropriately.
+func (o *MaintenanceWindow) AddMaintenanceWindowServers(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*MaintenanceWindowServer) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.MaintenanceWindowID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"maintenance_window_servers\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"maintenance_window_id"}),
+				strmangle.WhereClause("\"", "\"", 2, maintenanceWindowServerPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.MaintenanceWindowID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &maintenanceWindowR{
+			MaintenanceWindowServers: related,
+		}
+	} else {
+		o.R.MaintenanceWindowServers = append(o.R.MaintenanceWindowServers, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &maintenanceWindowServerR{
+				MaintenanceWindow: o,
+			}
+		} else {
+			rel.R.MaintenanceWindow = o
+		}
+	}
+	return nil
+}
+
+// MaintenanceWindows retrieves all the records using an executor.
+func MaintenanceWindows(mods ...qm.QueryMod) maintenanceWindowQuery {
+	mods = append(mods, qm.From("\"maintenance_windows\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"maintenance_windows\".*"})
+	}
+
+	return maintenanceWindowQuery{q}
+}
+
+// FindMaintenanceWindow retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindMaintenanceWindow(ctx context.Context, exec boil.ContextExecutor, iD string, selectCols ...string) (*MaintenanceWindow, error) {
+	maintenanceWindowObj := &MaintenanceWindow{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"maintenance_windows\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, maintenanceWindowObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from maintenance_windows")
+	}
+
+	if err = maintenanceWindowObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return maintenanceWindowObj, err
+	}
+
+	return maintenanceWindowObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *MaintenanceWindow) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no maintenance_windows provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if queries.MustTime(o.CreatedAt).IsZero() {
+			queries.SetScanner(&o.CreatedAt, currTime)
+		}
+		if queries.MustTime(o.UpdatedAt).IsZero() {
+			queries.SetScanner(&o.UpdatedAt, currTime)
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(maintenanceWindowColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	maintenanceWindowInsertCacheMut.RLock()
+	cache, cached := maintenanceWindowInsertCache[key]
+	maintenanceWindowInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			maintenanceWindowAllColumns,
+			maintenanceWindowColumnsWithDefault,
+			maintenanceWindowColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(maintenanceWindowType, maintenanceWindowMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(maintenanceWindowType, maintenanceWindowMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"maintenance_windows\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"maintenance_windows\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into maintenance_windows")
+	}
+
+	if !cached {
+		maintenanceWindowInsertCacheMut.Lock()
+		maintenanceWindowInsertCache[key] = cache
+		maintenanceWindowInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the MaintenanceWindow.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *MaintenanceWindow) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		queries.SetScanner(&o.UpdatedAt, currTime)
+	}
+
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	maintenanceWindowUpdateCacheMut.RLock()
+	cache, cached := maintenanceWindowUpdateCache[key]
+	maintenanceWindowUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			maintenanceWindowAllColumns,
+			maintenanceWindowPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update maintenance_windows, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"maintenance_windows\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, maintenanceWindowPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(maintenanceWindowType, maintenanceWindowMapping, append(wl, maintenanceWindowPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update maintenance_windows row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for maintenance_windows")
+	}
+
+	if !cached {
+		maintenanceWindowUpdateCacheMut.Lock()
+		maintenanceWindowUpdateCache[key] = cache
+		maintenanceWindowUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q maintenanceWindowQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for maintenance_windows")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for maintenance_windows")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o MaintenanceWindowSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), maintenanceWindowPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"maintenance_windows\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, maintenanceWindowPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in maintenanceWindow slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all maintenanceWindow")
+	}
+	return rowsAff, nil
+}
+
+// Delete deletes a single MaintenanceWindow record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *MaintenanceWindow) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no MaintenanceWindow provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), maintenanceWindowPrimaryKeyMapping)
+	sql := "DELETE FROM \"maintenance_windows\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from maintenance_windows")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for maintenance_windows")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q maintenanceWindowQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no maintenanceWindowQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from maintenance_windows")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for maintenance_windows")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o MaintenanceWindowSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(maintenanceWindowBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), maintenanceWindowPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"maintenance_windows\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, maintenanceWindowPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from maintenanceWindow slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for maintenance_windows")
+	}
+
+	if len(maintenanceWindowAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *MaintenanceWindow) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindMaintenanceWindow(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *MaintenanceWindowSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := MaintenanceWindowSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), maintenanceWindowPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"maintenance_windows\".* FROM \"maintenance_windows\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, maintenanceWindowPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in MaintenanceWindowSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// MaintenanceWindowExists checks if the MaintenanceWindow row exists.
+func MaintenanceWindowExists(ctx context.Context, exec boil.ContextExecutor, iD string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"maintenance_windows\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if maintenance_windows exists")
+	}
+
+	return exists, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *MaintenanceWindow) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no maintenance_windows provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if queries.MustTime(o.CreatedAt).IsZero() {
+			queries.SetScanner(&o.CreatedAt, currTime)
+		}
+		queries.SetScanner(&o.UpdatedAt, currTime)
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(maintenanceWindowColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	maintenanceWindowUpsertCacheMut.RLock()
+	cache, cached := maintenanceWindowUpsertCache[key]
+	maintenanceWindowUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			maintenanceWindowAllColumns,
+			maintenanceWindowColumnsWithDefault,
+			maintenanceWindowColumnsWithoutDefault,
+			nzDefaults,
+		)
+		update := updateColumns.UpdateColumnSet(
+			maintenanceWindowAllColumns,
+			maintenanceWindowPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert maintenance_windows, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(maintenanceWindowPrimaryKeyColumns))
+			copy(conflict, maintenanceWindowPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryCockroachDB(dialect, "\"maintenance_windows\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(maintenanceWindowType, maintenanceWindowMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(maintenanceWindowType, maintenanceWindowMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.DebugMode {
+		_, _ = fmt.Fprintln(boil.DebugWriter, cache.query)
+		_, _ = fmt.Fprintln(boil.DebugWriter, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if err == sql.ErrNoRows {
+			err = nil // CockcorachDB doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert maintenance_windows")
+	}
+
+	if !cached {
+		maintenanceWindowUpsertCacheMut.Lock()
+		maintenanceWindowUpsertCache[key] = cache
+		maintenanceWindowUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
@@ -0,0 +1,886 @@
+// Code generated by SQLBoiler 4.11.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/volatiletech/randomize"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/strmangle"
+)
+
+func testMaintenanceWindowsUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(maintenanceWindowAllColumns) == len(maintenanceWindowPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := MaintenanceWindow{}
+	if err = randomize.Struct(seed, &o, maintenanceWindowDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize MaintenanceWindow struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert MaintenanceWindow: %s", err)
+	}
+
+	count, err := MaintenanceWindows().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, maintenanceWindowDBTypes, false, maintenanceWindowPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize MaintenanceWindow struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert MaintenanceWindow: %s", err)
+	}
+
+	count, err = MaintenanceWindows().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testMaintenanceWindows(t *testing.T) {
+	t.Parallel()
+
+	query := MaintenanceWindows()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testMaintenanceWindowsDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &MaintenanceWindow{}
+	if err = randomize.Struct(seed, o, maintenanceWindowDBTypes, true, maintenanceWindowColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize MaintenanceWindow struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := MaintenanceWindows().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testMaintenanceWindowsQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &MaintenanceWindow{}
+	if err = randomize.Struct(seed, o, maintenanceWindowDBTypes, true, maintenanceWindowColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize MaintenanceWindow struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := MaintenanceWindows().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := MaintenanceWindows().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testMaintenanceWindowsSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &MaintenanceWindow{}
+	if err = randomize.Struct(seed, o, maintenanceWindowDBTypes, true, maintenanceWindowColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize MaintenanceWindow struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := MaintenanceWindowSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := MaintenanceWindows().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testMaintenanceWindowsExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &MaintenanceWindow{}
+	if err = randomize.Struct(seed, o, maintenanceWindowDBTypes, true, maintenanceWindowColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize MaintenanceWindow struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := MaintenanceWindowExists(ctx, tx, o.ID)
+	if err != nil {
+		t.Errorf("Unable to check if MaintenanceWindow exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected MaintenanceWindowExists to return true, but got false.")
+	}
+}
+
+func testMaintenanceWindowsFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &MaintenanceWindow{}
+	if err = randomize.Struct(seed, o, maintenanceWindowDBTypes, true, maintenanceWindowColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize MaintenanceWindow struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	maintenanceWindowFound, err := FindMaintenanceWindow(ctx, tx, o.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if maintenanceWindowFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testMaintenanceWindowsBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &MaintenanceWindow{}
+	if err = randomize.Struct(seed, o, maintenanceWindowDBTypes, true, maintenanceWindowColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize MaintenanceWindow struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = MaintenanceWindows().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testMaintenanceWindowsOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &MaintenanceWindow{}
+	if err = randomize.Struct(seed, o, maintenanceWindowDBTypes, true, maintenanceWindowColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize MaintenanceWindow struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := MaintenanceWindows().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testMaintenanceWindowsAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	maintenanceWindowOne := &MaintenanceWindow{}
+	maintenanceWindowTwo := &MaintenanceWindow{}
+	if err = randomize.Struct(seed, maintenanceWindowOne, maintenanceWindowDBTypes, false, maintenanceWindowColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize MaintenanceWindow struct: %s", err)
+	}
+	if err = randomize.Struct(seed, maintenanceWindowTwo, maintenanceWindowDBTypes, false, maintenanceWindowColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize MaintenanceWindow struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = maintenanceWindowOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = maintenanceWindowTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := MaintenanceWindows().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testMaintenanceWindowsCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	maintenanceWindowOne := &MaintenanceWindow{}
+	maintenanceWindowTwo := &MaintenanceWindow{}
+	if err = randomize.Struct(seed, maintenanceWindowOne, maintenanceWindowDBTypes, false, maintenanceWindowColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize MaintenanceWindow struct: %s", err)
+	}
+	if err = randomize.Struct(seed, maintenanceWindowTwo, maintenanceWindowDBTypes, false, maintenanceWindowColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize MaintenanceWindow struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = maintenanceWindowOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = maintenanceWindowTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := MaintenanceWindows().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func maintenanceWindowBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *MaintenanceWindow) error {
+	*o = MaintenanceWindow{}
+	return nil
+}
+
+func maintenanceWindowAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *MaintenanceWindow) error {
+	*o = MaintenanceWindow{}
+	return nil
+}
+
+func maintenanceWindowAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *MaintenanceWindow) error {
+	*o = MaintenanceWindow{}
+	return nil
+}
+
+func maintenanceWindowBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *MaintenanceWindow) error {
+	*o = MaintenanceWindow{}
+	return nil
+}
+
+func maintenanceWindowAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *MaintenanceWindow) error {
+	*o = MaintenanceWindow{}
+	return nil
+}
+
+func maintenanceWindowBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *MaintenanceWindow) error {
+	*o = MaintenanceWindow{}
+	return nil
+}
+
+func maintenanceWindowAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *MaintenanceWindow) error {
+	*o = MaintenanceWindow{}
+	return nil
+}
+
+func maintenanceWindowBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *MaintenanceWindow) error {
+	*o = MaintenanceWindow{}
+	return nil
+}
+
+func maintenanceWindowAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *MaintenanceWindow) error {
+	*o = MaintenanceWindow{}
+	return nil
+}
+
+func testMaintenanceWindowsHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &MaintenanceWindow{}
+	o := &MaintenanceWindow{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, maintenanceWindowDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize MaintenanceWindow object: %s", err)
+	}
+
+	AddMaintenanceWindowHook(boil.BeforeInsertHook, maintenanceWindowBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	maintenanceWindowBeforeInsertHooks = []MaintenanceWindowHook{}
+
+	AddMaintenanceWindowHook(boil.AfterInsertHook, maintenanceWindowAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	maintenanceWindowAfterInsertHooks = []MaintenanceWindowHook{}
+
+	AddMaintenanceWindowHook(boil.AfterSelectHook, maintenanceWindowAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	maintenanceWindowAfterSelectHooks = []MaintenanceWindowHook{}
+
+	AddMaintenanceWindowHook(boil.BeforeUpdateHook, maintenanceWindowBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	maintenanceWindowBeforeUpdateHooks = []MaintenanceWindowHook{}
+
+	AddMaintenanceWindowHook(boil.AfterUpdateHook, maintenanceWindowAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	maintenanceWindowAfterUpdateHooks = []MaintenanceWindowHook{}
+
+	AddMaintenanceWindowHook(boil.BeforeDeleteHook, maintenanceWindowBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	maintenanceWindowBeforeDeleteHooks = []MaintenanceWindowHook{}
+
+	AddMaintenanceWindowHook(boil.AfterDeleteHook, maintenanceWindowAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	maintenanceWindowAfterDeleteHooks = []MaintenanceWindowHook{}
+
+	AddMaintenanceWindowHook(boil.BeforeUpsertHook, maintenanceWindowBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	maintenanceWindowBeforeUpsertHooks = []MaintenanceWindowHook{}
+
+	AddMaintenanceWindowHook(boil.AfterUpsertHook, maintenanceWindowAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	maintenanceWindowAfterUpsertHooks = []MaintenanceWindowHook{}
+}
+
+func testMaintenanceWindowsInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &MaintenanceWindow{}
+	if err = randomize.Struct(seed, o, maintenanceWindowDBTypes, true, maintenanceWindowColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize MaintenanceWindow struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := MaintenanceWindows().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testMaintenanceWindowsInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &MaintenanceWindow{}
+	if err = randomize.Struct(seed, o, maintenanceWindowDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize MaintenanceWindow struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(maintenanceWindowColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := MaintenanceWindows().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testMaintenanceWindowToManyMaintenanceWindowServers(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a MaintenanceWindow
+	var b, c MaintenanceWindowServer
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, maintenanceWindowDBTypes, true, maintenanceWindowColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize MaintenanceWindow struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, maintenanceWindowServerDBTypes, false, maintenanceWindowServerColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, maintenanceWindowServerDBTypes, false, maintenanceWindowServerColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	b.MaintenanceWindowID = a.ID
+	c.MaintenanceWindowID = a.ID
+
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.MaintenanceWindowServers().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if v.MaintenanceWindowID == b.MaintenanceWindowID {
+			bFound = true
+		}
+		if v.MaintenanceWindowID == c.MaintenanceWindowID {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := MaintenanceWindowSlice{&a}
+	if err = a.L.LoadMaintenanceWindowServers(ctx, tx, false, (*[]*MaintenanceWindow)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.MaintenanceWindowServers); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.MaintenanceWindowServers = nil
+	if err = a.L.LoadMaintenanceWindowServers(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.MaintenanceWindowServers); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testMaintenanceWindowToManyAddOpMaintenanceWindowServers(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a MaintenanceWindow
+	var b, c, d, e MaintenanceWindowServer
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, maintenanceWindowDBTypes, false, strmangle.SetComplement(maintenanceWindowPrimaryKeyColumns, maintenanceWindowColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*MaintenanceWindowServer{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, maintenanceWindowServerDBTypes, false, strmangle.SetComplement(maintenanceWindowServerPrimaryKeyColumns, maintenanceWindowServerColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*MaintenanceWindowServer{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddMaintenanceWindowServers(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if a.ID != first.MaintenanceWindowID {
+			t.Error("foreign key was wrong value", a.ID, first.MaintenanceWindowID)
+		}
+		if a.ID != second.MaintenanceWindowID {
+			t.Error("foreign key was wrong value", a.ID, second.MaintenanceWindowID)
+		}
+
+		if first.R.MaintenanceWindow != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.MaintenanceWindow != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.MaintenanceWindowServers[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.MaintenanceWindowServers[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.MaintenanceWindowServers().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+
+func testMaintenanceWindowsReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &MaintenanceWindow{}
+	if err = randomize.Struct(seed, o, maintenanceWindowDBTypes, true, maintenanceWindowColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize MaintenanceWindow struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testMaintenanceWindowsReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &MaintenanceWindow{}
+	if err = randomize.Struct(seed, o, maintenanceWindowDBTypes, true, maintenanceWindowColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize MaintenanceWindow struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := MaintenanceWindowSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testMaintenanceWindowsSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &MaintenanceWindow{}
+	if err = randomize.Struct(seed, o, maintenanceWindowDBTypes, true, maintenanceWindowColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize MaintenanceWindow struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := MaintenanceWindows().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	maintenanceWindowDBTypes = map[string]string{`ID`: `uuid`, `Reason`: `string`, `StartsAt`: `timestamptz`, `EndsAt`: `timestamptz`, `FacilityCode`: `string`, `Attributes`: `jsonb`, `StartedEventAt`: `timestamptz`, `EndedEventAt`: `timestamptz`, `CreatedAt`: `timestamptz`, `UpdatedAt`: `timestamptz`}
+	_                        = bytes.MinRead
+)
+
+func testMaintenanceWindowsUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(maintenanceWindowPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(maintenanceWindowAllColumns) == len(maintenanceWindowPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &MaintenanceWindow{}
+	if err = randomize.Struct(seed, o, maintenanceWindowDBTypes, true, maintenanceWindowColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize MaintenanceWindow struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := MaintenanceWindows().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, maintenanceWindowDBTypes, true, maintenanceWindowPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize MaintenanceWindow struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testMaintenanceWindowsSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(maintenanceWindowAllColumns) == len(maintenanceWindowPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &MaintenanceWindow{}
+	if err = randomize.Struct(seed, o, maintenanceWindowDBTypes, true, maintenanceWindowColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize MaintenanceWindow struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := MaintenanceWindows().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, maintenanceWindowDBTypes, true, maintenanceWindowPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize MaintenanceWindow struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(maintenanceWindowAllColumns, maintenanceWindowPrimaryKeyColumns) {
+		fields = maintenanceWindowAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			maintenanceWindowAllColumns,
+			maintenanceWindowPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := MaintenanceWindowSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
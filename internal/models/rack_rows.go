@@ -0,0 +1,1283 @@
+// Code generated by SQLBoiler 4.11.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// RackRow is an object representing the database table.
+type RackRow struct {
+	ID        string    `boil:"id" json:"id" toml:"id" yaml:"id"`
+	RoomID    string    `boil:"room_id" json:"room_id" toml:"room_id" yaml:"room_id"`
+	Name      string    `boil:"name" json:"name" toml:"name" yaml:"name"`
+	CreatedAt null.Time `boil:"created_at" json:"created_at,omitempty" toml:"created_at" yaml:"created_at,omitempty"`
+	UpdatedAt null.Time `boil:"updated_at" json:"updated_at,omitempty" toml:"updated_at" yaml:"updated_at,omitempty"`
+
+	R *rackRowR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L rackRowL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var RackRowColumns = struct {
+	ID        string
+	RoomID    string
+	Name      string
+	CreatedAt string
+	UpdatedAt string
+}{
+	ID:        "id",
+	RoomID:    "room_id",
+	Name:      "name",
+	CreatedAt: "created_at",
+	UpdatedAt: "updated_at",
+}
+
+var RackRowTableColumns = struct {
+	ID        string
+	RoomID    string
+	Name      string
+	CreatedAt string
+	UpdatedAt string
+}{
+	ID:        "rack_rows.id",
+	RoomID:    "rack_rows.room_id",
+	Name:      "rack_rows.name",
+	CreatedAt: "rack_rows.created_at",
+	UpdatedAt: "rack_rows.updated_at",
+}
+
+// Generated where
+
+var RackRowWhere = struct {
+	ID        whereHelperstring
+	RoomID    whereHelperstring
+	Name      whereHelperstring
+	CreatedAt whereHelpernull_Time
+	UpdatedAt whereHelpernull_Time
+}{
+	ID:        whereHelperstring{field: "\"rack_rows\".\"id\""},
+	RoomID:    whereHelperstring{field: "\"rack_rows\".\"room_id\""},
+	Name:      whereHelperstring{field: "\"rack_rows\".\"name\""},
+	CreatedAt: whereHelpernull_Time{field: "\"rack_rows\".\"created_at\""},
+	UpdatedAt: whereHelpernull_Time{field: "\"rack_rows\".\"updated_at\""},
+}
+
+// RackRowRels is where relationship names are stored.
+var RackRowRels = struct {
+	Room     string
+	RowRacks string
+}{
+	Room:     "Room",
+	RowRacks: "RowRacks",
+}
+
+// rackRowR is where relationships are stored.
+type rackRowR struct {
+	Room     *Room     `boil:"Room" json:"Room" toml:"Room" yaml:"Room"`
+	RowRacks RackSlice `boil:"RowRacks" json:"RowRacks" toml:"RowRacks" yaml:"RowRacks"`
+}
+
+// NewStruct creates a new relationship struct
+func (*rackRowR) NewStruct() *rackRowR {
+	return &rackRowR{}
+}
+
+func (r *rackRowR) GetRoom() *Room {
+	if r == nil {
+		return nil
+	}
+	return r.Room
+}
+
+func (r *rackRowR) GetRowRacks() RackSlice {
+	if r == nil {
+		return nil
+	}
+	return r.RowRacks
+}
+
+// rackRowL is where Load methods for each relationship are stored.
+type rackRowL struct{}
+
+var (
+	rackRowAllColumns            = []string{"id", "room_id", "name", "created_at", "updated_at"}
+	rackRowColumnsWithoutDefault = []string{"room_id", "name"}
+	rackRowColumnsWithDefault    = []string{"id", "created_at", "updated_at"}
+	rackRowPrimaryKeyColumns     = []string{"id"}
+	rackRowGeneratedColumns      = []string{}
+)
+
+type (
+	// RackRowSlice is an alias for a slice of pointers to RackRow.
+	// This should almost always be used instead of []RackRow.
+	RackRowSlice []*RackRow
+	// RackRowHook is the signature for custom RackRow hook methods
+	RackRowHook func(context.Context, boil.ContextExecutor, *RackRow) error
+
+	rackRowQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	rackRowType                 = reflect.TypeOf(&RackRow{})
+	rackRowMapping              = queries.MakeStructMapping(rackRowType)
+	rackRowPrimaryKeyMapping, _ = queries.BindMapping(rackRowType, rackRowMapping, rackRowPrimaryKeyColumns)
+	rackRowInsertCacheMut       sync.RWMutex
+	rackRowInsertCache          = make(map[string]insertCache)
+	rackRowUpdateCacheMut       sync.RWMutex
+	rackRowUpdateCache          = make(map[string]updateCache)
+	rackRowUpsertCacheMut       sync.RWMutex
+	rackRowUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var rackRowAfterSelectHooks []RackRowHook
+
+var rackRowBeforeInsertHooks []RackRowHook
+var rackRowAfterInsertHooks []RackRowHook
+
+var rackRowBeforeUpdateHooks []RackRowHook
+var rackRowAfterUpdateHooks []RackRowHook
+
+var rackRowBeforeDeleteHooks []RackRowHook
+var rackRowAfterDeleteHooks []RackRowHook
+
+var rackRowBeforeUpsertHooks []RackRowHook
+var rackRowAfterUpsertHooks []RackRowHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *RackRow) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range rackRowAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *RackRow) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range rackRowBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *RackRow) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range rackRowAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *RackRow) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range rackRowBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *RackRow) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range rackRowAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *RackRow) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range rackRowBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *RackRow) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range rackRowAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *RackRow) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range rackRowBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *RackRow) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range rackRowAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddRackRowHook registers your hook function for all future operations.
+func AddRackRowHook(hookPoint boil.HookPoint, rackRowHook RackRowHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		rackRowAfterSelectHooks = append(rackRowAfterSelectHooks, rackRowHook)
+	case boil.BeforeInsertHook:
+		rackRowBeforeInsertHooks = append(rackRowBeforeInsertHooks, rackRowHook)
+	case boil.AfterInsertHook:
+		rackRowAfterInsertHooks = append(rackRowAfterInsertHooks, rackRowHook)
+	case boil.BeforeUpdateHook:
+		rackRowBeforeUpdateHooks = append(rackRowBeforeUpdateHooks, rackRowHook)
+	case boil.AfterUpdateHook:
+		rackRowAfterUpdateHooks = append(rackRowAfterUpdateHooks, rackRowHook)
+	case boil.BeforeDeleteHook:
+		rackRowBeforeDeleteHooks = append(rackRowBeforeDeleteHooks, rackRowHook)
+	case boil.AfterDeleteHook:
+		rackRowAfterDeleteHooks = append(rackRowAfterDeleteHooks, rackRowHook)
+	case boil.BeforeUpsertHook:
+		rackRowBeforeUpsertHooks = append(rackRowBeforeUpsertHooks, rackRowHook)
+	case boil.AfterUpsertHook:
+		rackRowAfterUpsertHooks = append(rackRowAfterUpsertHooks, rackRowHook)
+	}
+}
+
+// One returns a single rackRow record from the query.
+func (q rackRowQuery) One(ctx context.Context, exec boil.ContextExecutor) (*RackRow, error) {
+	o := &RackRow{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for rack_rows")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all RackRow records from the query.
+func (q rackRowQuery) All(ctx context.Context, exec boil.ContextExecutor) (RackRowSlice, error) {
+	var o []*RackRow
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to RackRow slice")
+	}
+
+	if len(rackRowAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all RackRow records in the query.
+func (q rackRowQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count rack_rows rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q rackRowQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if rack_rows exists")
+	}
+
+	return count > 0, nil
+}
+
+// Room pointed to by the foreign key.
+func (o *RackRow) Room(mods ...qm.QueryMod) roomQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.RoomID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Rooms(queryMods...)
+}
+
+// RowRacks retrieves all the rack's Racks with an executor via row_id column.
+func (o *RackRow) RowRacks(mods ...qm.QueryMod) rackQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"racks\".\"row_id\"=?", o.ID),
+	)
+
+	return Racks(queryMods...)
+}
+
+// LoadRoom allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (rackRowL) LoadRoom(ctx context.Context, e boil.ContextExecutor, singular bool, maybeRackRow interface{}, mods queries.Applicator) error {
+	var slice []*RackRow
+	var object *RackRow
+
+	if singular {
+		object = maybeRackRow.(*RackRow)
+	} else {
+		slice = *maybeRackRow.(*[]*RackRow)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &rackRowR{}
+		}
+		args = append(args, object.RoomID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &rackRowR{}
+			}
+
+			for _, a := range args {
+				if a == obj.RoomID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.RoomID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`rooms`),
+		qm.WhereIn(`rooms.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Room")
+	}
+
+	var resultSlice []*Room
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Room")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for rooms")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for rooms")
+	}
+
+	if len(rackRowAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Room = foreign
+		if foreign.R == nil {
+			foreign.R = &roomR{}
+		}
+		foreign.R.RackRows = append(foreign.R.RackRows, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.RoomID == foreign.ID {
+				local.R.Room = foreign
+				if foreign.R == nil {
+					foreign.R = &roomR{}
+				}
+				foreign.R.RackRows = append(foreign.R.RackRows, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadRowRacks allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (rackRowL) LoadRowRacks(ctx context.Context, e boil.ContextExecutor, singular bool, maybeRackRow interface{}, mods queries.Applicator) error {
+	var slice []*RackRow
+	var object *RackRow
+
+	if singular {
+		object = maybeRackRow.(*RackRow)
+	} else {
+		slice = *maybeRackRow.(*[]*RackRow)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &rackRowR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &rackRowR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`racks`),
+		qm.WhereIn(`racks.row_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load racks")
+	}
+
+	var resultSlice []*Rack
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice racks")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on racks")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for racks")
+	}
+
+	if len(rackAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.RowRacks = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &rackR{}
+			}
+			foreign.R.Row = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.RowID {
+				local.R.RowRacks = append(local.R.RowRacks, foreign)
+				if foreign.R == nil {
+					foreign.R = &rackR{}
+				}
+				foreign.R.Row = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetRoom of the rackRow to the related item.
+// Sets o.R.Room to related.
+// Adds o to related.R.RackRows.
+func (o *RackRow) SetRoom(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Room) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"rack_rows\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"room_id"}),
+		strmangle.WhereClause("\"", "\"", 2, rackRowPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.RoomID = related.ID
+	if o.R == nil {
+		o.R = &rackRowR{
+			Room: related,
+		}
+	} else {
+		o.R.Room = related
+	}
+
+	if related.R == nil {
+		related.R = &roomR{
+			RackRows: RackRowSlice{o},
+		}
+	} else {
+		related.R.RackRows = append(related.R.RackRows, o)
+	}
+
+	return nil
+}
+
+// AddRowRacks adds the given related objects to the existing relationships
+// of the rack_row, optionally inserting them as new records.
+// Appends related to o.R.RowRacks.
+// Sets related.R.Row appropriately.
+func (o *RackRow) AddRowRacks(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Rack) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.RowID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"racks\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"row_id"}),
+				strmangle.WhereClause("\"", "\"", 2, rackPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.RowID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &rackRowR{
+			RowRacks: related,
+		}
+	} else {
+		o.R.RowRacks = append(o.R.RowRacks, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &rackR{
+				Row: o,
+			}
+		} else {
+			rel.R.Row = o
+		}
+	}
+	return nil
+}
+
+// RackRows retrieves all the records using an executor.
+func RackRows(mods ...qm.QueryMod) rackRowQuery {
+	mods = append(mods, qm.From("\"rack_rows\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"rack_rows\".*"})
+	}
+
+	return rackRowQuery{q}
+}
+
+// FindRackRow retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindRackRow(ctx context.Context, exec boil.ContextExecutor, iD string, selectCols ...string) (*RackRow, error) {
+	rackRowObj := &RackRow{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"rack_rows\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, rackRowObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from rack_rows")
+	}
+
+	if err = rackRowObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return rackRowObj, err
+	}
+
+	return rackRowObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *RackRow) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no rack_rows provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if queries.MustTime(o.CreatedAt).IsZero() {
+			queries.SetScanner(&o.CreatedAt, currTime)
+		}
+		if queries.MustTime(o.UpdatedAt).IsZero() {
+			queries.SetScanner(&o.UpdatedAt, currTime)
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(rackRowColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	rackRowInsertCacheMut.RLock()
+	cache, cached := rackRowInsertCache[key]
+	rackRowInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			rackRowAllColumns,
+			rackRowColumnsWithDefault,
+			rackRowColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(rackRowType, rackRowMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(rackRowType, rackRowMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"rack_rows\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"rack_rows\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into rack_rows")
+	}
+
+	if !cached {
+		rackRowInsertCacheMut.Lock()
+		rackRowInsertCache[key] = cache
+		rackRowInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the RackRow.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *RackRow) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		queries.SetScanner(&o.UpdatedAt, currTime)
+	}
+
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	rackRowUpdateCacheMut.RLock()
+	cache, cached := rackRowUpdateCache[key]
+	rackRowUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			rackRowAllColumns,
+			rackRowPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update rack_rows, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"rack_rows\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, rackRowPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(rackRowType, rackRowMapping, append(wl, rackRowPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update rack_rows row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for rack_rows")
+	}
+
+	if !cached {
+		rackRowUpdateCacheMut.Lock()
+		rackRowUpdateCache[key] = cache
+		rackRowUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q rackRowQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for rack_rows")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for rack_rows")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o RackRowSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), rackRowPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"rack_rows\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, rackRowPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in rackRow slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all rackRow")
+	}
+	return rowsAff, nil
+}
+
+// Delete deletes a single RackRow record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *RackRow) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no RackRow provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), rackRowPrimaryKeyMapping)
+	sql := "DELETE FROM \"rack_rows\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from rack_rows")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for rack_rows")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q rackRowQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no rackRowQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from rack_rows")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for rack_rows")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o RackRowSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(rackRowBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), rackRowPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"rack_rows\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, rackRowPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from rackRow slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for rack_rows")
+	}
+
+	if len(rackRowAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *RackRow) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindRackRow(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *RackRowSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := RackRowSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), rackRowPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"rack_rows\".* FROM \"rack_rows\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, rackRowPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in RackRowSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// RackRowExists checks if the RackRow row exists.
+func RackRowExists(ctx context.Context, exec boil.ContextExecutor, iD string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"rack_rows\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if rack_rows exists")
+	}
+
+	return exists, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *RackRow) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no rack_rows provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if queries.MustTime(o.CreatedAt).IsZero() {
+			queries.SetScanner(&o.CreatedAt, currTime)
+		}
+		queries.SetScanner(&o.UpdatedAt, currTime)
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(rackRowColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	rackRowUpsertCacheMut.RLock()
+	cache, cached := rackRowUpsertCache[key]
+	rackRowUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			rackRowAllColumns,
+			rackRowColumnsWithDefault,
+			rackRowColumnsWithoutDefault,
+			nzDefaults,
+		)
+		update := updateColumns.UpdateColumnSet(
+			rackRowAllColumns,
+			rackRowPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert rack_rows, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(rackRowPrimaryKeyColumns))
+			copy(conflict, rackRowPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryCockroachDB(dialect, "\"rack_rows\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(rackRowType, rackRowMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(rackRowType, rackRowMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.DebugMode {
+		_, _ = fmt.Fprintln(boil.DebugWriter, cache.query)
+		_, _ = fmt.Fprintln(boil.DebugWriter, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if err == sql.ErrNoRows {
+			err = nil // CockcorachDB doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert rack_rows")
+	}
+
+	if !cached {
+		rackRowUpsertCacheMut.Lock()
+		rackRowUpsertCache[key] = cache
+		rackRowUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
@@ -0,0 +1,994 @@
+// Code generated by SQLBoiler 4.11.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/volatiletech/randomize"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/strmangle"
+)
+
+func testRackRowsUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(rackRowAllColumns) == len(rackRowPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := RackRow{}
+	if err = randomize.Struct(seed, &o, rackRowDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize RackRow struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert RackRow: %s", err)
+	}
+
+	count, err := RackRows().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, rackRowDBTypes, false, rackRowPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize RackRow struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert RackRow: %s", err)
+	}
+
+	count, err = RackRows().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testRackRows(t *testing.T) {
+	t.Parallel()
+
+	query := RackRows()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testRackRowsDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &RackRow{}
+	if err = randomize.Struct(seed, o, rackRowDBTypes, true, rackRowColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize RackRow struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := RackRows().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testRackRowsQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &RackRow{}
+	if err = randomize.Struct(seed, o, rackRowDBTypes, true, rackRowColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize RackRow struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := RackRows().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := RackRows().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testRackRowsSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &RackRow{}
+	if err = randomize.Struct(seed, o, rackRowDBTypes, true, rackRowColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize RackRow struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := RackRowSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := RackRows().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testRackRowsExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &RackRow{}
+	if err = randomize.Struct(seed, o, rackRowDBTypes, true, rackRowColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize RackRow struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := RackRowExists(ctx, tx, o.ID)
+	if err != nil {
+		t.Errorf("Unable to check if RackRow exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected RackRowExists to return true, but got false.")
+	}
+}
+
+func testRackRowsFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &RackRow{}
+	if err = randomize.Struct(seed, o, rackRowDBTypes, true, rackRowColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize RackRow struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	rackRowFound, err := FindRackRow(ctx, tx, o.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if rackRowFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testRackRowsBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &RackRow{}
+	if err = randomize.Struct(seed, o, rackRowDBTypes, true, rackRowColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize RackRow struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = RackRows().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testRackRowsOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &RackRow{}
+	if err = randomize.Struct(seed, o, rackRowDBTypes, true, rackRowColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize RackRow struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := RackRows().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testRackRowsAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	rackRowOne := &RackRow{}
+	rackRowTwo := &RackRow{}
+	if err = randomize.Struct(seed, rackRowOne, rackRowDBTypes, false, rackRowColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize RackRow struct: %s", err)
+	}
+	if err = randomize.Struct(seed, rackRowTwo, rackRowDBTypes, false, rackRowColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize RackRow struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = rackRowOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = rackRowTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := RackRows().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testRackRowsCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	rackRowOne := &RackRow{}
+	rackRowTwo := &RackRow{}
+	if err = randomize.Struct(seed, rackRowOne, rackRowDBTypes, false, rackRowColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize RackRow struct: %s", err)
+	}
+	if err = randomize.Struct(seed, rackRowTwo, rackRowDBTypes, false, rackRowColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize RackRow struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = rackRowOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = rackRowTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := RackRows().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func rackRowBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *RackRow) error {
+	*o = RackRow{}
+	return nil
+}
+
+func rackRowAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *RackRow) error {
+	*o = RackRow{}
+	return nil
+}
+
+func rackRowAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *RackRow) error {
+	*o = RackRow{}
+	return nil
+}
+
+func rackRowBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *RackRow) error {
+	*o = RackRow{}
+	return nil
+}
+
+func rackRowAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *RackRow) error {
+	*o = RackRow{}
+	return nil
+}
+
+func rackRowBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *RackRow) error {
+	*o = RackRow{}
+	return nil
+}
+
+func rackRowAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *RackRow) error {
+	*o = RackRow{}
+	return nil
+}
+
+func rackRowBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *RackRow) error {
+	*o = RackRow{}
+	return nil
+}
+
+func rackRowAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *RackRow) error {
+	*o = RackRow{}
+	return nil
+}
+
+func testRackRowsHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &RackRow{}
+	o := &RackRow{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, rackRowDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize RackRow object: %s", err)
+	}
+
+	AddRackRowHook(boil.BeforeInsertHook, rackRowBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	rackRowBeforeInsertHooks = []RackRowHook{}
+
+	AddRackRowHook(boil.AfterInsertHook, rackRowAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	rackRowAfterInsertHooks = []RackRowHook{}
+
+	AddRackRowHook(boil.AfterSelectHook, rackRowAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	rackRowAfterSelectHooks = []RackRowHook{}
+
+	AddRackRowHook(boil.BeforeUpdateHook, rackRowBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	rackRowBeforeUpdateHooks = []RackRowHook{}
+
+	AddRackRowHook(boil.AfterUpdateHook, rackRowAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	rackRowAfterUpdateHooks = []RackRowHook{}
+
+	AddRackRowHook(boil.BeforeDeleteHook, rackRowBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	rackRowBeforeDeleteHooks = []RackRowHook{}
+
+	AddRackRowHook(boil.AfterDeleteHook, rackRowAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	rackRowAfterDeleteHooks = []RackRowHook{}
+
+	AddRackRowHook(boil.BeforeUpsertHook, rackRowBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	rackRowBeforeUpsertHooks = []RackRowHook{}
+
+	AddRackRowHook(boil.AfterUpsertHook, rackRowAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	rackRowAfterUpsertHooks = []RackRowHook{}
+}
+
+func testRackRowsInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &RackRow{}
+	if err = randomize.Struct(seed, o, rackRowDBTypes, true, rackRowColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize RackRow struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := RackRows().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testRackRowsInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &RackRow{}
+	if err = randomize.Struct(seed, o, rackRowDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize RackRow struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(rackRowColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := RackRows().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testRackRowToManyRowRacks(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a RackRow
+	var b, c Rack
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, rackRowDBTypes, true, rackRowColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize RackRow struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, rackDBTypes, false, rackColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, rackDBTypes, false, rackColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	b.RowID = a.ID
+	c.RowID = a.ID
+
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.RowRacks().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if v.RowID == b.RowID {
+			bFound = true
+		}
+		if v.RowID == c.RowID {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := RackRowSlice{&a}
+	if err = a.L.LoadRowRacks(ctx, tx, false, (*[]*RackRow)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.RowRacks); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.RowRacks = nil
+	if err = a.L.LoadRowRacks(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.RowRacks); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testRackRowToManyAddOpRowRacks(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a RackRow
+	var b, c, d, e Rack
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, rackRowDBTypes, false, strmangle.SetComplement(rackRowPrimaryKeyColumns, rackRowColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*Rack{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, rackDBTypes, false, strmangle.SetComplement(rackPrimaryKeyColumns, rackColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*Rack{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddRowRacks(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if a.ID != first.RowID {
+			t.Error("foreign key was wrong value", a.ID, first.RowID)
+		}
+		if a.ID != second.RowID {
+			t.Error("foreign key was wrong value", a.ID, second.RowID)
+		}
+
+		if first.R.Row != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.Row != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.RowRacks[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.RowRacks[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.RowRacks().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+func testRackRowToOneRoomUsingRoom(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local RackRow
+	var foreign Room
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, rackRowDBTypes, false, rackRowColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize RackRow struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, roomDBTypes, false, roomColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Room struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.RoomID = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.Room().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := RackRowSlice{&local}
+	if err = local.L.LoadRoom(ctx, tx, false, (*[]*RackRow)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Room == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.Room = nil
+	if err = local.L.LoadRoom(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Room == nil {
+		t.Error("struct should have been eager loaded")
+	}
+}
+
+func testRackRowToOneSetOpRoomUsingRoom(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a RackRow
+	var b, c Room
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, rackRowDBTypes, false, strmangle.SetComplement(rackRowPrimaryKeyColumns, rackRowColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, roomDBTypes, false, strmangle.SetComplement(roomPrimaryKeyColumns, roomColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, roomDBTypes, false, strmangle.SetComplement(roomPrimaryKeyColumns, roomColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*Room{&b, &c} {
+		err = a.SetRoom(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.Room != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.RackRows[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.RoomID != x.ID {
+			t.Error("foreign key was wrong value", a.RoomID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.RoomID))
+		reflect.Indirect(reflect.ValueOf(&a.RoomID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if a.RoomID != x.ID {
+			t.Error("foreign key was wrong value", a.RoomID, x.ID)
+		}
+	}
+}
+
+func testRackRowsReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &RackRow{}
+	if err = randomize.Struct(seed, o, rackRowDBTypes, true, rackRowColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize RackRow struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testRackRowsReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &RackRow{}
+	if err = randomize.Struct(seed, o, rackRowDBTypes, true, rackRowColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize RackRow struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := RackRowSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testRackRowsSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &RackRow{}
+	if err = randomize.Struct(seed, o, rackRowDBTypes, true, rackRowColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize RackRow struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := RackRows().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	rackRowDBTypes = map[string]string{`ID`: `uuid`, `RoomID`: `uuid`, `Name`: `string`, `CreatedAt`: `timestamptz`, `UpdatedAt`: `timestamptz`}
+	_              = bytes.MinRead
+)
+
+func testRackRowsUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(rackRowPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(rackRowAllColumns) == len(rackRowPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &RackRow{}
+	if err = randomize.Struct(seed, o, rackRowDBTypes, true, rackRowColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize RackRow struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := RackRows().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, rackRowDBTypes, true, rackRowPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize RackRow struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testRackRowsSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(rackRowAllColumns) == len(rackRowPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &RackRow{}
+	if err = randomize.Struct(seed, o, rackRowDBTypes, true, rackRowColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize RackRow struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := RackRows().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, rackRowDBTypes, true, rackRowPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize RackRow struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(rackRowAllColumns, rackRowPrimaryKeyColumns) {
+		fields = rackRowAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			rackRowAllColumns,
+			rackRowPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := RackRowSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
@@ -0,0 +1,1290 @@
+// Code generated by SQLBoiler 4.11.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// Rack is an object representing the database table.
+type Rack struct {
+	ID        string    `boil:"id" json:"id" toml:"id" yaml:"id"`
+	RowID     string    `boil:"row_id" json:"row_id" toml:"row_id" yaml:"row_id"`
+	Name      string    `boil:"name" json:"name" toml:"name" yaml:"name"`
+	HeightU   int64     `boil:"height_u" json:"height_u" toml:"height_u" yaml:"height_u"`
+	CreatedAt null.Time `boil:"created_at" json:"created_at,omitempty" toml:"created_at" yaml:"created_at,omitempty"`
+	UpdatedAt null.Time `boil:"updated_at" json:"updated_at,omitempty" toml:"updated_at" yaml:"updated_at,omitempty"`
+
+	R *rackR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L rackL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var RackColumns = struct {
+	ID        string
+	RowID     string
+	Name      string
+	HeightU   string
+	CreatedAt string
+	UpdatedAt string
+}{
+	ID:        "id",
+	RowID:     "row_id",
+	Name:      "name",
+	HeightU:   "height_u",
+	CreatedAt: "created_at",
+	UpdatedAt: "updated_at",
+}
+
+var RackTableColumns = struct {
+	ID        string
+	RowID     string
+	Name      string
+	HeightU   string
+	CreatedAt string
+	UpdatedAt string
+}{
+	ID:        "racks.id",
+	RowID:     "racks.row_id",
+	Name:      "racks.name",
+	HeightU:   "racks.height_u",
+	CreatedAt: "racks.created_at",
+	UpdatedAt: "racks.updated_at",
+}
+
+// Generated where
+
+var RackWhere = struct {
+	ID        whereHelperstring
+	RowID     whereHelperstring
+	Name      whereHelperstring
+	HeightU   whereHelperint64
+	CreatedAt whereHelpernull_Time
+	UpdatedAt whereHelpernull_Time
+}{
+	ID:        whereHelperstring{field: "\"racks\".\"id\""},
+	RowID:     whereHelperstring{field: "\"racks\".\"row_id\""},
+	Name:      whereHelperstring{field: "\"racks\".\"name\""},
+	HeightU:   whereHelperint64{field: "\"racks\".\"height_u\""},
+	CreatedAt: whereHelpernull_Time{field: "\"racks\".\"created_at\""},
+	UpdatedAt: whereHelpernull_Time{field: "\"racks\".\"updated_at\""},
+}
+
+// RackRels is where relationship names are stored.
+var RackRels = struct {
+	Row              string
+	ServerPlacements string
+}{
+	Row:              "Row",
+	ServerPlacements: "ServerPlacements",
+}
+
+// rackR is where relationships are stored.
+type rackR struct {
+	Row              *RackRow             `boil:"Row" json:"Row" toml:"Row" yaml:"Row"`
+	ServerPlacements ServerPlacementSlice `boil:"ServerPlacements" json:"ServerPlacements" toml:"ServerPlacements" yaml:"ServerPlacements"`
+}
+
+// NewStruct creates a new relationship struct
+func (*rackR) NewStruct() *rackR {
+	return &rackR{}
+}
+
+func (r *rackR) GetRow() *RackRow {
+	if r == nil {
+		return nil
+	}
+	return r.Row
+}
+
+func (r *rackR) GetServerPlacements() ServerPlacementSlice {
+	if r == nil {
+		return nil
+	}
+	return r.ServerPlacements
+}
+
+// rackL is where Load methods for each relationship are stored.
+type rackL struct{}
+
+var (
+	rackAllColumns            = []string{"id", "row_id", "name", "height_u", "created_at", "updated_at"}
+	rackColumnsWithoutDefault = []string{"row_id", "name"}
+	rackColumnsWithDefault    = []string{"id", "height_u", "created_at", "updated_at"}
+	rackPrimaryKeyColumns     = []string{"id"}
+	rackGeneratedColumns      = []string{}
+)
+
+type (
+	// RackSlice is an alias for a slice of pointers to Rack.
+	// This should almost always be used instead of []Rack.
+	RackSlice []*Rack
+	// RackHook is the signature for custom Rack hook methods
+	RackHook func(context.Context, boil.ContextExecutor, *Rack) error
+
+	rackQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	rackType                 = reflect.TypeOf(&Rack{})
+	rackMapping              = queries.MakeStructMapping(rackType)
+	rackPrimaryKeyMapping, _ = queries.BindMapping(rackType, rackMapping, rackPrimaryKeyColumns)
+	rackInsertCacheMut       sync.RWMutex
+	rackInsertCache          = make(map[string]insertCache)
+	rackUpdateCacheMut       sync.RWMutex
+	rackUpdateCache          = make(map[string]updateCache)
+	rackUpsertCacheMut       sync.RWMutex
+	rackUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var rackAfterSelectHooks []RackHook
+
+var rackBeforeInsertHooks []RackHook
+var rackAfterInsertHooks []RackHook
+
+var rackBeforeUpdateHooks []RackHook
+var rackAfterUpdateHooks []RackHook
+
+var rackBeforeDeleteHooks []RackHook
+var rackAfterDeleteHooks []RackHook
+
+var rackBeforeUpsertHooks []RackHook
+var rackAfterUpsertHooks []RackHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *Rack) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range rackAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *Rack) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range rackBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *Rack) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range rackAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *Rack) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range rackBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *Rack) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range rackAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *Rack) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range rackBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *Rack) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range rackAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *Rack) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range rackBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *Rack) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range rackAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddRackHook registers your hook function for all future operations.
+func AddRackHook(hookPoint boil.HookPoint, rackHook RackHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		rackAfterSelectHooks = append(rackAfterSelectHooks, rackHook)
+	case boil.BeforeInsertHook:
+		rackBeforeInsertHooks = append(rackBeforeInsertHooks, rackHook)
+	case boil.AfterInsertHook:
+		rackAfterInsertHooks = append(rackAfterInsertHooks, rackHook)
+	case boil.BeforeUpdateHook:
+		rackBeforeUpdateHooks = append(rackBeforeUpdateHooks, rackHook)
+	case boil.AfterUpdateHook:
+		rackAfterUpdateHooks = append(rackAfterUpdateHooks, rackHook)
+	case boil.BeforeDeleteHook:
+		rackBeforeDeleteHooks = append(rackBeforeDeleteHooks, rackHook)
+	case boil.AfterDeleteHook:
+		rackAfterDeleteHooks = append(rackAfterDeleteHooks, rackHook)
+	case boil.BeforeUpsertHook:
+		rackBeforeUpsertHooks = append(rackBeforeUpsertHooks, rackHook)
+	case boil.AfterUpsertHook:
+		rackAfterUpsertHooks = append(rackAfterUpsertHooks, rackHook)
+	}
+}
+
+// One returns a single rack record from the query.
+func (q rackQuery) One(ctx context.Context, exec boil.ContextExecutor) (*Rack, error) {
+	o := &Rack{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for racks")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all Rack records from the query.
+func (q rackQuery) All(ctx context.Context, exec boil.ContextExecutor) (RackSlice, error) {
+	var o []*Rack
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to Rack slice")
+	}
+
+	if len(rackAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all Rack records in the query.
+func (q rackQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count racks rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q rackQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if racks exists")
+	}
+
+	return count > 0, nil
+}
+
+// Row pointed to by the foreign key.
+func (o *Rack) Row(mods ...qm.QueryMod) rackRowQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.RowID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return RackRows(queryMods...)
+}
+
+// ServerPlacements retrieves all the server_placement's ServerPlacements with an executor.
+func (o *Rack) ServerPlacements(mods ...qm.QueryMod) serverPlacementQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"server_placements\".\"rack_id\"=?", o.ID),
+	)
+
+	return ServerPlacements(queryMods...)
+}
+
+// LoadRow allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (rackL) LoadRow(ctx context.Context, e boil.ContextExecutor, singular bool, maybeRack interface{}, mods queries.Applicator) error {
+	var slice []*Rack
+	var object *Rack
+
+	if singular {
+		object = maybeRack.(*Rack)
+	} else {
+		slice = *maybeRack.(*[]*Rack)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &rackR{}
+		}
+		args = append(args, object.RowID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &rackR{}
+			}
+
+			for _, a := range args {
+				if a == obj.RowID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.RowID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`rack_rows`),
+		qm.WhereIn(`rack_rows.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load RackRow")
+	}
+
+	var resultSlice []*RackRow
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice RackRow")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for rack_rows")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for rack_rows")
+	}
+
+	if len(rackAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Row = foreign
+		if foreign.R == nil {
+			foreign.R = &rackRowR{}
+		}
+		foreign.R.RowRacks = append(foreign.R.RowRacks, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.RowID == foreign.ID {
+				local.R.Row = foreign
+				if foreign.R == nil {
+					foreign.R = &rackRowR{}
+				}
+				foreign.R.RowRacks = append(foreign.R.RowRacks, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadServerPlacements allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (rackL) LoadServerPlacements(ctx context.Context, e boil.ContextExecutor, singular bool, maybeRack interface{}, mods queries.Applicator) error {
+	var slice []*Rack
+	var object *Rack
+
+	if singular {
+		object = maybeRack.(*Rack)
+	} else {
+		slice = *maybeRack.(*[]*Rack)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &rackR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &rackR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`server_placements`),
+		qm.WhereIn(`server_placements.rack_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load server_placements")
+	}
+
+	var resultSlice []*ServerPlacement
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice server_placements")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on server_placements")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for server_placements")
+	}
+
+	if len(serverPlacementAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.ServerPlacements = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &serverPlacementR{}
+			}
+			foreign.R.Rack = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.RackID {
+				local.R.ServerPlacements = append(local.R.ServerPlacements, foreign)
+				if foreign.R == nil {
+					foreign.R = &serverPlacementR{}
+				}
+				foreign.R.Rack = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetRow of the rack to the related item.
+// Sets o.R.Row to related.
+// Adds o to related.R.RowRacks.
+func (o *Rack) SetRow(ctx context.Context, exec boil.ContextExecutor, insert bool, related *RackRow) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"racks\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"row_id"}),
+		strmangle.WhereClause("\"", "\"", 2, rackPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.RowID = related.ID
+	if o.R == nil {
+		o.R = &rackR{
+			Row: related,
+		}
+	} else {
+		o.R.Row = related
+	}
+
+	if related.R == nil {
+		related.R = &rackRowR{
+			RowRacks: RackSlice{o},
+		}
+	} else {
+		related.R.RowRacks = append(related.R.RowRacks, o)
+	}
+
+	return nil
+}
+
+// AddServerPlacements adds the given related objects to the existing relationships
+// of the rack, optionally inserting them as new records.
+// Appends related to o.R.ServerPlacements.
+// Sets related.R.Rack appropriately.
+func (o *Rack) AddServerPlacements(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*ServerPlacement) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.RackID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"server_placements\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"rack_id"}),
+				strmangle.WhereClause("\"", "\"", 2, serverPlacementPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.RackID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &rackR{
+			ServerPlacements: related,
+		}
+	} else {
+		o.R.ServerPlacements = append(o.R.ServerPlacements, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &serverPlacementR{
+				Rack: o,
+			}
+		} else {
+			rel.R.Rack = o
+		}
+	}
+	return nil
+}
+
+// Racks retrieves all the records using an executor.
+func Racks(mods ...qm.QueryMod) rackQuery {
+	mods = append(mods, qm.From("\"racks\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"racks\".*"})
+	}
+
+	return rackQuery{q}
+}
+
+// FindRack retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindRack(ctx context.Context, exec boil.ContextExecutor, iD string, selectCols ...string) (*Rack, error) {
+	rackObj := &Rack{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"racks\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, rackObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from racks")
+	}
+
+	if err = rackObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return rackObj, err
+	}
+
+	return rackObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *Rack) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no racks provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if queries.MustTime(o.CreatedAt).IsZero() {
+			queries.SetScanner(&o.CreatedAt, currTime)
+		}
+		if queries.MustTime(o.UpdatedAt).IsZero() {
+			queries.SetScanner(&o.UpdatedAt, currTime)
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(rackColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	rackInsertCacheMut.RLock()
+	cache, cached := rackInsertCache[key]
+	rackInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			rackAllColumns,
+			rackColumnsWithDefault,
+			rackColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(rackType, rackMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(rackType, rackMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"racks\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"racks\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into racks")
+	}
+
+	if !cached {
+		rackInsertCacheMut.Lock()
+		rackInsertCache[key] = cache
+		rackInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the Rack.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *Rack) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		queries.SetScanner(&o.UpdatedAt, currTime)
+	}
+
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	rackUpdateCacheMut.RLock()
+	cache, cached := rackUpdateCache[key]
+	rackUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			rackAllColumns,
+			rackPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update racks, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"racks\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, rackPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(rackType, rackMapping, append(wl, rackPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update racks row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for racks")
+	}
+
+	if !cached {
+		rackUpdateCacheMut.Lock()
+		rackUpdateCache[key] = cache
+		rackUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q rackQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for racks")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for racks")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o RackSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), rackPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"racks\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, rackPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in rack slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all rack")
+	}
+	return rowsAff, nil
+}
+
+// Delete deletes a single Rack record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *Rack) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no Rack provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), rackPrimaryKeyMapping)
+	sql := "DELETE FROM \"racks\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from racks")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for racks")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q rackQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no rackQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from racks")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for racks")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o RackSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(rackBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), rackPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"racks\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, rackPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from rack slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for racks")
+	}
+
+	if len(rackAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *Rack) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindRack(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *RackSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := RackSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), rackPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"racks\".* FROM \"racks\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, rackPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in RackSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// RackExists checks if the Rack row exists.
+func RackExists(ctx context.Context, exec boil.ContextExecutor, iD string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"racks\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if racks exists")
+	}
+
+	return exists, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *Rack) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no racks provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if queries.MustTime(o.CreatedAt).IsZero() {
+			queries.SetScanner(&o.CreatedAt, currTime)
+		}
+		queries.SetScanner(&o.UpdatedAt, currTime)
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(rackColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	rackUpsertCacheMut.RLock()
+	cache, cached := rackUpsertCache[key]
+	rackUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			rackAllColumns,
+			rackColumnsWithDefault,
+			rackColumnsWithoutDefault,
+			nzDefaults,
+		)
+		update := updateColumns.UpdateColumnSet(
+			rackAllColumns,
+			rackPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert racks, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(rackPrimaryKeyColumns))
+			copy(conflict, rackPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryCockroachDB(dialect, "\"racks\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(rackType, rackMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(rackType, rackMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.DebugMode {
+		_, _ = fmt.Fprintln(boil.DebugWriter, cache.query)
+		_, _ = fmt.Fprintln(boil.DebugWriter, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if err == sql.ErrNoRows {
+			err = nil // CockcorachDB doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert racks")
+	}
+
+	if !cached {
+		rackUpsertCacheMut.Lock()
+		rackUpsertCache[key] = cache
+		rackUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
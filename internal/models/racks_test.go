@@ -0,0 +1,994 @@
+// Code generated by SQLBoiler 4.11.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/volatiletech/randomize"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/strmangle"
+)
+
+func testRacksUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(rackAllColumns) == len(rackPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := Rack{}
+	if err = randomize.Struct(seed, &o, rackDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize Rack struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert Rack: %s", err)
+	}
+
+	count, err := Racks().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, rackDBTypes, false, rackPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize Rack struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert Rack: %s", err)
+	}
+
+	count, err = Racks().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testRacks(t *testing.T) {
+	t.Parallel()
+
+	query := Racks()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testRacksDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Rack{}
+	if err = randomize.Struct(seed, o, rackDBTypes, true, rackColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Rack struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := Racks().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testRacksQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Rack{}
+	if err = randomize.Struct(seed, o, rackDBTypes, true, rackColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Rack struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := Racks().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := Racks().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testRacksSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Rack{}
+	if err = randomize.Struct(seed, o, rackDBTypes, true, rackColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Rack struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := RackSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := Racks().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testRacksExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Rack{}
+	if err = randomize.Struct(seed, o, rackDBTypes, true, rackColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Rack struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := RackExists(ctx, tx, o.ID)
+	if err != nil {
+		t.Errorf("Unable to check if Rack exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected RackExists to return true, but got false.")
+	}
+}
+
+func testRacksFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Rack{}
+	if err = randomize.Struct(seed, o, rackDBTypes, true, rackColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Rack struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	rackFound, err := FindRack(ctx, tx, o.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if rackFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testRacksBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Rack{}
+	if err = randomize.Struct(seed, o, rackDBTypes, true, rackColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Rack struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = Racks().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testRacksOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Rack{}
+	if err = randomize.Struct(seed, o, rackDBTypes, true, rackColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Rack struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := Racks().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testRacksAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	rackOne := &Rack{}
+	rackTwo := &Rack{}
+	if err = randomize.Struct(seed, rackOne, rackDBTypes, false, rackColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Rack struct: %s", err)
+	}
+	if err = randomize.Struct(seed, rackTwo, rackDBTypes, false, rackColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Rack struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = rackOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = rackTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := Racks().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testRacksCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	rackOne := &Rack{}
+	rackTwo := &Rack{}
+	if err = randomize.Struct(seed, rackOne, rackDBTypes, false, rackColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Rack struct: %s", err)
+	}
+	if err = randomize.Struct(seed, rackTwo, rackDBTypes, false, rackColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Rack struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = rackOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = rackTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Racks().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func rackBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *Rack) error {
+	*o = Rack{}
+	return nil
+}
+
+func rackAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *Rack) error {
+	*o = Rack{}
+	return nil
+}
+
+func rackAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *Rack) error {
+	*o = Rack{}
+	return nil
+}
+
+func rackBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *Rack) error {
+	*o = Rack{}
+	return nil
+}
+
+func rackAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *Rack) error {
+	*o = Rack{}
+	return nil
+}
+
+func rackBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *Rack) error {
+	*o = Rack{}
+	return nil
+}
+
+func rackAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *Rack) error {
+	*o = Rack{}
+	return nil
+}
+
+func rackBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *Rack) error {
+	*o = Rack{}
+	return nil
+}
+
+func rackAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *Rack) error {
+	*o = Rack{}
+	return nil
+}
+
+func testRacksHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &Rack{}
+	o := &Rack{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, rackDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize Rack object: %s", err)
+	}
+
+	AddRackHook(boil.BeforeInsertHook, rackBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	rackBeforeInsertHooks = []RackHook{}
+
+	AddRackHook(boil.AfterInsertHook, rackAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	rackAfterInsertHooks = []RackHook{}
+
+	AddRackHook(boil.AfterSelectHook, rackAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	rackAfterSelectHooks = []RackHook{}
+
+	AddRackHook(boil.BeforeUpdateHook, rackBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	rackBeforeUpdateHooks = []RackHook{}
+
+	AddRackHook(boil.AfterUpdateHook, rackAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	rackAfterUpdateHooks = []RackHook{}
+
+	AddRackHook(boil.BeforeDeleteHook, rackBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	rackBeforeDeleteHooks = []RackHook{}
+
+	AddRackHook(boil.AfterDeleteHook, rackAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	rackAfterDeleteHooks = []RackHook{}
+
+	AddRackHook(boil.BeforeUpsertHook, rackBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	rackBeforeUpsertHooks = []RackHook{}
+
+	AddRackHook(boil.AfterUpsertHook, rackAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	rackAfterUpsertHooks = []RackHook{}
+}
+
+func testRacksInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Rack{}
+	if err = randomize.Struct(seed, o, rackDBTypes, true, rackColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Rack struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Racks().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testRacksInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Rack{}
+	if err = randomize.Struct(seed, o, rackDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize Rack struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(rackColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Racks().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testRackToManyServerPlacements(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Rack
+	var b, c ServerPlacement
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, rackDBTypes, true, rackColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Rack struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, serverPlacementDBTypes, false, serverPlacementColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, serverPlacementDBTypes, false, serverPlacementColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	b.RackID = a.ID
+	c.RackID = a.ID
+
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.ServerPlacements().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if v.RackID == b.RackID {
+			bFound = true
+		}
+		if v.RackID == c.RackID {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := RackSlice{&a}
+	if err = a.L.LoadServerPlacements(ctx, tx, false, (*[]*Rack)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.ServerPlacements); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.ServerPlacements = nil
+	if err = a.L.LoadServerPlacements(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.ServerPlacements); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testRackToManyAddOpServerPlacements(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Rack
+	var b, c, d, e ServerPlacement
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, rackDBTypes, false, strmangle.SetComplement(rackPrimaryKeyColumns, rackColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*ServerPlacement{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, serverPlacementDBTypes, false, strmangle.SetComplement(serverPlacementPrimaryKeyColumns, serverPlacementColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*ServerPlacement{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddServerPlacements(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if a.ID != first.RackID {
+			t.Error("foreign key was wrong value", a.ID, first.RackID)
+		}
+		if a.ID != second.RackID {
+			t.Error("foreign key was wrong value", a.ID, second.RackID)
+		}
+
+		if first.R.Rack != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.Rack != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.ServerPlacements[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.ServerPlacements[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.ServerPlacements().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+func testRackToOneRackRowUsingRow(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local Rack
+	var foreign RackRow
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, rackDBTypes, false, rackColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Rack struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, rackRowDBTypes, false, rackRowColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize RackRow struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.RowID = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.Row().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := RackSlice{&local}
+	if err = local.L.LoadRow(ctx, tx, false, (*[]*Rack)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Row == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.Row = nil
+	if err = local.L.LoadRow(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Row == nil {
+		t.Error("struct should have been eager loaded")
+	}
+}
+
+func testRackToOneSetOpRackRowUsingRow(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Rack
+	var b, c RackRow
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, rackDBTypes, false, strmangle.SetComplement(rackPrimaryKeyColumns, rackColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, rackRowDBTypes, false, strmangle.SetComplement(rackRowPrimaryKeyColumns, rackRowColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, rackRowDBTypes, false, strmangle.SetComplement(rackRowPrimaryKeyColumns, rackRowColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*RackRow{&b, &c} {
+		err = a.SetRow(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.Row != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.RowRacks[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.RowID != x.ID {
+			t.Error("foreign key was wrong value", a.RowID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.RowID))
+		reflect.Indirect(reflect.ValueOf(&a.RowID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if a.RowID != x.ID {
+			t.Error("foreign key was wrong value", a.RowID, x.ID)
+		}
+	}
+}
+
+func testRacksReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Rack{}
+	if err = randomize.Struct(seed, o, rackDBTypes, true, rackColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Rack struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testRacksReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Rack{}
+	if err = randomize.Struct(seed, o, rackDBTypes, true, rackColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Rack struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := RackSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testRacksSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Rack{}
+	if err = randomize.Struct(seed, o, rackDBTypes, true, rackColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Rack struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := Racks().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	rackDBTypes = map[string]string{`ID`: `uuid`, `RowID`: `uuid`, `Name`: `string`, `HeightU`: `int8`, `CreatedAt`: `timestamptz`, `UpdatedAt`: `timestamptz`}
+	_           = bytes.MinRead
+)
+
+func testRacksUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(rackPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(rackAllColumns) == len(rackPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Rack{}
+	if err = randomize.Struct(seed, o, rackDBTypes, true, rackColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Rack struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Racks().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, rackDBTypes, true, rackPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize Rack struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testRacksSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(rackAllColumns) == len(rackPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Rack{}
+	if err = randomize.Struct(seed, o, rackDBTypes, true, rackColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Rack struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Racks().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, rackDBTypes, true, rackPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize Rack struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(rackAllColumns, rackPrimaryKeyColumns) {
+		fields = rackAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			rackAllColumns,
+			rackPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := RackSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
@@ -0,0 +1,1283 @@
+// Code generated by SQLBoiler 4.11.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// Room is an object representing the database table.
+type Room struct {
+	ID         string    `boil:"id" json:"id" toml:"id" yaml:"id"`
+	FacilityID string    `boil:"facility_id" json:"facility_id" toml:"facility_id" yaml:"facility_id"`
+	Name       string    `boil:"name" json:"name" toml:"name" yaml:"name"`
+	CreatedAt  null.Time `boil:"created_at" json:"created_at,omitempty" toml:"created_at" yaml:"created_at,omitempty"`
+	UpdatedAt  null.Time `boil:"updated_at" json:"updated_at,omitempty" toml:"updated_at" yaml:"updated_at,omitempty"`
+
+	R *roomR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L roomL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var RoomColumns = struct {
+	ID         string
+	FacilityID string
+	Name       string
+	CreatedAt  string
+	UpdatedAt  string
+}{
+	ID:         "id",
+	FacilityID: "facility_id",
+	Name:       "name",
+	CreatedAt:  "created_at",
+	UpdatedAt:  "updated_at",
+}
+
+var RoomTableColumns = struct {
+	ID         string
+	FacilityID string
+	Name       string
+	CreatedAt  string
+	UpdatedAt  string
+}{
+	ID:         "rooms.id",
+	FacilityID: "rooms.facility_id",
+	Name:       "rooms.name",
+	CreatedAt:  "rooms.created_at",
+	UpdatedAt:  "rooms.updated_at",
+}
+
+// Generated where
+
+var RoomWhere = struct {
+	ID         whereHelperstring
+	FacilityID whereHelperstring
+	Name       whereHelperstring
+	CreatedAt  whereHelpernull_Time
+	UpdatedAt  whereHelpernull_Time
+}{
+	ID:         whereHelperstring{field: "\"rooms\".\"id\""},
+	FacilityID: whereHelperstring{field: "\"rooms\".\"facility_id\""},
+	Name:       whereHelperstring{field: "\"rooms\".\"name\""},
+	CreatedAt:  whereHelpernull_Time{field: "\"rooms\".\"created_at\""},
+	UpdatedAt:  whereHelpernull_Time{field: "\"rooms\".\"updated_at\""},
+}
+
+// RoomRels is where relationship names are stored.
+var RoomRels = struct {
+	Facility string
+	RackRows string
+}{
+	Facility: "Facility",
+	RackRows: "RackRows",
+}
+
+// roomR is where relationships are stored.
+type roomR struct {
+	Facility *Facility    `boil:"Facility" json:"Facility" toml:"Facility" yaml:"Facility"`
+	RackRows RackRowSlice `boil:"RackRows" json:"RackRows" toml:"RackRows" yaml:"RackRows"`
+}
+
+// NewStruct creates a new relationship struct
+func (*roomR) NewStruct() *roomR {
+	return &roomR{}
+}
+
+func (r *roomR) GetFacility() *Facility {
+	if r == nil {
+		return nil
+	}
+	return r.Facility
+}
+
+func (r *roomR) GetRackRows() RackRowSlice {
+	if r == nil {
+		return nil
+	}
+	return r.RackRows
+}
+
+// roomL is where Load methods for each relationship are stored.
+type roomL struct{}
+
+var (
+	roomAllColumns            = []string{"id", "facility_id", "name", "created_at", "updated_at"}
+	roomColumnsWithoutDefault = []string{"facility_id", "name"}
+	roomColumnsWithDefault    = []string{"id", "created_at", "updated_at"}
+	roomPrimaryKeyColumns     = []string{"id"}
+	roomGeneratedColumns      = []string{}
+)
+
+type (
+	// RoomSlice is an alias for a slice of pointers to Room.
+	// This should almost always be used instead of []Room.
+	RoomSlice []*Room
+	// RoomHook is the signature for custom Room hook methods
+	RoomHook func(context.Context, boil.ContextExecutor, *Room) error
+
+	roomQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	roomType                 = reflect.TypeOf(&Room{})
+	roomMapping              = queries.MakeStructMapping(roomType)
+	roomPrimaryKeyMapping, _ = queries.BindMapping(roomType, roomMapping, roomPrimaryKeyColumns)
+	roomInsertCacheMut       sync.RWMutex
+	roomInsertCache          = make(map[string]insertCache)
+	roomUpdateCacheMut       sync.RWMutex
+	roomUpdateCache          = make(map[string]updateCache)
+	roomUpsertCacheMut       sync.RWMutex
+	roomUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var roomAfterSelectHooks []RoomHook
+
+var roomBeforeInsertHooks []RoomHook
+var roomAfterInsertHooks []RoomHook
+
+var roomBeforeUpdateHooks []RoomHook
+var roomAfterUpdateHooks []RoomHook
+
+var roomBeforeDeleteHooks []RoomHook
+var roomAfterDeleteHooks []RoomHook
+
+var roomBeforeUpsertHooks []RoomHook
+var roomAfterUpsertHooks []RoomHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *Room) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range roomAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *Room) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range roomBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *Room) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range roomAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *Room) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range roomBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *Room) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range roomAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *Room) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range roomBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *Room) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range roomAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *Room) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range roomBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *Room) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range roomAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddRoomHook registers your hook function for all future operations.
+func AddRoomHook(hookPoint boil.HookPoint, roomHook RoomHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		roomAfterSelectHooks = append(roomAfterSelectHooks, roomHook)
+	case boil.BeforeInsertHook:
+		roomBeforeInsertHooks = append(roomBeforeInsertHooks, roomHook)
+	case boil.AfterInsertHook:
+		roomAfterInsertHooks = append(roomAfterInsertHooks, roomHook)
+	case boil.BeforeUpdateHook:
+		roomBeforeUpdateHooks = append(roomBeforeUpdateHooks, roomHook)
+	case boil.AfterUpdateHook:
+		roomAfterUpdateHooks = append(roomAfterUpdateHooks, roomHook)
+	case boil.BeforeDeleteHook:
+		roomBeforeDeleteHooks = append(roomBeforeDeleteHooks, roomHook)
+	case boil.AfterDeleteHook:
+		roomAfterDeleteHooks = append(roomAfterDeleteHooks, roomHook)
+	case boil.BeforeUpsertHook:
+		roomBeforeUpsertHooks = append(roomBeforeUpsertHooks, roomHook)
+	case boil.AfterUpsertHook:
+		roomAfterUpsertHooks = append(roomAfterUpsertHooks, roomHook)
+	}
+}
+
+// One returns a single room record from the query.
+func (q roomQuery) One(ctx context.Context, exec boil.ContextExecutor) (*Room, error) {
+	o := &Room{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for rooms")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all Room records from the query.
+func (q roomQuery) All(ctx context.Context, exec boil.ContextExecutor) (RoomSlice, error) {
+	var o []*Room
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to Room slice")
+	}
+
+	if len(roomAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all Room records in the query.
+func (q roomQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count rooms rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q roomQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if rooms exists")
+	}
+
+	return count > 0, nil
+}
+
+// Facility pointed to by the foreign key.
+func (o *Room) Facility(mods ...qm.QueryMod) facilityQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.FacilityID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Facilities(queryMods...)
+}
+
+// RackRows retrieves all the rack_row's RackRows with an executor.
+func (o *Room) RackRows(mods ...qm.QueryMod) rackRowQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"rack_rows\".\"room_id\"=?", o.ID),
+	)
+
+	return RackRows(queryMods...)
+}
+
+// LoadFacility allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (roomL) LoadFacility(ctx context.Context, e boil.ContextExecutor, singular bool, maybeRoom interface{}, mods queries.Applicator) error {
+	var slice []*Room
+	var object *Room
+
+	if singular {
+		object = maybeRoom.(*Room)
+	} else {
+		slice = *maybeRoom.(*[]*Room)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &roomR{}
+		}
+		args = append(args, object.FacilityID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &roomR{}
+			}
+
+			for _, a := range args {
+				if a == obj.FacilityID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.FacilityID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`facilities`),
+		qm.WhereIn(`facilities.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Facility")
+	}
+
+	var resultSlice []*Facility
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Facility")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for facilities")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for facilities")
+	}
+
+	if len(roomAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Facility = foreign
+		if foreign.R == nil {
+			foreign.R = &facilityR{}
+		}
+		foreign.R.Rooms = append(foreign.R.Rooms, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.FacilityID == foreign.ID {
+				local.R.Facility = foreign
+				if foreign.R == nil {
+					foreign.R = &facilityR{}
+				}
+				foreign.R.Rooms = append(foreign.R.Rooms, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadRackRows allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (roomL) LoadRackRows(ctx context.Context, e boil.ContextExecutor, singular bool, maybeRoom interface{}, mods queries.Applicator) error {
+	var slice []*Room
+	var object *Room
+
+	if singular {
+		object = maybeRoom.(*Room)
+	} else {
+		slice = *maybeRoom.(*[]*Room)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &roomR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &roomR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`rack_rows`),
+		qm.WhereIn(`rack_rows.room_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load rack_rows")
+	}
+
+	var resultSlice []*RackRow
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice rack_rows")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on rack_rows")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for rack_rows")
+	}
+
+	if len(rackRowAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.RackRows = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &rackRowR{}
+			}
+			foreign.R.Room = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.RoomID {
+				local.R.RackRows = append(local.R.RackRows, foreign)
+				if foreign.R == nil {
+					foreign.R = &rackRowR{}
+				}
+				foreign.R.Room = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetFacility of the room to the related item.
+// Sets o.R.Facility to related.
+// Adds o to related.R.Rooms.
+func (o *Room) SetFacility(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Facility) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"rooms\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"facility_id"}),
+		strmangle.WhereClause("\"", "\"", 2, roomPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.FacilityID = related.ID
+	if o.R == nil {
+		o.R = &roomR{
+			Facility: related,
+		}
+	} else {
+		o.R.Facility = related
+	}
+
+	if related.R == nil {
+		related.R = &facilityR{
+			Rooms: RoomSlice{o},
+		}
+	} else {
+		related.R.Rooms = append(related.R.Rooms, o)
+	}
+
+	return nil
+}
+
+// AddRackRows adds the given related objects to the existing relationships
+// of the room, optionally inserting them as new records.
+// Appends related to o.R.RackRows.
+// Sets related.R.Room appropriately.
+func (o *Room) AddRackRows(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*RackRow) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.RoomID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"rack_rows\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"room_id"}),
+				strmangle.WhereClause("\"", "\"", 2, rackRowPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.RoomID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &roomR{
+			RackRows: related,
+		}
+	} else {
+		o.R.RackRows = append(o.R.RackRows, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &rackRowR{
+				Room: o,
+			}
+		} else {
+			rel.R.Room = o
+		}
+	}
+	return nil
+}
+
+// Rooms retrieves all the records using an executor.
+func Rooms(mods ...qm.QueryMod) roomQuery {
+	mods = append(mods, qm.From("\"rooms\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"rooms\".*"})
+	}
+
+	return roomQuery{q}
+}
+
+// FindRoom retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindRoom(ctx context.Context, exec boil.ContextExecutor, iD string, selectCols ...string) (*Room, error) {
+	roomObj := &Room{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"rooms\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, roomObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from rooms")
+	}
+
+	if err = roomObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return roomObj, err
+	}
+
+	return roomObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *Room) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no rooms provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if queries.MustTime(o.CreatedAt).IsZero() {
+			queries.SetScanner(&o.CreatedAt, currTime)
+		}
+		if queries.MustTime(o.UpdatedAt).IsZero() {
+			queries.SetScanner(&o.UpdatedAt, currTime)
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(roomColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	roomInsertCacheMut.RLock()
+	cache, cached := roomInsertCache[key]
+	roomInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			roomAllColumns,
+			roomColumnsWithDefault,
+			roomColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(roomType, roomMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(roomType, roomMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"rooms\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"rooms\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into rooms")
+	}
+
+	if !cached {
+		roomInsertCacheMut.Lock()
+		roomInsertCache[key] = cache
+		roomInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the Room.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *Room) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		queries.SetScanner(&o.UpdatedAt, currTime)
+	}
+
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	roomUpdateCacheMut.RLock()
+	cache, cached := roomUpdateCache[key]
+	roomUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			roomAllColumns,
+			roomPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update rooms, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"rooms\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, roomPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(roomType, roomMapping, append(wl, roomPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update rooms row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for rooms")
+	}
+
+	if !cached {
+		roomUpdateCacheMut.Lock()
+		roomUpdateCache[key] = cache
+		roomUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q roomQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for rooms")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for rooms")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o RoomSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), roomPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"rooms\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, roomPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in room slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all room")
+	}
+	return rowsAff, nil
+}
+
+// Delete deletes a single Room record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *Room) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no Room provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), roomPrimaryKeyMapping)
+	sql := "DELETE FROM \"rooms\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from rooms")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for rooms")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q roomQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no roomQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from rooms")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for rooms")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o RoomSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(roomBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), roomPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"rooms\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, roomPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from room slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for rooms")
+	}
+
+	if len(roomAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *Room) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindRoom(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *RoomSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := RoomSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), roomPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"rooms\".* FROM \"rooms\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, roomPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in RoomSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// RoomExists checks if the Room row exists.
+func RoomExists(ctx context.Context, exec boil.ContextExecutor, iD string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"rooms\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if rooms exists")
+	}
+
+	return exists, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *Room) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no rooms provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if queries.MustTime(o.CreatedAt).IsZero() {
+			queries.SetScanner(&o.CreatedAt, currTime)
+		}
+		queries.SetScanner(&o.UpdatedAt, currTime)
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(roomColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	roomUpsertCacheMut.RLock()
+	cache, cached := roomUpsertCache[key]
+	roomUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			roomAllColumns,
+			roomColumnsWithDefault,
+			roomColumnsWithoutDefault,
+			nzDefaults,
+		)
+		update := updateColumns.UpdateColumnSet(
+			roomAllColumns,
+			roomPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert rooms, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(roomPrimaryKeyColumns))
+			copy(conflict, roomPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryCockroachDB(dialect, "\"rooms\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(roomType, roomMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(roomType, roomMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.DebugMode {
+		_, _ = fmt.Fprintln(boil.DebugWriter, cache.query)
+		_, _ = fmt.Fprintln(boil.DebugWriter, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if err == sql.ErrNoRows {
+			err = nil // CockcorachDB doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert rooms")
+	}
+
+	if !cached {
+		roomUpsertCacheMut.Lock()
+		roomUpsertCache[key] = cache
+		roomUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
@@ -0,0 +1,994 @@
+// Code generated by SQLBoiler 4.11.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/volatiletech/randomize"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/strmangle"
+)
+
+func testRoomsUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(roomAllColumns) == len(roomPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := Room{}
+	if err = randomize.Struct(seed, &o, roomDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize Room struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert Room: %s", err)
+	}
+
+	count, err := Rooms().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, roomDBTypes, false, roomPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize Room struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert Room: %s", err)
+	}
+
+	count, err = Rooms().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testRooms(t *testing.T) {
+	t.Parallel()
+
+	query := Rooms()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testRoomsDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Room{}
+	if err = randomize.Struct(seed, o, roomDBTypes, true, roomColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Room struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := Rooms().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testRoomsQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Room{}
+	if err = randomize.Struct(seed, o, roomDBTypes, true, roomColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Room struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := Rooms().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := Rooms().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testRoomsSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Room{}
+	if err = randomize.Struct(seed, o, roomDBTypes, true, roomColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Room struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := RoomSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := Rooms().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testRoomsExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Room{}
+	if err = randomize.Struct(seed, o, roomDBTypes, true, roomColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Room struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := RoomExists(ctx, tx, o.ID)
+	if err != nil {
+		t.Errorf("Unable to check if Room exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected RoomExists to return true, but got false.")
+	}
+}
+
+func testRoomsFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Room{}
+	if err = randomize.Struct(seed, o, roomDBTypes, true, roomColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Room struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	roomFound, err := FindRoom(ctx, tx, o.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if roomFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testRoomsBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Room{}
+	if err = randomize.Struct(seed, o, roomDBTypes, true, roomColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Room struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = Rooms().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testRoomsOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Room{}
+	if err = randomize.Struct(seed, o, roomDBTypes, true, roomColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Room struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := Rooms().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testRoomsAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	roomOne := &Room{}
+	roomTwo := &Room{}
+	if err = randomize.Struct(seed, roomOne, roomDBTypes, false, roomColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Room struct: %s", err)
+	}
+	if err = randomize.Struct(seed, roomTwo, roomDBTypes, false, roomColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Room struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = roomOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = roomTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := Rooms().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testRoomsCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	roomOne := &Room{}
+	roomTwo := &Room{}
+	if err = randomize.Struct(seed, roomOne, roomDBTypes, false, roomColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Room struct: %s", err)
+	}
+	if err = randomize.Struct(seed, roomTwo, roomDBTypes, false, roomColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Room struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = roomOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = roomTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Rooms().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func roomBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *Room) error {
+	*o = Room{}
+	return nil
+}
+
+func roomAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *Room) error {
+	*o = Room{}
+	return nil
+}
+
+func roomAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *Room) error {
+	*o = Room{}
+	return nil
+}
+
+func roomBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *Room) error {
+	*o = Room{}
+	return nil
+}
+
+func roomAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *Room) error {
+	*o = Room{}
+	return nil
+}
+
+func roomBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *Room) error {
+	*o = Room{}
+	return nil
+}
+
+func roomAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *Room) error {
+	*o = Room{}
+	return nil
+}
+
+func roomBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *Room) error {
+	*o = Room{}
+	return nil
+}
+
+func roomAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *Room) error {
+	*o = Room{}
+	return nil
+}
+
+func testRoomsHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &Room{}
+	o := &Room{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, roomDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize Room object: %s", err)
+	}
+
+	AddRoomHook(boil.BeforeInsertHook, roomBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	roomBeforeInsertHooks = []RoomHook{}
+
+	AddRoomHook(boil.AfterInsertHook, roomAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	roomAfterInsertHooks = []RoomHook{}
+
+	AddRoomHook(boil.AfterSelectHook, roomAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	roomAfterSelectHooks = []RoomHook{}
+
+	AddRoomHook(boil.BeforeUpdateHook, roomBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	roomBeforeUpdateHooks = []RoomHook{}
+
+	AddRoomHook(boil.AfterUpdateHook, roomAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	roomAfterUpdateHooks = []RoomHook{}
+
+	AddRoomHook(boil.BeforeDeleteHook, roomBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	roomBeforeDeleteHooks = []RoomHook{}
+
+	AddRoomHook(boil.AfterDeleteHook, roomAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	roomAfterDeleteHooks = []RoomHook{}
+
+	AddRoomHook(boil.BeforeUpsertHook, roomBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	roomBeforeUpsertHooks = []RoomHook{}
+
+	AddRoomHook(boil.AfterUpsertHook, roomAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	roomAfterUpsertHooks = []RoomHook{}
+}
+
+func testRoomsInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Room{}
+	if err = randomize.Struct(seed, o, roomDBTypes, true, roomColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Room struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Rooms().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testRoomsInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Room{}
+	if err = randomize.Struct(seed, o, roomDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize Room struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(roomColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Rooms().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testRoomToManyRackRows(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Room
+	var b, c RackRow
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, roomDBTypes, true, roomColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Room struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, rackRowDBTypes, false, rackRowColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, rackRowDBTypes, false, rackRowColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	b.RoomID = a.ID
+	c.RoomID = a.ID
+
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.RackRows().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if v.RoomID == b.RoomID {
+			bFound = true
+		}
+		if v.RoomID == c.RoomID {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := RoomSlice{&a}
+	if err = a.L.LoadRackRows(ctx, tx, false, (*[]*Room)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.RackRows); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.RackRows = nil
+	if err = a.L.LoadRackRows(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.RackRows); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testRoomToManyAddOpRackRows(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Room
+	var b, c, d, e RackRow
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, roomDBTypes, false, strmangle.SetComplement(roomPrimaryKeyColumns, roomColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*RackRow{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, rackRowDBTypes, false, strmangle.SetComplement(rackRowPrimaryKeyColumns, rackRowColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*RackRow{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddRackRows(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if a.ID != first.RoomID {
+			t.Error("foreign key was wrong value", a.ID, first.RoomID)
+		}
+		if a.ID != second.RoomID {
+			t.Error("foreign key was wrong value", a.ID, second.RoomID)
+		}
+
+		if first.R.Room != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.Room != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.RackRows[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.RackRows[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.RackRows().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+func testRoomToOneFacilityUsingFacility(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local Room
+	var foreign Facility
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, roomDBTypes, false, roomColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Room struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, facilityDBTypes, false, facilityColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Facility struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.FacilityID = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.Facility().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := RoomSlice{&local}
+	if err = local.L.LoadFacility(ctx, tx, false, (*[]*Room)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Facility == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.Facility = nil
+	if err = local.L.LoadFacility(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Facility == nil {
+		t.Error("struct should have been eager loaded")
+	}
+}
+
+func testRoomToOneSetOpFacilityUsingFacility(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Room
+	var b, c Facility
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, roomDBTypes, false, strmangle.SetComplement(roomPrimaryKeyColumns, roomColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, facilityDBTypes, false, strmangle.SetComplement(facilityPrimaryKeyColumns, facilityColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, facilityDBTypes, false, strmangle.SetComplement(facilityPrimaryKeyColumns, facilityColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*Facility{&b, &c} {
+		err = a.SetFacility(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.Facility != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.Rooms[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.FacilityID != x.ID {
+			t.Error("foreign key was wrong value", a.FacilityID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.FacilityID))
+		reflect.Indirect(reflect.ValueOf(&a.FacilityID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if a.FacilityID != x.ID {
+			t.Error("foreign key was wrong value", a.FacilityID, x.ID)
+		}
+	}
+}
+
+func testRoomsReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Room{}
+	if err = randomize.Struct(seed, o, roomDBTypes, true, roomColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Room struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testRoomsReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Room{}
+	if err = randomize.Struct(seed, o, roomDBTypes, true, roomColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Room struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := RoomSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testRoomsSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Room{}
+	if err = randomize.Struct(seed, o, roomDBTypes, true, roomColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Room struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := Rooms().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	roomDBTypes = map[string]string{`ID`: `uuid`, `FacilityID`: `uuid`, `Name`: `string`, `CreatedAt`: `timestamptz`, `UpdatedAt`: `timestamptz`}
+	_           = bytes.MinRead
+)
+
+func testRoomsUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(roomPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(roomAllColumns) == len(roomPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Room{}
+	if err = randomize.Struct(seed, o, roomDBTypes, true, roomColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Room struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Rooms().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, roomDBTypes, true, roomPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize Room struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testRoomsSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(roomAllColumns) == len(roomPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Room{}
+	if err = randomize.Struct(seed, o, roomDBTypes, true, roomColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Room struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Rooms().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, roomDBTypes, true, roomPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize Room struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(roomAllColumns, roomPrimaryKeyColumns) {
+		fields = roomAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			roomAllColumns,
+			roomPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := RoomSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
@@ -24,58 +24,81 @@ import (
 
 // ServerComponentType is an object representing the database table.
 type ServerComponentType struct {
-	ID        string    `boil:"id" json:"id" toml:"id" yaml:"id"`
-	Name      string    `boil:"name" json:"name" toml:"name" yaml:"name"`
-	CreatedAt null.Time `boil:"created_at" json:"created_at,omitempty" toml:"created_at" yaml:"created_at,omitempty"`
-	UpdatedAt null.Time `boil:"updated_at" json:"updated_at,omitempty" toml:"updated_at" yaml:"updated_at,omitempty"`
-	Slug      string    `boil:"slug" json:"slug" toml:"slug" yaml:"slug"`
+	ID          string      `boil:"id" json:"id" toml:"id" yaml:"id"`
+	Name        string      `boil:"name" json:"name" toml:"name" yaml:"name"`
+	CreatedAt   null.Time   `boil:"created_at" json:"created_at,omitempty" toml:"created_at" yaml:"created_at,omitempty"`
+	UpdatedAt   null.Time   `boil:"updated_at" json:"updated_at,omitempty" toml:"updated_at" yaml:"updated_at,omitempty"`
+	Slug        string      `boil:"slug" json:"slug" toml:"slug" yaml:"slug"`
+	Description null.String `boil:"description" json:"description,omitempty" toml:"description" yaml:"description,omitempty"`
+	Deprecated  bool        `boil:"deprecated" json:"deprecated" toml:"deprecated" yaml:"deprecated"`
 
 	R *serverComponentTypeR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L serverComponentTypeL  `boil:"-" json:"-" toml:"-" yaml:"-"`
 }
 
 var ServerComponentTypeColumns = struct {
-	ID        string
-	Name      string
-	CreatedAt string
-	UpdatedAt string
-	Slug      string
+	ID          string
+	Name        string
+	CreatedAt   string
+	UpdatedAt   string
+	Slug        string
+	Description string
+	Deprecated  string
 }{
-	ID:        "id",
-	Name:      "name",
-	CreatedAt: "created_at",
-	UpdatedAt: "updated_at",
-	Slug:      "slug",
+	ID:          "id",
+	Name:        "name",
+	CreatedAt:   "created_at",
+	UpdatedAt:   "updated_at",
+	Slug:        "slug",
+	Description: "description",
+	Deprecated:  "deprecated",
 }
 
 var ServerComponentTypeTableColumns = struct {
-	ID        string
-	Name      string
-	CreatedAt string
-	UpdatedAt string
-	Slug      string
+	ID          string
+	Name        string
+	CreatedAt   string
+	UpdatedAt   string
+	Slug        string
+	Description string
+	Deprecated  string
 }{
-	ID:        "server_component_types.id",
-	Name:      "server_component_types.name",
-	CreatedAt: "server_component_types.created_at",
-	UpdatedAt: "server_component_types.updated_at",
-	Slug:      "server_component_types.slug",
+	ID:          "server_component_types.id",
+	Name:        "server_component_types.name",
+	CreatedAt:   "server_component_types.created_at",
+	UpdatedAt:   "server_component_types.updated_at",
+	Slug:        "server_component_types.slug",
+	Description: "server_component_types.description",
+	Deprecated:  "server_component_types.deprecated",
 }
 
 // Generated where
 
+type whereHelperbool struct{ field string }
+
+func (w whereHelperbool) EQ(x bool) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.EQ, x) }
+func (w whereHelperbool) NEQ(x bool) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
+func (w whereHelperbool) LT(x bool) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.LT, x) }
+func (w whereHelperbool) LTE(x bool) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.LTE, x) }
+func (w whereHelperbool) GT(x bool) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.GT, x) }
+func (w whereHelperbool) GTE(x bool) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GTE, x) }
+
 var ServerComponentTypeWhere = struct {
-	ID        whereHelperstring
-	Name      whereHelperstring
-	CreatedAt whereHelpernull_Time
-	UpdatedAt whereHelpernull_Time
-	Slug      whereHelperstring
+	ID          whereHelperstring
+	Name        whereHelperstring
+	CreatedAt   whereHelpernull_Time
+	UpdatedAt   whereHelpernull_Time
+	Slug        whereHelperstring
+	Description whereHelpernull_String
+	Deprecated  whereHelperbool
 }{
-	ID:        whereHelperstring{field: "\"server_component_types\".\"id\""},
-	Name:      whereHelperstring{field: "\"server_component_types\".\"name\""},
-	CreatedAt: whereHelpernull_Time{field: "\"server_component_types\".\"created_at\""},
-	UpdatedAt: whereHelpernull_Time{field: "\"server_component_types\".\"updated_at\""},
-	Slug:      whereHelperstring{field: "\"server_component_types\".\"slug\""},
+	ID:          whereHelperstring{field: "\"server_component_types\".\"id\""},
+	Name:        whereHelperstring{field: "\"server_component_types\".\"name\""},
+	CreatedAt:   whereHelpernull_Time{field: "\"server_component_types\".\"created_at\""},
+	UpdatedAt:   whereHelpernull_Time{field: "\"server_component_types\".\"updated_at\""},
+	Slug:        whereHelperstring{field: "\"server_component_types\".\"slug\""},
+	Description: whereHelpernull_String{field: "\"server_component_types\".\"description\""},
+	Deprecated:  whereHelperbool{field: "\"server_component_types\".\"deprecated\""},
 }
 
 // ServerComponentTypeRels is where relationship names are stored.
@@ -106,9 +129,9 @@ func (r *serverComponentTypeR) GetServerComponents() ServerComponentSlice {
 type serverComponentTypeL struct{}
 
 var (
-	serverComponentTypeAllColumns            = []string{"id", "name", "created_at", "updated_at", "slug"}
+	serverComponentTypeAllColumns            = []string{"id", "name", "created_at", "updated_at", "slug", "description", "deprecated"}
 	serverComponentTypeColumnsWithoutDefault = []string{"name", "slug"}
-	serverComponentTypeColumnsWithDefault    = []string{"id", "created_at", "updated_at"}
+	serverComponentTypeColumnsWithDefault    = []string{"id", "created_at", "updated_at", "description", "deprecated"}
 	serverComponentTypePrimaryKeyColumns     = []string{"id"}
 	serverComponentTypeGeneratedColumns      = []string{}
 )
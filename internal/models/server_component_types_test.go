@@ -770,7 +770,7 @@ func testServerComponentTypesSelect(t *testing.T) {
 }
 
 var (
-	serverComponentTypeDBTypes = map[string]string{`ID`: `uuid`, `Name`: `string`, `CreatedAt`: `timestamptz`, `UpdatedAt`: `timestamptz`, `Slug`: `string`}
+	serverComponentTypeDBTypes = map[string]string{`ID`: `uuid`, `Name`: `string`, `CreatedAt`: `timestamptz`, `UpdatedAt`: `timestamptz`, `Slug`: `string`, `Description`: `string`, `Deprecated`: `bool`}
 	_                          = bytes.MinRead
 )
 
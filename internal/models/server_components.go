@@ -19,6 +19,7 @@ import (
 	"github.com/volatiletech/sqlboiler/v4/queries"
 	"github.com/volatiletech/sqlboiler/v4/queries/qm"
 	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/sqlboiler/v4/types"
 	"github.com/volatiletech/strmangle"
 )
 
@@ -33,6 +34,7 @@ type ServerComponent struct {
 	ServerID              string      `boil:"server_id" json:"server_id" toml:"server_id" yaml:"server_id"`
 	CreatedAt             null.Time   `boil:"created_at" json:"created_at,omitempty" toml:"created_at" yaml:"created_at,omitempty"`
 	UpdatedAt             null.Time   `boil:"updated_at" json:"updated_at,omitempty" toml:"updated_at" yaml:"updated_at,omitempty"`
+	Labels                types.JSON  `boil:"labels" json:"labels" toml:"labels" yaml:"labels"`
 
 	R *serverComponentR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L serverComponentL  `boil:"-" json:"-" toml:"-" yaml:"-"`
@@ -48,6 +50,7 @@ var ServerComponentColumns = struct {
 	ServerID              string
 	CreatedAt             string
 	UpdatedAt             string
+	Labels                string
 }{
 	ID:                    "id",
 	Name:                  "name",
@@ -58,6 +61,7 @@ var ServerComponentColumns = struct {
 	ServerID:              "server_id",
 	CreatedAt:             "created_at",
 	UpdatedAt:             "updated_at",
+	Labels:                "labels",
 }
 
 var ServerComponentTableColumns = struct {
@@ -70,6 +74,7 @@ var ServerComponentTableColumns = struct {
 	ServerID              string
 	CreatedAt             string
 	UpdatedAt             string
+	Labels                string
 }{
 	ID:                    "server_components.id",
 	Name:                  "server_components.name",
@@ -80,6 +85,7 @@ var ServerComponentTableColumns = struct {
 	ServerID:              "server_components.server_id",
 	CreatedAt:             "server_components.created_at",
 	UpdatedAt:             "server_components.updated_at",
+	Labels:                "server_components.labels",
 }
 
 // Generated where
@@ -94,6 +100,7 @@ var ServerComponentWhere = struct {
 	ServerID              whereHelperstring
 	CreatedAt             whereHelpernull_Time
 	UpdatedAt             whereHelpernull_Time
+	Labels                whereHelpertypes_JSON
 }{
 	ID:                    whereHelperstring{field: "\"server_components\".\"id\""},
 	Name:                  whereHelpernull_String{field: "\"server_components\".\"name\""},
@@ -104,6 +111,7 @@ var ServerComponentWhere = struct {
 	ServerID:              whereHelperstring{field: "\"server_components\".\"server_id\""},
 	CreatedAt:             whereHelpernull_Time{field: "\"server_components\".\"created_at\""},
 	UpdatedAt:             whereHelpernull_Time{field: "\"server_components\".\"updated_at\""},
+	Labels:                whereHelpertypes_JSON{field: "\"server_components\".\"labels\""},
 }
 
 // ServerComponentRels is where relationship names are stored.
@@ -164,9 +172,9 @@ func (r *serverComponentR) GetVersionedAttributes() VersionedAttributeSlice {
 type serverComponentL struct{}
 
 var (
-	serverComponentAllColumns            = []string{"id", "name", "vendor", "model", "serial", "server_component_type_id", "server_id", "created_at", "updated_at"}
+	serverComponentAllColumns            = []string{"id", "name", "vendor", "model", "serial", "server_component_type_id", "server_id", "created_at", "updated_at", "labels"}
 	serverComponentColumnsWithoutDefault = []string{"server_component_type_id", "server_id"}
-	serverComponentColumnsWithDefault    = []string{"id", "name", "vendor", "model", "serial", "created_at", "updated_at"}
+	serverComponentColumnsWithDefault    = []string{"id", "name", "vendor", "model", "serial", "created_at", "updated_at", "labels"}
 	serverComponentPrimaryKeyColumns     = []string{"id"}
 	serverComponentGeneratedColumns      = []string{}
 )
@@ -1489,7 +1489,7 @@ func testServerComponentsSelect(t *testing.T) {
 }
 
 var (
-	serverComponentDBTypes = map[string]string{`ID`: `uuid`, `Name`: `string`, `Vendor`: `string`, `Model`: `string`, `Serial`: `string`, `ServerComponentTypeID`: `uuid`, `ServerID`: `uuid`, `CreatedAt`: `timestamptz`, `UpdatedAt`: `timestamptz`}
+	serverComponentDBTypes = map[string]string{`ID`: `uuid`, `Name`: `string`, `Vendor`: `string`, `Model`: `string`, `Serial`: `string`, `ServerComponentTypeID`: `uuid`, `ServerID`: `uuid`, `CreatedAt`: `timestamptz`, `UpdatedAt`: `timestamptz`, `Labels`: `jsonb`}
 	_                      = bytes.MinRead
 )
 
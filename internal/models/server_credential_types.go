@@ -14,6 +14,7 @@ import (
 	"time"
 
 	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
 	"github.com/volatiletech/sqlboiler/v4/boil"
 	"github.com/volatiletech/sqlboiler/v4/queries"
 	"github.com/volatiletech/sqlboiler/v4/queries/qm"
@@ -23,95 +24,100 @@ import (
 
 // ServerCredentialType is an object representing the database table.
 type ServerCredentialType struct {
-	ID        string    `boil:"id" json:"id" toml:"id" yaml:"id"`
-	Name      string    `boil:"name" json:"name" toml:"name" yaml:"name"`
-	Slug      string    `boil:"slug" json:"slug" toml:"slug" yaml:"slug"`
-	Builtin   bool      `boil:"builtin" json:"builtin" toml:"builtin" yaml:"builtin"`
-	CreatedAt time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
-	UpdatedAt time.Time `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	ID                   string      `boil:"id" json:"id" toml:"id" yaml:"id"`
+	Name                 string      `boil:"name" json:"name" toml:"name" yaml:"name"`
+	Slug                 string      `boil:"slug" json:"slug" toml:"slug" yaml:"slug"`
+	Builtin              bool        `boil:"builtin" json:"builtin" toml:"builtin" yaml:"builtin"`
+	CreatedAt            time.Time   `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt            time.Time   `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	VersionRetention     int64       `boil:"version_retention" json:"version_retention" toml:"version_retention" yaml:"version_retention"`
+	PasswordPolicy       null.JSON   `boil:"password_policy" json:"password_policy,omitempty" toml:"password_policy" yaml:"password_policy,omitempty"`
+	RequireJustification bool        `boil:"require_justification" json:"require_justification" toml:"require_justification" yaml:"require_justification"`
+	Description          null.String `boil:"description" json:"description,omitempty" toml:"description" yaml:"description,omitempty"`
+	Deprecated           bool        `boil:"deprecated" json:"deprecated" toml:"deprecated" yaml:"deprecated"`
 
 	R *serverCredentialTypeR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L serverCredentialTypeL  `boil:"-" json:"-" toml:"-" yaml:"-"`
 }
 
 var ServerCredentialTypeColumns = struct {
-	ID        string
-	Name      string
-	Slug      string
-	Builtin   string
-	CreatedAt string
-	UpdatedAt string
+	ID                   string
+	Name                 string
+	Slug                 string
+	Builtin              string
+	CreatedAt            string
+	UpdatedAt            string
+	VersionRetention     string
+	PasswordPolicy       string
+	RequireJustification string
+	Description          string
+	Deprecated           string
 }{
-	ID:        "id",
-	Name:      "name",
-	Slug:      "slug",
-	Builtin:   "builtin",
-	CreatedAt: "created_at",
-	UpdatedAt: "updated_at",
+	ID:                   "id",
+	Name:                 "name",
+	Slug:                 "slug",
+	Builtin:              "builtin",
+	CreatedAt:            "created_at",
+	UpdatedAt:            "updated_at",
+	VersionRetention:     "version_retention",
+	PasswordPolicy:       "password_policy",
+	RequireJustification: "require_justification",
+	Description:          "description",
+	Deprecated:           "deprecated",
 }
 
 var ServerCredentialTypeTableColumns = struct {
-	ID        string
-	Name      string
-	Slug      string
-	Builtin   string
-	CreatedAt string
-	UpdatedAt string
+	ID                   string
+	Name                 string
+	Slug                 string
+	Builtin              string
+	CreatedAt            string
+	UpdatedAt            string
+	VersionRetention     string
+	PasswordPolicy       string
+	RequireJustification string
+	Description          string
+	Deprecated           string
 }{
-	ID:        "server_credential_types.id",
-	Name:      "server_credential_types.name",
-	Slug:      "server_credential_types.slug",
-	Builtin:   "server_credential_types.builtin",
-	CreatedAt: "server_credential_types.created_at",
-	UpdatedAt: "server_credential_types.updated_at",
+	ID:                   "server_credential_types.id",
+	Name:                 "server_credential_types.name",
+	Slug:                 "server_credential_types.slug",
+	Builtin:              "server_credential_types.builtin",
+	CreatedAt:            "server_credential_types.created_at",
+	UpdatedAt:            "server_credential_types.updated_at",
+	VersionRetention:     "server_credential_types.version_retention",
+	PasswordPolicy:       "server_credential_types.password_policy",
+	RequireJustification: "server_credential_types.require_justification",
+	Description:          "server_credential_types.description",
+	Deprecated:           "server_credential_types.deprecated",
 }
 
 // Generated where
 
-type whereHelperbool struct{ field string }
-
-func (w whereHelperbool) EQ(x bool) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.EQ, x) }
-func (w whereHelperbool) NEQ(x bool) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
-func (w whereHelperbool) LT(x bool) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.LT, x) }
-func (w whereHelperbool) LTE(x bool) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.LTE, x) }
-func (w whereHelperbool) GT(x bool) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.GT, x) }
-func (w whereHelperbool) GTE(x bool) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GTE, x) }
-
-type whereHelpertime_Time struct{ field string }
-
-func (w whereHelpertime_Time) EQ(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.EQ, x)
-}
-func (w whereHelpertime_Time) NEQ(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.NEQ, x)
-}
-func (w whereHelpertime_Time) LT(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LT, x)
-}
-func (w whereHelpertime_Time) LTE(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LTE, x)
-}
-func (w whereHelpertime_Time) GT(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GT, x)
-}
-func (w whereHelpertime_Time) GTE(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GTE, x)
-}
-
 var ServerCredentialTypeWhere = struct {
-	ID        whereHelperstring
-	Name      whereHelperstring
-	Slug      whereHelperstring
-	Builtin   whereHelperbool
-	CreatedAt whereHelpertime_Time
-	UpdatedAt whereHelpertime_Time
+	ID                   whereHelperstring
+	Name                 whereHelperstring
+	Slug                 whereHelperstring
+	Builtin              whereHelperbool
+	CreatedAt            whereHelpertime_Time
+	UpdatedAt            whereHelpertime_Time
+	VersionRetention     whereHelperint64
+	PasswordPolicy       whereHelpernull_JSON
+	RequireJustification whereHelperbool
+	Description          whereHelpernull_String
+	Deprecated           whereHelperbool
 }{
-	ID:        whereHelperstring{field: "\"server_credential_types\".\"id\""},
-	Name:      whereHelperstring{field: "\"server_credential_types\".\"name\""},
-	Slug:      whereHelperstring{field: "\"server_credential_types\".\"slug\""},
-	Builtin:   whereHelperbool{field: "\"server_credential_types\".\"builtin\""},
-	CreatedAt: whereHelpertime_Time{field: "\"server_credential_types\".\"created_at\""},
-	UpdatedAt: whereHelpertime_Time{field: "\"server_credential_types\".\"updated_at\""},
+	ID:                   whereHelperstring{field: "\"server_credential_types\".\"id\""},
+	Name:                 whereHelperstring{field: "\"server_credential_types\".\"name\""},
+	Slug:                 whereHelperstring{field: "\"server_credential_types\".\"slug\""},
+	Builtin:              whereHelperbool{field: "\"server_credential_types\".\"builtin\""},
+	CreatedAt:            whereHelpertime_Time{field: "\"server_credential_types\".\"created_at\""},
+	UpdatedAt:            whereHelpertime_Time{field: "\"server_credential_types\".\"updated_at\""},
+	VersionRetention:     whereHelperint64{field: "\"server_credential_types\".\"version_retention\""},
+	PasswordPolicy:       whereHelpernull_JSON{field: "\"server_credential_types\".\"password_policy\""},
+	RequireJustification: whereHelperbool{field: "\"server_credential_types\".\"require_justification\""},
+	Description:          whereHelpernull_String{field: "\"server_credential_types\".\"description\""},
+	Deprecated:           whereHelperbool{field: "\"server_credential_types\".\"deprecated\""},
 }
 
 // ServerCredentialTypeRels is where relationship names are stored.
@@ -142,9 +148,9 @@ func (r *serverCredentialTypeR) GetServerCredentials() ServerCredentialSlice {
 type serverCredentialTypeL struct{}
 
 var (
-	serverCredentialTypeAllColumns            = []string{"id", "name", "slug", "builtin", "created_at", "updated_at"}
+	serverCredentialTypeAllColumns            = []string{"id", "name", "slug", "builtin", "created_at", "updated_at", "version_retention", "password_policy", "require_justification", "description", "deprecated"}
 	serverCredentialTypeColumnsWithoutDefault = []string{"name", "slug", "created_at", "updated_at"}
-	serverCredentialTypeColumnsWithDefault    = []string{"id", "builtin"}
+	serverCredentialTypeColumnsWithDefault    = []string{"id", "builtin", "version_retention", "password_policy", "require_justification", "description", "deprecated"}
 	serverCredentialTypePrimaryKeyColumns     = []string{"id"}
 	serverCredentialTypeGeneratedColumns      = []string{}
 )
@@ -0,0 +1,1104 @@
+// Code generated by SQLBoiler 4.11.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// ServerCredentialVersion is an object representing the database table.
+type ServerCredentialVersion struct {
+	ID                 string    `boil:"id" json:"id" toml:"id" yaml:"id"`
+	ServerCredentialID string    `boil:"server_credential_id" json:"server_credential_id" toml:"server_credential_id" yaml:"server_credential_id"`
+	Version            int64     `boil:"version" json:"version" toml:"version" yaml:"version"`
+	Username           string    `boil:"username" json:"username" toml:"username" yaml:"username"`
+	Password           string    `boil:"password" json:"password" toml:"password" yaml:"password"`
+	CreatedAt          time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+
+	R *serverCredentialVersionR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L serverCredentialVersionL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var ServerCredentialVersionColumns = struct {
+	ID                 string
+	ServerCredentialID string
+	Version            string
+	Username           string
+	Password           string
+	CreatedAt          string
+}{
+	ID:                 "id",
+	ServerCredentialID: "server_credential_id",
+	Version:            "version",
+	Username:           "username",
+	Password:           "password",
+	CreatedAt:          "created_at",
+}
+
+var ServerCredentialVersionTableColumns = struct {
+	ID                 string
+	ServerCredentialID string
+	Version            string
+	Username           string
+	Password           string
+	CreatedAt          string
+}{
+	ID:                 "server_credential_versions.id",
+	ServerCredentialID: "server_credential_versions.server_credential_id",
+	Version:            "server_credential_versions.version",
+	Username:           "server_credential_versions.username",
+	Password:           "server_credential_versions.password",
+	CreatedAt:          "server_credential_versions.created_at",
+}
+
+// Generated where
+
+var ServerCredentialVersionWhere = struct {
+	ID                 whereHelperstring
+	ServerCredentialID whereHelperstring
+	Version            whereHelperint64
+	Username           whereHelperstring
+	Password           whereHelperstring
+	CreatedAt          whereHelpertime_Time
+}{
+	ID:                 whereHelperstring{field: "\"server_credential_versions\".\"id\""},
+	ServerCredentialID: whereHelperstring{field: "\"server_credential_versions\".\"server_credential_id\""},
+	Version:            whereHelperint64{field: "\"server_credential_versions\".\"version\""},
+	Username:           whereHelperstring{field: "\"server_credential_versions\".\"username\""},
+	Password:           whereHelperstring{field: "\"server_credential_versions\".\"password\""},
+	CreatedAt:          whereHelpertime_Time{field: "\"server_credential_versions\".\"created_at\""},
+}
+
+// ServerCredentialVersionRels is where relationship names are stored.
+var ServerCredentialVersionRels = struct {
+	ServerCredential string
+}{
+	ServerCredential: "ServerCredential",
+}
+
+// serverCredentialVersionR is where relationships are stored.
+type serverCredentialVersionR struct {
+	ServerCredential *ServerCredential `boil:"ServerCredential" json:"ServerCredential" toml:"ServerCredential" yaml:"ServerCredential"`
+}
+
+// NewStruct creates a new relationship struct
+func (*serverCredentialVersionR) NewStruct() *serverCredentialVersionR {
+	return &serverCredentialVersionR{}
+}
+
+func (r *serverCredentialVersionR) GetServerCredential() *ServerCredential {
+	if r == nil {
+		return nil
+	}
+	return r.ServerCredential
+}
+
+// serverCredentialVersionL is where Load methods for each relationship are stored.
+type serverCredentialVersionL struct{}
+
+var (
+	serverCredentialVersionAllColumns            = []string{"id", "server_credential_id", "version", "username", "password", "created_at"}
+	serverCredentialVersionColumnsWithoutDefault = []string{"server_credential_id", "version", "username", "password"}
+	serverCredentialVersionColumnsWithDefault    = []string{"id", "created_at"}
+	serverCredentialVersionPrimaryKeyColumns     = []string{"id"}
+	serverCredentialVersionGeneratedColumns      = []string{}
+)
+
+type (
+	// ServerCredentialVersionSlice is an alias for a slice of pointers to ServerCredentialVersion.
+	// This should almost always be used instead of []ServerCredentialVersion.
+	ServerCredentialVersionSlice []*ServerCredentialVersion
+	// ServerCredentialVersionHook is the signature for custom ServerCredentialVersion hook methods
+	ServerCredentialVersionHook func(context.Context, boil.ContextExecutor, *ServerCredentialVersion) error
+
+	serverCredentialVersionQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	serverCredentialVersionType                 = reflect.TypeOf(&ServerCredentialVersion{})
+	serverCredentialVersionMapping              = queries.MakeStructMapping(serverCredentialVersionType)
+	serverCredentialVersionPrimaryKeyMapping, _ = queries.BindMapping(serverCredentialVersionType, serverCredentialVersionMapping, serverCredentialVersionPrimaryKeyColumns)
+	serverCredentialVersionInsertCacheMut       sync.RWMutex
+	serverCredentialVersionInsertCache          = make(map[string]insertCache)
+	serverCredentialVersionUpdateCacheMut       sync.RWMutex
+	serverCredentialVersionUpdateCache          = make(map[string]updateCache)
+	serverCredentialVersionUpsertCacheMut       sync.RWMutex
+	serverCredentialVersionUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var serverCredentialVersionAfterSelectHooks []ServerCredentialVersionHook
+
+var serverCredentialVersionBeforeInsertHooks []ServerCredentialVersionHook
+var serverCredentialVersionAfterInsertHooks []ServerCredentialVersionHook
+
+var serverCredentialVersionBeforeUpdateHooks []ServerCredentialVersionHook
+var serverCredentialVersionAfterUpdateHooks []ServerCredentialVersionHook
+
+var serverCredentialVersionBeforeDeleteHooks []ServerCredentialVersionHook
+var serverCredentialVersionAfterDeleteHooks []ServerCredentialVersionHook
+
+var serverCredentialVersionBeforeUpsertHooks []ServerCredentialVersionHook
+var serverCredentialVersionAfterUpsertHooks []ServerCredentialVersionHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *ServerCredentialVersion) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range serverCredentialVersionAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *ServerCredentialVersion) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range serverCredentialVersionBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *ServerCredentialVersion) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range serverCredentialVersionAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *ServerCredentialVersion) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range serverCredentialVersionBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *ServerCredentialVersion) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range serverCredentialVersionAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *ServerCredentialVersion) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range serverCredentialVersionBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *ServerCredentialVersion) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range serverCredentialVersionAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *ServerCredentialVersion) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range serverCredentialVersionBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *ServerCredentialVersion) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range serverCredentialVersionAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddServerCredentialVersionHook registers your hook function for all future operations.
+func AddServerCredentialVersionHook(hookPoint boil.HookPoint, serverCredentialVersionHook ServerCredentialVersionHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		serverCredentialVersionAfterSelectHooks = append(serverCredentialVersionAfterSelectHooks, serverCredentialVersionHook)
+	case boil.BeforeInsertHook:
+		serverCredentialVersionBeforeInsertHooks = append(serverCredentialVersionBeforeInsertHooks, serverCredentialVersionHook)
+	case boil.AfterInsertHook:
+		serverCredentialVersionAfterInsertHooks = append(serverCredentialVersionAfterInsertHooks, serverCredentialVersionHook)
+	case boil.BeforeUpdateHook:
+		serverCredentialVersionBeforeUpdateHooks = append(serverCredentialVersionBeforeUpdateHooks, serverCredentialVersionHook)
+	case boil.AfterUpdateHook:
+		serverCredentialVersionAfterUpdateHooks = append(serverCredentialVersionAfterUpdateHooks, serverCredentialVersionHook)
+	case boil.BeforeDeleteHook:
+		serverCredentialVersionBeforeDeleteHooks = append(serverCredentialVersionBeforeDeleteHooks, serverCredentialVersionHook)
+	case boil.AfterDeleteHook:
+		serverCredentialVersionAfterDeleteHooks = append(serverCredentialVersionAfterDeleteHooks, serverCredentialVersionHook)
+	case boil.BeforeUpsertHook:
+		serverCredentialVersionBeforeUpsertHooks = append(serverCredentialVersionBeforeUpsertHooks, serverCredentialVersionHook)
+	case boil.AfterUpsertHook:
+		serverCredentialVersionAfterUpsertHooks = append(serverCredentialVersionAfterUpsertHooks, serverCredentialVersionHook)
+	}
+}
+
+// One returns a single serverCredentialVersion record from the query.
+func (q serverCredentialVersionQuery) One(ctx context.Context, exec boil.ContextExecutor) (*ServerCredentialVersion, error) {
+	o := &ServerCredentialVersion{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for server_credential_versions")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all ServerCredentialVersion records from the query.
+func (q serverCredentialVersionQuery) All(ctx context.Context, exec boil.ContextExecutor) (ServerCredentialVersionSlice, error) {
+	var o []*ServerCredentialVersion
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to ServerCredentialVersion slice")
+	}
+
+	if len(serverCredentialVersionAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all ServerCredentialVersion records in the query.
+func (q serverCredentialVersionQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count server_credential_versions rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q serverCredentialVersionQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if server_credential_versions exists")
+	}
+
+	return count > 0, nil
+}
+
+// ServerCredential pointed to by the foreign key.
+func (o *ServerCredentialVersion) ServerCredential(mods ...qm.QueryMod) serverCredentialQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.ServerCredentialID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return ServerCredentials(queryMods...)
+}
+
+// LoadServerCredential allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (serverCredentialVersionL) LoadServerCredential(ctx context.Context, e boil.ContextExecutor, singular bool, maybeServerCredentialVersion interface{}, mods queries.Applicator) error {
+	var slice []*ServerCredentialVersion
+	var object *ServerCredentialVersion
+
+	if singular {
+		object = maybeServerCredentialVersion.(*ServerCredentialVersion)
+	} else {
+		slice = *maybeServerCredentialVersion.(*[]*ServerCredentialVersion)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &serverCredentialVersionR{}
+		}
+		args = append(args, object.ServerCredentialID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &serverCredentialVersionR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ServerCredentialID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ServerCredentialID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`server_credentials`),
+		qm.WhereIn(`server_credentials.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load ServerCredential")
+	}
+
+	var resultSlice []*ServerCredential
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice ServerCredential")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for server_credentials")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for server_credentials")
+	}
+
+	if len(serverCredentialVersionAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.ServerCredential = foreign
+		if foreign.R == nil {
+			foreign.R = &serverCredentialR{}
+		}
+		foreign.R.ServerCredentialVersions = append(foreign.R.ServerCredentialVersions, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ServerCredentialID == foreign.ID {
+				local.R.ServerCredential = foreign
+				if foreign.R == nil {
+					foreign.R = &serverCredentialR{}
+				}
+				foreign.R.ServerCredentialVersions = append(foreign.R.ServerCredentialVersions, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetServerCredential of the serverCredentialVersion to the related item.
+// Sets o.R.ServerCredential to related.
+// Adds o to related.R.ServerCredentialVersions.
+func (o *ServerCredentialVersion) SetServerCredential(ctx context.Context, exec boil.ContextExecutor, insert bool, related *ServerCredential) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"server_credential_versions\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"server_credential_id"}),
+		strmangle.WhereClause("\"", "\"", 2, serverCredentialVersionPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.ServerCredentialID = related.ID
+	if o.R == nil {
+		o.R = &serverCredentialVersionR{
+			ServerCredential: related,
+		}
+	} else {
+		o.R.ServerCredential = related
+	}
+
+	if related.R == nil {
+		related.R = &serverCredentialR{
+			ServerCredentialVersions: ServerCredentialVersionSlice{o},
+		}
+	} else {
+		related.R.ServerCredentialVersions = append(related.R.ServerCredentialVersions, o)
+	}
+
+	return nil
+}
+
+// ServerCredentialVersions retrieves all the records using an executor.
+func ServerCredentialVersions(mods ...qm.QueryMod) serverCredentialVersionQuery {
+	mods = append(mods, qm.From("\"server_credential_versions\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"server_credential_versions\".*"})
+	}
+
+	return serverCredentialVersionQuery{q}
+}
+
+// FindServerCredentialVersion retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindServerCredentialVersion(ctx context.Context, exec boil.ContextExecutor, iD string, selectCols ...string) (*ServerCredentialVersion, error) {
+	serverCredentialVersionObj := &ServerCredentialVersion{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"server_credential_versions\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, serverCredentialVersionObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from server_credential_versions")
+	}
+
+	if err = serverCredentialVersionObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return serverCredentialVersionObj, err
+	}
+
+	return serverCredentialVersionObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *ServerCredentialVersion) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no server_credential_versions provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(serverCredentialVersionColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	serverCredentialVersionInsertCacheMut.RLock()
+	cache, cached := serverCredentialVersionInsertCache[key]
+	serverCredentialVersionInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			serverCredentialVersionAllColumns,
+			serverCredentialVersionColumnsWithDefault,
+			serverCredentialVersionColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(serverCredentialVersionType, serverCredentialVersionMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(serverCredentialVersionType, serverCredentialVersionMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"server_credential_versions\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"server_credential_versions\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into server_credential_versions")
+	}
+
+	if !cached {
+		serverCredentialVersionInsertCacheMut.Lock()
+		serverCredentialVersionInsertCache[key] = cache
+		serverCredentialVersionInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the ServerCredentialVersion.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *ServerCredentialVersion) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	serverCredentialVersionUpdateCacheMut.RLock()
+	cache, cached := serverCredentialVersionUpdateCache[key]
+	serverCredentialVersionUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			serverCredentialVersionAllColumns,
+			serverCredentialVersionPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update server_credential_versions, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"server_credential_versions\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, serverCredentialVersionPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(serverCredentialVersionType, serverCredentialVersionMapping, append(wl, serverCredentialVersionPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update server_credential_versions row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for server_credential_versions")
+	}
+
+	if !cached {
+		serverCredentialVersionUpdateCacheMut.Lock()
+		serverCredentialVersionUpdateCache[key] = cache
+		serverCredentialVersionUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q serverCredentialVersionQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for server_credential_versions")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for server_credential_versions")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o ServerCredentialVersionSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), serverCredentialVersionPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"server_credential_versions\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, serverCredentialVersionPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in serverCredentialVersion slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all serverCredentialVersion")
+	}
+	return rowsAff, nil
+}
+
+// Delete deletes a single ServerCredentialVersion record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *ServerCredentialVersion) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no ServerCredentialVersion provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), serverCredentialVersionPrimaryKeyMapping)
+	sql := "DELETE FROM \"server_credential_versions\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from server_credential_versions")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for server_credential_versions")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q serverCredentialVersionQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no serverCredentialVersionQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from server_credential_versions")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for server_credential_versions")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o ServerCredentialVersionSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(serverCredentialVersionBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), serverCredentialVersionPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"server_credential_versions\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, serverCredentialVersionPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from serverCredentialVersion slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for server_credential_versions")
+	}
+
+	if len(serverCredentialVersionAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *ServerCredentialVersion) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindServerCredentialVersion(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *ServerCredentialVersionSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := ServerCredentialVersionSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), serverCredentialVersionPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"server_credential_versions\".* FROM \"server_credential_versions\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, serverCredentialVersionPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in ServerCredentialVersionSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// ServerCredentialVersionExists checks if the ServerCredentialVersion row exists.
+func ServerCredentialVersionExists(ctx context.Context, exec boil.ContextExecutor, iD string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"server_credential_versions\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if server_credential_versions exists")
+	}
+
+	return exists, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *ServerCredentialVersion) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no server_credential_versions provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(serverCredentialVersionColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	serverCredentialVersionUpsertCacheMut.RLock()
+	cache, cached := serverCredentialVersionUpsertCache[key]
+	serverCredentialVersionUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			serverCredentialVersionAllColumns,
+			serverCredentialVersionColumnsWithDefault,
+			serverCredentialVersionColumnsWithoutDefault,
+			nzDefaults,
+		)
+		update := updateColumns.UpdateColumnSet(
+			serverCredentialVersionAllColumns,
+			serverCredentialVersionPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert server_credential_versions, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(serverCredentialVersionPrimaryKeyColumns))
+			copy(conflict, serverCredentialVersionPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryCockroachDB(dialect, "\"server_credential_versions\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(serverCredentialVersionType, serverCredentialVersionMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(serverCredentialVersionType, serverCredentialVersionMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.DebugMode {
+		_, _ = fmt.Fprintln(boil.DebugWriter, cache.query)
+		_, _ = fmt.Fprintln(boil.DebugWriter, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if err == sql.ErrNoRows {
+			err = nil // CockcorachDB doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert server_credential_versions")
+	}
+
+	if !cached {
+		serverCredentialVersionUpsertCacheMut.Lock()
+		serverCredentialVersionUpsertCache[key] = cache
+		serverCredentialVersionUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
@@ -0,0 +1,841 @@
+// Code generated by SQLBoiler 4.11.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/volatiletech/randomize"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/strmangle"
+)
+
+func testServerCredentialVersionsUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(serverCredentialVersionAllColumns) == len(serverCredentialVersionPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := ServerCredentialVersion{}
+	if err = randomize.Struct(seed, &o, serverCredentialVersionDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize ServerCredentialVersion struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert ServerCredentialVersion: %s", err)
+	}
+
+	count, err := ServerCredentialVersions().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, serverCredentialVersionDBTypes, false, serverCredentialVersionPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize ServerCredentialVersion struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert ServerCredentialVersion: %s", err)
+	}
+
+	count, err = ServerCredentialVersions().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testServerCredentialVersions(t *testing.T) {
+	t.Parallel()
+
+	query := ServerCredentialVersions()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testServerCredentialVersionsDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ServerCredentialVersion{}
+	if err = randomize.Struct(seed, o, serverCredentialVersionDBTypes, true, serverCredentialVersionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ServerCredentialVersion struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := ServerCredentialVersions().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testServerCredentialVersionsQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ServerCredentialVersion{}
+	if err = randomize.Struct(seed, o, serverCredentialVersionDBTypes, true, serverCredentialVersionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ServerCredentialVersion struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := ServerCredentialVersions().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := ServerCredentialVersions().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testServerCredentialVersionsSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ServerCredentialVersion{}
+	if err = randomize.Struct(seed, o, serverCredentialVersionDBTypes, true, serverCredentialVersionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ServerCredentialVersion struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := ServerCredentialVersionSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := ServerCredentialVersions().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testServerCredentialVersionsExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ServerCredentialVersion{}
+	if err = randomize.Struct(seed, o, serverCredentialVersionDBTypes, true, serverCredentialVersionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ServerCredentialVersion struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := ServerCredentialVersionExists(ctx, tx, o.ID)
+	if err != nil {
+		t.Errorf("Unable to check if ServerCredentialVersion exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected ServerCredentialVersionExists to return true, but got false.")
+	}
+}
+
+func testServerCredentialVersionsFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ServerCredentialVersion{}
+	if err = randomize.Struct(seed, o, serverCredentialVersionDBTypes, true, serverCredentialVersionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ServerCredentialVersion struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	serverCredentialVersionFound, err := FindServerCredentialVersion(ctx, tx, o.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if serverCredentialVersionFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testServerCredentialVersionsBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ServerCredentialVersion{}
+	if err = randomize.Struct(seed, o, serverCredentialVersionDBTypes, true, serverCredentialVersionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ServerCredentialVersion struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = ServerCredentialVersions().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testServerCredentialVersionsOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ServerCredentialVersion{}
+	if err = randomize.Struct(seed, o, serverCredentialVersionDBTypes, true, serverCredentialVersionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ServerCredentialVersion struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := ServerCredentialVersions().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testServerCredentialVersionsAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	serverCredentialVersionOne := &ServerCredentialVersion{}
+	serverCredentialVersionTwo := &ServerCredentialVersion{}
+	if err = randomize.Struct(seed, serverCredentialVersionOne, serverCredentialVersionDBTypes, false, serverCredentialVersionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ServerCredentialVersion struct: %s", err)
+	}
+	if err = randomize.Struct(seed, serverCredentialVersionTwo, serverCredentialVersionDBTypes, false, serverCredentialVersionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ServerCredentialVersion struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = serverCredentialVersionOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = serverCredentialVersionTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := ServerCredentialVersions().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testServerCredentialVersionsCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	serverCredentialVersionOne := &ServerCredentialVersion{}
+	serverCredentialVersionTwo := &ServerCredentialVersion{}
+	if err = randomize.Struct(seed, serverCredentialVersionOne, serverCredentialVersionDBTypes, false, serverCredentialVersionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ServerCredentialVersion struct: %s", err)
+	}
+	if err = randomize.Struct(seed, serverCredentialVersionTwo, serverCredentialVersionDBTypes, false, serverCredentialVersionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ServerCredentialVersion struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = serverCredentialVersionOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = serverCredentialVersionTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := ServerCredentialVersions().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func serverCredentialVersionBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *ServerCredentialVersion) error {
+	*o = ServerCredentialVersion{}
+	return nil
+}
+
+func serverCredentialVersionAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *ServerCredentialVersion) error {
+	*o = ServerCredentialVersion{}
+	return nil
+}
+
+func serverCredentialVersionAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *ServerCredentialVersion) error {
+	*o = ServerCredentialVersion{}
+	return nil
+}
+
+func serverCredentialVersionBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *ServerCredentialVersion) error {
+	*o = ServerCredentialVersion{}
+	return nil
+}
+
+func serverCredentialVersionAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *ServerCredentialVersion) error {
+	*o = ServerCredentialVersion{}
+	return nil
+}
+
+func serverCredentialVersionBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *ServerCredentialVersion) error {
+	*o = ServerCredentialVersion{}
+	return nil
+}
+
+func serverCredentialVersionAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *ServerCredentialVersion) error {
+	*o = ServerCredentialVersion{}
+	return nil
+}
+
+func serverCredentialVersionBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *ServerCredentialVersion) error {
+	*o = ServerCredentialVersion{}
+	return nil
+}
+
+func serverCredentialVersionAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *ServerCredentialVersion) error {
+	*o = ServerCredentialVersion{}
+	return nil
+}
+
+func testServerCredentialVersionsHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &ServerCredentialVersion{}
+	o := &ServerCredentialVersion{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, serverCredentialVersionDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize ServerCredentialVersion object: %s", err)
+	}
+
+	AddServerCredentialVersionHook(boil.BeforeInsertHook, serverCredentialVersionBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	serverCredentialVersionBeforeInsertHooks = []ServerCredentialVersionHook{}
+
+	AddServerCredentialVersionHook(boil.AfterInsertHook, serverCredentialVersionAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	serverCredentialVersionAfterInsertHooks = []ServerCredentialVersionHook{}
+
+	AddServerCredentialVersionHook(boil.AfterSelectHook, serverCredentialVersionAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	serverCredentialVersionAfterSelectHooks = []ServerCredentialVersionHook{}
+
+	AddServerCredentialVersionHook(boil.BeforeUpdateHook, serverCredentialVersionBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	serverCredentialVersionBeforeUpdateHooks = []ServerCredentialVersionHook{}
+
+	AddServerCredentialVersionHook(boil.AfterUpdateHook, serverCredentialVersionAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	serverCredentialVersionAfterUpdateHooks = []ServerCredentialVersionHook{}
+
+	AddServerCredentialVersionHook(boil.BeforeDeleteHook, serverCredentialVersionBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	serverCredentialVersionBeforeDeleteHooks = []ServerCredentialVersionHook{}
+
+	AddServerCredentialVersionHook(boil.AfterDeleteHook, serverCredentialVersionAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	serverCredentialVersionAfterDeleteHooks = []ServerCredentialVersionHook{}
+
+	AddServerCredentialVersionHook(boil.BeforeUpsertHook, serverCredentialVersionBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	serverCredentialVersionBeforeUpsertHooks = []ServerCredentialVersionHook{}
+
+	AddServerCredentialVersionHook(boil.AfterUpsertHook, serverCredentialVersionAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	serverCredentialVersionAfterUpsertHooks = []ServerCredentialVersionHook{}
+}
+
+func testServerCredentialVersionsInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ServerCredentialVersion{}
+	if err = randomize.Struct(seed, o, serverCredentialVersionDBTypes, true, serverCredentialVersionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ServerCredentialVersion struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := ServerCredentialVersions().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testServerCredentialVersionsInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ServerCredentialVersion{}
+	if err = randomize.Struct(seed, o, serverCredentialVersionDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize ServerCredentialVersion struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(serverCredentialVersionColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := ServerCredentialVersions().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testServerCredentialVersionToOneServerCredentialUsingServerCredential(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local ServerCredentialVersion
+	var foreign ServerCredential
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, serverCredentialVersionDBTypes, false, serverCredentialVersionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ServerCredentialVersion struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, serverCredentialDBTypes, false, serverCredentialColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ServerCredential struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.ServerCredentialID = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.ServerCredential().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := ServerCredentialVersionSlice{&local}
+	if err = local.L.LoadServerCredential(ctx, tx, false, (*[]*ServerCredentialVersion)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.ServerCredential == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.ServerCredential = nil
+	if err = local.L.LoadServerCredential(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.ServerCredential == nil {
+		t.Error("struct should have been eager loaded")
+	}
+}
+
+func testServerCredentialVersionToOneSetOpServerCredentialUsingServerCredential(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a ServerCredentialVersion
+	var b, c ServerCredential
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, serverCredentialVersionDBTypes, false, strmangle.SetComplement(serverCredentialVersionPrimaryKeyColumns, serverCredentialVersionColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, serverCredentialDBTypes, false, strmangle.SetComplement(serverCredentialPrimaryKeyColumns, serverCredentialColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, serverCredentialDBTypes, false, strmangle.SetComplement(serverCredentialPrimaryKeyColumns, serverCredentialColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*ServerCredential{&b, &c} {
+		err = a.SetServerCredential(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.ServerCredential != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.ServerCredentialVersions[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.ServerCredentialID != x.ID {
+			t.Error("foreign key was wrong value", a.ServerCredentialID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.ServerCredentialID))
+		reflect.Indirect(reflect.ValueOf(&a.ServerCredentialID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if a.ServerCredentialID != x.ID {
+			t.Error("foreign key was wrong value", a.ServerCredentialID, x.ID)
+		}
+	}
+}
+
+func testServerCredentialVersionsReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ServerCredentialVersion{}
+	if err = randomize.Struct(seed, o, serverCredentialVersionDBTypes, true, serverCredentialVersionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ServerCredentialVersion struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testServerCredentialVersionsReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ServerCredentialVersion{}
+	if err = randomize.Struct(seed, o, serverCredentialVersionDBTypes, true, serverCredentialVersionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ServerCredentialVersion struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := ServerCredentialVersionSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testServerCredentialVersionsSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ServerCredentialVersion{}
+	if err = randomize.Struct(seed, o, serverCredentialVersionDBTypes, true, serverCredentialVersionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ServerCredentialVersion struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := ServerCredentialVersions().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	serverCredentialVersionDBTypes = map[string]string{`ID`: `uuid`, `ServerCredentialID`: `uuid`, `Version`: `int8`, `Username`: `string`, `Password`: `string`, `CreatedAt`: `timestamptz`}
+	_                              = bytes.MinRead
+)
+
+func testServerCredentialVersionsUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(serverCredentialVersionPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(serverCredentialVersionAllColumns) == len(serverCredentialVersionPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ServerCredentialVersion{}
+	if err = randomize.Struct(seed, o, serverCredentialVersionDBTypes, true, serverCredentialVersionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ServerCredentialVersion struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := ServerCredentialVersions().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, serverCredentialVersionDBTypes, true, serverCredentialVersionPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize ServerCredentialVersion struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testServerCredentialVersionsSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(serverCredentialVersionAllColumns) == len(serverCredentialVersionPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ServerCredentialVersion{}
+	if err = randomize.Struct(seed, o, serverCredentialVersionDBTypes, true, serverCredentialVersionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ServerCredentialVersion struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := ServerCredentialVersions().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, serverCredentialVersionDBTypes, true, serverCredentialVersionPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize ServerCredentialVersion struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(serverCredentialVersionAllColumns, serverCredentialVersionPrimaryKeyColumns) {
+		fields = serverCredentialVersionAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			serverCredentialVersionAllColumns,
+			serverCredentialVersionPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := ServerCredentialVersionSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
@@ -542,6 +542,159 @@ func testServerCredentialsInsertWhitelist(t *testing.T) {
 	}
 }
 
+func testServerCredentialToManyServerCredentialVersions(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a ServerCredential
+	var b, c ServerCredentialVersion
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, serverCredentialDBTypes, true, serverCredentialColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ServerCredential struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, serverCredentialVersionDBTypes, false, serverCredentialVersionColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, serverCredentialVersionDBTypes, false, serverCredentialVersionColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	b.ServerCredentialID = a.ID
+	c.ServerCredentialID = a.ID
+
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.ServerCredentialVersions().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if v.ServerCredentialID == b.ServerCredentialID {
+			bFound = true
+		}
+		if v.ServerCredentialID == c.ServerCredentialID {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := ServerCredentialSlice{&a}
+	if err = a.L.LoadServerCredentialVersions(ctx, tx, false, (*[]*ServerCredential)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.ServerCredentialVersions); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.ServerCredentialVersions = nil
+	if err = a.L.LoadServerCredentialVersions(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.ServerCredentialVersions); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testServerCredentialToManyAddOpServerCredentialVersions(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a ServerCredential
+	var b, c, d, e ServerCredentialVersion
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, serverCredentialDBTypes, false, strmangle.SetComplement(serverCredentialPrimaryKeyColumns, serverCredentialColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*ServerCredentialVersion{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, serverCredentialVersionDBTypes, false, strmangle.SetComplement(serverCredentialVersionPrimaryKeyColumns, serverCredentialVersionColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*ServerCredentialVersion{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddServerCredentialVersions(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if a.ID != first.ServerCredentialID {
+			t.Error("foreign key was wrong value", a.ID, first.ServerCredentialID)
+		}
+		if a.ID != second.ServerCredentialID {
+			t.Error("foreign key was wrong value", a.ID, second.ServerCredentialID)
+		}
+
+		if first.R.ServerCredential != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.ServerCredential != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.ServerCredentialVersions[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.ServerCredentialVersions[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.ServerCredentialVersions().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
 func testServerCredentialToOneServerCredentialTypeUsingServerCredentialType(t *testing.T) {
 	ctx := context.Background()
 	tx := MustTx(boil.BeginTx(ctx, nil))
@@ -833,7 +986,7 @@ func testServerCredentialsSelect(t *testing.T) {
 }
 
 var (
-	serverCredentialDBTypes = map[string]string{`ID`: `uuid`, `ServerID`: `uuid`, `ServerCredentialTypeID`: `uuid`, `Password`: `string`, `CreatedAt`: `timestamptz`, `UpdatedAt`: `timestamptz`, `Username`: `string`}
+	serverCredentialDBTypes = map[string]string{`ID`: `uuid`, `ServerID`: `uuid`, `ServerCredentialTypeID`: `uuid`, `Password`: `string`, `CreatedAt`: `timestamptz`, `UpdatedAt`: `timestamptz`, `Username`: `string`, `Version`: `int8`}
 	_                       = bytes.MinRead
 )
 
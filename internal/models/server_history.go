@@ -0,0 +1,925 @@
+// Code generated by SQLBoiler 4.11.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// ServerHistory is an object representing the database table.
+type ServerHistory struct {
+	ID         string    `boil:"id" json:"id" toml:"id" yaml:"id"`
+	Seq        int64     `boil:"seq" json:"seq" toml:"seq" yaml:"seq"`
+	ServerID   string    `boil:"server_id" json:"server_id" toml:"server_id" yaml:"server_id"`
+	Entity     string    `boil:"entity" json:"entity" toml:"entity" yaml:"entity"`
+	EntityID   string    `boil:"entity_id" json:"entity_id" toml:"entity_id" yaml:"entity_id"`
+	Snapshot   null.JSON `boil:"snapshot" json:"snapshot,omitempty" toml:"snapshot" yaml:"snapshot,omitempty"`
+	RecordedAt time.Time `boil:"recorded_at" json:"recorded_at" toml:"recorded_at" yaml:"recorded_at"`
+
+	R *serverHistoryR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L serverHistoryL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var ServerHistoryColumns = struct {
+	ID         string
+	Seq        string
+	ServerID   string
+	Entity     string
+	EntityID   string
+	Snapshot   string
+	RecordedAt string
+}{
+	ID:         "id",
+	Seq:        "seq",
+	ServerID:   "server_id",
+	Entity:     "entity",
+	EntityID:   "entity_id",
+	Snapshot:   "snapshot",
+	RecordedAt: "recorded_at",
+}
+
+var ServerHistoryTableColumns = struct {
+	ID         string
+	Seq        string
+	ServerID   string
+	Entity     string
+	EntityID   string
+	Snapshot   string
+	RecordedAt string
+}{
+	ID:         "server_history.id",
+	Seq:        "server_history.seq",
+	ServerID:   "server_history.server_id",
+	Entity:     "server_history.entity",
+	EntityID:   "server_history.entity_id",
+	Snapshot:   "server_history.snapshot",
+	RecordedAt: "server_history.recorded_at",
+}
+
+// Generated where
+
+var ServerHistoryWhere = struct {
+	ID         whereHelperstring
+	Seq        whereHelperint64
+	ServerID   whereHelperstring
+	Entity     whereHelperstring
+	EntityID   whereHelperstring
+	Snapshot   whereHelpernull_JSON
+	RecordedAt whereHelpertime_Time
+}{
+	ID:         whereHelperstring{field: "\"server_history\".\"id\""},
+	Seq:        whereHelperint64{field: "\"server_history\".\"seq\""},
+	ServerID:   whereHelperstring{field: "\"server_history\".\"server_id\""},
+	Entity:     whereHelperstring{field: "\"server_history\".\"entity\""},
+	EntityID:   whereHelperstring{field: "\"server_history\".\"entity_id\""},
+	Snapshot:   whereHelpernull_JSON{field: "\"server_history\".\"snapshot\""},
+	RecordedAt: whereHelpertime_Time{field: "\"server_history\".\"recorded_at\""},
+}
+
+// ServerHistoryRels is where relationship names are stored.
+var ServerHistoryRels = struct {
+}{}
+
+// serverHistoryR is where relationships are stored.
+type serverHistoryR struct {
+}
+
+// NewStruct creates a new relationship struct
+func (*serverHistoryR) NewStruct() *serverHistoryR {
+	return &serverHistoryR{}
+}
+
+// serverHistoryL is where Load methods for each relationship are stored.
+type serverHistoryL struct{}
+
+var (
+	serverHistoryAllColumns            = []string{"id", "seq", "server_id", "entity", "entity_id", "snapshot", "recorded_at"}
+	serverHistoryColumnsWithoutDefault = []string{"server_id", "entity", "entity_id"}
+	serverHistoryColumnsWithDefault    = []string{"id", "seq", "snapshot", "recorded_at"}
+	serverHistoryPrimaryKeyColumns     = []string{"id"}
+	serverHistoryGeneratedColumns      = []string{}
+)
+
+type (
+	// ServerHistorySlice is an alias for a slice of pointers to ServerHistory.
+	// This should almost always be used instead of []ServerHistory.
+	ServerHistorySlice []*ServerHistory
+	// ServerHistoryHook is the signature for custom ServerHistory hook methods
+	ServerHistoryHook func(context.Context, boil.ContextExecutor, *ServerHistory) error
+
+	serverHistoryQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	serverHistoryType                 = reflect.TypeOf(&ServerHistory{})
+	serverHistoryMapping              = queries.MakeStructMapping(serverHistoryType)
+	serverHistoryPrimaryKeyMapping, _ = queries.BindMapping(serverHistoryType, serverHistoryMapping, serverHistoryPrimaryKeyColumns)
+	serverHistoryInsertCacheMut       sync.RWMutex
+	serverHistoryInsertCache          = make(map[string]insertCache)
+	serverHistoryUpdateCacheMut       sync.RWMutex
+	serverHistoryUpdateCache          = make(map[string]updateCache)
+	serverHistoryUpsertCacheMut       sync.RWMutex
+	serverHistoryUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var serverHistoryAfterSelectHooks []ServerHistoryHook
+
+var serverHistoryBeforeInsertHooks []ServerHistoryHook
+var serverHistoryAfterInsertHooks []ServerHistoryHook
+
+var serverHistoryBeforeUpdateHooks []ServerHistoryHook
+var serverHistoryAfterUpdateHooks []ServerHistoryHook
+
+var serverHistoryBeforeDeleteHooks []ServerHistoryHook
+var serverHistoryAfterDeleteHooks []ServerHistoryHook
+
+var serverHistoryBeforeUpsertHooks []ServerHistoryHook
+var serverHistoryAfterUpsertHooks []ServerHistoryHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *ServerHistory) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range serverHistoryAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *ServerHistory) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range serverHistoryBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *ServerHistory) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range serverHistoryAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *ServerHistory) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range serverHistoryBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *ServerHistory) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range serverHistoryAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *ServerHistory) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range serverHistoryBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *ServerHistory) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range serverHistoryAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *ServerHistory) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range serverHistoryBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *ServerHistory) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range serverHistoryAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddServerHistoryHook registers your hook function for all future operations.
+func AddServerHistoryHook(hookPoint boil.HookPoint, serverHistoryHook ServerHistoryHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		serverHistoryAfterSelectHooks = append(serverHistoryAfterSelectHooks, serverHistoryHook)
+	case boil.BeforeInsertHook:
+		serverHistoryBeforeInsertHooks = append(serverHistoryBeforeInsertHooks, serverHistoryHook)
+	case boil.AfterInsertHook:
+		serverHistoryAfterInsertHooks = append(serverHistoryAfterInsertHooks, serverHistoryHook)
+	case boil.BeforeUpdateHook:
+		serverHistoryBeforeUpdateHooks = append(serverHistoryBeforeUpdateHooks, serverHistoryHook)
+	case boil.AfterUpdateHook:
+		serverHistoryAfterUpdateHooks = append(serverHistoryAfterUpdateHooks, serverHistoryHook)
+	case boil.BeforeDeleteHook:
+		serverHistoryBeforeDeleteHooks = append(serverHistoryBeforeDeleteHooks, serverHistoryHook)
+	case boil.AfterDeleteHook:
+		serverHistoryAfterDeleteHooks = append(serverHistoryAfterDeleteHooks, serverHistoryHook)
+	case boil.BeforeUpsertHook:
+		serverHistoryBeforeUpsertHooks = append(serverHistoryBeforeUpsertHooks, serverHistoryHook)
+	case boil.AfterUpsertHook:
+		serverHistoryAfterUpsertHooks = append(serverHistoryAfterUpsertHooks, serverHistoryHook)
+	}
+}
+
+// One returns a single serverHistory record from the query.
+func (q serverHistoryQuery) One(ctx context.Context, exec boil.ContextExecutor) (*ServerHistory, error) {
+	o := &ServerHistory{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for server_history")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all ServerHistory records from the query.
+func (q serverHistoryQuery) All(ctx context.Context, exec boil.ContextExecutor) (ServerHistorySlice, error) {
+	var o []*ServerHistory
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to ServerHistory slice")
+	}
+
+	if len(serverHistoryAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all ServerHistory records in the query.
+func (q serverHistoryQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count server_history rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q serverHistoryQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if server_history exists")
+	}
+
+	return count > 0, nil
+}
+
+// ServerHistories retrieves all the records using an executor.
+func ServerHistories(mods ...qm.QueryMod) serverHistoryQuery {
+	mods = append(mods, qm.From("\"server_history\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"server_history\".*"})
+	}
+
+	return serverHistoryQuery{q}
+}
+
+// FindServerHistory retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindServerHistory(ctx context.Context, exec boil.ContextExecutor, iD string, selectCols ...string) (*ServerHistory, error) {
+	serverHistoryObj := &ServerHistory{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"server_history\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, serverHistoryObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from server_history")
+	}
+
+	if err = serverHistoryObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return serverHistoryObj, err
+	}
+
+	return serverHistoryObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *ServerHistory) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no server_history provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(serverHistoryColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	serverHistoryInsertCacheMut.RLock()
+	cache, cached := serverHistoryInsertCache[key]
+	serverHistoryInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			serverHistoryAllColumns,
+			serverHistoryColumnsWithDefault,
+			serverHistoryColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(serverHistoryType, serverHistoryMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(serverHistoryType, serverHistoryMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"server_history\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"server_history\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into server_history")
+	}
+
+	if !cached {
+		serverHistoryInsertCacheMut.Lock()
+		serverHistoryInsertCache[key] = cache
+		serverHistoryInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the ServerHistory.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *ServerHistory) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	serverHistoryUpdateCacheMut.RLock()
+	cache, cached := serverHistoryUpdateCache[key]
+	serverHistoryUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			serverHistoryAllColumns,
+			serverHistoryPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update server_history, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"server_history\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, serverHistoryPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(serverHistoryType, serverHistoryMapping, append(wl, serverHistoryPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update server_history row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for server_history")
+	}
+
+	if !cached {
+		serverHistoryUpdateCacheMut.Lock()
+		serverHistoryUpdateCache[key] = cache
+		serverHistoryUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q serverHistoryQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for server_history")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for server_history")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o ServerHistorySlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), serverHistoryPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"server_history\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, serverHistoryPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in serverHistory slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all serverHistory")
+	}
+	return rowsAff, nil
+}
+
+// Delete deletes a single ServerHistory record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *ServerHistory) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no ServerHistory provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), serverHistoryPrimaryKeyMapping)
+	sql := "DELETE FROM \"server_history\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from server_history")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for server_history")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q serverHistoryQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no serverHistoryQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from server_history")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for server_history")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o ServerHistorySlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(serverHistoryBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), serverHistoryPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"server_history\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, serverHistoryPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from serverHistory slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for server_history")
+	}
+
+	if len(serverHistoryAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *ServerHistory) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindServerHistory(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *ServerHistorySlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := ServerHistorySlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), serverHistoryPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"server_history\".* FROM \"server_history\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, serverHistoryPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in ServerHistorySlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// ServerHistoryExists checks if the ServerHistory row exists.
+func ServerHistoryExists(ctx context.Context, exec boil.ContextExecutor, iD string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"server_history\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if server_history exists")
+	}
+
+	return exists, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *ServerHistory) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no server_history provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(serverHistoryColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	serverHistoryUpsertCacheMut.RLock()
+	cache, cached := serverHistoryUpsertCache[key]
+	serverHistoryUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			serverHistoryAllColumns,
+			serverHistoryColumnsWithDefault,
+			serverHistoryColumnsWithoutDefault,
+			nzDefaults,
+		)
+		update := updateColumns.UpdateColumnSet(
+			serverHistoryAllColumns,
+			serverHistoryPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert server_history, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(serverHistoryPrimaryKeyColumns))
+			copy(conflict, serverHistoryPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryCockroachDB(dialect, "\"server_history\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(serverHistoryType, serverHistoryMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(serverHistoryType, serverHistoryMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.DebugMode {
+		_, _ = fmt.Fprintln(boil.DebugWriter, cache.query)
+		_, _ = fmt.Fprintln(boil.DebugWriter, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if err == sql.ErrNoRows {
+			err = nil // CockcorachDB doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert server_history")
+	}
+
+	if !cached {
+		serverHistoryUpsertCacheMut.Lock()
+		serverHistoryUpsertCache[key] = cache
+		serverHistoryUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
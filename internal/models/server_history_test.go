@@ -0,0 +1,732 @@
+// Code generated by SQLBoiler 4.11.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/volatiletech/randomize"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/strmangle"
+)
+
+func testServerHistoriesUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(serverHistoryAllColumns) == len(serverHistoryPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := ServerHistory{}
+	if err = randomize.Struct(seed, &o, serverHistoryDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize ServerHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert ServerHistory: %s", err)
+	}
+
+	count, err := ServerHistories().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, serverHistoryDBTypes, false, serverHistoryPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize ServerHistory struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert ServerHistory: %s", err)
+	}
+
+	count, err = ServerHistories().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testServerHistories(t *testing.T) {
+	t.Parallel()
+
+	query := ServerHistories()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testServerHistoriesDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ServerHistory{}
+	if err = randomize.Struct(seed, o, serverHistoryDBTypes, true, serverHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ServerHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := ServerHistories().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testServerHistoriesQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ServerHistory{}
+	if err = randomize.Struct(seed, o, serverHistoryDBTypes, true, serverHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ServerHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := ServerHistories().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := ServerHistories().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testServerHistoriesSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ServerHistory{}
+	if err = randomize.Struct(seed, o, serverHistoryDBTypes, true, serverHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ServerHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := ServerHistorySlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := ServerHistories().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testServerHistoriesExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ServerHistory{}
+	if err = randomize.Struct(seed, o, serverHistoryDBTypes, true, serverHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ServerHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := ServerHistoryExists(ctx, tx, o.ID)
+	if err != nil {
+		t.Errorf("Unable to check if ServerHistory exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected ServerHistoryExists to return true, but got false.")
+	}
+}
+
+func testServerHistoriesFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ServerHistory{}
+	if err = randomize.Struct(seed, o, serverHistoryDBTypes, true, serverHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ServerHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	serverHistoryFound, err := FindServerHistory(ctx, tx, o.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if serverHistoryFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testServerHistoriesBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ServerHistory{}
+	if err = randomize.Struct(seed, o, serverHistoryDBTypes, true, serverHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ServerHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = ServerHistories().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testServerHistoriesOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ServerHistory{}
+	if err = randomize.Struct(seed, o, serverHistoryDBTypes, true, serverHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ServerHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := ServerHistories().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testServerHistoriesAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	serverHistoryOne := &ServerHistory{}
+	serverHistoryTwo := &ServerHistory{}
+	if err = randomize.Struct(seed, serverHistoryOne, serverHistoryDBTypes, false, serverHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ServerHistory struct: %s", err)
+	}
+	if err = randomize.Struct(seed, serverHistoryTwo, serverHistoryDBTypes, false, serverHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ServerHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = serverHistoryOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = serverHistoryTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := ServerHistories().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testServerHistoriesCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	serverHistoryOne := &ServerHistory{}
+	serverHistoryTwo := &ServerHistory{}
+	if err = randomize.Struct(seed, serverHistoryOne, serverHistoryDBTypes, false, serverHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ServerHistory struct: %s", err)
+	}
+	if err = randomize.Struct(seed, serverHistoryTwo, serverHistoryDBTypes, false, serverHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ServerHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = serverHistoryOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = serverHistoryTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := ServerHistories().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func serverHistoryBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *ServerHistory) error {
+	*o = ServerHistory{}
+	return nil
+}
+
+func serverHistoryAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *ServerHistory) error {
+	*o = ServerHistory{}
+	return nil
+}
+
+func serverHistoryAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *ServerHistory) error {
+	*o = ServerHistory{}
+	return nil
+}
+
+func serverHistoryBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *ServerHistory) error {
+	*o = ServerHistory{}
+	return nil
+}
+
+func serverHistoryAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *ServerHistory) error {
+	*o = ServerHistory{}
+	return nil
+}
+
+func serverHistoryBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *ServerHistory) error {
+	*o = ServerHistory{}
+	return nil
+}
+
+func serverHistoryAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *ServerHistory) error {
+	*o = ServerHistory{}
+	return nil
+}
+
+func serverHistoryBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *ServerHistory) error {
+	*o = ServerHistory{}
+	return nil
+}
+
+func serverHistoryAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *ServerHistory) error {
+	*o = ServerHistory{}
+	return nil
+}
+
+func testServerHistoriesHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &ServerHistory{}
+	o := &ServerHistory{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, serverHistoryDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize ServerHistory object: %s", err)
+	}
+
+	AddServerHistoryHook(boil.BeforeInsertHook, serverHistoryBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	serverHistoryBeforeInsertHooks = []ServerHistoryHook{}
+
+	AddServerHistoryHook(boil.AfterInsertHook, serverHistoryAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	serverHistoryAfterInsertHooks = []ServerHistoryHook{}
+
+	AddServerHistoryHook(boil.AfterSelectHook, serverHistoryAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	serverHistoryAfterSelectHooks = []ServerHistoryHook{}
+
+	AddServerHistoryHook(boil.BeforeUpdateHook, serverHistoryBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	serverHistoryBeforeUpdateHooks = []ServerHistoryHook{}
+
+	AddServerHistoryHook(boil.AfterUpdateHook, serverHistoryAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	serverHistoryAfterUpdateHooks = []ServerHistoryHook{}
+
+	AddServerHistoryHook(boil.BeforeDeleteHook, serverHistoryBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	serverHistoryBeforeDeleteHooks = []ServerHistoryHook{}
+
+	AddServerHistoryHook(boil.AfterDeleteHook, serverHistoryAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	serverHistoryAfterDeleteHooks = []ServerHistoryHook{}
+
+	AddServerHistoryHook(boil.BeforeUpsertHook, serverHistoryBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	serverHistoryBeforeUpsertHooks = []ServerHistoryHook{}
+
+	AddServerHistoryHook(boil.AfterUpsertHook, serverHistoryAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	serverHistoryAfterUpsertHooks = []ServerHistoryHook{}
+}
+
+func testServerHistoriesInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ServerHistory{}
+	if err = randomize.Struct(seed, o, serverHistoryDBTypes, true, serverHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ServerHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := ServerHistories().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testServerHistoriesInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ServerHistory{}
+	if err = randomize.Struct(seed, o, serverHistoryDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize ServerHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(serverHistoryColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := ServerHistories().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testServerHistoriesReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ServerHistory{}
+	if err = randomize.Struct(seed, o, serverHistoryDBTypes, true, serverHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ServerHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testServerHistoriesReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ServerHistory{}
+	if err = randomize.Struct(seed, o, serverHistoryDBTypes, true, serverHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ServerHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := ServerHistorySlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testServerHistoriesSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ServerHistory{}
+	if err = randomize.Struct(seed, o, serverHistoryDBTypes, true, serverHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ServerHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := ServerHistories().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	serverHistoryDBTypes = map[string]string{`ID`: `uuid`, `Seq`: `int8`, `ServerID`: `uuid`, `Entity`: `string`, `EntityID`: `uuid`, `Snapshot`: `jsonb`, `RecordedAt`: `timestamptz`}
+	_                    = bytes.MinRead
+)
+
+func testServerHistoriesUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(serverHistoryPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(serverHistoryAllColumns) == len(serverHistoryPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ServerHistory{}
+	if err = randomize.Struct(seed, o, serverHistoryDBTypes, true, serverHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ServerHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := ServerHistories().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, serverHistoryDBTypes, true, serverHistoryPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize ServerHistory struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testServerHistoriesSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(serverHistoryAllColumns) == len(serverHistoryPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ServerHistory{}
+	if err = randomize.Struct(seed, o, serverHistoryDBTypes, true, serverHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ServerHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := ServerHistories().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, serverHistoryDBTypes, true, serverHistoryPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize ServerHistory struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(serverHistoryAllColumns, serverHistoryPrimaryKeyColumns) {
+		fields = serverHistoryAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			serverHistoryAllColumns,
+			serverHistoryPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := ServerHistorySlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
@@ -0,0 +1,1116 @@
+// Code generated by SQLBoiler 4.11.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// ServerLease is an object representing the database table.
+type ServerLease struct {
+	ID        string    `boil:"id" json:"id" toml:"id" yaml:"id"`
+	ServerID  string    `boil:"server_id" json:"server_id" toml:"server_id" yaml:"server_id"`
+	Holder    string    `boil:"holder" json:"holder" toml:"holder" yaml:"holder"`
+	ExpiresAt time.Time `boil:"expires_at" json:"expires_at" toml:"expires_at" yaml:"expires_at"`
+	CreatedAt null.Time `boil:"created_at" json:"created_at,omitempty" toml:"created_at" yaml:"created_at,omitempty"`
+	UpdatedAt null.Time `boil:"updated_at" json:"updated_at,omitempty" toml:"updated_at" yaml:"updated_at,omitempty"`
+
+	R *serverLeaseR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L serverLeaseL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var ServerLeaseColumns = struct {
+	ID        string
+	ServerID  string
+	Holder    string
+	ExpiresAt string
+	CreatedAt string
+	UpdatedAt string
+}{
+	ID:        "id",
+	ServerID:  "server_id",
+	Holder:    "holder",
+	ExpiresAt: "expires_at",
+	CreatedAt: "created_at",
+	UpdatedAt: "updated_at",
+}
+
+var ServerLeaseTableColumns = struct {
+	ID        string
+	ServerID  string
+	Holder    string
+	ExpiresAt string
+	CreatedAt string
+	UpdatedAt string
+}{
+	ID:        "server_leases.id",
+	ServerID:  "server_leases.server_id",
+	Holder:    "server_leases.holder",
+	ExpiresAt: "server_leases.expires_at",
+	CreatedAt: "server_leases.created_at",
+	UpdatedAt: "server_leases.updated_at",
+}
+
+// Generated where
+
+var ServerLeaseWhere = struct {
+	ID        whereHelperstring
+	ServerID  whereHelperstring
+	Holder    whereHelperstring
+	ExpiresAt whereHelpertime_Time
+	CreatedAt whereHelpernull_Time
+	UpdatedAt whereHelpernull_Time
+}{
+	ID:        whereHelperstring{field: "\"server_leases\".\"id\""},
+	ServerID:  whereHelperstring{field: "\"server_leases\".\"server_id\""},
+	Holder:    whereHelperstring{field: "\"server_leases\".\"holder\""},
+	ExpiresAt: whereHelpertime_Time{field: "\"server_leases\".\"expires_at\""},
+	CreatedAt: whereHelpernull_Time{field: "\"server_leases\".\"created_at\""},
+	UpdatedAt: whereHelpernull_Time{field: "\"server_leases\".\"updated_at\""},
+}
+
+// ServerLeaseRels is where relationship names are stored.
+var ServerLeaseRels = struct {
+	Server string
+}{
+	Server: "Server",
+}
+
+// serverLeaseR is where relationships are stored.
+type serverLeaseR struct {
+	Server *Server `boil:"Server" json:"Server" toml:"Server" yaml:"Server"`
+}
+
+// NewStruct creates a new relationship struct
+func (*serverLeaseR) NewStruct() *serverLeaseR {
+	return &serverLeaseR{}
+}
+
+func (r *serverLeaseR) GetServer() *Server {
+	if r == nil {
+		return nil
+	}
+	return r.Server
+}
+
+// serverLeaseL is where Load methods for each relationship are stored.
+type serverLeaseL struct{}
+
+var (
+	serverLeaseAllColumns            = []string{"id", "server_id", "holder", "expires_at", "created_at", "updated_at"}
+	serverLeaseColumnsWithoutDefault = []string{"server_id", "holder", "expires_at"}
+	serverLeaseColumnsWithDefault    = []string{"id", "created_at", "updated_at"}
+	serverLeasePrimaryKeyColumns     = []string{"id"}
+	serverLeaseGeneratedColumns      = []string{}
+)
+
+type (
+	// ServerLeaseSlice is an alias for a slice of pointers to ServerLease.
+	// This should almost always be used instead of []ServerLease.
+	ServerLeaseSlice []*ServerLease
+	// ServerLeaseHook is the signature for custom ServerLease hook methods
+	ServerLeaseHook func(context.Context, boil.ContextExecutor, *ServerLease) error
+
+	serverLeaseQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	serverLeaseType                 = reflect.TypeOf(&ServerLease{})
+	serverLeaseMapping              = queries.MakeStructMapping(serverLeaseType)
+	serverLeasePrimaryKeyMapping, _ = queries.BindMapping(serverLeaseType, serverLeaseMapping, serverLeasePrimaryKeyColumns)
+	serverLeaseInsertCacheMut       sync.RWMutex
+	serverLeaseInsertCache          = make(map[string]insertCache)
+	serverLeaseUpdateCacheMut       sync.RWMutex
+	serverLeaseUpdateCache          = make(map[string]updateCache)
+	serverLeaseUpsertCacheMut       sync.RWMutex
+	serverLeaseUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var serverLeaseAfterSelectHooks []ServerLeaseHook
+
+var serverLeaseBeforeInsertHooks []ServerLeaseHook
+var serverLeaseAfterInsertHooks []ServerLeaseHook
+
+var serverLeaseBeforeUpdateHooks []ServerLeaseHook
+var serverLeaseAfterUpdateHooks []ServerLeaseHook
+
+var serverLeaseBeforeDeleteHooks []ServerLeaseHook
+var serverLeaseAfterDeleteHooks []ServerLeaseHook
+
+var serverLeaseBeforeUpsertHooks []ServerLeaseHook
+var serverLeaseAfterUpsertHooks []ServerLeaseHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *ServerLease) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range serverLeaseAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *ServerLease) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range serverLeaseBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *ServerLease) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range serverLeaseAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *ServerLease) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range serverLeaseBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *ServerLease) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range serverLeaseAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *ServerLease) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range serverLeaseBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *ServerLease) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range serverLeaseAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *ServerLease) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range serverLeaseBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *ServerLease) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range serverLeaseAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddServerLeaseHook registers your hook function for all future operations.
+func AddServerLeaseHook(hookPoint boil.HookPoint, serverLeaseHook ServerLeaseHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		serverLeaseAfterSelectHooks = append(serverLeaseAfterSelectHooks, serverLeaseHook)
+	case boil.BeforeInsertHook:
+		serverLeaseBeforeInsertHooks = append(serverLeaseBeforeInsertHooks, serverLeaseHook)
+	case boil.AfterInsertHook:
+		serverLeaseAfterInsertHooks = append(serverLeaseAfterInsertHooks, serverLeaseHook)
+	case boil.BeforeUpdateHook:
+		serverLeaseBeforeUpdateHooks = append(serverLeaseBeforeUpdateHooks, serverLeaseHook)
+	case boil.AfterUpdateHook:
+		serverLeaseAfterUpdateHooks = append(serverLeaseAfterUpdateHooks, serverLeaseHook)
+	case boil.BeforeDeleteHook:
+		serverLeaseBeforeDeleteHooks = append(serverLeaseBeforeDeleteHooks, serverLeaseHook)
+	case boil.AfterDeleteHook:
+		serverLeaseAfterDeleteHooks = append(serverLeaseAfterDeleteHooks, serverLeaseHook)
+	case boil.BeforeUpsertHook:
+		serverLeaseBeforeUpsertHooks = append(serverLeaseBeforeUpsertHooks, serverLeaseHook)
+	case boil.AfterUpsertHook:
+		serverLeaseAfterUpsertHooks = append(serverLeaseAfterUpsertHooks, serverLeaseHook)
+	}
+}
+
+// One returns a single serverLease record from the query.
+func (q serverLeaseQuery) One(ctx context.Context, exec boil.ContextExecutor) (*ServerLease, error) {
+	o := &ServerLease{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for server_leases")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all ServerLease records from the query.
+func (q serverLeaseQuery) All(ctx context.Context, exec boil.ContextExecutor) (ServerLeaseSlice, error) {
+	var o []*ServerLease
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to ServerLease slice")
+	}
+
+	if len(serverLeaseAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all ServerLease records in the query.
+func (q serverLeaseQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count server_leases rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q serverLeaseQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if server_leases exists")
+	}
+
+	return count > 0, nil
+}
+
+// Server pointed to by the foreign key.
+func (o *ServerLease) Server(mods ...qm.QueryMod) serverQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.ServerID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Servers(queryMods...)
+}
+
+// LoadServer allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (serverLeaseL) LoadServer(ctx context.Context, e boil.ContextExecutor, singular bool, maybeServerLease interface{}, mods queries.Applicator) error {
+	var slice []*ServerLease
+	var object *ServerLease
+
+	if singular {
+		object = maybeServerLease.(*ServerLease)
+	} else {
+		slice = *maybeServerLease.(*[]*ServerLease)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &serverLeaseR{}
+		}
+		args = append(args, object.ServerID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &serverLeaseR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ServerID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ServerID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`servers`),
+		qm.WhereIn(`servers.id in ?`, args...),
+		qmhelper.WhereIsNull(`servers.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Server")
+	}
+
+	var resultSlice []*Server
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Server")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for servers")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for servers")
+	}
+
+	if len(serverLeaseAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Server = foreign
+		if foreign.R == nil {
+			foreign.R = &serverR{}
+		}
+		foreign.R.ServerLease = object
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ServerID == foreign.ID {
+				local.R.Server = foreign
+				if foreign.R == nil {
+					foreign.R = &serverR{}
+				}
+				foreign.R.ServerLease = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetServer of the serverLease to the related item.
+// Sets o.R.Server to related.
+// Adds o to related.R.ServerLease.
+func (o *ServerLease) SetServer(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Server) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"server_leases\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"server_id"}),
+		strmangle.WhereClause("\"", "\"", 2, serverLeasePrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.ServerID = related.ID
+	if o.R == nil {
+		o.R = &serverLeaseR{
+			Server: related,
+		}
+	} else {
+		o.R.Server = related
+	}
+
+	if related.R == nil {
+		related.R = &serverR{
+			ServerLease: o,
+		}
+	} else {
+		related.R.ServerLease = o
+	}
+
+	return nil
+}
+
+// ServerLeases retrieves all the records using an executor.
+func ServerLeases(mods ...qm.QueryMod) serverLeaseQuery {
+	mods = append(mods, qm.From("\"server_leases\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"server_leases\".*"})
+	}
+
+	return serverLeaseQuery{q}
+}
+
+// FindServerLease retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindServerLease(ctx context.Context, exec boil.ContextExecutor, iD string, selectCols ...string) (*ServerLease, error) {
+	serverLeaseObj := &ServerLease{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"server_leases\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, serverLeaseObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from server_leases")
+	}
+
+	if err = serverLeaseObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return serverLeaseObj, err
+	}
+
+	return serverLeaseObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *ServerLease) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no server_leases provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if queries.MustTime(o.CreatedAt).IsZero() {
+			queries.SetScanner(&o.CreatedAt, currTime)
+		}
+		if queries.MustTime(o.UpdatedAt).IsZero() {
+			queries.SetScanner(&o.UpdatedAt, currTime)
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(serverLeaseColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	serverLeaseInsertCacheMut.RLock()
+	cache, cached := serverLeaseInsertCache[key]
+	serverLeaseInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			serverLeaseAllColumns,
+			serverLeaseColumnsWithDefault,
+			serverLeaseColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(serverLeaseType, serverLeaseMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(serverLeaseType, serverLeaseMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"server_leases\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"server_leases\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into server_leases")
+	}
+
+	if !cached {
+		serverLeaseInsertCacheMut.Lock()
+		serverLeaseInsertCache[key] = cache
+		serverLeaseInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the ServerLease.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *ServerLease) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		queries.SetScanner(&o.UpdatedAt, currTime)
+	}
+
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	serverLeaseUpdateCacheMut.RLock()
+	cache, cached := serverLeaseUpdateCache[key]
+	serverLeaseUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			serverLeaseAllColumns,
+			serverLeasePrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update server_leases, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"server_leases\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, serverLeasePrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(serverLeaseType, serverLeaseMapping, append(wl, serverLeasePrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update server_leases row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for server_leases")
+	}
+
+	if !cached {
+		serverLeaseUpdateCacheMut.Lock()
+		serverLeaseUpdateCache[key] = cache
+		serverLeaseUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q serverLeaseQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for server_leases")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for server_leases")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o ServerLeaseSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), serverLeasePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"server_leases\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, serverLeasePrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in serverLease slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all serverLease")
+	}
+	return rowsAff, nil
+}
+
+// Delete deletes a single ServerLease record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *ServerLease) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no ServerLease provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), serverLeasePrimaryKeyMapping)
+	sql := "DELETE FROM \"server_leases\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from server_leases")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for server_leases")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q serverLeaseQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no serverLeaseQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from server_leases")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for server_leases")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o ServerLeaseSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(serverLeaseBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), serverLeasePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"server_leases\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, serverLeasePrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from serverLease slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for server_leases")
+	}
+
+	if len(serverLeaseAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *ServerLease) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindServerLease(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *ServerLeaseSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := ServerLeaseSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), serverLeasePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"server_leases\".* FROM \"server_leases\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, serverLeasePrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in ServerLeaseSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// ServerLeaseExists checks if the ServerLease row exists.
+func ServerLeaseExists(ctx context.Context, exec boil.ContextExecutor, iD string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"server_leases\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if server_leases exists")
+	}
+
+	return exists, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *ServerLease) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no server_leases provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if queries.MustTime(o.CreatedAt).IsZero() {
+			queries.SetScanner(&o.CreatedAt, currTime)
+		}
+		queries.SetScanner(&o.UpdatedAt, currTime)
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(serverLeaseColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	serverLeaseUpsertCacheMut.RLock()
+	cache, cached := serverLeaseUpsertCache[key]
+	serverLeaseUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			serverLeaseAllColumns,
+			serverLeaseColumnsWithDefault,
+			serverLeaseColumnsWithoutDefault,
+			nzDefaults,
+		)
+		update := updateColumns.UpdateColumnSet(
+			serverLeaseAllColumns,
+			serverLeasePrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert server_leases, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(serverLeasePrimaryKeyColumns))
+			copy(conflict, serverLeasePrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryCockroachDB(dialect, "\"server_leases\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(serverLeaseType, serverLeaseMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(serverLeaseType, serverLeaseMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.DebugMode {
+		_, _ = fmt.Fprintln(boil.DebugWriter, cache.query)
+		_, _ = fmt.Fprintln(boil.DebugWriter, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if err == sql.ErrNoRows {
+			err = nil // CockcorachDB doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert server_leases")
+	}
+
+	if !cached {
+		serverLeaseUpsertCacheMut.Lock()
+		serverLeaseUpsertCache[key] = cache
+		serverLeaseUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
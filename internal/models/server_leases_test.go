@@ -0,0 +1,841 @@
+// Code generated by SQLBoiler 4.11.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/volatiletech/randomize"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/strmangle"
+)
+
+func testServerLeasesUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(serverLeaseAllColumns) == len(serverLeasePrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := ServerLease{}
+	if err = randomize.Struct(seed, &o, serverLeaseDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize ServerLease struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert ServerLease: %s", err)
+	}
+
+	count, err := ServerLeases().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, serverLeaseDBTypes, false, serverLeasePrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize ServerLease struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert ServerLease: %s", err)
+	}
+
+	count, err = ServerLeases().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testServerLeases(t *testing.T) {
+	t.Parallel()
+
+	query := ServerLeases()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testServerLeasesDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ServerLease{}
+	if err = randomize.Struct(seed, o, serverLeaseDBTypes, true, serverLeaseColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ServerLease struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := ServerLeases().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testServerLeasesQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ServerLease{}
+	if err = randomize.Struct(seed, o, serverLeaseDBTypes, true, serverLeaseColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ServerLease struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := ServerLeases().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := ServerLeases().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testServerLeasesSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ServerLease{}
+	if err = randomize.Struct(seed, o, serverLeaseDBTypes, true, serverLeaseColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ServerLease struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := ServerLeaseSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := ServerLeases().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testServerLeasesExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ServerLease{}
+	if err = randomize.Struct(seed, o, serverLeaseDBTypes, true, serverLeaseColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ServerLease struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := ServerLeaseExists(ctx, tx, o.ID)
+	if err != nil {
+		t.Errorf("Unable to check if ServerLease exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected ServerLeaseExists to return true, but got false.")
+	}
+}
+
+func testServerLeasesFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ServerLease{}
+	if err = randomize.Struct(seed, o, serverLeaseDBTypes, true, serverLeaseColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ServerLease struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	serverLeaseFound, err := FindServerLease(ctx, tx, o.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if serverLeaseFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testServerLeasesBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ServerLease{}
+	if err = randomize.Struct(seed, o, serverLeaseDBTypes, true, serverLeaseColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ServerLease struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = ServerLeases().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testServerLeasesOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ServerLease{}
+	if err = randomize.Struct(seed, o, serverLeaseDBTypes, true, serverLeaseColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ServerLease struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := ServerLeases().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testServerLeasesAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	serverLeaseOne := &ServerLease{}
+	serverLeaseTwo := &ServerLease{}
+	if err = randomize.Struct(seed, serverLeaseOne, serverLeaseDBTypes, false, serverLeaseColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ServerLease struct: %s", err)
+	}
+	if err = randomize.Struct(seed, serverLeaseTwo, serverLeaseDBTypes, false, serverLeaseColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ServerLease struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = serverLeaseOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = serverLeaseTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := ServerLeases().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testServerLeasesCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	serverLeaseOne := &ServerLease{}
+	serverLeaseTwo := &ServerLease{}
+	if err = randomize.Struct(seed, serverLeaseOne, serverLeaseDBTypes, false, serverLeaseColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ServerLease struct: %s", err)
+	}
+	if err = randomize.Struct(seed, serverLeaseTwo, serverLeaseDBTypes, false, serverLeaseColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ServerLease struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = serverLeaseOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = serverLeaseTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := ServerLeases().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func serverLeaseBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *ServerLease) error {
+	*o = ServerLease{}
+	return nil
+}
+
+func serverLeaseAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *ServerLease) error {
+	*o = ServerLease{}
+	return nil
+}
+
+func serverLeaseAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *ServerLease) error {
+	*o = ServerLease{}
+	return nil
+}
+
+func serverLeaseBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *ServerLease) error {
+	*o = ServerLease{}
+	return nil
+}
+
+func serverLeaseAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *ServerLease) error {
+	*o = ServerLease{}
+	return nil
+}
+
+func serverLeaseBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *ServerLease) error {
+	*o = ServerLease{}
+	return nil
+}
+
+func serverLeaseAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *ServerLease) error {
+	*o = ServerLease{}
+	return nil
+}
+
+func serverLeaseBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *ServerLease) error {
+	*o = ServerLease{}
+	return nil
+}
+
+func serverLeaseAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *ServerLease) error {
+	*o = ServerLease{}
+	return nil
+}
+
+func testServerLeasesHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &ServerLease{}
+	o := &ServerLease{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, serverLeaseDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize ServerLease object: %s", err)
+	}
+
+	AddServerLeaseHook(boil.BeforeInsertHook, serverLeaseBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	serverLeaseBeforeInsertHooks = []ServerLeaseHook{}
+
+	AddServerLeaseHook(boil.AfterInsertHook, serverLeaseAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	serverLeaseAfterInsertHooks = []ServerLeaseHook{}
+
+	AddServerLeaseHook(boil.AfterSelectHook, serverLeaseAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	serverLeaseAfterSelectHooks = []ServerLeaseHook{}
+
+	AddServerLeaseHook(boil.BeforeUpdateHook, serverLeaseBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	serverLeaseBeforeUpdateHooks = []ServerLeaseHook{}
+
+	AddServerLeaseHook(boil.AfterUpdateHook, serverLeaseAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	serverLeaseAfterUpdateHooks = []ServerLeaseHook{}
+
+	AddServerLeaseHook(boil.BeforeDeleteHook, serverLeaseBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	serverLeaseBeforeDeleteHooks = []ServerLeaseHook{}
+
+	AddServerLeaseHook(boil.AfterDeleteHook, serverLeaseAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	serverLeaseAfterDeleteHooks = []ServerLeaseHook{}
+
+	AddServerLeaseHook(boil.BeforeUpsertHook, serverLeaseBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	serverLeaseBeforeUpsertHooks = []ServerLeaseHook{}
+
+	AddServerLeaseHook(boil.AfterUpsertHook, serverLeaseAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	serverLeaseAfterUpsertHooks = []ServerLeaseHook{}
+}
+
+func testServerLeasesInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ServerLease{}
+	if err = randomize.Struct(seed, o, serverLeaseDBTypes, true, serverLeaseColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ServerLease struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := ServerLeases().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testServerLeasesInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ServerLease{}
+	if err = randomize.Struct(seed, o, serverLeaseDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize ServerLease struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(serverLeaseColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := ServerLeases().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testServerLeaseToOneServerUsingServer(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local ServerLease
+	var foreign Server
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, serverLeaseDBTypes, false, serverLeaseColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ServerLease struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, serverDBTypes, false, serverColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Server struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.ServerID = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.Server().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := ServerLeaseSlice{&local}
+	if err = local.L.LoadServer(ctx, tx, false, (*[]*ServerLease)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Server == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.Server = nil
+	if err = local.L.LoadServer(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Server == nil {
+		t.Error("struct should have been eager loaded")
+	}
+}
+
+func testServerLeaseToOneSetOpServerUsingServer(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a ServerLease
+	var b, c Server
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, serverLeaseDBTypes, false, strmangle.SetComplement(serverLeasePrimaryKeyColumns, serverLeaseColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, serverDBTypes, false, strmangle.SetComplement(serverPrimaryKeyColumns, serverColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, serverDBTypes, false, strmangle.SetComplement(serverPrimaryKeyColumns, serverColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*Server{&b, &c} {
+		err = a.SetServer(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.Server != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.ServerLease != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.ServerID != x.ID {
+			t.Error("foreign key was wrong value", a.ServerID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.ServerID))
+		reflect.Indirect(reflect.ValueOf(&a.ServerID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if a.ServerID != x.ID {
+			t.Error("foreign key was wrong value", a.ServerID, x.ID)
+		}
+	}
+}
+
+func testServerLeasesReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ServerLease{}
+	if err = randomize.Struct(seed, o, serverLeaseDBTypes, true, serverLeaseColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ServerLease struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testServerLeasesReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ServerLease{}
+	if err = randomize.Struct(seed, o, serverLeaseDBTypes, true, serverLeaseColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ServerLease struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := ServerLeaseSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testServerLeasesSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ServerLease{}
+	if err = randomize.Struct(seed, o, serverLeaseDBTypes, true, serverLeaseColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ServerLease struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := ServerLeases().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	serverLeaseDBTypes = map[string]string{`ID`: `uuid`, `ServerID`: `uuid`, `Holder`: `string`, `ExpiresAt`: `timestamptz`, `CreatedAt`: `timestamptz`, `UpdatedAt`: `timestamptz`}
+	_                  = bytes.MinRead
+)
+
+func testServerLeasesUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(serverLeasePrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(serverLeaseAllColumns) == len(serverLeasePrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ServerLease{}
+	if err = randomize.Struct(seed, o, serverLeaseDBTypes, true, serverLeaseColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ServerLease struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := ServerLeases().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, serverLeaseDBTypes, true, serverLeasePrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize ServerLease struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testServerLeasesSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(serverLeaseAllColumns) == len(serverLeasePrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ServerLease{}
+	if err = randomize.Struct(seed, o, serverLeaseDBTypes, true, serverLeaseColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ServerLease struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := ServerLeases().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, serverLeaseDBTypes, true, serverLeasePrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize ServerLease struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(serverLeaseAllColumns, serverLeasePrimaryKeyColumns) {
+		fields = serverLeaseAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			serverLeaseAllColumns,
+			serverLeasePrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := ServerLeaseSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
@@ -0,0 +1,1295 @@
+// Code generated by SQLBoiler 4.11.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// ServerPlacement is an object representing the database table.
+type ServerPlacement struct {
+	ID        string    `boil:"id" json:"id" toml:"id" yaml:"id"`
+	ServerID  string    `boil:"server_id" json:"server_id" toml:"server_id" yaml:"server_id"`
+	RackID    string    `boil:"rack_id" json:"rack_id" toml:"rack_id" yaml:"rack_id"`
+	PositionU int64     `boil:"position_u" json:"position_u" toml:"position_u" yaml:"position_u"`
+	HeightU   int64     `boil:"height_u" json:"height_u" toml:"height_u" yaml:"height_u"`
+	CreatedAt null.Time `boil:"created_at" json:"created_at,omitempty" toml:"created_at" yaml:"created_at,omitempty"`
+	UpdatedAt null.Time `boil:"updated_at" json:"updated_at,omitempty" toml:"updated_at" yaml:"updated_at,omitempty"`
+
+	R *serverPlacementR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L serverPlacementL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var ServerPlacementColumns = struct {
+	ID        string
+	ServerID  string
+	RackID    string
+	PositionU string
+	HeightU   string
+	CreatedAt string
+	UpdatedAt string
+}{
+	ID:        "id",
+	ServerID:  "server_id",
+	RackID:    "rack_id",
+	PositionU: "position_u",
+	HeightU:   "height_u",
+	CreatedAt: "created_at",
+	UpdatedAt: "updated_at",
+}
+
+var ServerPlacementTableColumns = struct {
+	ID        string
+	ServerID  string
+	RackID    string
+	PositionU string
+	HeightU   string
+	CreatedAt string
+	UpdatedAt string
+}{
+	ID:        "server_placements.id",
+	ServerID:  "server_placements.server_id",
+	RackID:    "server_placements.rack_id",
+	PositionU: "server_placements.position_u",
+	HeightU:   "server_placements.height_u",
+	CreatedAt: "server_placements.created_at",
+	UpdatedAt: "server_placements.updated_at",
+}
+
+// Generated where
+
+var ServerPlacementWhere = struct {
+	ID        whereHelperstring
+	ServerID  whereHelperstring
+	RackID    whereHelperstring
+	PositionU whereHelperint64
+	HeightU   whereHelperint64
+	CreatedAt whereHelpernull_Time
+	UpdatedAt whereHelpernull_Time
+}{
+	ID:        whereHelperstring{field: "\"server_placements\".\"id\""},
+	ServerID:  whereHelperstring{field: "\"server_placements\".\"server_id\""},
+	RackID:    whereHelperstring{field: "\"server_placements\".\"rack_id\""},
+	PositionU: whereHelperint64{field: "\"server_placements\".\"position_u\""},
+	HeightU:   whereHelperint64{field: "\"server_placements\".\"height_u\""},
+	CreatedAt: whereHelpernull_Time{field: "\"server_placements\".\"created_at\""},
+	UpdatedAt: whereHelpernull_Time{field: "\"server_placements\".\"updated_at\""},
+}
+
+// ServerPlacementRels is where relationship names are stored.
+var ServerPlacementRels = struct {
+	Server string
+	Rack   string
+}{
+	Server: "Server",
+	Rack:   "Rack",
+}
+
+// serverPlacementR is where relationships are stored.
+type serverPlacementR struct {
+	Server *Server `boil:"Server" json:"Server" toml:"Server" yaml:"Server"`
+	Rack   *Rack   `boil:"Rack" json:"Rack" toml:"Rack" yaml:"Rack"`
+}
+
+// NewStruct creates a new relationship struct
+func (*serverPlacementR) NewStruct() *serverPlacementR {
+	return &serverPlacementR{}
+}
+
+func (r *serverPlacementR) GetServer() *Server {
+	if r == nil {
+		return nil
+	}
+	return r.Server
+}
+
+func (r *serverPlacementR) GetRack() *Rack {
+	if r == nil {
+		return nil
+	}
+	return r.Rack
+}
+
+// serverPlacementL is where Load methods for each relationship are stored.
+type serverPlacementL struct{}
+
+var (
+	serverPlacementAllColumns            = []string{"id", "server_id", "rack_id", "position_u", "height_u", "created_at", "updated_at"}
+	serverPlacementColumnsWithoutDefault = []string{"server_id", "rack_id", "position_u"}
+	serverPlacementColumnsWithDefault    = []string{"id", "height_u", "created_at", "updated_at"}
+	serverPlacementPrimaryKeyColumns     = []string{"id"}
+	serverPlacementGeneratedColumns      = []string{}
+)
+
+type (
+	// ServerPlacementSlice is an alias for a slice of pointers to ServerPlacement.
+	// This should almost always be used instead of []ServerPlacement.
+	ServerPlacementSlice []*ServerPlacement
+	// ServerPlacementHook is the signature for custom ServerPlacement hook methods
+	ServerPlacementHook func(context.Context, boil.ContextExecutor, *ServerPlacement) error
+
+	serverPlacementQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	serverPlacementType                 = reflect.TypeOf(&ServerPlacement{})
+	serverPlacementMapping              = queries.MakeStructMapping(serverPlacementType)
+	serverPlacementPrimaryKeyMapping, _ = queries.BindMapping(serverPlacementType, serverPlacementMapping, serverPlacementPrimaryKeyColumns)
+	serverPlacementInsertCacheMut       sync.RWMutex
+	serverPlacementInsertCache          = make(map[string]insertCache)
+	serverPlacementUpdateCacheMut       sync.RWMutex
+	serverPlacementUpdateCache          = make(map[string]updateCache)
+	serverPlacementUpsertCacheMut       sync.RWMutex
+	serverPlacementUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var serverPlacementAfterSelectHooks []ServerPlacementHook
+
+var serverPlacementBeforeInsertHooks []ServerPlacementHook
+var serverPlacementAfterInsertHooks []ServerPlacementHook
+
+var serverPlacementBeforeUpdateHooks []ServerPlacementHook
+var serverPlacementAfterUpdateHooks []ServerPlacementHook
+
+var serverPlacementBeforeDeleteHooks []ServerPlacementHook
+var serverPlacementAfterDeleteHooks []ServerPlacementHook
+
+var serverPlacementBeforeUpsertHooks []ServerPlacementHook
+var serverPlacementAfterUpsertHooks []ServerPlacementHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *ServerPlacement) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range serverPlacementAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *ServerPlacement) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range serverPlacementBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *ServerPlacement) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range serverPlacementAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *ServerPlacement) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range serverPlacementBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *ServerPlacement) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range serverPlacementAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *ServerPlacement) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range serverPlacementBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *ServerPlacement) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range serverPlacementAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *ServerPlacement) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range serverPlacementBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *ServerPlacement) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range serverPlacementAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddServerPlacementHook registers your hook function for all future operations.
+func AddServerPlacementHook(hookPoint boil.HookPoint, serverPlacementHook ServerPlacementHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		serverPlacementAfterSelectHooks = append(serverPlacementAfterSelectHooks, serverPlacementHook)
+	case boil.BeforeInsertHook:
+		serverPlacementBeforeInsertHooks = append(serverPlacementBeforeInsertHooks, serverPlacementHook)
+	case boil.AfterInsertHook:
+		serverPlacementAfterInsertHooks = append(serverPlacementAfterInsertHooks, serverPlacementHook)
+	case boil.BeforeUpdateHook:
+		serverPlacementBeforeUpdateHooks = append(serverPlacementBeforeUpdateHooks, serverPlacementHook)
+	case boil.AfterUpdateHook:
+		serverPlacementAfterUpdateHooks = append(serverPlacementAfterUpdateHooks, serverPlacementHook)
+	case boil.BeforeDeleteHook:
+		serverPlacementBeforeDeleteHooks = append(serverPlacementBeforeDeleteHooks, serverPlacementHook)
+	case boil.AfterDeleteHook:
+		serverPlacementAfterDeleteHooks = append(serverPlacementAfterDeleteHooks, serverPlacementHook)
+	case boil.BeforeUpsertHook:
+		serverPlacementBeforeUpsertHooks = append(serverPlacementBeforeUpsertHooks, serverPlacementHook)
+	case boil.AfterUpsertHook:
+		serverPlacementAfterUpsertHooks = append(serverPlacementAfterUpsertHooks, serverPlacementHook)
+	}
+}
+
+// One returns a single serverPlacement record from the query.
+func (q serverPlacementQuery) One(ctx context.Context, exec boil.ContextExecutor) (*ServerPlacement, error) {
+	o := &ServerPlacement{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for server_placements")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all ServerPlacement records from the query.
+func (q serverPlacementQuery) All(ctx context.Context, exec boil.ContextExecutor) (ServerPlacementSlice, error) {
+	var o []*ServerPlacement
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to ServerPlacement slice")
+	}
+
+	if len(serverPlacementAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all ServerPlacement records in the query.
+func (q serverPlacementQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count server_placements rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q serverPlacementQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if server_placements exists")
+	}
+
+	return count > 0, nil
+}
+
+// Server pointed to by the foreign key.
+func (o *ServerPlacement) Server(mods ...qm.QueryMod) serverQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.ServerID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Servers(queryMods...)
+}
+
+// Rack pointed to by the foreign key.
+func (o *ServerPlacement) Rack(mods ...qm.QueryMod) rackQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.RackID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Racks(queryMods...)
+}
+
+// LoadServer allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (serverPlacementL) LoadServer(ctx context.Context, e boil.ContextExecutor, singular bool, maybeServerPlacement interface{}, mods queries.Applicator) error {
+	var slice []*ServerPlacement
+	var object *ServerPlacement
+
+	if singular {
+		object = maybeServerPlacement.(*ServerPlacement)
+	} else {
+		slice = *maybeServerPlacement.(*[]*ServerPlacement)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &serverPlacementR{}
+		}
+		args = append(args, object.ServerID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &serverPlacementR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ServerID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ServerID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`servers`),
+		qm.WhereIn(`servers.id in ?`, args...),
+		qmhelper.WhereIsNull(`servers.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Server")
+	}
+
+	var resultSlice []*Server
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Server")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for servers")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for servers")
+	}
+
+	if len(serverPlacementAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Server = foreign
+		if foreign.R == nil {
+			foreign.R = &serverR{}
+		}
+		foreign.R.ServerPlacement = object
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ServerID == foreign.ID {
+				local.R.Server = foreign
+				if foreign.R == nil {
+					foreign.R = &serverR{}
+				}
+				foreign.R.ServerPlacement = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadRack allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (serverPlacementL) LoadRack(ctx context.Context, e boil.ContextExecutor, singular bool, maybeServerPlacement interface{}, mods queries.Applicator) error {
+	var slice []*ServerPlacement
+	var object *ServerPlacement
+
+	if singular {
+		object = maybeServerPlacement.(*ServerPlacement)
+	} else {
+		slice = *maybeServerPlacement.(*[]*ServerPlacement)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &serverPlacementR{}
+		}
+		args = append(args, object.RackID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &serverPlacementR{}
+			}
+
+			for _, a := range args {
+				if a == obj.RackID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.RackID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`racks`),
+		qm.WhereIn(`racks.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Rack")
+	}
+
+	var resultSlice []*Rack
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Rack")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for racks")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for racks")
+	}
+
+	if len(serverPlacementAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Rack = foreign
+		if foreign.R == nil {
+			foreign.R = &rackR{}
+		}
+		foreign.R.ServerPlacements = append(foreign.R.ServerPlacements, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.RackID == foreign.ID {
+				local.R.Rack = foreign
+				if foreign.R == nil {
+					foreign.R = &rackR{}
+				}
+				foreign.R.ServerPlacements = append(foreign.R.ServerPlacements, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetServer of the serverPlacement to the related item.
+// Sets o.R.Server to related.
+// Adds o to related.R.ServerPlacement.
+func (o *ServerPlacement) SetServer(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Server) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"server_placements\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"server_id"}),
+		strmangle.WhereClause("\"", "\"", 2, serverPlacementPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.ServerID = related.ID
+	if o.R == nil {
+		o.R = &serverPlacementR{
+			Server: related,
+		}
+	} else {
+		o.R.Server = related
+	}
+
+	if related.R == nil {
+		related.R = &serverR{
+			ServerPlacement: o,
+		}
+	} else {
+		related.R.ServerPlacement = o
+	}
+
+	return nil
+}
+
+// SetRack of the serverPlacement to the related item.
+// Sets o.R.Rack to related.
+// Adds o to related.R.ServerPlacements.
+func (o *ServerPlacement) SetRack(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Rack) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"server_placements\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"rack_id"}),
+		strmangle.WhereClause("\"", "\"", 2, serverPlacementPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.RackID = related.ID
+	if o.R == nil {
+		o.R = &serverPlacementR{
+			Rack: related,
+		}
+	} else {
+		o.R.Rack = related
+	}
+
+	if related.R == nil {
+		related.R = &rackR{
+			ServerPlacements: ServerPlacementSlice{o},
+		}
+	} else {
+		related.R.ServerPlacements = append(related.R.ServerPlacements, o)
+	}
+
+	return nil
+}
+
+// ServerPlacements retrieves all the records using an executor.
+func ServerPlacements(mods ...qm.QueryMod) serverPlacementQuery {
+	mods = append(mods, qm.From("\"server_placements\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"server_placements\".*"})
+	}
+
+	return serverPlacementQuery{q}
+}
+
+// FindServerPlacement retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindServerPlacement(ctx context.Context, exec boil.ContextExecutor, iD string, selectCols ...string) (*ServerPlacement, error) {
+	serverPlacementObj := &ServerPlacement{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"server_placements\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, serverPlacementObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from server_placements")
+	}
+
+	if err = serverPlacementObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return serverPlacementObj, err
+	}
+
+	return serverPlacementObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *ServerPlacement) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no server_placements provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if queries.MustTime(o.CreatedAt).IsZero() {
+			queries.SetScanner(&o.CreatedAt, currTime)
+		}
+		if queries.MustTime(o.UpdatedAt).IsZero() {
+			queries.SetScanner(&o.UpdatedAt, currTime)
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(serverPlacementColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	serverPlacementInsertCacheMut.RLock()
+	cache, cached := serverPlacementInsertCache[key]
+	serverPlacementInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			serverPlacementAllColumns,
+			serverPlacementColumnsWithDefault,
+			serverPlacementColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(serverPlacementType, serverPlacementMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(serverPlacementType, serverPlacementMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"server_placements\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"server_placements\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into server_placements")
+	}
+
+	if !cached {
+		serverPlacementInsertCacheMut.Lock()
+		serverPlacementInsertCache[key] = cache
+		serverPlacementInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the ServerPlacement.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *ServerPlacement) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		queries.SetScanner(&o.UpdatedAt, currTime)
+	}
+
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	serverPlacementUpdateCacheMut.RLock()
+	cache, cached := serverPlacementUpdateCache[key]
+	serverPlacementUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			serverPlacementAllColumns,
+			serverPlacementPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update server_placements, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"server_placements\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, serverPlacementPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(serverPlacementType, serverPlacementMapping, append(wl, serverPlacementPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update server_placements row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for server_placements")
+	}
+
+	if !cached {
+		serverPlacementUpdateCacheMut.Lock()
+		serverPlacementUpdateCache[key] = cache
+		serverPlacementUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q serverPlacementQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for server_placements")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for server_placements")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o ServerPlacementSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), serverPlacementPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"server_placements\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, serverPlacementPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in serverPlacement slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all serverPlacement")
+	}
+	return rowsAff, nil
+}
+
+// Delete deletes a single ServerPlacement record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *ServerPlacement) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no ServerPlacement provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), serverPlacementPrimaryKeyMapping)
+	sql := "DELETE FROM \"server_placements\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from server_placements")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for server_placements")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q serverPlacementQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no serverPlacementQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from server_placements")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for server_placements")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o ServerPlacementSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(serverPlacementBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), serverPlacementPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"server_placements\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, serverPlacementPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from serverPlacement slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for server_placements")
+	}
+
+	if len(serverPlacementAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *ServerPlacement) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindServerPlacement(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *ServerPlacementSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := ServerPlacementSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), serverPlacementPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"server_placements\".* FROM \"server_placements\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, serverPlacementPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in ServerPlacementSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// ServerPlacementExists checks if the ServerPlacement row exists.
+func ServerPlacementExists(ctx context.Context, exec boil.ContextExecutor, iD string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"server_placements\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if server_placements exists")
+	}
+
+	return exists, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *ServerPlacement) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no server_placements provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if queries.MustTime(o.CreatedAt).IsZero() {
+			queries.SetScanner(&o.CreatedAt, currTime)
+		}
+		queries.SetScanner(&o.UpdatedAt, currTime)
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(serverPlacementColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	serverPlacementUpsertCacheMut.RLock()
+	cache, cached := serverPlacementUpsertCache[key]
+	serverPlacementUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			serverPlacementAllColumns,
+			serverPlacementColumnsWithDefault,
+			serverPlacementColumnsWithoutDefault,
+			nzDefaults,
+		)
+		update := updateColumns.UpdateColumnSet(
+			serverPlacementAllColumns,
+			serverPlacementPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert server_placements, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(serverPlacementPrimaryKeyColumns))
+			copy(conflict, serverPlacementPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryCockroachDB(dialect, "\"server_placements\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(serverPlacementType, serverPlacementMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(serverPlacementType, serverPlacementMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.DebugMode {
+		_, _ = fmt.Fprintln(boil.DebugWriter, cache.query)
+		_, _ = fmt.Fprintln(boil.DebugWriter, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if err == sql.ErrNoRows {
+			err = nil // CockcorachDB doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert server_placements")
+	}
+
+	if !cached {
+		serverPlacementUpsertCacheMut.Lock()
+		serverPlacementUpsertCache[key] = cache
+		serverPlacementUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
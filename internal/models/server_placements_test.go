@@ -0,0 +1,949 @@
+// Code generated by SQLBoiler 4.11.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/volatiletech/randomize"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/strmangle"
+)
+
+func testServerPlacementsUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(serverPlacementAllColumns) == len(serverPlacementPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := ServerPlacement{}
+	if err = randomize.Struct(seed, &o, serverPlacementDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize ServerPlacement struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert ServerPlacement: %s", err)
+	}
+
+	count, err := ServerPlacements().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, serverPlacementDBTypes, false, serverPlacementPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize ServerPlacement struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert ServerPlacement: %s", err)
+	}
+
+	count, err = ServerPlacements().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testServerPlacements(t *testing.T) {
+	t.Parallel()
+
+	query := ServerPlacements()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testServerPlacementsDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ServerPlacement{}
+	if err = randomize.Struct(seed, o, serverPlacementDBTypes, true, serverPlacementColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ServerPlacement struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := ServerPlacements().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testServerPlacementsQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ServerPlacement{}
+	if err = randomize.Struct(seed, o, serverPlacementDBTypes, true, serverPlacementColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ServerPlacement struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := ServerPlacements().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := ServerPlacements().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testServerPlacementsSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ServerPlacement{}
+	if err = randomize.Struct(seed, o, serverPlacementDBTypes, true, serverPlacementColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ServerPlacement struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := ServerPlacementSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := ServerPlacements().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testServerPlacementsExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ServerPlacement{}
+	if err = randomize.Struct(seed, o, serverPlacementDBTypes, true, serverPlacementColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ServerPlacement struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := ServerPlacementExists(ctx, tx, o.ID)
+	if err != nil {
+		t.Errorf("Unable to check if ServerPlacement exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected ServerPlacementExists to return true, but got false.")
+	}
+}
+
+func testServerPlacementsFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ServerPlacement{}
+	if err = randomize.Struct(seed, o, serverPlacementDBTypes, true, serverPlacementColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ServerPlacement struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	serverPlacementFound, err := FindServerPlacement(ctx, tx, o.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if serverPlacementFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testServerPlacementsBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ServerPlacement{}
+	if err = randomize.Struct(seed, o, serverPlacementDBTypes, true, serverPlacementColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ServerPlacement struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = ServerPlacements().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testServerPlacementsOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ServerPlacement{}
+	if err = randomize.Struct(seed, o, serverPlacementDBTypes, true, serverPlacementColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ServerPlacement struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := ServerPlacements().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testServerPlacementsAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	serverPlacementOne := &ServerPlacement{}
+	serverPlacementTwo := &ServerPlacement{}
+	if err = randomize.Struct(seed, serverPlacementOne, serverPlacementDBTypes, false, serverPlacementColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ServerPlacement struct: %s", err)
+	}
+	if err = randomize.Struct(seed, serverPlacementTwo, serverPlacementDBTypes, false, serverPlacementColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ServerPlacement struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = serverPlacementOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = serverPlacementTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := ServerPlacements().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testServerPlacementsCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	serverPlacementOne := &ServerPlacement{}
+	serverPlacementTwo := &ServerPlacement{}
+	if err = randomize.Struct(seed, serverPlacementOne, serverPlacementDBTypes, false, serverPlacementColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ServerPlacement struct: %s", err)
+	}
+	if err = randomize.Struct(seed, serverPlacementTwo, serverPlacementDBTypes, false, serverPlacementColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ServerPlacement struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = serverPlacementOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = serverPlacementTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := ServerPlacements().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func serverPlacementBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *ServerPlacement) error {
+	*o = ServerPlacement{}
+	return nil
+}
+
+func serverPlacementAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *ServerPlacement) error {
+	*o = ServerPlacement{}
+	return nil
+}
+
+func serverPlacementAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *ServerPlacement) error {
+	*o = ServerPlacement{}
+	return nil
+}
+
+func serverPlacementBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *ServerPlacement) error {
+	*o = ServerPlacement{}
+	return nil
+}
+
+func serverPlacementAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *ServerPlacement) error {
+	*o = ServerPlacement{}
+	return nil
+}
+
+func serverPlacementBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *ServerPlacement) error {
+	*o = ServerPlacement{}
+	return nil
+}
+
+func serverPlacementAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *ServerPlacement) error {
+	*o = ServerPlacement{}
+	return nil
+}
+
+func serverPlacementBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *ServerPlacement) error {
+	*o = ServerPlacement{}
+	return nil
+}
+
+func serverPlacementAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *ServerPlacement) error {
+	*o = ServerPlacement{}
+	return nil
+}
+
+func testServerPlacementsHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &ServerPlacement{}
+	o := &ServerPlacement{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, serverPlacementDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize ServerPlacement object: %s", err)
+	}
+
+	AddServerPlacementHook(boil.BeforeInsertHook, serverPlacementBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	serverPlacementBeforeInsertHooks = []ServerPlacementHook{}
+
+	AddServerPlacementHook(boil.AfterInsertHook, serverPlacementAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	serverPlacementAfterInsertHooks = []ServerPlacementHook{}
+
+	AddServerPlacementHook(boil.AfterSelectHook, serverPlacementAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	serverPlacementAfterSelectHooks = []ServerPlacementHook{}
+
+	AddServerPlacementHook(boil.BeforeUpdateHook, serverPlacementBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	serverPlacementBeforeUpdateHooks = []ServerPlacementHook{}
+
+	AddServerPlacementHook(boil.AfterUpdateHook, serverPlacementAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	serverPlacementAfterUpdateHooks = []ServerPlacementHook{}
+
+	AddServerPlacementHook(boil.BeforeDeleteHook, serverPlacementBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	serverPlacementBeforeDeleteHooks = []ServerPlacementHook{}
+
+	AddServerPlacementHook(boil.AfterDeleteHook, serverPlacementAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	serverPlacementAfterDeleteHooks = []ServerPlacementHook{}
+
+	AddServerPlacementHook(boil.BeforeUpsertHook, serverPlacementBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	serverPlacementBeforeUpsertHooks = []ServerPlacementHook{}
+
+	AddServerPlacementHook(boil.AfterUpsertHook, serverPlacementAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	serverPlacementAfterUpsertHooks = []ServerPlacementHook{}
+}
+
+func testServerPlacementsInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ServerPlacement{}
+	if err = randomize.Struct(seed, o, serverPlacementDBTypes, true, serverPlacementColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ServerPlacement struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := ServerPlacements().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testServerPlacementsInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ServerPlacement{}
+	if err = randomize.Struct(seed, o, serverPlacementDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize ServerPlacement struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(serverPlacementColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := ServerPlacements().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testServerPlacementToOneServerUsingServer(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local ServerPlacement
+	var foreign Server
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, serverPlacementDBTypes, false, serverPlacementColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ServerPlacement struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, serverDBTypes, false, serverColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Server struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.ServerID = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.Server().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := ServerPlacementSlice{&local}
+	if err = local.L.LoadServer(ctx, tx, false, (*[]*ServerPlacement)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Server == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.Server = nil
+	if err = local.L.LoadServer(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Server == nil {
+		t.Error("struct should have been eager loaded")
+	}
+}
+
+func testServerPlacementToOneRackUsingRack(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local ServerPlacement
+	var foreign Rack
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, serverPlacementDBTypes, false, serverPlacementColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ServerPlacement struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, rackDBTypes, false, rackColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Rack struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.RackID = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.Rack().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := ServerPlacementSlice{&local}
+	if err = local.L.LoadRack(ctx, tx, false, (*[]*ServerPlacement)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Rack == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.Rack = nil
+	if err = local.L.LoadRack(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Rack == nil {
+		t.Error("struct should have been eager loaded")
+	}
+}
+
+func testServerPlacementToOneSetOpServerUsingServer(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a ServerPlacement
+	var b, c Server
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, serverPlacementDBTypes, false, strmangle.SetComplement(serverPlacementPrimaryKeyColumns, serverPlacementColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, serverDBTypes, false, strmangle.SetComplement(serverPrimaryKeyColumns, serverColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, serverDBTypes, false, strmangle.SetComplement(serverPrimaryKeyColumns, serverColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*Server{&b, &c} {
+		err = a.SetServer(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.Server != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.ServerPlacement != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.ServerID != x.ID {
+			t.Error("foreign key was wrong value", a.ServerID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.ServerID))
+		reflect.Indirect(reflect.ValueOf(&a.ServerID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if a.ServerID != x.ID {
+			t.Error("foreign key was wrong value", a.ServerID, x.ID)
+		}
+	}
+}
+func testServerPlacementToOneSetOpRackUsingRack(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a ServerPlacement
+	var b, c Rack
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, serverPlacementDBTypes, false, strmangle.SetComplement(serverPlacementPrimaryKeyColumns, serverPlacementColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, rackDBTypes, false, strmangle.SetComplement(rackPrimaryKeyColumns, rackColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, rackDBTypes, false, strmangle.SetComplement(rackPrimaryKeyColumns, rackColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*Rack{&b, &c} {
+		err = a.SetRack(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.Rack != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.ServerPlacements[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.RackID != x.ID {
+			t.Error("foreign key was wrong value", a.RackID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.RackID))
+		reflect.Indirect(reflect.ValueOf(&a.RackID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if a.RackID != x.ID {
+			t.Error("foreign key was wrong value", a.RackID, x.ID)
+		}
+	}
+}
+
+func testServerPlacementsReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ServerPlacement{}
+	if err = randomize.Struct(seed, o, serverPlacementDBTypes, true, serverPlacementColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ServerPlacement struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testServerPlacementsReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ServerPlacement{}
+	if err = randomize.Struct(seed, o, serverPlacementDBTypes, true, serverPlacementColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ServerPlacement struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := ServerPlacementSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testServerPlacementsSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ServerPlacement{}
+	if err = randomize.Struct(seed, o, serverPlacementDBTypes, true, serverPlacementColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ServerPlacement struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := ServerPlacements().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	serverPlacementDBTypes = map[string]string{`ID`: `uuid`, `ServerID`: `uuid`, `RackID`: `uuid`, `PositionU`: `int8`, `HeightU`: `int8`, `CreatedAt`: `timestamptz`, `UpdatedAt`: `timestamptz`}
+	_                      = bytes.MinRead
+)
+
+func testServerPlacementsUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(serverPlacementPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(serverPlacementAllColumns) == len(serverPlacementPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ServerPlacement{}
+	if err = randomize.Struct(seed, o, serverPlacementDBTypes, true, serverPlacementColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ServerPlacement struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := ServerPlacements().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, serverPlacementDBTypes, true, serverPlacementPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize ServerPlacement struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testServerPlacementsSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(serverPlacementAllColumns) == len(serverPlacementPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ServerPlacement{}
+	if err = randomize.Struct(seed, o, serverPlacementDBTypes, true, serverPlacementColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ServerPlacement struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := ServerPlacements().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, serverPlacementDBTypes, true, serverPlacementPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize ServerPlacement struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(serverPlacementAllColumns, serverPlacementPrimaryKeyColumns) {
+		fields = serverPlacementAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			serverPlacementAllColumns,
+			serverPlacementPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := ServerPlacementSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
@@ -0,0 +1,1120 @@
+// Code generated by SQLBoiler 4.11.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// ServerStateTransition is an object representing the database table.
+type ServerStateTransition struct {
+	ID           string      `boil:"id" json:"id" toml:"id" yaml:"id"`
+	ServerID     string      `boil:"server_id" json:"server_id" toml:"server_id" yaml:"server_id"`
+	FromState    string      `boil:"from_state" json:"from_state" toml:"from_state" yaml:"from_state"`
+	ToState      string      `boil:"to_state" json:"to_state" toml:"to_state" yaml:"to_state"`
+	Reason       string      `boil:"reason" json:"reason" toml:"reason" yaml:"reason"`
+	ActorSubject null.String `boil:"actor_subject" json:"actor_subject,omitempty" toml:"actor_subject" yaml:"actor_subject,omitempty"`
+	ActorUser    null.String `boil:"actor_user" json:"actor_user,omitempty" toml:"actor_user" yaml:"actor_user,omitempty"`
+	CreatedAt    time.Time   `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+
+	R *serverStateTransitionR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L serverStateTransitionL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var ServerStateTransitionColumns = struct {
+	ID           string
+	ServerID     string
+	FromState    string
+	ToState      string
+	Reason       string
+	ActorSubject string
+	ActorUser    string
+	CreatedAt    string
+}{
+	ID:           "id",
+	ServerID:     "server_id",
+	FromState:    "from_state",
+	ToState:      "to_state",
+	Reason:       "reason",
+	ActorSubject: "actor_subject",
+	ActorUser:    "actor_user",
+	CreatedAt:    "created_at",
+}
+
+var ServerStateTransitionTableColumns = struct {
+	ID           string
+	ServerID     string
+	FromState    string
+	ToState      string
+	Reason       string
+	ActorSubject string
+	ActorUser    string
+	CreatedAt    string
+}{
+	ID:           "server_state_transitions.id",
+	ServerID:     "server_state_transitions.server_id",
+	FromState:    "server_state_transitions.from_state",
+	ToState:      "server_state_transitions.to_state",
+	Reason:       "server_state_transitions.reason",
+	ActorSubject: "server_state_transitions.actor_subject",
+	ActorUser:    "server_state_transitions.actor_user",
+	CreatedAt:    "server_state_transitions.created_at",
+}
+
+// Generated where
+
+var ServerStateTransitionWhere = struct {
+	ID           whereHelperstring
+	ServerID     whereHelperstring
+	FromState    whereHelperstring
+	ToState      whereHelperstring
+	Reason       whereHelperstring
+	ActorSubject whereHelpernull_String
+	ActorUser    whereHelpernull_String
+	CreatedAt    whereHelpertime_Time
+}{
+	ID:           whereHelperstring{field: "\"server_state_transitions\".\"id\""},
+	ServerID:     whereHelperstring{field: "\"server_state_transitions\".\"server_id\""},
+	FromState:    whereHelperstring{field: "\"server_state_transitions\".\"from_state\""},
+	ToState:      whereHelperstring{field: "\"server_state_transitions\".\"to_state\""},
+	Reason:       whereHelperstring{field: "\"server_state_transitions\".\"reason\""},
+	ActorSubject: whereHelpernull_String{field: "\"server_state_transitions\".\"actor_subject\""},
+	ActorUser:    whereHelpernull_String{field: "\"server_state_transitions\".\"actor_user\""},
+	CreatedAt:    whereHelpertime_Time{field: "\"server_state_transitions\".\"created_at\""},
+}
+
+// ServerStateTransitionRels is where relationship names are stored.
+var ServerStateTransitionRels = struct {
+	Server string
+}{
+	Server: "Server",
+}
+
+// serverStateTransitionR is where relationships are stored.
+type serverStateTransitionR struct {
+	Server *Server `boil:"Server" json:"Server" toml:"Server" yaml:"Server"`
+}
+
+// NewStruct creates a new relationship struct
+func (*serverStateTransitionR) NewStruct() *serverStateTransitionR {
+	return &serverStateTransitionR{}
+}
+
+func (r *serverStateTransitionR) GetServer() *Server {
+	if r == nil {
+		return nil
+	}
+	return r.Server
+}
+
+// serverStateTransitionL is where Load methods for each relationship are stored.
+type serverStateTransitionL struct{}
+
+var (
+	serverStateTransitionAllColumns            = []string{"id", "server_id", "from_state", "to_state", "reason", "actor_subject", "actor_user", "created_at"}
+	serverStateTransitionColumnsWithoutDefault = []string{"server_id", "from_state", "to_state", "reason"}
+	serverStateTransitionColumnsWithDefault    = []string{"id", "actor_subject", "actor_user", "created_at"}
+	serverStateTransitionPrimaryKeyColumns     = []string{"id"}
+	serverStateTransitionGeneratedColumns      = []string{}
+)
+
+type (
+	// ServerStateTransitionSlice is an alias for a slice of pointers to ServerStateTransition.
+	// This should almost always be used instead of []ServerStateTransition.
+	ServerStateTransitionSlice []*ServerStateTransition
+	// ServerStateTransitionHook is the signature for custom ServerStateTransition hook methods
+	ServerStateTransitionHook func(context.Context, boil.ContextExecutor, *ServerStateTransition) error
+
+	serverStateTransitionQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	serverStateTransitionType                 = reflect.TypeOf(&ServerStateTransition{})
+	serverStateTransitionMapping              = queries.MakeStructMapping(serverStateTransitionType)
+	serverStateTransitionPrimaryKeyMapping, _ = queries.BindMapping(serverStateTransitionType, serverStateTransitionMapping, serverStateTransitionPrimaryKeyColumns)
+	serverStateTransitionInsertCacheMut       sync.RWMutex
+	serverStateTransitionInsertCache          = make(map[string]insertCache)
+	serverStateTransitionUpdateCacheMut       sync.RWMutex
+	serverStateTransitionUpdateCache          = make(map[string]updateCache)
+	serverStateTransitionUpsertCacheMut       sync.RWMutex
+	serverStateTransitionUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var serverStateTransitionAfterSelectHooks []ServerStateTransitionHook
+
+var serverStateTransitionBeforeInsertHooks []ServerStateTransitionHook
+var serverStateTransitionAfterInsertHooks []ServerStateTransitionHook
+
+var serverStateTransitionBeforeUpdateHooks []ServerStateTransitionHook
+var serverStateTransitionAfterUpdateHooks []ServerStateTransitionHook
+
+var serverStateTransitionBeforeDeleteHooks []ServerStateTransitionHook
+var serverStateTransitionAfterDeleteHooks []ServerStateTransitionHook
+
+var serverStateTransitionBeforeUpsertHooks []ServerStateTransitionHook
+var serverStateTransitionAfterUpsertHooks []ServerStateTransitionHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *ServerStateTransition) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range serverStateTransitionAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *ServerStateTransition) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range serverStateTransitionBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *ServerStateTransition) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range serverStateTransitionAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *ServerStateTransition) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range serverStateTransitionBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *ServerStateTransition) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range serverStateTransitionAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *ServerStateTransition) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range serverStateTransitionBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *ServerStateTransition) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range serverStateTransitionAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *ServerStateTransition) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range serverStateTransitionBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *ServerStateTransition) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range serverStateTransitionAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddServerStateTransitionHook registers your hook function for all future operations.
+func AddServerStateTransitionHook(hookPoint boil.HookPoint, serverStateTransitionHook ServerStateTransitionHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		serverStateTransitionAfterSelectHooks = append(serverStateTransitionAfterSelectHooks, serverStateTransitionHook)
+	case boil.BeforeInsertHook:
+		serverStateTransitionBeforeInsertHooks = append(serverStateTransitionBeforeInsertHooks, serverStateTransitionHook)
+	case boil.AfterInsertHook:
+		serverStateTransitionAfterInsertHooks = append(serverStateTransitionAfterInsertHooks, serverStateTransitionHook)
+	case boil.BeforeUpdateHook:
+		serverStateTransitionBeforeUpdateHooks = append(serverStateTransitionBeforeUpdateHooks, serverStateTransitionHook)
+	case boil.AfterUpdateHook:
+		serverStateTransitionAfterUpdateHooks = append(serverStateTransitionAfterUpdateHooks, serverStateTransitionHook)
+	case boil.BeforeDeleteHook:
+		serverStateTransitionBeforeDeleteHooks = append(serverStateTransitionBeforeDeleteHooks, serverStateTransitionHook)
+	case boil.AfterDeleteHook:
+		serverStateTransitionAfterDeleteHooks = append(serverStateTransitionAfterDeleteHooks, serverStateTransitionHook)
+	case boil.BeforeUpsertHook:
+		serverStateTransitionBeforeUpsertHooks = append(serverStateTransitionBeforeUpsertHooks, serverStateTransitionHook)
+	case boil.AfterUpsertHook:
+		serverStateTransitionAfterUpsertHooks = append(serverStateTransitionAfterUpsertHooks, serverStateTransitionHook)
+	}
+}
+
+// One returns a single serverStateTransition record from the query.
+func (q serverStateTransitionQuery) One(ctx context.Context, exec boil.ContextExecutor) (*ServerStateTransition, error) {
+	o := &ServerStateTransition{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for server_state_transitions")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all ServerStateTransition records from the query.
+func (q serverStateTransitionQuery) All(ctx context.Context, exec boil.ContextExecutor) (ServerStateTransitionSlice, error) {
+	var o []*ServerStateTransition
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to ServerStateTransition slice")
+	}
+
+	if len(serverStateTransitionAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all ServerStateTransition records in the query.
+func (q serverStateTransitionQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count server_state_transitions rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q serverStateTransitionQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if server_state_transitions exists")
+	}
+
+	return count > 0, nil
+}
+
+// Server pointed to by the foreign key.
+func (o *ServerStateTransition) Server(mods ...qm.QueryMod) serverQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.ServerID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Servers(queryMods...)
+}
+
+// LoadServer allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (serverStateTransitionL) LoadServer(ctx context.Context, e boil.ContextExecutor, singular bool, maybeServerStateTransition interface{}, mods queries.Applicator) error {
+	var slice []*ServerStateTransition
+	var object *ServerStateTransition
+
+	if singular {
+		object = maybeServerStateTransition.(*ServerStateTransition)
+	} else {
+		slice = *maybeServerStateTransition.(*[]*ServerStateTransition)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &serverStateTransitionR{}
+		}
+		args = append(args, object.ServerID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &serverStateTransitionR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ServerID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ServerID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`servers`),
+		qm.WhereIn(`servers.id in ?`, args...),
+		qmhelper.WhereIsNull(`servers.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Server")
+	}
+
+	var resultSlice []*Server
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Server")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for servers")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for servers")
+	}
+
+	if len(serverStateTransitionAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Server = foreign
+		if foreign.R == nil {
+			foreign.R = &serverR{}
+		}
+		foreign.R.ServerStateTransitions = append(foreign.R.ServerStateTransitions, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ServerID == foreign.ID {
+				local.R.Server = foreign
+				if foreign.R == nil {
+					foreign.R = &serverR{}
+				}
+				foreign.R.ServerStateTransitions = append(foreign.R.ServerStateTransitions, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetServer of the serverStateTransition to the related item.
+// Sets o.R.Server to related.
+// Adds o to related.R.ServerStateTransitions.
+func (o *ServerStateTransition) SetServer(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Server) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"server_state_transitions\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"server_id"}),
+		strmangle.WhereClause("\"", "\"", 2, serverStateTransitionPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.ServerID = related.ID
+	if o.R == nil {
+		o.R = &serverStateTransitionR{
+			Server: related,
+		}
+	} else {
+		o.R.Server = related
+	}
+
+	if related.R == nil {
+		related.R = &serverR{
+			ServerStateTransitions: ServerStateTransitionSlice{o},
+		}
+	} else {
+		related.R.ServerStateTransitions = append(related.R.ServerStateTransitions, o)
+	}
+
+	return nil
+}
+
+// ServerStateTransitions retrieves all the records using an executor.
+func ServerStateTransitions(mods ...qm.QueryMod) serverStateTransitionQuery {
+	mods = append(mods, qm.From("\"server_state_transitions\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"server_state_transitions\".*"})
+	}
+
+	return serverStateTransitionQuery{q}
+}
+
+// FindServerStateTransition retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindServerStateTransition(ctx context.Context, exec boil.ContextExecutor, iD string, selectCols ...string) (*ServerStateTransition, error) {
+	serverStateTransitionObj := &ServerStateTransition{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"server_state_transitions\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, serverStateTransitionObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from server_state_transitions")
+	}
+
+	if err = serverStateTransitionObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return serverStateTransitionObj, err
+	}
+
+	return serverStateTransitionObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *ServerStateTransition) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no server_state_transitions provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(serverStateTransitionColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	serverStateTransitionInsertCacheMut.RLock()
+	cache, cached := serverStateTransitionInsertCache[key]
+	serverStateTransitionInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			serverStateTransitionAllColumns,
+			serverStateTransitionColumnsWithDefault,
+			serverStateTransitionColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(serverStateTransitionType, serverStateTransitionMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(serverStateTransitionType, serverStateTransitionMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"server_state_transitions\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"server_state_transitions\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into server_state_transitions")
+	}
+
+	if !cached {
+		serverStateTransitionInsertCacheMut.Lock()
+		serverStateTransitionInsertCache[key] = cache
+		serverStateTransitionInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the ServerStateTransition.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *ServerStateTransition) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	serverStateTransitionUpdateCacheMut.RLock()
+	cache, cached := serverStateTransitionUpdateCache[key]
+	serverStateTransitionUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			serverStateTransitionAllColumns,
+			serverStateTransitionPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update server_state_transitions, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"server_state_transitions\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, serverStateTransitionPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(serverStateTransitionType, serverStateTransitionMapping, append(wl, serverStateTransitionPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update server_state_transitions row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for server_state_transitions")
+	}
+
+	if !cached {
+		serverStateTransitionUpdateCacheMut.Lock()
+		serverStateTransitionUpdateCache[key] = cache
+		serverStateTransitionUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q serverStateTransitionQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for server_state_transitions")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for server_state_transitions")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o ServerStateTransitionSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), serverStateTransitionPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"server_state_transitions\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, serverStateTransitionPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in serverStateTransition slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all serverStateTransition")
+	}
+	return rowsAff, nil
+}
+
+// Delete deletes a single ServerStateTransition record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *ServerStateTransition) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no ServerStateTransition provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), serverStateTransitionPrimaryKeyMapping)
+	sql := "DELETE FROM \"server_state_transitions\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from server_state_transitions")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for server_state_transitions")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q serverStateTransitionQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no serverStateTransitionQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from server_state_transitions")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for server_state_transitions")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o ServerStateTransitionSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(serverStateTransitionBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), serverStateTransitionPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"server_state_transitions\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, serverStateTransitionPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from serverStateTransition slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for server_state_transitions")
+	}
+
+	if len(serverStateTransitionAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *ServerStateTransition) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindServerStateTransition(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *ServerStateTransitionSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := ServerStateTransitionSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), serverStateTransitionPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"server_state_transitions\".* FROM \"server_state_transitions\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, serverStateTransitionPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in ServerStateTransitionSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// ServerStateTransitionExists checks if the ServerStateTransition row exists.
+func ServerStateTransitionExists(ctx context.Context, exec boil.ContextExecutor, iD string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"server_state_transitions\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if server_state_transitions exists")
+	}
+
+	return exists, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *ServerStateTransition) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no server_state_transitions provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(serverStateTransitionColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	serverStateTransitionUpsertCacheMut.RLock()
+	cache, cached := serverStateTransitionUpsertCache[key]
+	serverStateTransitionUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			serverStateTransitionAllColumns,
+			serverStateTransitionColumnsWithDefault,
+			serverStateTransitionColumnsWithoutDefault,
+			nzDefaults,
+		)
+		update := updateColumns.UpdateColumnSet(
+			serverStateTransitionAllColumns,
+			serverStateTransitionPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert server_state_transitions, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(serverStateTransitionPrimaryKeyColumns))
+			copy(conflict, serverStateTransitionPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryCockroachDB(dialect, "\"server_state_transitions\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(serverStateTransitionType, serverStateTransitionMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(serverStateTransitionType, serverStateTransitionMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.DebugMode {
+		_, _ = fmt.Fprintln(boil.DebugWriter, cache.query)
+		_, _ = fmt.Fprintln(boil.DebugWriter, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if err == sql.ErrNoRows {
+			err = nil // CockcorachDB doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert server_state_transitions")
+	}
+
+	if !cached {
+		serverStateTransitionUpsertCacheMut.Lock()
+		serverStateTransitionUpsertCache[key] = cache
+		serverStateTransitionUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
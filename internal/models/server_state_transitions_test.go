@@ -0,0 +1,841 @@
+// Code generated by SQLBoiler 4.11.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/volatiletech/randomize"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/strmangle"
+)
+
+func testServerStateTransitionsUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(serverStateTransitionAllColumns) == len(serverStateTransitionPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := ServerStateTransition{}
+	if err = randomize.Struct(seed, &o, serverStateTransitionDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize ServerStateTransition struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert ServerStateTransition: %s", err)
+	}
+
+	count, err := ServerStateTransitions().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, serverStateTransitionDBTypes, false, serverStateTransitionPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize ServerStateTransition struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert ServerStateTransition: %s", err)
+	}
+
+	count, err = ServerStateTransitions().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testServerStateTransitions(t *testing.T) {
+	t.Parallel()
+
+	query := ServerStateTransitions()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testServerStateTransitionsDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ServerStateTransition{}
+	if err = randomize.Struct(seed, o, serverStateTransitionDBTypes, true, serverStateTransitionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ServerStateTransition struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := ServerStateTransitions().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testServerStateTransitionsQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ServerStateTransition{}
+	if err = randomize.Struct(seed, o, serverStateTransitionDBTypes, true, serverStateTransitionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ServerStateTransition struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := ServerStateTransitions().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := ServerStateTransitions().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testServerStateTransitionsSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ServerStateTransition{}
+	if err = randomize.Struct(seed, o, serverStateTransitionDBTypes, true, serverStateTransitionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ServerStateTransition struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := ServerStateTransitionSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := ServerStateTransitions().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testServerStateTransitionsExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ServerStateTransition{}
+	if err = randomize.Struct(seed, o, serverStateTransitionDBTypes, true, serverStateTransitionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ServerStateTransition struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := ServerStateTransitionExists(ctx, tx, o.ID)
+	if err != nil {
+		t.Errorf("Unable to check if ServerStateTransition exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected ServerStateTransitionExists to return true, but got false.")
+	}
+}
+
+func testServerStateTransitionsFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ServerStateTransition{}
+	if err = randomize.Struct(seed, o, serverStateTransitionDBTypes, true, serverStateTransitionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ServerStateTransition struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	serverStateTransitionFound, err := FindServerStateTransition(ctx, tx, o.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if serverStateTransitionFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testServerStateTransitionsBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ServerStateTransition{}
+	if err = randomize.Struct(seed, o, serverStateTransitionDBTypes, true, serverStateTransitionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ServerStateTransition struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = ServerStateTransitions().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testServerStateTransitionsOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ServerStateTransition{}
+	if err = randomize.Struct(seed, o, serverStateTransitionDBTypes, true, serverStateTransitionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ServerStateTransition struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := ServerStateTransitions().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testServerStateTransitionsAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	serverStateTransitionOne := &ServerStateTransition{}
+	serverStateTransitionTwo := &ServerStateTransition{}
+	if err = randomize.Struct(seed, serverStateTransitionOne, serverStateTransitionDBTypes, false, serverStateTransitionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ServerStateTransition struct: %s", err)
+	}
+	if err = randomize.Struct(seed, serverStateTransitionTwo, serverStateTransitionDBTypes, false, serverStateTransitionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ServerStateTransition struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = serverStateTransitionOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = serverStateTransitionTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := ServerStateTransitions().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testServerStateTransitionsCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	serverStateTransitionOne := &ServerStateTransition{}
+	serverStateTransitionTwo := &ServerStateTransition{}
+	if err = randomize.Struct(seed, serverStateTransitionOne, serverStateTransitionDBTypes, false, serverStateTransitionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ServerStateTransition struct: %s", err)
+	}
+	if err = randomize.Struct(seed, serverStateTransitionTwo, serverStateTransitionDBTypes, false, serverStateTransitionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ServerStateTransition struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = serverStateTransitionOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = serverStateTransitionTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := ServerStateTransitions().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func serverStateTransitionBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *ServerStateTransition) error {
+	*o = ServerStateTransition{}
+	return nil
+}
+
+func serverStateTransitionAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *ServerStateTransition) error {
+	*o = ServerStateTransition{}
+	return nil
+}
+
+func serverStateTransitionAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *ServerStateTransition) error {
+	*o = ServerStateTransition{}
+	return nil
+}
+
+func serverStateTransitionBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *ServerStateTransition) error {
+	*o = ServerStateTransition{}
+	return nil
+}
+
+func serverStateTransitionAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *ServerStateTransition) error {
+	*o = ServerStateTransition{}
+	return nil
+}
+
+func serverStateTransitionBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *ServerStateTransition) error {
+	*o = ServerStateTransition{}
+	return nil
+}
+
+func serverStateTransitionAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *ServerStateTransition) error {
+	*o = ServerStateTransition{}
+	return nil
+}
+
+func serverStateTransitionBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *ServerStateTransition) error {
+	*o = ServerStateTransition{}
+	return nil
+}
+
+func serverStateTransitionAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *ServerStateTransition) error {
+	*o = ServerStateTransition{}
+	return nil
+}
+
+func testServerStateTransitionsHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &ServerStateTransition{}
+	o := &ServerStateTransition{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, serverStateTransitionDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize ServerStateTransition object: %s", err)
+	}
+
+	AddServerStateTransitionHook(boil.BeforeInsertHook, serverStateTransitionBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	serverStateTransitionBeforeInsertHooks = []ServerStateTransitionHook{}
+
+	AddServerStateTransitionHook(boil.AfterInsertHook, serverStateTransitionAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	serverStateTransitionAfterInsertHooks = []ServerStateTransitionHook{}
+
+	AddServerStateTransitionHook(boil.AfterSelectHook, serverStateTransitionAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	serverStateTransitionAfterSelectHooks = []ServerStateTransitionHook{}
+
+	AddServerStateTransitionHook(boil.BeforeUpdateHook, serverStateTransitionBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	serverStateTransitionBeforeUpdateHooks = []ServerStateTransitionHook{}
+
+	AddServerStateTransitionHook(boil.AfterUpdateHook, serverStateTransitionAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	serverStateTransitionAfterUpdateHooks = []ServerStateTransitionHook{}
+
+	AddServerStateTransitionHook(boil.BeforeDeleteHook, serverStateTransitionBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	serverStateTransitionBeforeDeleteHooks = []ServerStateTransitionHook{}
+
+	AddServerStateTransitionHook(boil.AfterDeleteHook, serverStateTransitionAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	serverStateTransitionAfterDeleteHooks = []ServerStateTransitionHook{}
+
+	AddServerStateTransitionHook(boil.BeforeUpsertHook, serverStateTransitionBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	serverStateTransitionBeforeUpsertHooks = []ServerStateTransitionHook{}
+
+	AddServerStateTransitionHook(boil.AfterUpsertHook, serverStateTransitionAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	serverStateTransitionAfterUpsertHooks = []ServerStateTransitionHook{}
+}
+
+func testServerStateTransitionsInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ServerStateTransition{}
+	if err = randomize.Struct(seed, o, serverStateTransitionDBTypes, true, serverStateTransitionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ServerStateTransition struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := ServerStateTransitions().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testServerStateTransitionsInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ServerStateTransition{}
+	if err = randomize.Struct(seed, o, serverStateTransitionDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize ServerStateTransition struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(serverStateTransitionColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := ServerStateTransitions().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testServerStateTransitionToOneServerUsingServer(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local ServerStateTransition
+	var foreign Server
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, serverStateTransitionDBTypes, false, serverStateTransitionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ServerStateTransition struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, serverDBTypes, false, serverColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Server struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.ServerID = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.Server().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := ServerStateTransitionSlice{&local}
+	if err = local.L.LoadServer(ctx, tx, false, (*[]*ServerStateTransition)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Server == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.Server = nil
+	if err = local.L.LoadServer(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Server == nil {
+		t.Error("struct should have been eager loaded")
+	}
+}
+
+func testServerStateTransitionToOneSetOpServerUsingServer(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a ServerStateTransition
+	var b, c Server
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, serverStateTransitionDBTypes, false, strmangle.SetComplement(serverStateTransitionPrimaryKeyColumns, serverStateTransitionColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, serverDBTypes, false, strmangle.SetComplement(serverPrimaryKeyColumns, serverColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, serverDBTypes, false, strmangle.SetComplement(serverPrimaryKeyColumns, serverColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*Server{&b, &c} {
+		err = a.SetServer(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.Server != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.ServerStateTransitions[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.ServerID != x.ID {
+			t.Error("foreign key was wrong value", a.ServerID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.ServerID))
+		reflect.Indirect(reflect.ValueOf(&a.ServerID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if a.ServerID != x.ID {
+			t.Error("foreign key was wrong value", a.ServerID, x.ID)
+		}
+	}
+}
+
+func testServerStateTransitionsReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ServerStateTransition{}
+	if err = randomize.Struct(seed, o, serverStateTransitionDBTypes, true, serverStateTransitionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ServerStateTransition struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testServerStateTransitionsReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ServerStateTransition{}
+	if err = randomize.Struct(seed, o, serverStateTransitionDBTypes, true, serverStateTransitionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ServerStateTransition struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := ServerStateTransitionSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testServerStateTransitionsSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ServerStateTransition{}
+	if err = randomize.Struct(seed, o, serverStateTransitionDBTypes, true, serverStateTransitionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ServerStateTransition struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := ServerStateTransitions().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	serverStateTransitionDBTypes = map[string]string{`ID`: `uuid`, `ServerID`: `uuid`, `FromState`: `string`, `ToState`: `string`, `Reason`: `string`, `ActorSubject`: `string`, `ActorUser`: `string`, `CreatedAt`: `timestamptz`}
+	_                            = bytes.MinRead
+)
+
+func testServerStateTransitionsUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(serverStateTransitionPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(serverStateTransitionAllColumns) == len(serverStateTransitionPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ServerStateTransition{}
+	if err = randomize.Struct(seed, o, serverStateTransitionDBTypes, true, serverStateTransitionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ServerStateTransition struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := ServerStateTransitions().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, serverStateTransitionDBTypes, true, serverStateTransitionPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize ServerStateTransition struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testServerStateTransitionsSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(serverStateTransitionAllColumns) == len(serverStateTransitionPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ServerStateTransition{}
+	if err = randomize.Struct(seed, o, serverStateTransitionDBTypes, true, serverStateTransitionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ServerStateTransition struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := ServerStateTransitions().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, serverStateTransitionDBTypes, true, serverStateTransitionPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize ServerStateTransition struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(serverStateTransitionAllColumns, serverStateTransitionPrimaryKeyColumns) {
+		fields = serverStateTransitionAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			serverStateTransitionAllColumns,
+			serverStateTransitionPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := ServerStateTransitionSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
@@ -19,6 +19,7 @@ import (
 	"github.com/volatiletech/sqlboiler/v4/queries"
 	"github.com/volatiletech/sqlboiler/v4/queries/qm"
 	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/sqlboiler/v4/types"
 	"github.com/volatiletech/strmangle"
 )
 
@@ -30,6 +31,8 @@ type Server struct {
 	CreatedAt    null.Time   `boil:"created_at" json:"created_at,omitempty" toml:"created_at" yaml:"created_at,omitempty"`
 	UpdatedAt    null.Time   `boil:"updated_at" json:"updated_at,omitempty" toml:"updated_at" yaml:"updated_at,omitempty"`
 	DeletedAt    null.Time   `boil:"deleted_at" json:"deleted_at,omitempty" toml:"deleted_at" yaml:"deleted_at,omitempty"`
+	State        string      `boil:"state" json:"state" toml:"state" yaml:"state"`
+	Labels       types.JSON  `boil:"labels" json:"labels" toml:"labels" yaml:"labels"`
 
 	R *serverR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L serverL  `boil:"-" json:"-" toml:"-" yaml:"-"`
@@ -42,6 +45,8 @@ var ServerColumns = struct {
 	CreatedAt    string
 	UpdatedAt    string
 	DeletedAt    string
+	State        string
+	Labels       string
 }{
 	ID:           "id",
 	Name:         "name",
@@ -49,6 +54,8 @@ var ServerColumns = struct {
 	CreatedAt:    "created_at",
 	UpdatedAt:    "updated_at",
 	DeletedAt:    "deleted_at",
+	State:        "state",
+	Labels:       "labels",
 }
 
 var ServerTableColumns = struct {
@@ -58,6 +65,8 @@ var ServerTableColumns = struct {
 	CreatedAt    string
 	UpdatedAt    string
 	DeletedAt    string
+	State        string
+	Labels       string
 }{
 	ID:           "servers.id",
 	Name:         "servers.name",
@@ -65,6 +74,8 @@ var ServerTableColumns = struct {
 	CreatedAt:    "servers.created_at",
 	UpdatedAt:    "servers.updated_at",
 	DeletedAt:    "servers.deleted_at",
+	State:        "servers.state",
+	Labels:       "servers.labels",
 }
 
 // Generated where
@@ -76,6 +87,8 @@ var ServerWhere = struct {
 	CreatedAt    whereHelpernull_Time
 	UpdatedAt    whereHelpernull_Time
 	DeletedAt    whereHelpernull_Time
+	State        whereHelperstring
+	Labels       whereHelpertypes_JSON
 }{
 	ID:           whereHelperstring{field: "\"servers\".\"id\""},
 	Name:         whereHelpernull_String{field: "\"servers\".\"name\""},
@@ -83,27 +96,47 @@ var ServerWhere = struct {
 	CreatedAt:    whereHelpernull_Time{field: "\"servers\".\"created_at\""},
 	UpdatedAt:    whereHelpernull_Time{field: "\"servers\".\"updated_at\""},
 	DeletedAt:    whereHelpernull_Time{field: "\"servers\".\"deleted_at\""},
+	State:        whereHelperstring{field: "\"servers\".\"state\""},
+	Labels:       whereHelpertypes_JSON{field: "\"servers\".\"labels\""},
 }
 
 // ServerRels is where relationship names are stored.
 var ServerRels = struct {
-	Attributes          string
-	ServerComponents    string
-	ServerCredentials   string
-	VersionedAttributes string
+	BomInfo                  string
+	FirmwareSetAssignment    string
+	ServerLease              string
+	ServerPlacement          string
+	Attributes               string
+	MaintenanceWindowServers string
+	ServerComponents         string
+	ServerCredentials        string
+	ServerStateTransitions   string
+	VersionedAttributes      string
 }{
-	Attributes:          "Attributes",
-	ServerComponents:    "ServerComponents",
-	ServerCredentials:   "ServerCredentials",
-	VersionedAttributes: "VersionedAttributes",
+	BomInfo:                  "BomInfo",
+	FirmwareSetAssignment:    "FirmwareSetAssignment",
+	ServerLease:              "ServerLease",
+	ServerPlacement:          "ServerPlacement",
+	Attributes:               "Attributes",
+	MaintenanceWindowServers: "MaintenanceWindowServers",
+	ServerComponents:         "ServerComponents",
+	ServerCredentials:        "ServerCredentials",
+	ServerStateTransitions:   "ServerStateTransitions",
+	VersionedAttributes:      "VersionedAttributes",
 }
 
 // serverR is where relationships are stored.
 type serverR struct {
-	Attributes          AttributeSlice          `boil:"Attributes" json:"Attributes" toml:"Attributes" yaml:"Attributes"`
-	ServerComponents    ServerComponentSlice    `boil:"ServerComponents" json:"ServerComponents" toml:"ServerComponents" yaml:"ServerComponents"`
-	ServerCredentials   ServerCredentialSlice   `boil:"ServerCredentials" json:"ServerCredentials" toml:"ServerCredentials" yaml:"ServerCredentials"`
-	VersionedAttributes VersionedAttributeSlice `boil:"VersionedAttributes" json:"VersionedAttributes" toml:"VersionedAttributes" yaml:"VersionedAttributes"`
+	BomInfo                  *BomInfo                     `boil:"BomInfo" json:"BomInfo" toml:"BomInfo" yaml:"BomInfo"`
+	FirmwareSetAssignment    *FirmwareSetAssignment       `boil:"FirmwareSetAssignment" json:"FirmwareSetAssignment" toml:"FirmwareSetAssignment" yaml:"FirmwareSetAssignment"`
+	ServerLease              *ServerLease                 `boil:"ServerLease" json:"ServerLease" toml:"ServerLease" yaml:"ServerLease"`
+	ServerPlacement          *ServerPlacement             `boil:"ServerPlacement" json:"ServerPlacement" toml:"ServerPlacement" yaml:"ServerPlacement"`
+	Attributes               AttributeSlice               `boil:"Attributes" json:"Attributes" toml:"Attributes" yaml:"Attributes"`
+	MaintenanceWindowServers MaintenanceWindowServerSlice `boil:"MaintenanceWindowServers" json:"MaintenanceWindowServers" toml:"MaintenanceWindowServers" yaml:"MaintenanceWindowServers"`
+	ServerComponents         ServerComponentSlice         `boil:"ServerComponents" json:"ServerComponents" toml:"ServerComponents" yaml:"ServerComponents"`
+	ServerCredentials        ServerCredentialSlice        `boil:"ServerCredentials" json:"ServerCredentials" toml:"ServerCredentials" yaml:"ServerCredentials"`
+	ServerStateTransitions   ServerStateTransitionSlice   `boil:"ServerStateTransitions" json:"ServerStateTransitions" toml:"ServerStateTransitions" yaml:"ServerStateTransitions"`
+	VersionedAttributes      VersionedAttributeSlice      `boil:"VersionedAttributes" json:"VersionedAttributes" toml:"VersionedAttributes" yaml:"VersionedAttributes"`
 }
 
 // NewStruct creates a new relationship struct
@@ -111,6 +144,34 @@ func (*serverR) NewStruct() *serverR {
 	return &serverR{}
 }
 
+func (r *serverR) GetBomInfo() *BomInfo {
+	if r == nil {
+		return nil
+	}
+	return r.BomInfo
+}
+
+func (r *serverR) GetFirmwareSetAssignment() *FirmwareSetAssignment {
+	if r == nil {
+		return nil
+	}
+	return r.FirmwareSetAssignment
+}
+
+func (r *serverR) GetServerLease() *ServerLease {
+	if r == nil {
+		return nil
+	}
+	return r.ServerLease
+}
+
+func (r *serverR) GetServerPlacement() *ServerPlacement {
+	if r == nil {
+		return nil
+	}
+	return r.ServerPlacement
+}
+
 func (r *serverR) GetAttributes() AttributeSlice {
 	if r == nil {
 		return nil
@@ -118,6 +179,13 @@ func (r *serverR) GetAttributes() AttributeSlice {
 	return r.Attributes
 }
 
+func (r *serverR) GetMaintenanceWindowServers() MaintenanceWindowServerSlice {
+	if r == nil {
+		return nil
+	}
+	return r.MaintenanceWindowServers
+}
+
 func (r *serverR) GetServerComponents() ServerComponentSlice {
 	if r == nil {
 		return nil
@@ -132,6 +200,13 @@ func (r *serverR) GetServerCredentials() ServerCredentialSlice {
 	return r.ServerCredentials
 }
 
+func (r *serverR) GetServerStateTransitions() ServerStateTransitionSlice {
+	if r == nil {
+		return nil
+	}
+	return r.ServerStateTransitions
+}
+
 func (r *serverR) GetVersionedAttributes() VersionedAttributeSlice {
 	if r == nil {
 		return nil
@@ -143,9 +218,9 @@ func (r *serverR) GetVersionedAttributes() VersionedAttributeSlice {
 type serverL struct{}
 
 var (
-	serverAllColumns            = []string{"id", "name", "facility_code", "created_at", "updated_at", "deleted_at"}
+	serverAllColumns            = []string{"id", "name", "facility_code", "created_at", "updated_at", "deleted_at", "state", "labels"}
 	serverColumnsWithoutDefault = []string{}
-	serverColumnsWithDefault    = []string{"id", "name", "facility_code", "created_at", "updated_at", "deleted_at"}
+	serverColumnsWithDefault    = []string{"id", "name", "facility_code", "created_at", "updated_at", "deleted_at", "state", "labels"}
 	serverPrimaryKeyColumns     = []string{"id"}
 	serverGeneratedColumns      = []string{}
 )
@@ -428,6 +503,50 @@ func (q serverQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (boo
 	return count > 0, nil
 }
 
+// BomInfo pointed to by the foreign key.
+func (o *Server) BomInfo(mods ...qm.QueryMod) bomInfoQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"server_id\" = ?", o.ID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return BomInfos(queryMods...)
+}
+
+// FirmwareSetAssignment pointed to by the foreign key.
+func (o *Server) FirmwareSetAssignment(mods ...qm.QueryMod) firmwareSetAssignmentQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"server_id\" = ?", o.ID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return FirmwareSetAssignments(queryMods...)
+}
+
+// ServerLease pointed to by the foreign key.
+func (o *Server) ServerLease(mods ...qm.QueryMod) serverLeaseQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"server_id\" = ?", o.ID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return ServerLeases(queryMods...)
+}
+
+// ServerPlacement pointed to by the foreign key.
+func (o *Server) ServerPlacement(mods ...qm.QueryMod) serverPlacementQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"server_id\" = ?", o.ID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return ServerPlacements(queryMods...)
+}
+
 // Attributes retrieves all the attribute's Attributes with an executor.
 func (o *Server) Attributes(mods ...qm.QueryMod) attributeQuery {
 	var queryMods []qm.QueryMod
@@ -442,6 +561,20 @@ func (o *Server) Attributes(mods ...qm.QueryMod) attributeQuery {
 	return Attributes(queryMods...)
 }
 
+// MaintenanceWindowServers retrieves all the maintenance_window_server's MaintenanceWindowServers with an executor.
+func (o *Server) MaintenanceWindowServers(mods ...qm.QueryMod) maintenanceWindowServerQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"maintenance_window_servers\".\"server_id\"=?", o.ID),
+	)
+
+	return MaintenanceWindowServers(queryMods...)
+}
+
 // ServerComponents retrieves all the server_component's ServerComponents with an executor.
 func (o *Server) ServerComponents(mods ...qm.QueryMod) serverComponentQuery {
 	var queryMods []qm.QueryMod
@@ -470,6 +603,20 @@ func (o *Server) ServerCredentials(mods ...qm.QueryMod) serverCredentialQuery {
 	return ServerCredentials(queryMods...)
 }
 
+// ServerStateTransitions retrieves all the server_state_transition's ServerStateTransitions with an executor.
+func (o *Server) ServerStateTransitions(mods ...qm.QueryMod) serverStateTransitionQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"server_state_transitions\".\"server_id\"=?", o.ID),
+	)
+
+	return ServerStateTransitions(queryMods...)
+}
+
 // VersionedAttributes retrieves all the versioned_attribute's VersionedAttributes with an executor.
 func (o *Server) VersionedAttributes(mods ...qm.QueryMod) versionedAttributeQuery {
 	var queryMods []qm.QueryMod
@@ -484,9 +631,9 @@ func (o *Server) VersionedAttributes(mods ...qm.QueryMod) versionedAttributeQuer
 	return VersionedAttributes(queryMods...)
 }
 
-// LoadAttributes allows an eager lookup of values, cached into the
-// loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (serverL) LoadAttributes(ctx context.Context, e boil.ContextExecutor, singular bool, maybeServer interface{}, mods queries.Applicator) error {
+// LoadBomInfo allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-1 relationship.
+func (serverL) LoadBomInfo(ctx context.Context, e boil.ContextExecutor, singular bool, maybeServer interface{}, mods queries.Applicator) error {
 	var slice []*Server
 	var object *Server
 
@@ -524,8 +671,8 @@ func (serverL) LoadAttributes(ctx context.Context, e boil.ContextExecutor, singu
 	}
 
 	query := NewQuery(
-		qm.From(`attributes`),
-		qm.WhereIn(`attributes.server_id in ?`, args...),
+		qm.From(`bom_info`),
+		qm.WhereIn(`bom_info.server_id in ?`, args...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -533,45 +680,48 @@ func (serverL) LoadAttributes(ctx context.Context, e boil.ContextExecutor, singu
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load attributes")
+		return errors.Wrap(err, "failed to eager load BomInfo")
 	}
 
-	var resultSlice []*Attribute
+	var resultSlice []*BomInfo
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice attributes")
+		return errors.Wrap(err, "failed to bind eager loaded slice BomInfo")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on attributes")
+		return errors.Wrap(err, "failed to close results of eager load for bom_info")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for attributes")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for bom_info")
 	}
 
-	if len(attributeAfterSelectHooks) != 0 {
+	if len(serverAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
 			}
 		}
 	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
 	if singular {
-		object.R.Attributes = resultSlice
-		for _, foreign := range resultSlice {
-			if foreign.R == nil {
-				foreign.R = &attributeR{}
-			}
-			foreign.R.Server = object
+		foreign := resultSlice[0]
+		object.R.BomInfo = foreign
+		if foreign.R == nil {
+			foreign.R = &bomInfoR{}
 		}
-		return nil
+		foreign.R.Server = object
 	}
 
-	for _, foreign := range resultSlice {
-		for _, local := range slice {
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
 			if queries.Equal(local.ID, foreign.ServerID) {
-				local.R.Attributes = append(local.R.Attributes, foreign)
+				local.R.BomInfo = foreign
 				if foreign.R == nil {
-					foreign.R = &attributeR{}
+					foreign.R = &bomInfoR{}
 				}
 				foreign.R.Server = local
 				break
@@ -582,9 +732,9 @@ func (serverL) LoadAttributes(ctx context.Context, e boil.ContextExecutor, singu
 	return nil
 }
 
-// LoadServerComponents allows an eager lookup of values, cached into the
-// loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (serverL) LoadServerComponents(ctx context.Context, e boil.ContextExecutor, singular bool, maybeServer interface{}, mods queries.Applicator) error {
+// LoadFirmwareSetAssignment allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-1 relationship.
+func (serverL) LoadFirmwareSetAssignment(ctx context.Context, e boil.ContextExecutor, singular bool, maybeServer interface{}, mods queries.Applicator) error {
 	var slice []*Server
 	var object *Server
 
@@ -608,7 +758,7 @@ func (serverL) LoadServerComponents(ctx context.Context, e boil.ContextExecutor,
 			}
 
 			for _, a := range args {
-				if a == obj.ID {
+				if queries.Equal(a, obj.ID) {
 					continue Outer
 				}
 			}
@@ -622,8 +772,8 @@ func (serverL) LoadServerComponents(ctx context.Context, e boil.ContextExecutor,
 	}
 
 	query := NewQuery(
-		qm.From(`server_components`),
-		qm.WhereIn(`server_components.server_id in ?`, args...),
+		qm.From(`firmware_set_assignments`),
+		qm.WhereIn(`firmware_set_assignments.server_id in ?`, args...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -631,45 +781,48 @@ func (serverL) LoadServerComponents(ctx context.Context, e boil.ContextExecutor,
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load server_components")
+		return errors.Wrap(err, "failed to eager load FirmwareSetAssignment")
 	}
 
-	var resultSlice []*ServerComponent
+	var resultSlice []*FirmwareSetAssignment
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice server_components")
+		return errors.Wrap(err, "failed to bind eager loaded slice FirmwareSetAssignment")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on server_components")
+		return errors.Wrap(err, "failed to close results of eager load for firmware_set_assignments")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for server_components")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for firmware_set_assignments")
 	}
 
-	if len(serverComponentAfterSelectHooks) != 0 {
+	if len(serverAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
 			}
 		}
 	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
 	if singular {
-		object.R.ServerComponents = resultSlice
-		for _, foreign := range resultSlice {
-			if foreign.R == nil {
-				foreign.R = &serverComponentR{}
-			}
-			foreign.R.Server = object
+		foreign := resultSlice[0]
+		object.R.FirmwareSetAssignment = foreign
+		if foreign.R == nil {
+			foreign.R = &firmwareSetAssignmentR{}
 		}
-		return nil
+		foreign.R.Server = object
 	}
 
-	for _, foreign := range resultSlice {
-		for _, local := range slice {
-			if local.ID == foreign.ServerID {
-				local.R.ServerComponents = append(local.R.ServerComponents, foreign)
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.ID, foreign.ServerID) {
+				local.R.FirmwareSetAssignment = foreign
 				if foreign.R == nil {
-					foreign.R = &serverComponentR{}
+					foreign.R = &firmwareSetAssignmentR{}
 				}
 				foreign.R.Server = local
 				break
@@ -680,9 +833,9 @@ func (serverL) LoadServerComponents(ctx context.Context, e boil.ContextExecutor,
 	return nil
 }
 
-// LoadServerCredentials allows an eager lookup of values, cached into the
-// loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (serverL) LoadServerCredentials(ctx context.Context, e boil.ContextExecutor, singular bool, maybeServer interface{}, mods queries.Applicator) error {
+// LoadServerLease allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-1 relationship.
+func (serverL) LoadServerLease(ctx context.Context, e boil.ContextExecutor, singular bool, maybeServer interface{}, mods queries.Applicator) error {
 	var slice []*Server
 	var object *Server
 
@@ -720,8 +873,8 @@ func (serverL) LoadServerCredentials(ctx context.Context, e boil.ContextExecutor
 	}
 
 	query := NewQuery(
-		qm.From(`server_credentials`),
-		qm.WhereIn(`server_credentials.server_id in ?`, args...),
+		qm.From(`server_leases`),
+		qm.WhereIn(`server_leases.server_id in ?`, args...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -729,45 +882,48 @@ func (serverL) LoadServerCredentials(ctx context.Context, e boil.ContextExecutor
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load server_credentials")
+		return errors.Wrap(err, "failed to eager load ServerLease")
 	}
 
-	var resultSlice []*ServerCredential
+	var resultSlice []*ServerLease
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice server_credentials")
+		return errors.Wrap(err, "failed to bind eager loaded slice ServerLease")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on server_credentials")
+		return errors.Wrap(err, "failed to close results of eager load for server_leases")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for server_credentials")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for server_leases")
 	}
 
-	if len(serverCredentialAfterSelectHooks) != 0 {
+	if len(serverAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
 			}
 		}
 	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
 	if singular {
-		object.R.ServerCredentials = resultSlice
-		for _, foreign := range resultSlice {
-			if foreign.R == nil {
-				foreign.R = &serverCredentialR{}
-			}
-			foreign.R.Server = object
+		foreign := resultSlice[0]
+		object.R.ServerLease = foreign
+		if foreign.R == nil {
+			foreign.R = &serverLeaseR{}
 		}
-		return nil
+		foreign.R.Server = object
 	}
 
-	for _, foreign := range resultSlice {
-		for _, local := range slice {
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
 			if local.ID == foreign.ServerID {
-				local.R.ServerCredentials = append(local.R.ServerCredentials, foreign)
+				local.R.ServerLease = foreign
 				if foreign.R == nil {
-					foreign.R = &serverCredentialR{}
+					foreign.R = &serverLeaseR{}
 				}
 				foreign.R.Server = local
 				break
@@ -778,9 +934,9 @@ func (serverL) LoadServerCredentials(ctx context.Context, e boil.ContextExecutor
 	return nil
 }
 
-// LoadVersionedAttributes allows an eager lookup of values, cached into the
-// loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (serverL) LoadVersionedAttributes(ctx context.Context, e boil.ContextExecutor, singular bool, maybeServer interface{}, mods queries.Applicator) error {
+// LoadServerPlacement allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-1 relationship.
+func (serverL) LoadServerPlacement(ctx context.Context, e boil.ContextExecutor, singular bool, maybeServer interface{}, mods queries.Applicator) error {
 	var slice []*Server
 	var object *Server
 
@@ -804,7 +960,7 @@ func (serverL) LoadVersionedAttributes(ctx context.Context, e boil.ContextExecut
 			}
 
 			for _, a := range args {
-				if queries.Equal(a, obj.ID) {
+				if a == obj.ID {
 					continue Outer
 				}
 			}
@@ -818,8 +974,8 @@ func (serverL) LoadVersionedAttributes(ctx context.Context, e boil.ContextExecut
 	}
 
 	query := NewQuery(
-		qm.From(`versioned_attributes`),
-		qm.WhereIn(`versioned_attributes.server_id in ?`, args...),
+		qm.From(`server_placements`),
+		qm.WhereIn(`server_placements.server_id in ?`, args...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -827,45 +983,48 @@ func (serverL) LoadVersionedAttributes(ctx context.Context, e boil.ContextExecut
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load versioned_attributes")
+		return errors.Wrap(err, "failed to eager load ServerPlacement")
 	}
 
-	var resultSlice []*VersionedAttribute
+	var resultSlice []*ServerPlacement
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice versioned_attributes")
+		return errors.Wrap(err, "failed to bind eager loaded slice ServerPlacement")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on versioned_attributes")
+		return errors.Wrap(err, "failed to close results of eager load for server_placements")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for versioned_attributes")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for server_placements")
 	}
 
-	if len(versionedAttributeAfterSelectHooks) != 0 {
+	if len(serverAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
 			}
 		}
 	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
 	if singular {
-		object.R.VersionedAttributes = resultSlice
-		for _, foreign := range resultSlice {
-			if foreign.R == nil {
-				foreign.R = &versionedAttributeR{}
-			}
-			foreign.R.Server = object
+		foreign := resultSlice[0]
+		object.R.ServerPlacement = foreign
+		if foreign.R == nil {
+			foreign.R = &serverPlacementR{}
 		}
-		return nil
+		foreign.R.Server = object
 	}
 
-	for _, foreign := range resultSlice {
-		for _, local := range slice {
-			if queries.Equal(local.ID, foreign.ServerID) {
-				local.R.VersionedAttributes = append(local.R.VersionedAttributes, foreign)
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ID == foreign.ServerID {
+				local.R.ServerPlacement = foreign
 				if foreign.R == nil {
-					foreign.R = &versionedAttributeR{}
+					foreign.R = &serverPlacementR{}
 				}
 				foreign.R.Server = local
 				break
@@ -876,54 +1035,890 @@ func (serverL) LoadVersionedAttributes(ctx context.Context, e boil.ContextExecut
 	return nil
 }
 
-// AddAttributes adds the given related objects to the existing relationships
-// of the server, optionally inserting them as new records.
-// Appends related to o.R.Attributes.
-// Sets related.R.Server appropriately.
-func (o *Server) AddAttributes(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Attribute) error {
-	var err error
-	for _, rel := range related {
-		if insert {
-			queries.Assign(&rel.ServerID, o.ID)
-			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
-				return errors.Wrap(err, "failed to insert into foreign table")
-			}
-		} else {
-			updateQuery := fmt.Sprintf(
-				"UPDATE \"attributes\" SET %s WHERE %s",
-				strmangle.SetParamNames("\"", "\"", 1, []string{"server_id"}),
-				strmangle.WhereClause("\"", "\"", 2, attributePrimaryKeyColumns),
-			)
-			values := []interface{}{o.ID, rel.ID}
-
-			if boil.IsDebug(ctx) {
-				writer := boil.DebugWriterFrom(ctx)
-				fmt.Fprintln(writer, updateQuery)
-				fmt.Fprintln(writer, values)
-			}
-			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
-				return errors.Wrap(err, "failed to update foreign table")
-			}
+// LoadAttributes allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (serverL) LoadAttributes(ctx context.Context, e boil.ContextExecutor, singular bool, maybeServer interface{}, mods queries.Applicator) error {
+	var slice []*Server
+	var object *Server
 
-			queries.Assign(&rel.ServerID, o.ID)
-		}
+	if singular {
+		object = maybeServer.(*Server)
+	} else {
+		slice = *maybeServer.(*[]*Server)
 	}
 
-	if o.R == nil {
-		o.R = &serverR{
-			Attributes: related,
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &serverR{}
 		}
+		args = append(args, object.ID)
 	} else {
-		o.R.Attributes = append(o.R.Attributes, related...)
-	}
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &serverR{}
+			}
 
-	for _, rel := range related {
-		if rel.R == nil {
-			rel.R = &attributeR{
-				Server: o,
+			for _, a := range args {
+				if queries.Equal(a, obj.ID) {
+					continue Outer
+				}
 			}
-		} else {
-			rel.R.Server = o
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`attributes`),
+		qm.WhereIn(`attributes.server_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load attributes")
+	}
+
+	var resultSlice []*Attribute
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice attributes")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on attributes")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for attributes")
+	}
+
+	if len(attributeAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.Attributes = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &attributeR{}
+			}
+			foreign.R.Server = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.ServerID) {
+				local.R.Attributes = append(local.R.Attributes, foreign)
+				if foreign.R == nil {
+					foreign.R = &attributeR{}
+				}
+				foreign.R.Server = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadMaintenanceWindowServers allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (serverL) LoadMaintenanceWindowServers(ctx context.Context, e boil.ContextExecutor, singular bool, maybeServer interface{}, mods queries.Applicator) error {
+	var slice []*Server
+	var object *Server
+
+	if singular {
+		object = maybeServer.(*Server)
+	} else {
+		slice = *maybeServer.(*[]*Server)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &serverR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &serverR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`maintenance_window_servers`),
+		qm.WhereIn(`maintenance_window_servers.server_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load maintenance_window_servers")
+	}
+
+	var resultSlice []*MaintenanceWindowServer
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice maintenance_window_servers")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on maintenance_window_servers")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for maintenance_window_servers")
+	}
+
+	if len(maintenanceWindowServerAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.MaintenanceWindowServers = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &maintenanceWindowServerR{}
+			}
+			foreign.R.Server = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.ServerID {
+				local.R.MaintenanceWindowServers = append(local.R.MaintenanceWindowServers, foreign)
+				if foreign.R == nil {
+					foreign.R = &maintenanceWindowServerR{}
+				}
+				foreign.R.Server = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadServerComponents allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (serverL) LoadServerComponents(ctx context.Context, e boil.ContextExecutor, singular bool, maybeServer interface{}, mods queries.Applicator) error {
+	var slice []*Server
+	var object *Server
+
+	if singular {
+		object = maybeServer.(*Server)
+	} else {
+		slice = *maybeServer.(*[]*Server)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &serverR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &serverR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`server_components`),
+		qm.WhereIn(`server_components.server_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load server_components")
+	}
+
+	var resultSlice []*ServerComponent
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice server_components")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on server_components")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for server_components")
+	}
+
+	if len(serverComponentAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.ServerComponents = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &serverComponentR{}
+			}
+			foreign.R.Server = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.ServerID {
+				local.R.ServerComponents = append(local.R.ServerComponents, foreign)
+				if foreign.R == nil {
+					foreign.R = &serverComponentR{}
+				}
+				foreign.R.Server = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadServerCredentials allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (serverL) LoadServerCredentials(ctx context.Context, e boil.ContextExecutor, singular bool, maybeServer interface{}, mods queries.Applicator) error {
+	var slice []*Server
+	var object *Server
+
+	if singular {
+		object = maybeServer.(*Server)
+	} else {
+		slice = *maybeServer.(*[]*Server)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &serverR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &serverR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`server_credentials`),
+		qm.WhereIn(`server_credentials.server_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load server_credentials")
+	}
+
+	var resultSlice []*ServerCredential
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice server_credentials")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on server_credentials")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for server_credentials")
+	}
+
+	if len(serverCredentialAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.ServerCredentials = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &serverCredentialR{}
+			}
+			foreign.R.Server = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.ServerID {
+				local.R.ServerCredentials = append(local.R.ServerCredentials, foreign)
+				if foreign.R == nil {
+					foreign.R = &serverCredentialR{}
+				}
+				foreign.R.Server = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadServerStateTransitions allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (serverL) LoadServerStateTransitions(ctx context.Context, e boil.ContextExecutor, singular bool, maybeServer interface{}, mods queries.Applicator) error {
+	var slice []*Server
+	var object *Server
+
+	if singular {
+		object = maybeServer.(*Server)
+	} else {
+		slice = *maybeServer.(*[]*Server)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &serverR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &serverR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`server_state_transitions`),
+		qm.WhereIn(`server_state_transitions.server_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load server_state_transitions")
+	}
+
+	var resultSlice []*ServerStateTransition
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice server_state_transitions")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on server_state_transitions")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for server_state_transitions")
+	}
+
+	if len(serverStateTransitionAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.ServerStateTransitions = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &serverStateTransitionR{}
+			}
+			foreign.R.Server = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.ServerID {
+				local.R.ServerStateTransitions = append(local.R.ServerStateTransitions, foreign)
+				if foreign.R == nil {
+					foreign.R = &serverStateTransitionR{}
+				}
+				foreign.R.Server = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadVersionedAttributes allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (serverL) LoadVersionedAttributes(ctx context.Context, e boil.ContextExecutor, singular bool, maybeServer interface{}, mods queries.Applicator) error {
+	var slice []*Server
+	var object *Server
+
+	if singular {
+		object = maybeServer.(*Server)
+	} else {
+		slice = *maybeServer.(*[]*Server)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &serverR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &serverR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.ID) {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`versioned_attributes`),
+		qm.WhereIn(`versioned_attributes.server_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load versioned_attributes")
+	}
+
+	var resultSlice []*VersionedAttribute
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice versioned_attributes")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on versioned_attributes")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for versioned_attributes")
+	}
+
+	if len(versionedAttributeAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.VersionedAttributes = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &versionedAttributeR{}
+			}
+			foreign.R.Server = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.ServerID) {
+				local.R.VersionedAttributes = append(local.R.VersionedAttributes, foreign)
+				if foreign.R == nil {
+					foreign.R = &versionedAttributeR{}
+				}
+				foreign.R.Server = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetBomInfo of the server to the related item.
+// Sets o.R.BomInfo to related.
+// Adds o to related.R.Server.
+func (o *Server) SetBomInfo(ctx context.Context, exec boil.ContextExecutor, insert bool, related *BomInfo) error {
+	var err error
+
+	if insert {
+		queries.Assign(&related.ServerID, o.ID)
+
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	} else {
+		updateQuery := fmt.Sprintf(
+			"UPDATE \"bom_info\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, []string{"server_id"}),
+			strmangle.WhereClause("\"", "\"", 2, bomInfoPrimaryKeyColumns),
+		)
+		values := []interface{}{o.ID, related.SerialNum}
+
+		if boil.IsDebug(ctx) {
+			writer := boil.DebugWriterFrom(ctx)
+			fmt.Fprintln(writer, updateQuery)
+			fmt.Fprintln(writer, values)
+		}
+		if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+			return errors.Wrap(err, "failed to update foreign table")
+		}
+
+		queries.Assign(&related.ServerID, o.ID)
+	}
+
+	if o.R == nil {
+		o.R = &serverR{
+			BomInfo: related,
+		}
+	} else {
+		o.R.BomInfo = related
+	}
+
+	if related.R == nil {
+		related.R = &bomInfoR{
+			Server: o,
+		}
+	} else {
+		related.R.Server = o
+	}
+	return nil
+}
+
+// RemoveBomInfo relationship.
+// Sets o.R.BomInfo to nil.
+// Removes o from all passed in related items' relationships struct.
+func (o *Server) RemoveBomInfo(ctx context.Context, exec boil.ContextExecutor, related *BomInfo) error {
+	var err error
+
+	queries.SetScanner(&related.ServerID, nil)
+	if _, err = related.Update(ctx, exec, boil.Whitelist("server_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.BomInfo = nil
+	}
+
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	related.R.Server = nil
+
+	return nil
+}
+
+// SetFirmwareSetAssignment of the server to the related item.
+// Sets o.R.FirmwareSetAssignment to related.
+// Adds o to related.R.Server.
+func (o *Server) SetFirmwareSetAssignment(ctx context.Context, exec boil.ContextExecutor, insert bool, related *FirmwareSetAssignment) error {
+	var err error
+
+	if insert {
+		queries.Assign(&related.ServerID, o.ID)
+
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	} else {
+		updateQuery := fmt.Sprintf(
+			"UPDATE \"firmware_set_assignments\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, []string{"server_id"}),
+			strmangle.WhereClause("\"", "\"", 2, firmwareSetAssignmentPrimaryKeyColumns),
+		)
+		values := []interface{}{o.ID, related.ID}
+
+		if boil.IsDebug(ctx) {
+			writer := boil.DebugWriterFrom(ctx)
+			fmt.Fprintln(writer, updateQuery)
+			fmt.Fprintln(writer, values)
+		}
+		if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+			return errors.Wrap(err, "failed to update foreign table")
+		}
+
+		queries.Assign(&related.ServerID, o.ID)
+	}
+
+	if o.R == nil {
+		o.R = &serverR{
+			FirmwareSetAssignment: related,
+		}
+	} else {
+		o.R.FirmwareSetAssignment = related
+	}
+
+	if related.R == nil {
+		related.R = &firmwareSetAssignmentR{
+			Server: o,
+		}
+	} else {
+		related.R.Server = o
+	}
+	return nil
+}
+
+// RemoveFirmwareSetAssignment relationship.
+// Sets o.R.FirmwareSetAssignment to nil.
+// Removes o from all passed in related items' relationships struct.
+func (o *Server) RemoveFirmwareSetAssignment(ctx context.Context, exec boil.ContextExecutor, related *FirmwareSetAssignment) error {
+	var err error
+
+	queries.SetScanner(&related.ServerID, nil)
+	if _, err = related.Update(ctx, exec, boil.Whitelist("server_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.FirmwareSetAssignment = nil
+	}
+
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	related.R.Server = nil
+
+	return nil
+}
+
+// SetServerLease of the server to the related item.
+// Sets o.R.ServerLease to related.
+// Adds o to related.R.Server.
+func (o *Server) SetServerLease(ctx context.Context, exec boil.ContextExecutor, insert bool, related *ServerLease) error {
+	var err error
+
+	if insert {
+		related.ServerID = o.ID
+
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	} else {
+		updateQuery := fmt.Sprintf(
+			"UPDATE \"server_leases\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, []string{"server_id"}),
+			strmangle.WhereClause("\"", "\"", 2, serverLeasePrimaryKeyColumns),
+		)
+		values := []interface{}{o.ID, related.ID}
+
+		if boil.IsDebug(ctx) {
+			writer := boil.DebugWriterFrom(ctx)
+			fmt.Fprintln(writer, updateQuery)
+			fmt.Fprintln(writer, values)
+		}
+		if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+			return errors.Wrap(err, "failed to update foreign table")
+		}
+
+		related.ServerID = o.ID
+	}
+
+	if o.R == nil {
+		o.R = &serverR{
+			ServerLease: related,
+		}
+	} else {
+		o.R.ServerLease = related
+	}
+
+	if related.R == nil {
+		related.R = &serverLeaseR{
+			Server: o,
+		}
+	} else {
+		related.R.Server = o
+	}
+	return nil
+}
+
+// SetServerPlacement of the server to the related item.
+// Sets o.R.ServerPlacement to related.
+// Adds o to related.R.Server.
+func (o *Server) SetServerPlacement(ctx context.Context, exec boil.ContextExecutor, insert bool, related *ServerPlacement) error {
+	var err error
+
+	if insert {
+		related.ServerID = o.ID
+
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	} else {
+		updateQuery := fmt.Sprintf(
+			"UPDATE \"server_placements\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, []string{"server_id"}),
+			strmangle.WhereClause("\"", "\"", 2, serverPlacementPrimaryKeyColumns),
+		)
+		values := []interface{}{o.ID, related.ID}
+
+		if boil.IsDebug(ctx) {
+			writer := boil.DebugWriterFrom(ctx)
+			fmt.Fprintln(writer, updateQuery)
+			fmt.Fprintln(writer, values)
+		}
+		if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+			return errors.Wrap(err, "failed to update foreign table")
+		}
+
+		related.ServerID = o.ID
+	}
+
+	if o.R == nil {
+		o.R = &serverR{
+			ServerPlacement: related,
+		}
+	} else {
+		o.R.ServerPlacement = related
+	}
+
+	if related.R == nil {
+		related.R = &serverPlacementR{
+			Server: o,
+		}
+	} else {
+		related.R.Server = o
+	}
+	return nil
+}
+
+// AddAttributes adds the given related objects to the existing relationships
+// of the server, optionally inserting them as new records.
+// Appends related to o.R.Attributes.
+// Sets related.R.Server appropriately.
+func (o *Server) AddAttributes(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Attribute) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.ServerID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"attributes\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"server_id"}),
+				strmangle.WhereClause("\"", "\"", 2, attributePrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.ServerID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &serverR{
+			Attributes: related,
+		}
+	} else {
+		o.R.Attributes = append(o.R.Attributes, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &attributeR{
+				Server: o,
+			}
+		} else {
+			rel.R.Server = o
 		}
 	}
 	return nil
@@ -1003,6 +1998,59 @@ func (o *Server) RemoveAttributes(ctx context.Context, exec boil.ContextExecutor
 	return nil
 }
 
+// AddMaintenanceWindowServers adds the given related objects to the existing relationships
+// of the server, optionally inserting them as new records.
+// Appends related to o.R.MaintenanceWindowServers.
+// Sets related.R.Server appropriately.
+func (o *Server) AddMaintenanceWindowServers(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*MaintenanceWindowServer) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.ServerID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"maintenance_window_servers\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"server_id"}),
+				strmangle.WhereClause("\"", "\"", 2, maintenanceWindowServerPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.ServerID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &serverR{
+			MaintenanceWindowServers: related,
+		}
+	} else {
+		o.R.MaintenanceWindowServers = append(o.R.MaintenanceWindowServers, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &maintenanceWindowServerR{
+				Server: o,
+			}
+		} else {
+			rel.R.Server = o
+		}
+	}
+	return nil
+}
+
 // AddServerComponents adds the given related objects to the existing relationships
 // of the server, optionally inserting them as new records.
 // Appends related to o.R.ServerComponents.
@@ -1109,6 +2157,59 @@ func (o *Server) AddServerCredentials(ctx context.Context, exec boil.ContextExec
 	return nil
 }
 
+// AddServerStateTransitions adds the given related objects to the existing relationships
+// of the server, optionally inserting them as new records.
+// Appends related to o.R.ServerStateTransitions.
+// Sets related.R.Server appropriately.
+func (o *Server) AddServerStateTransitions(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*ServerStateTransition) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.ServerID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"server_state_transitions\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"server_id"}),
+				strmangle.WhereClause("\"", "\"", 2, serverStateTransitionPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.ServerID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &serverR{
+			ServerStateTransitions: related,
+		}
+	} else {
+		o.R.ServerStateTransitions = append(o.R.ServerStateTransitions, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &serverStateTransitionR{
+				Server: o,
+			}
+		} else {
+			rel.R.Server = o
+		}
+	}
+	return nil
+}
+
 // AddVersionedAttributes adds the given related objects to the existing relationships
 // of the server, optionally inserting them as new records.
 // Appends related to o.R.VersionedAttributes.
@@ -643,6 +643,559 @@ func testServersInsertWhitelist(t *testing.T) {
 	}
 }
 
+func testServerOneToOneBomInfoUsingBomInfo(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var foreign BomInfo
+	var local Server
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &foreign, bomInfoDBTypes, true, bomInfoColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize BomInfo struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &local, serverDBTypes, true, serverColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Server struct: %s", err)
+	}
+
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&foreign.ServerID, local.ID)
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.BomInfo().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !queries.Equal(check.ServerID, foreign.ServerID) {
+		t.Errorf("want: %v, got %v", foreign.ServerID, check.ServerID)
+	}
+
+	slice := ServerSlice{&local}
+	if err = local.L.LoadBomInfo(ctx, tx, false, (*[]*Server)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.BomInfo == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.BomInfo = nil
+	if err = local.L.LoadBomInfo(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.BomInfo == nil {
+		t.Error("struct should have been eager loaded")
+	}
+}
+
+func testServerOneToOneFirmwareSetAssignmentUsingFirmwareSetAssignment(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var foreign FirmwareSetAssignment
+	var local Server
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &foreign, firmwareSetAssignmentDBTypes, true, firmwareSetAssignmentColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FirmwareSetAssignment struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &local, serverDBTypes, true, serverColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Server struct: %s", err)
+	}
+
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&foreign.ServerID, local.ID)
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.FirmwareSetAssignment().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !queries.Equal(check.ServerID, foreign.ServerID) {
+		t.Errorf("want: %v, got %v", foreign.ServerID, check.ServerID)
+	}
+
+	slice := ServerSlice{&local}
+	if err = local.L.LoadFirmwareSetAssignment(ctx, tx, false, (*[]*Server)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.FirmwareSetAssignment == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.FirmwareSetAssignment = nil
+	if err = local.L.LoadFirmwareSetAssignment(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.FirmwareSetAssignment == nil {
+		t.Error("struct should have been eager loaded")
+	}
+}
+
+func testServerOneToOneServerLeaseUsingServerLease(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var foreign ServerLease
+	var local Server
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &foreign, serverLeaseDBTypes, true, serverLeaseColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ServerLease struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &local, serverDBTypes, true, serverColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Server struct: %s", err)
+	}
+
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreign.ServerID = local.ID
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.ServerLease().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ServerID != foreign.ServerID {
+		t.Errorf("want: %v, got %v", foreign.ServerID, check.ServerID)
+	}
+
+	slice := ServerSlice{&local}
+	if err = local.L.LoadServerLease(ctx, tx, false, (*[]*Server)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.ServerLease == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.ServerLease = nil
+	if err = local.L.LoadServerLease(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.ServerLease == nil {
+		t.Error("struct should have been eager loaded")
+	}
+}
+
+func testServerOneToOneServerPlacementUsingServerPlacement(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var foreign ServerPlacement
+	var local Server
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &foreign, serverPlacementDBTypes, true, serverPlacementColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ServerPlacement struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &local, serverDBTypes, true, serverColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Server struct: %s", err)
+	}
+
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreign.ServerID = local.ID
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.ServerPlacement().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ServerID != foreign.ServerID {
+		t.Errorf("want: %v, got %v", foreign.ServerID, check.ServerID)
+	}
+
+	slice := ServerSlice{&local}
+	if err = local.L.LoadServerPlacement(ctx, tx, false, (*[]*Server)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.ServerPlacement == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.ServerPlacement = nil
+	if err = local.L.LoadServerPlacement(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.ServerPlacement == nil {
+		t.Error("struct should have been eager loaded")
+	}
+}
+
+func testServerOneToOneSetOpBomInfoUsingBomInfo(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Server
+	var b, c BomInfo
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, serverDBTypes, false, strmangle.SetComplement(serverPrimaryKeyColumns, serverColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, bomInfoDBTypes, false, strmangle.SetComplement(bomInfoPrimaryKeyColumns, bomInfoColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, bomInfoDBTypes, false, strmangle.SetComplement(bomInfoPrimaryKeyColumns, bomInfoColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*BomInfo{&b, &c} {
+		err = a.SetBomInfo(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.BomInfo != x {
+			t.Error("relationship struct not set to correct value")
+		}
+		if x.R.Server != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+
+		if !queries.Equal(a.ID, x.ServerID) {
+			t.Error("foreign key was wrong value", a.ID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(x.ServerID))
+		reflect.Indirect(reflect.ValueOf(&x.ServerID)).Set(zero)
+
+		if err = x.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if !queries.Equal(a.ID, x.ServerID) {
+			t.Error("foreign key was wrong value", a.ID, x.ServerID)
+		}
+
+		if _, err = x.Delete(ctx, tx); err != nil {
+			t.Fatal("failed to delete x", err)
+		}
+	}
+}
+
+func testServerOneToOneRemoveOpBomInfoUsingBomInfo(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Server
+	var b BomInfo
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, serverDBTypes, false, strmangle.SetComplement(serverPrimaryKeyColumns, serverColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, bomInfoDBTypes, false, strmangle.SetComplement(bomInfoPrimaryKeyColumns, bomInfoColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.SetBomInfo(ctx, tx, true, &b); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.RemoveBomInfo(ctx, tx, &b); err != nil {
+		t.Error("failed to remove relationship")
+	}
+
+	count, err := a.BomInfo().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 0 {
+		t.Error("want no relationships remaining")
+	}
+
+	if a.R.BomInfo != nil {
+		t.Error("R struct entry should be nil")
+	}
+
+	if !queries.IsValuerNil(b.ServerID) {
+		t.Error("foreign key column should be nil")
+	}
+
+	if b.R.Server != nil {
+		t.Error("failed to remove a from b's relationships")
+	}
+}
+
+func testServerOneToOneSetOpFirmwareSetAssignmentUsingFirmwareSetAssignment(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Server
+	var b, c FirmwareSetAssignment
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, serverDBTypes, false, strmangle.SetComplement(serverPrimaryKeyColumns, serverColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, firmwareSetAssignmentDBTypes, false, strmangle.SetComplement(firmwareSetAssignmentPrimaryKeyColumns, firmwareSetAssignmentColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, firmwareSetAssignmentDBTypes, false, strmangle.SetComplement(firmwareSetAssignmentPrimaryKeyColumns, firmwareSetAssignmentColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*FirmwareSetAssignment{&b, &c} {
+		err = a.SetFirmwareSetAssignment(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.FirmwareSetAssignment != x {
+			t.Error("relationship struct not set to correct value")
+		}
+		if x.R.Server != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+
+		if !queries.Equal(a.ID, x.ServerID) {
+			t.Error("foreign key was wrong value", a.ID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(x.ServerID))
+		reflect.Indirect(reflect.ValueOf(&x.ServerID)).Set(zero)
+
+		if err = x.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if !queries.Equal(a.ID, x.ServerID) {
+			t.Error("foreign key was wrong value", a.ID, x.ServerID)
+		}
+
+		if _, err = x.Delete(ctx, tx); err != nil {
+			t.Fatal("failed to delete x", err)
+		}
+	}
+}
+
+func testServerOneToOneRemoveOpFirmwareSetAssignmentUsingFirmwareSetAssignment(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Server
+	var b FirmwareSetAssignment
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, serverDBTypes, false, strmangle.SetComplement(serverPrimaryKeyColumns, serverColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, firmwareSetAssignmentDBTypes, false, strmangle.SetComplement(firmwareSetAssignmentPrimaryKeyColumns, firmwareSetAssignmentColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.SetFirmwareSetAssignment(ctx, tx, true, &b); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.RemoveFirmwareSetAssignment(ctx, tx, &b); err != nil {
+		t.Error("failed to remove relationship")
+	}
+
+	count, err := a.FirmwareSetAssignment().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 0 {
+		t.Error("want no relationships remaining")
+	}
+
+	if a.R.FirmwareSetAssignment != nil {
+		t.Error("R struct entry should be nil")
+	}
+
+	if !queries.IsValuerNil(b.ServerID) {
+		t.Error("foreign key column should be nil")
+	}
+
+	if b.R.Server != nil {
+		t.Error("failed to remove a from b's relationships")
+	}
+}
+
+func testServerOneToOneSetOpServerLeaseUsingServerLease(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Server
+	var b, c ServerLease
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, serverDBTypes, false, strmangle.SetComplement(serverPrimaryKeyColumns, serverColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, serverLeaseDBTypes, false, strmangle.SetComplement(serverLeasePrimaryKeyColumns, serverLeaseColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, serverLeaseDBTypes, false, strmangle.SetComplement(serverLeasePrimaryKeyColumns, serverLeaseColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*ServerLease{&b, &c} {
+		err = a.SetServerLease(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.ServerLease != x {
+			t.Error("relationship struct not set to correct value")
+		}
+		if x.R.Server != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+
+		if a.ID != x.ServerID {
+			t.Error("foreign key was wrong value", a.ID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(x.ServerID))
+		reflect.Indirect(reflect.ValueOf(&x.ServerID)).Set(zero)
+
+		if err = x.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if a.ID != x.ServerID {
+			t.Error("foreign key was wrong value", a.ID, x.ServerID)
+		}
+
+		if _, err = x.Delete(ctx, tx); err != nil {
+			t.Fatal("failed to delete x", err)
+		}
+	}
+}
+func testServerOneToOneSetOpServerPlacementUsingServerPlacement(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Server
+	var b, c ServerPlacement
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, serverDBTypes, false, strmangle.SetComplement(serverPrimaryKeyColumns, serverColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, serverPlacementDBTypes, false, strmangle.SetComplement(serverPlacementPrimaryKeyColumns, serverPlacementColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, serverPlacementDBTypes, false, strmangle.SetComplement(serverPlacementPrimaryKeyColumns, serverPlacementColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*ServerPlacement{&b, &c} {
+		err = a.SetServerPlacement(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.ServerPlacement != x {
+			t.Error("relationship struct not set to correct value")
+		}
+		if x.R.Server != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+
+		if a.ID != x.ServerID {
+			t.Error("foreign key was wrong value", a.ID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(x.ServerID))
+		reflect.Indirect(reflect.ValueOf(&x.ServerID)).Set(zero)
+
+		if err = x.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if a.ID != x.ServerID {
+			t.Error("foreign key was wrong value", a.ID, x.ServerID)
+		}
+
+		if _, err = x.Delete(ctx, tx); err != nil {
+			t.Fatal("failed to delete x", err)
+		}
+	}
+}
+
 func testServerToManyAttributes(t *testing.T) {
 	var err error
 	ctx := context.Background()
@@ -650,7 +1203,84 @@ func testServerToManyAttributes(t *testing.T) {
 	defer func() { _ = tx.Rollback() }()
 
 	var a Server
-	var b, c Attribute
+	var b, c Attribute
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, serverDBTypes, true, serverColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Server struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, attributeDBTypes, false, attributeColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, attributeDBTypes, false, attributeColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&b.ServerID, a.ID)
+	queries.Assign(&c.ServerID, a.ID)
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.Attributes().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if queries.Equal(v.ServerID, b.ServerID) {
+			bFound = true
+		}
+		if queries.Equal(v.ServerID, c.ServerID) {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := ServerSlice{&a}
+	if err = a.L.LoadAttributes(ctx, tx, false, (*[]*Server)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.Attributes); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.Attributes = nil
+	if err = a.L.LoadAttributes(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.Attributes); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testServerToManyMaintenanceWindowServers(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Server
+	var b, c MaintenanceWindowServer
 
 	seed := randomize.NewSeed()
 	if err = randomize.Struct(seed, &a, serverDBTypes, true, serverColumnsWithDefault...); err != nil {
@@ -661,15 +1291,16 @@ func testServerToManyAttributes(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	if err = randomize.Struct(seed, &b, attributeDBTypes, false, attributeColumnsWithDefault...); err != nil {
+	if err = randomize.Struct(seed, &b, maintenanceWindowServerDBTypes, false, maintenanceWindowServerColumnsWithDefault...); err != nil {
 		t.Fatal(err)
 	}
-	if err = randomize.Struct(seed, &c, attributeDBTypes, false, attributeColumnsWithDefault...); err != nil {
+	if err = randomize.Struct(seed, &c, maintenanceWindowServerDBTypes, false, maintenanceWindowServerColumnsWithDefault...); err != nil {
 		t.Fatal(err)
 	}
 
-	queries.Assign(&b.ServerID, a.ID)
-	queries.Assign(&c.ServerID, a.ID)
+	b.ServerID = a.ID
+	c.ServerID = a.ID
+
 	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
 		t.Fatal(err)
 	}
@@ -677,17 +1308,17 @@ func testServerToManyAttributes(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	check, err := a.Attributes().All(ctx, tx)
+	check, err := a.MaintenanceWindowServers().All(ctx, tx)
 	if err != nil {
 		t.Fatal(err)
 	}
 
 	bFound, cFound := false, false
 	for _, v := range check {
-		if queries.Equal(v.ServerID, b.ServerID) {
+		if v.ServerID == b.ServerID {
 			bFound = true
 		}
-		if queries.Equal(v.ServerID, c.ServerID) {
+		if v.ServerID == c.ServerID {
 			cFound = true
 		}
 	}
@@ -700,18 +1331,18 @@ func testServerToManyAttributes(t *testing.T) {
 	}
 
 	slice := ServerSlice{&a}
-	if err = a.L.LoadAttributes(ctx, tx, false, (*[]*Server)(&slice), nil); err != nil {
+	if err = a.L.LoadMaintenanceWindowServers(ctx, tx, false, (*[]*Server)(&slice), nil); err != nil {
 		t.Fatal(err)
 	}
-	if got := len(a.R.Attributes); got != 2 {
+	if got := len(a.R.MaintenanceWindowServers); got != 2 {
 		t.Error("number of eager loaded records wrong, got:", got)
 	}
 
-	a.R.Attributes = nil
-	if err = a.L.LoadAttributes(ctx, tx, true, &a, nil); err != nil {
+	a.R.MaintenanceWindowServers = nil
+	if err = a.L.LoadMaintenanceWindowServers(ctx, tx, true, &a, nil); err != nil {
 		t.Fatal(err)
 	}
-	if got := len(a.R.Attributes); got != 2 {
+	if got := len(a.R.MaintenanceWindowServers); got != 2 {
 		t.Error("number of eager loaded records wrong, got:", got)
 	}
 
@@ -876,6 +1507,84 @@ func testServerToManyServerCredentials(t *testing.T) {
 	}
 }
 
+func testServerToManyServerStateTransitions(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Server
+	var b, c ServerStateTransition
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, serverDBTypes, true, serverColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Server struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, serverStateTransitionDBTypes, false, serverStateTransitionColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, serverStateTransitionDBTypes, false, serverStateTransitionColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	b.ServerID = a.ID
+	c.ServerID = a.ID
+
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.ServerStateTransitions().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if v.ServerID == b.ServerID {
+			bFound = true
+		}
+		if v.ServerID == c.ServerID {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := ServerSlice{&a}
+	if err = a.L.LoadServerStateTransitions(ctx, tx, false, (*[]*Server)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.ServerStateTransitions); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.ServerStateTransitions = nil
+	if err = a.L.LoadServerStateTransitions(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.ServerStateTransitions); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
 func testServerToManyVersionedAttributes(t *testing.T) {
 	var err error
 	ctx := context.Background()
@@ -1204,6 +1913,81 @@ func testServerToManyRemoveOpAttributes(t *testing.T) {
 	}
 }
 
+func testServerToManyAddOpMaintenanceWindowServers(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Server
+	var b, c, d, e MaintenanceWindowServer
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, serverDBTypes, false, strmangle.SetComplement(serverPrimaryKeyColumns, serverColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*MaintenanceWindowServer{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, maintenanceWindowServerDBTypes, false, strmangle.SetComplement(maintenanceWindowServerPrimaryKeyColumns, maintenanceWindowServerColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*MaintenanceWindowServer{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddMaintenanceWindowServers(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if a.ID != first.ServerID {
+			t.Error("foreign key was wrong value", a.ID, first.ServerID)
+		}
+		if a.ID != second.ServerID {
+			t.Error("foreign key was wrong value", a.ID, second.ServerID)
+		}
+
+		if first.R.Server != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.Server != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.MaintenanceWindowServers[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.MaintenanceWindowServers[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.MaintenanceWindowServers().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
 func testServerToManyAddOpServerComponents(t *testing.T) {
 	var err error
 
@@ -1354,6 +2138,81 @@ func testServerToManyAddOpServerCredentials(t *testing.T) {
 		}
 	}
 }
+func testServerToManyAddOpServerStateTransitions(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Server
+	var b, c, d, e ServerStateTransition
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, serverDBTypes, false, strmangle.SetComplement(serverPrimaryKeyColumns, serverColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*ServerStateTransition{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, serverStateTransitionDBTypes, false, strmangle.SetComplement(serverStateTransitionPrimaryKeyColumns, serverStateTransitionColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*ServerStateTransition{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddServerStateTransitions(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if a.ID != first.ServerID {
+			t.Error("foreign key was wrong value", a.ID, first.ServerID)
+		}
+		if a.ID != second.ServerID {
+			t.Error("foreign key was wrong value", a.ID, second.ServerID)
+		}
+
+		if first.R.Server != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.Server != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.ServerStateTransitions[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.ServerStateTransitions[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.ServerStateTransitions().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
 func testServerToManyAddOpVersionedAttributes(t *testing.T) {
 	var err error
 
@@ -1679,7 +2538,7 @@ func testServersSelect(t *testing.T) {
 }
 
 var (
-	serverDBTypes = map[string]string{`ID`: `uuid`, `Name`: `string`, `FacilityCode`: `string`, `CreatedAt`: `timestamptz`, `UpdatedAt`: `timestamptz`, `DeletedAt`: `timestamptz`}
+	serverDBTypes = map[string]string{`ID`: `uuid`, `Name`: `string`, `FacilityCode`: `string`, `CreatedAt`: `timestamptz`, `UpdatedAt`: `timestamptz`, `DeletedAt`: `timestamptz`, `State`: `string`, `Labels`: `jsonb`}
 	_             = bytes.MinRead
 )
 
@@ -0,0 +1,33 @@
+// Package outbox implements a transactional outbox for events. Events are
+// written to the event_outbox table in the same transaction as the change they
+// describe and a Relay publishes them to the event stream afterwards, so a
+// committed change always results in an event even when the stream is down.
+package outbox // import "go.hollow.sh/serverservice/internal/outbox"
+
+import (
+	"context"
+
+	"github.com/volatiletech/sqlboiler/v4/boil"
+
+	"go.hollow.sh/serverservice/internal/models"
+)
+
+// Enqueue stores an event in the outbox using the given executor, which should
+// be the transaction the described change is written in. Events that share a
+// partition key are published in the order they were enqueued.
+//
+// That order follows the time the event was inserted, not the time its
+// transaction committed. Changes to the same rows are serialized before their
+// events are enqueued, but events of concurrent changes to different rows of
+// a server, like two attribute namespaces, may be published in either order.
+// Consumers that need the latest state must order the events of a server by
+// the updated_at of their metadata.
+func Enqueue(ctx context.Context, exec boil.ContextExecutor, subject, partitionKey string, payload []byte) error {
+	evt := &models.EventOutbox{
+		Subject:      subject,
+		PartitionKey: partitionKey,
+		Payload:      payload,
+	}
+
+	return evt.Insert(ctx, exec, boil.Infer())
+}
@@ -0,0 +1,249 @@
+package outbox
+
+import (
+	"context"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"go.hollow.sh/toolbox/events"
+	"go.uber.org/zap"
+
+	"go.hollow.sh/serverservice/internal/models"
+)
+
+var (
+	defaultInterval    = 1 * time.Second
+	defaultBatchSize   = 100
+	defaultRetryDelay  = 1 * time.Second
+	defaultMaxAttempts = 20
+	maxRetryDelay      = 5 * time.Minute
+)
+
+// Relay drains the event outbox to an event stream. An event that fails to
+// publish is retried after a delay that doubles with each attempt, up to five
+// minutes, and later events with the same partition key are held back until
+// it goes through. After MaxAttempts it's moved to the dead letter table so
+// the rest of its partition can be published.
+//
+// Delivery is at-least-once, an event may be published again if the relay
+// stops between publishing it and removing it from the outbox.
+//
+// Several replicas may run a relay, each batch is locked for the time it's
+// published so the relays take turns. Locked rows aren't skipped: a relay
+// publishing the events after a locked one would reorder its partition.
+//
+// The stream doesn't have to be reachable when the relay starts. Without a
+// Stream the relay calls OpenStream on every pass until it succeeds, events
+// wait in the outbox meanwhile.
+type Relay struct {
+	DB     *sqlx.DB
+	Stream events.Stream
+	// OpenStream opens the stream the events are published to when Stream
+	// isn't set, the stream it returns is closed when Run returns
+	OpenStream  func() (events.Stream, error)
+	Logger      *zap.Logger
+	Interval    time.Duration
+	BatchSize   int
+	RetryDelay  time.Duration
+	MaxAttempts int
+}
+
+// Run drains the outbox every interval until the context is canceled
+func (r *Relay) Run(ctx context.Context) {
+	interval := r.Interval
+	if interval <= 0 {
+		interval = defaultInterval
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	if r.Stream == nil {
+		defer func() {
+			if r.Stream != nil {
+				// nolint:errcheck // nothing is published once the relay stopped
+				r.Stream.Close()
+			}
+		}()
+	}
+
+	for {
+		if err := r.Drain(ctx); err != nil && ctx.Err() == nil {
+			r.Logger.With(zap.Error(err)).Error("unable to drain event outbox")
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Drain publishes pending events until the outbox is empty or only events
+// held back by a failed publish remain. The stream is opened first when it
+// isn't yet.
+func (r *Relay) Drain(ctx context.Context) error {
+	if r.Stream == nil {
+		stream, err := r.OpenStream()
+		if err != nil {
+			return errors.Wrap(err, "opening the event stream")
+		}
+
+		r.Stream = stream
+	}
+
+	batchSize := r.BatchSize
+	if batchSize <= 0 {
+		batchSize = defaultBatchSize
+	}
+
+	// partitions that failed during this pass are left out of the following
+	// batches, even once their retry is due
+	blocked := map[string]bool{}
+
+	for {
+		fetched, err := r.drainBatch(ctx, batchSize, blocked)
+		if err != nil {
+			return err
+		}
+
+		if fetched < batchSize {
+			return nil
+		}
+	}
+}
+
+// drainBatch publishes a single batch of events, leaving out the partitions
+// that are blocked or waiting for a retry, and returns how many were fetched.
+// The batch is locked until it has been published and removed.
+func (r *Relay) drainBatch(ctx context.Context, batchSize int, blocked map[string]bool) (int, error) {
+	tx, err := r.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+
+	// nolint:errcheck // rollback is a no-op when the transaction is successful
+	defer tx.Rollback()
+
+	now := time.Now()
+
+	mods := []qm.QueryMod{
+		qm.Where("partition_key NOT IN (SELECT partition_key FROM event_outbox WHERE retry_at > ?)", now),
+		qm.OrderBy(models.EventOutboxColumns.Seq + " ASC"),
+		qm.Limit(batchSize),
+		qm.For("UPDATE"),
+	}
+
+	if len(blocked) > 0 {
+		keys := make([]interface{}, 0, len(blocked))
+		for k := range blocked {
+			keys = append(keys, k)
+		}
+
+		mods = append(mods, qm.WhereNotIn(models.EventOutboxColumns.PartitionKey+" NOT IN ?", keys...))
+	}
+
+	pending, err := models.EventOutboxes(mods...).All(ctx, tx)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, evt := range pending {
+		if blocked[evt.PartitionKey] {
+			continue
+		}
+
+		if err := r.Stream.Publish(ctx, evt.Subject, evt.Payload); err != nil {
+			blocked[evt.PartitionKey] = true
+
+			if err := r.failed(ctx, tx, evt, err, now); err != nil {
+				return len(pending), err
+			}
+
+			continue
+		}
+
+		if _, err := evt.Delete(ctx, tx); err != nil {
+			return len(pending), err
+		}
+	}
+
+	return len(pending), tx.Commit()
+}
+
+// failed records a failed publish of the event and schedules its retry, or
+// moves it to the dead letter table once it ran out of attempts
+func (r *Relay) failed(ctx context.Context, exec boil.ContextExecutor, evt *models.EventOutbox, pubErr error, now time.Time) error {
+	maxAttempts := r.MaxAttempts
+	if maxAttempts <= 0 {
+		maxAttempts = defaultMaxAttempts
+	}
+
+	evt.Attempts++
+	evt.LastError = null.StringFrom(pubErr.Error())
+
+	logger := r.Logger.With(zap.Error(pubErr),
+		zap.String("subject", evt.Subject),
+		zap.String("partition_key", evt.PartitionKey),
+		zap.Int64("attempts", evt.Attempts),
+	)
+
+	if evt.Attempts >= int64(maxAttempts) {
+		logger.Error("unable to publish event, moving it to the dead letters")
+
+		dead := &models.EventOutboxDeadLetter{
+			ID:           evt.ID,
+			Seq:          evt.Seq,
+			Subject:      evt.Subject,
+			PartitionKey: evt.PartitionKey,
+			Payload:      evt.Payload,
+			Attempts:     evt.Attempts,
+			LastError:    evt.LastError,
+			CreatedAt:    evt.CreatedAt,
+		}
+
+		if err := dead.Insert(ctx, exec, boil.Infer()); err != nil {
+			return err
+		}
+
+		_, err := evt.Delete(ctx, exec)
+
+		return err
+	}
+
+	logger.Warn("unable to publish event, will retry")
+
+	evt.RetryAt = null.TimeFrom(now.Add(r.retryDelay(evt.Attempts)))
+
+	_, err := evt.Update(ctx, exec, boil.Whitelist(
+		models.EventOutboxColumns.Attempts,
+		models.EventOutboxColumns.LastError,
+		models.EventOutboxColumns.RetryAt,
+	))
+
+	return err
+}
+
+// retryDelay returns how long to wait before the next attempt, the delay
+// doubles with each attempt
+func (r *Relay) retryDelay(attempts int64) time.Duration {
+	delay := r.RetryDelay
+	if delay <= 0 {
+		delay = defaultRetryDelay
+	}
+
+	for i := int64(1); i < attempts && delay < maxRetryDelay; i++ {
+		delay *= 2
+	}
+
+	if delay > maxRetryDelay {
+		return maxRetryDelay
+	}
+
+	return delay
+}
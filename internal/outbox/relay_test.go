@@ -0,0 +1,175 @@
+package outbox_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"go.hollow.sh/toolbox/events"
+	"go.uber.org/zap"
+
+	"go.hollow.sh/serverservice/internal/dbtools"
+	"go.hollow.sh/serverservice/internal/models"
+	"go.hollow.sh/serverservice/internal/outbox"
+)
+
+var errStreamDown = errors.New("stream down")
+
+// fakeStream records published subjects and fails publishing for subjects in failOn
+type fakeStream struct {
+	events.Stream
+	published []string
+	failOn    map[string]bool
+}
+
+func (f *fakeStream) Publish(_ context.Context, subject string, _ []byte) error {
+	if f.failOn[subject] {
+		return errStreamDown
+	}
+
+	f.published = append(f.published, subject)
+
+	return nil
+}
+
+func TestIntegrationRelayDrain(t *testing.T) {
+	db := dbtools.DatabaseTest(t)
+	ctx := context.TODO()
+
+	enqueue := func(subject, key string) {
+		require.NoError(t, outbox.Enqueue(ctx, db, subject, key, []byte(`{}`)))
+	}
+
+	enqueue("server.create", "server-a")
+	enqueue("server.update", "server-a")
+	enqueue("server.create", "server-b")
+	enqueue("server.delete", "server-b")
+
+	stream := &fakeStream{failOn: map[string]bool{"server.create": true}}
+	relay := &outbox.Relay{DB: db, Stream: stream, Logger: zap.NewNop(), BatchSize: 1, RetryDelay: time.Nanosecond}
+
+	require.NoError(t, relay.Drain(ctx))
+
+	// a failed event holds back later events for the same key
+	assert.Empty(t, stream.published)
+
+	pending, err := models.EventOutboxes().All(ctx, db)
+	require.NoError(t, err)
+	assert.Len(t, pending, 4)
+
+	stream.failOn = nil
+
+	require.NoError(t, relay.Drain(ctx))
+	assert.Equal(t, []string{"server.create", "server.update", "server.create", "server.delete"}, stream.published)
+
+	count, err := models.EventOutboxes().Count(ctx, db)
+	require.NoError(t, err)
+	assert.Zero(t, count)
+}
+
+func TestIntegrationRelayRecordsFailures(t *testing.T) {
+	db := dbtools.DatabaseTest(t)
+	ctx := context.TODO()
+
+	require.NoError(t, outbox.Enqueue(ctx, db, "server.update", "server-a", []byte(`{}`)))
+
+	stream := &fakeStream{failOn: map[string]bool{"server.update": true}}
+	relay := &outbox.Relay{DB: db, Stream: stream, Logger: zap.NewNop(), RetryDelay: time.Nanosecond}
+
+	require.NoError(t, relay.Drain(ctx))
+	require.NoError(t, relay.Drain(ctx))
+
+	evt, err := models.EventOutboxes().One(ctx, db)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), evt.Attempts)
+	assert.Equal(t, errStreamDown.Error(), evt.LastError.String)
+}
+
+func TestIntegrationRelaySkipsBlockedPartitions(t *testing.T) {
+	db := dbtools.DatabaseTest(t)
+	ctx := context.TODO()
+
+	// the first batch only holds events of the failing partition
+	for i := 0; i < 3; i++ {
+		require.NoError(t, outbox.Enqueue(ctx, db, "server.update", "server-a", []byte(`{}`)))
+	}
+
+	require.NoError(t, outbox.Enqueue(ctx, db, "server.create", "server-b", []byte(`{}`)))
+
+	stream := &fakeStream{failOn: map[string]bool{"server.update": true}}
+	relay := &outbox.Relay{DB: db, Stream: stream, Logger: zap.NewNop(), BatchSize: 2}
+
+	require.NoError(t, relay.Drain(ctx))
+	assert.Equal(t, []string{"server.create"}, stream.published)
+
+	// the failed partition waits for its retry
+	stream.failOn = nil
+
+	require.NoError(t, relay.Drain(ctx))
+	assert.Equal(t, []string{"server.create"}, stream.published)
+
+	pending, err := models.EventOutboxes(qm.OrderBy("seq ASC")).All(ctx, db)
+	require.NoError(t, err)
+	require.Len(t, pending, 3)
+	assert.True(t, pending[0].RetryAt.Valid)
+	assert.Equal(t, int64(1), pending[0].Attempts)
+}
+
+func TestIntegrationRelayDeadLetters(t *testing.T) {
+	db := dbtools.DatabaseTest(t)
+	ctx := context.TODO()
+
+	require.NoError(t, outbox.Enqueue(ctx, db, "server.update", "server-a", []byte(`{}`)))
+	require.NoError(t, outbox.Enqueue(ctx, db, "server.delete", "server-a", []byte(`{}`)))
+
+	stream := &fakeStream{failOn: map[string]bool{"server.update": true}}
+	relay := &outbox.Relay{DB: db, Stream: stream, Logger: zap.NewNop(), RetryDelay: time.Nanosecond, MaxAttempts: 2}
+
+	require.NoError(t, relay.Drain(ctx))
+	assert.Empty(t, stream.published)
+
+	// the second failure moves the event out of the way of its partition
+	require.NoError(t, relay.Drain(ctx))
+	require.NoError(t, relay.Drain(ctx))
+	assert.Equal(t, []string{"server.delete"}, stream.published)
+
+	dead, err := models.EventOutboxDeadLetters().All(ctx, db)
+	require.NoError(t, err)
+	require.Len(t, dead, 1)
+	assert.Equal(t, "server.update", dead[0].Subject)
+	assert.Equal(t, int64(2), dead[0].Attempts)
+	assert.Equal(t, errStreamDown.Error(), dead[0].LastError.String)
+}
+
+func TestIntegrationRelayOpensStream(t *testing.T) {
+	db := dbtools.DatabaseTest(t)
+	ctx := context.TODO()
+
+	require.NoError(t, outbox.Enqueue(ctx, db, "server.create", "server-a", []byte(`{}`)))
+
+	stream := &fakeStream{}
+	reachable := false
+	relay := &outbox.Relay{DB: db, Logger: zap.NewNop(), OpenStream: func() (events.Stream, error) {
+		if !reachable {
+			return nil, errStreamDown
+		}
+
+		return stream, nil
+	}}
+
+	// the events wait in the outbox until the stream can be opened
+	assert.ErrorIs(t, relay.Drain(ctx), errStreamDown)
+
+	count, err := models.EventOutboxes().Count(ctx, db)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), count)
+
+	reachable = true
+
+	require.NoError(t, relay.Drain(ctx))
+	assert.Equal(t, []string{"server.create"}, stream.published)
+}
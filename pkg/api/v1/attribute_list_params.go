@@ -65,9 +65,14 @@ func encodeAttributesListParams(alp []AttributeListParams, key string, q url.Val
 }
 
 func parseQueryAttributesListParams(c *gin.Context, key string) []AttributeListParams {
-	alp := []AttributeListParams{}
+	return ParseAttributeListParams(c.QueryArray(key))
+}
 
-	attrQueryParams := c.QueryArray(key)
+// ParseAttributeListParams parses attribute filters given in the format used
+// by the attr and ver_attr query params, "ns~keys.dot.seperated~operation~value"
+// optionally followed by "~or" or "~and" when more than one is given.
+func ParseAttributeListParams(attrQueryParams []string) []AttributeListParams {
+	alp := []AttributeListParams{}
 
 	for _, p := range attrQueryParams {
 		// format accepted
@@ -168,7 +173,15 @@ func (p *AttributeListParams) setJSONBWhereClause(tblName, jsonPath string, valu
 		values = append(values, p.Value)
 		where = fmt.Sprintf("json_extract_path_text(%s.data::JSONB, %s) LIKE ?", tblName, jsonPath)
 	case OperatorEqual:
-		values = append(values, p.Value)
+		value := p.Value
+
+		// MAC addresses are matched in their canonical form however they were
+		// written in the filter
+		if mac, err := ParseMACAddress(p.Value); err == nil && len(p.Keys) != 0 && isMACAttributeKey(p.Keys[len(p.Keys)-1]) {
+			value = mac.String()
+		}
+
+		values = append(values, value)
 		where = fmt.Sprintf("json_extract_path_text(%s.data::JSONB, %s) = ?", tblName, jsonPath)
 	default:
 		// we only have keys so we just want to ensure the key is there
@@ -283,6 +283,35 @@ func TestSetJSONBWhereClause(t *testing.T) {
 			"json_extract_path_text(foo.data::JSONB, ?) = ?",
 			"equal",
 		},
+		{
+			"?",
+			AttributeListParams{
+				Namespace: "hollow.versioned",
+				Keys: []string{
+					"nic",
+					"mac_address",
+				},
+				Operator: "eq",
+				Value:    "AA-BB-CC-DD-EE-FF",
+			},
+			[]interface{}{"aa:bb:cc:dd:ee:ff"},
+			"json_extract_path_text(foo.data::JSONB, ?) = ?",
+			"equal mac address",
+		},
+		{
+			"?",
+			AttributeListParams{
+				Namespace: "hollow.versioned",
+				Keys: []string{
+					"mac_address",
+				},
+				Operator: "eq",
+				Value:    "unknown",
+			},
+			[]interface{}{"unknown"},
+			"json_extract_path_text(foo.data::JSONB, ?) = ?",
+			"equal mac address key with another value",
+		},
 		{
 			"",
 			AttributeListParams{
@@ -0,0 +1,51 @@
+package serverservice
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// AttributeSelector matches servers whose attributes in Namespace hold Value
+// at the path given by Keys, for example the vendor or model.
+type AttributeSelector struct {
+	Namespace string   `json:"namespace" binding:"required"`
+	Keys      []string `json:"keys" binding:"required"`
+	Value     string   `json:"value"`
+}
+
+// matches returns true when the attributes of a server, given by namespace,
+// hold the value the selector is looking for
+func (s *AttributeSelector) matches(attrs map[string]json.RawMessage) bool {
+	data, ok := attrs[s.Namespace]
+	if !ok {
+		return false
+	}
+
+	var v interface{}
+	if err := json.Unmarshal(data, &v); err != nil {
+		return false
+	}
+
+	for _, k := range s.Keys {
+		m, ok := v.(map[string]interface{})
+		if !ok {
+			return false
+		}
+
+		v = m[k]
+	}
+
+	return v != nil && fmt.Sprint(v) == s.Value
+}
+
+// matchAttributeSelectors returns true when the attributes match all of the
+// selectors
+func matchAttributeSelectors(selectors []AttributeSelector, attrs map[string]json.RawMessage) bool {
+	for i := range selectors {
+		if !selectors[i].matches(attrs) {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,189 @@
+package serverservice
+
+import (
+	"encoding/json"
+	"net/url"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+
+	"go.hollow.sh/serverservice/internal/models"
+)
+
+// Audit event resource types
+const (
+	AuditResourceServer                     = "server"
+	AuditResourceServerAttributes           = "server-attributes"
+	AuditResourceServerVersionedAttributes  = "server-versioned-attributes"
+	AuditResourceServerComponents           = "server-components"
+	AuditResourceServerCredential           = "server-credential"
+	AuditResourceServerComponentType        = "server-component-type"
+	AuditResourceServerCredentialType       = "server-credential-type"
+	AuditResourceServerComponentFirmware    = "server-component-firmware"
+	AuditResourceServerComponentFirmwareSet = "server-component-firmware-set"
+	AuditResourceBillOfMaterials            = "bill-of-materials"
+	AuditResourceFirmwareSetAssignment      = "firmware-set-assignment"
+	AuditResourceFacility                   = "facility"
+	AuditResourceRoom                       = "room"
+	AuditResourceRow                        = "row"
+	AuditResourceRack                       = "rack"
+	AuditResourceServerPlacement            = "server-placement"
+	AuditResourceServerStateTransition      = "server-state-transition"
+	AuditResourceServerLease                = "server-lease"
+	AuditResourceMaintenanceWindow          = "maintenance-window"
+)
+
+// Audit event actions
+const (
+	AuditActionCreate = "create"
+	AuditActionUpdate = "update"
+	AuditActionDelete = "delete"
+	AuditActionRead   = "read"
+)
+
+// AuditEvent is a record of a change made through the API, or of a credential
+// being read, along with the identity that made the request.
+type AuditEvent struct {
+	ID           uuid.UUID       `json:"id"`
+	ActorSubject string          `json:"actor_subject,omitempty"`
+	ActorUser    string          `json:"actor_user,omitempty"`
+	Method       string          `json:"method"`
+	Route        string          `json:"route"`
+	Action       string          `json:"action"`
+	ResourceType string          `json:"resource_type"`
+	ResourceID   string          `json:"resource_id,omitempty"`
+	ServerID     *uuid.UUID      `json:"server_id,omitempty"`
+	Before       json.RawMessage `json:"before,omitempty"`
+	After        json.RawMessage `json:"after,omitempty"`
+	// Justification is the reason given for reading a credential
+	Justification string    `json:"justification,omitempty"`
+	CreatedAt     time.Time `json:"created_at"`
+}
+
+func (a *AuditEvent) fromDBModel(dbA *models.AuditEvent) error {
+	var err error
+
+	a.ID, err = uuid.Parse(dbA.ID)
+	if err != nil {
+		return err
+	}
+
+	if dbA.ServerID.Valid {
+		sID, err := uuid.Parse(dbA.ServerID.String)
+		if err != nil {
+			return err
+		}
+
+		a.ServerID = &sID
+	}
+
+	a.ActorSubject = dbA.ActorSubject.String
+	a.ActorUser = dbA.ActorUser.String
+	a.Method = dbA.Method
+	a.Route = dbA.Route
+	a.Action = dbA.Action
+	a.ResourceType = dbA.ResourceType
+	a.ResourceID = dbA.ResourceID.String
+	a.Justification = dbA.Justification.String
+	a.CreatedAt = dbA.CreatedAt
+
+	if dbA.Before.Valid {
+		a.Before = json.RawMessage(dbA.Before.JSON)
+	}
+
+	if dbA.After.Valid {
+		a.After = json.RawMessage(dbA.After.JSON)
+	}
+
+	return nil
+}
+
+// AuditEventListParams allows you to filter the audit log
+type AuditEventListParams struct {
+	Actor        string    `form:"actor"`
+	ResourceType string    `form:"resource_type"`
+	ResourceID   string    `form:"resource_id"`
+	ServerID     string    `form:"server_id"`
+	Action       string    `form:"action"`
+	Since        time.Time `form:"since" time_format:"2006-01-02T15:04:05Z07:00"`
+	Until        time.Time `form:"until" time_format:"2006-01-02T15:04:05Z07:00"`
+	Pagination   *PaginationParams
+}
+
+// setQuery implements the queryParams interface
+func (p *AuditEventListParams) setQuery(q url.Values) {
+	if p == nil {
+		return
+	}
+
+	if p.Actor != "" {
+		q.Set("actor", p.Actor)
+	}
+
+	if p.ResourceType != "" {
+		q.Set("resource_type", p.ResourceType)
+	}
+
+	if p.ResourceID != "" {
+		q.Set("resource_id", p.ResourceID)
+	}
+
+	if p.ServerID != "" {
+		q.Set("server_id", p.ServerID)
+	}
+
+	if p.Action != "" {
+		q.Set("action", p.Action)
+	}
+
+	if !p.Since.IsZero() {
+		q.Set("since", p.Since.Format(time.RFC3339))
+	}
+
+	if !p.Until.IsZero() {
+		q.Set("until", p.Until.Format(time.RFC3339))
+	}
+
+	p.Pagination.setQuery(q)
+}
+
+// queryMods converts the list params into sql conditions that can be added to sql queries
+func (p *AuditEventListParams) queryMods() []qm.QueryMod {
+	mods := []qm.QueryMod{}
+
+	if p.Actor != "" {
+		// match either the subject or the user name, whichever the caller knows
+		mods = append(mods, qm.Expr(
+			models.AuditEventWhere.ActorSubject.EQ(null.StringFrom(p.Actor)),
+			qm.Or2(models.AuditEventWhere.ActorUser.EQ(null.StringFrom(p.Actor))),
+		))
+	}
+
+	if p.ResourceType != "" {
+		mods = append(mods, models.AuditEventWhere.ResourceType.EQ(p.ResourceType))
+	}
+
+	if p.ResourceID != "" {
+		mods = append(mods, models.AuditEventWhere.ResourceID.EQ(null.StringFrom(p.ResourceID)))
+	}
+
+	if p.ServerID != "" {
+		mods = append(mods, models.AuditEventWhere.ServerID.EQ(null.StringFrom(p.ServerID)))
+	}
+
+	if p.Action != "" {
+		mods = append(mods, models.AuditEventWhere.Action.EQ(p.Action))
+	}
+
+	if !p.Since.IsZero() {
+		mods = append(mods, models.AuditEventWhere.CreatedAt.GTE(p.Since))
+	}
+
+	if !p.Until.IsZero() {
+		mods = append(mods, models.AuditEventWhere.CreatedAt.LT(p.Until))
+	}
+
+	return mods
+}
@@ -1,14 +1,20 @@
 package serverservice
 
 import (
-	"strings"
-
+	"github.com/google/uuid"
 	"github.com/pkg/errors"
 	"github.com/volatiletech/null/v8"
 
 	"go.hollow.sh/serverservice/internal/models"
 )
 
+var (
+	errBom = errors.New("invalid bom")
+	// errBomMacAddressOwned is returned when a bom lists a MAC address that
+	// is stored under another serial number
+	errBomMacAddressOwned = errors.New("mac address belongs to another bom")
+)
+
 // Bom provides a struct to map the bom_info table.
 // Naming conversion is strange here just in order to make it consistent
 // with generated BomInfo.
@@ -19,6 +25,10 @@ type Bom struct {
 	NumDefiPmi    string `json:"num_defi_pmi"`
 	NumDefPWD     string `json:"num_def_pwd"` // DefPWD is the IPMI Password in the portal
 	Metro         string `json:"metro"`
+
+	// ServerUUID is the server the bom is linked to, it's set by the service
+	// when a server's chassis serial or BMC MAC address matches the bom
+	ServerUUID *uuid.UUID `json:"server_uuid,omitempty"`
 }
 
 // AocMacAddressBom provides a struct to map the aoc_mac_address table.
@@ -51,6 +61,46 @@ func (b *Bom) toDBModel() (*models.BomInfo, error) {
 	return dbB, nil
 }
 
+// validate returns the error writing the bom would fail with because of a
+// blank field or an invalid MAC address, the MAC addresses are rewritten in
+// their canonical form
+func (b *Bom) validate() error {
+	if err := b.normalize(); err != nil {
+		return err
+	}
+
+	if _, err := b.toDBModel(); err != nil {
+		return err
+	}
+
+	if _, err := b.toAocMacAddressDBModels(); err != nil {
+		return err
+	}
+
+	_, err := b.toBmcMacAddressDBModels()
+
+	return err
+}
+
+// normalize rewrites the MAC address lists in their canonical form, so the
+// same address is stored and looked up the same way however it was written
+func (b *Bom) normalize() error {
+	aocMacAddrs, err := parseMACAddressList("aoc-mac-address", b.AocMacAddress)
+	if err != nil {
+		return err
+	}
+
+	bmcMacAddrs, err := parseMACAddressList("bmc-mac-address", b.BmcMacAddress)
+	if err != nil {
+		return err
+	}
+
+	b.AocMacAddress = joinMACAddresses(aocMacAddrs)
+	b.BmcMacAddress = joinMACAddresses(bmcMacAddrs)
+
+	return nil
+}
+
 // toDBModel converts BomInfo to Bom.
 func (b *Bom) fromDBModel(bomInfo *models.BomInfo) error {
 	b.SerialNum = bomInfo.SerialNum
@@ -59,23 +109,37 @@ func (b *Bom) fromDBModel(bomInfo *models.BomInfo) error {
 	b.NumDefiPmi = bomInfo.NumDefiPmi.String
 	b.NumDefPWD = bomInfo.NumDefPWD.String
 	b.Metro = bomInfo.Metro.String
+	b.ServerUUID = nil
+
+	if bomInfo.ServerID.Valid {
+		u, err := uuid.Parse(bomInfo.ServerID.String)
+		if err != nil {
+			return err
+		}
+
+		b.ServerUUID = &u
+	}
 
 	return nil
 }
 
 // toAocMacAddressDBModels converts Bom to one or multiple AocMacAddress.
 func (b *Bom) toAocMacAddressDBModels() ([]*models.AocMacAddress, error) {
-	if b.AocMacAddress == "" {
+	aocMacAddrs, err := parseMACAddressList("aoc-mac-address", b.AocMacAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(aocMacAddrs) == 0 {
 		return nil, errors.Errorf("the primary key aoc-mac-address can not be blank")
 	}
 
 	dbAs := []*models.AocMacAddress{}
 
-	AocMacAddrs := strings.Split(b.AocMacAddress, ",")
-	for _, aocMacAddr := range AocMacAddrs {
+	for _, aocMacAddr := range aocMacAddrs {
 		dbA := &models.AocMacAddress{
 			SerialNum:     b.SerialNum,
-			AocMacAddress: aocMacAddr,
+			AocMacAddress: aocMacAddr.String(),
 		}
 		dbAs = append(dbAs, dbA)
 	}
@@ -85,17 +149,21 @@ func (b *Bom) toAocMacAddressDBModels() ([]*models.AocMacAddress, error) {
 
 // toBmcMacAddressDBModels converts Bom to one or multiple BmcMacAddress.
 func (b *Bom) toBmcMacAddressDBModels() ([]*models.BMCMacAddress, error) {
-	if b.BmcMacAddress == "" {
+	bmcMacAddrs, err := parseMACAddressList("bmc-mac-address", b.BmcMacAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(bmcMacAddrs) == 0 {
 		return nil, errors.Errorf("the primary key bmc-mac-address can not be blank")
 	}
 
 	dbBs := []*models.BMCMacAddress{}
 
-	BmcMacAddrs := strings.Split(b.BmcMacAddress, ",")
-	for _, bmcMacAddr := range BmcMacAddrs {
+	for _, bmcMacAddr := range bmcMacAddrs {
 		dbB := &models.BMCMacAddress{
 			SerialNum:     b.SerialNum,
-			BMCMacAddress: bmcMacAddr,
+			BMCMacAddress: bmcMacAddr.String(),
 		}
 		dbBs = append(dbBs, dbB)
 	}
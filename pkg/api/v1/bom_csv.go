@@ -0,0 +1,204 @@
+package serverservice
+
+import (
+	"bufio"
+	"encoding/csv"
+	"io"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// Statuses of a row in a bom upload
+const (
+	BomUploadStatusCreated = "created"
+	BomUploadStatusUpdated = "updated"
+	BomUploadStatusFailed  = "failed"
+)
+
+var errBomCSV = errors.New("error in bom csv")
+
+// bomCSVColumns maps the normalized header names used by vendor manifests to
+// the Bom field they hold
+var bomCSVColumns = map[string]string{
+	"serial_num":        "serial_num",
+	"serial":            "serial_num",
+	"serial_number":     "serial_num",
+	"sn":                "serial_num",
+	"aoc_mac_address":   "aoc_mac_address",
+	"aoc_mac_addresses": "aoc_mac_address",
+	"aoc_mac":           "aoc_mac_address",
+	"bmc_mac_address":   "bmc_mac_address",
+	"bmc_mac_addresses": "bmc_mac_address",
+	"bmc_mac":           "bmc_mac_address",
+	"ipmi_mac":          "bmc_mac_address",
+	"num_defi_pmi":      "num_defi_pmi",
+	"num_def_pwd":       "num_def_pwd",
+	"metro":             "metro",
+}
+
+// BomUploadResult is the outcome of a row of a bom CSV upload, Row is the
+// line of the file the row starts on with the header on line 1.
+type BomUploadResult struct {
+	Row       int    `json:"row"`
+	SerialNum string `json:"serial_num,omitempty"`
+	Status    string `json:"status"`
+	Error     string `json:"error,omitempty"`
+}
+
+// BomUploadReport is returned by a bom CSV upload with the result of each row
+type BomUploadReport struct {
+	Created int               `json:"created"`
+	Updated int               `json:"updated"`
+	Failed  int               `json:"failed"`
+	Results []BomUploadResult `json:"results"`
+}
+
+func (r *BomUploadReport) add(row int, serialNum string, updated bool, err error) {
+	result := BomUploadResult{Row: row, SerialNum: serialNum}
+
+	switch {
+	case err != nil:
+		result.Status = BomUploadStatusFailed
+		result.Error = err.Error()
+		r.Failed++
+	case updated:
+		result.Status = BomUploadStatusUpdated
+		r.Updated++
+	default:
+		result.Status = BomUploadStatusCreated
+		r.Created++
+	}
+
+	r.Results = append(r.Results, result)
+}
+
+// bomCSVRow is a row read from a bom CSV, err is set when the row can't be
+// turned into a bom
+type bomCSVRow struct {
+	number int
+	bom    Bom
+	err    error
+}
+
+// parseBomCSV reads the boms of a vendor manifest. The first row is the
+// header, columns are matched by name and columns that don't hold a Bom field
+// are ignored. Files exported from a spreadsheet as tab separated values are
+// accepted as well. A cell can list several MAC addresses separated by
+// commas, semicolons or whitespace.
+func parseBomCSV(f io.Reader) ([]bomCSVRow, error) {
+	br := bufio.NewReader(f)
+
+	// the delimiter is picked from the header line
+	header, err := br.Peek(br.Size())
+	if err != nil && !errors.Is(err, io.EOF) && !errors.Is(err, bufio.ErrBufferFull) {
+		return nil, err
+	}
+
+	if i := strings.IndexByte(string(header), '\n'); i >= 0 {
+		header = header[:i]
+	}
+
+	cr := csv.NewReader(br)
+	cr.FieldsPerRecord = -1
+	cr.TrimLeadingSpace = true
+
+	if strings.Contains(string(header), "\t") && !strings.Contains(string(header), ",") {
+		cr.Comma = '\t'
+	}
+
+	names, err := cr.Read()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, errors.Wrap(errBomCSV, "the file is empty")
+		}
+
+		return nil, err
+	}
+
+	columns := map[int]string{}
+
+	for i, name := range names {
+		name = strings.ToLower(strings.TrimSpace(strings.TrimPrefix(name, "\ufeff")))
+		name = strings.NewReplacer(" ", "_", "-", "_", ".", "_").Replace(name)
+
+		if field, ok := bomCSVColumns[name]; ok {
+			columns[i] = field
+		}
+	}
+
+	hasSerial := false
+
+	for _, field := range columns {
+		hasSerial = hasSerial || field == "serial_num"
+	}
+
+	if !hasSerial {
+		return nil, errors.Wrap(errBomCSV, "the header has no serial number column")
+	}
+
+	rows := []bomCSVRow{}
+	line, _ := cr.FieldPos(0)
+
+	for {
+		record, err := cr.Read()
+		if errors.Is(err, io.EOF) {
+			return rows, nil
+		}
+
+		if err != nil {
+			// a row that can't be parsed ends the file, the rows read so far
+			// are still written. FieldPos can't be used here, it panics when
+			// the first field of the row is the malformed one.
+			line++
+
+			var pe *csv.ParseError
+			if errors.As(err, &pe) {
+				line = pe.StartLine
+			}
+
+			rows = append(rows, bomCSVRow{number: line, err: errors.Wrap(errBomCSV, err.Error())})
+
+			return rows, nil
+		}
+
+		line, _ = cr.FieldPos(0)
+		row := bomCSVRow{number: line}
+
+		empty := true
+
+		for i, value := range record {
+			value = strings.TrimSpace(value)
+			empty = empty && value == ""
+
+			switch columns[i] {
+			case "serial_num":
+				row.bom.SerialNum = value
+			case "aoc_mac_address":
+				row.bom.AocMacAddress = joinBomMacAddrs(value)
+			case "bmc_mac_address":
+				row.bom.BmcMacAddress = joinBomMacAddrs(value)
+			case "num_defi_pmi":
+				row.bom.NumDefiPmi = value
+			case "num_def_pwd":
+				row.bom.NumDefPWD = value
+			case "metro":
+				row.bom.Metro = value
+			}
+		}
+
+		if empty {
+			continue
+		}
+
+		rows = append(rows, row)
+	}
+}
+
+// joinBomMacAddrs returns the MAC addresses of a cell in the comma separated
+// form Bom holds them in
+func joinBomMacAddrs(cell string) string {
+	return strings.Join(strings.FieldsFunc(cell, func(r rune) bool {
+		return r == ',' || r == ';' || r == ' ' || r == '\t' || r == '\n' || r == '\r'
+	}), ",")
+}
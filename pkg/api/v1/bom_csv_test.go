@@ -0,0 +1,115 @@
+package serverservice
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseBomCSV(t *testing.T) {
+	testCases := []struct {
+		testName string
+		csv      string
+		expected []bomCSVRow
+		err      string
+	}{
+		{
+			"column names",
+			"serial_num,aoc_mac_address,bmc_mac_address,num_defi_pmi,num_def_pwd,metro\n" +
+				"sn1,aoc1,bmc1,pmi1,pwd1,da\n",
+			[]bomCSVRow{
+				{number: 2, bom: Bom{SerialNum: "sn1", AocMacAddress: "aoc1", BmcMacAddress: "bmc1", NumDefiPmi: "pmi1", NumDefPWD: "pwd1", Metro: "da"}},
+			},
+			"",
+		},
+		{
+			"vendor header aliases and unknown columns",
+			"\ufeffSerial Number,Model,AOC-MAC,IPMI MAC\n" +
+				"sn1,r640,aoc1,bmc1\n",
+			[]bomCSVRow{
+				{number: 2, bom: Bom{SerialNum: "sn1", AocMacAddress: "aoc1", BmcMacAddress: "bmc1"}},
+			},
+			"",
+		},
+		{
+			"tab separated",
+			"sn\taoc_mac\tbmc_mac\n" +
+				"sn1\taoc1,aoc2\tbmc1\n",
+			[]bomCSVRow{
+				{number: 2, bom: Bom{SerialNum: "sn1", AocMacAddress: "aoc1,aoc2", BmcMacAddress: "bmc1"}},
+			},
+			"",
+		},
+		{
+			"several MAC addresses in a cell",
+			"serial,aoc_mac,bmc_mac\n" +
+				"sn1,\"aoc1; aoc2\naoc3\",\"bmc1, bmc2,\"\n" +
+				"sn2,aoc4,bmc3\n",
+			[]bomCSVRow{
+				{number: 2, bom: Bom{SerialNum: "sn1", AocMacAddress: "aoc1,aoc2,aoc3", BmcMacAddress: "bmc1,bmc2"}},
+				{number: 4, bom: Bom{SerialNum: "sn2", AocMacAddress: "aoc4", BmcMacAddress: "bmc3"}},
+			},
+			"",
+		},
+		{
+			"empty rows are skipped",
+			"serial,aoc_mac,bmc_mac\n" +
+				",,\n" +
+				"sn1,aoc1,bmc1\n",
+			[]bomCSVRow{
+				{number: 3, bom: Bom{SerialNum: "sn1", AocMacAddress: "aoc1", BmcMacAddress: "bmc1"}},
+			},
+			"",
+		},
+		{
+			"missing serial column",
+			"aoc_mac,bmc_mac\n" +
+				"aoc1,bmc1\n",
+			nil,
+			"the header has no serial number column",
+		},
+		{
+			"empty file",
+			"",
+			nil,
+			"the file is empty",
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.testName, func(t *testing.T) {
+			rows, err := parseBomCSV(strings.NewReader(tt.csv))
+			if tt.err != "" {
+				assert.ErrorIs(t, err, errBomCSV)
+				assert.ErrorContains(t, err, tt.err)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, rows)
+		})
+	}
+}
+
+func TestParseBomCSVMalformedRow(t *testing.T) {
+	rows, err := parseBomCSV(strings.NewReader("serial,aoc_mac,bmc_mac\nsn1,aoc1,bmc1\nsn2,\"aoc2,bmc2\n"))
+	require.NoError(t, err)
+	require.Len(t, rows, 2)
+
+	assert.NoError(t, rows[0].err)
+	assert.Equal(t, 3, rows[1].number)
+	assert.ErrorIs(t, rows[1].err, errBomCSV)
+}
+
+func TestParseBomCSVMalformedFirstField(t *testing.T) {
+	rows, err := parseBomCSV(strings.NewReader("serial,aoc_mac,bmc_mac\nsn1,aoc1,bmc1\nsn\"2,aoc2,bmc2\n"))
+	require.NoError(t, err)
+	require.Len(t, rows, 2)
+
+	assert.NoError(t, rows[0].err)
+	assert.Equal(t, 3, rows[1].number)
+	assert.ErrorIs(t, rows[1].err, errBomCSV)
+}
@@ -0,0 +1,51 @@
+package serverservice
+
+import (
+	"net/url"
+	"strings"
+
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+
+	"go.hollow.sh/serverservice/internal/models"
+)
+
+// BomListParams allows you to filter the results
+type BomListParams struct {
+	Metro           string `form:"metro"`
+	SerialNumPrefix string `form:"serial_num_prefix"`
+	Pagination      *PaginationParams
+}
+
+func (p *BomListParams) setQuery(q url.Values) {
+	if p == nil {
+		return
+	}
+
+	if p.Metro != "" {
+		q.Set("metro", p.Metro)
+	}
+
+	if p.SerialNumPrefix != "" {
+		q.Set("serial_num_prefix", p.SerialNumPrefix)
+	}
+
+	p.Pagination.setQuery(q)
+}
+
+// queryMods converts the list params into sql conditions that can be added to sql queries
+func (p *BomListParams) queryMods() []qm.QueryMod {
+	mods := []qm.QueryMod{}
+
+	if p.Metro != "" {
+		mods = append(mods, models.BomInfoWhere.Metro.EQ(null.StringFrom(p.Metro)))
+	}
+
+	if p.SerialNumPrefix != "" {
+		// the prefix is matched literally, LIKE wildcards in it are escaped
+		prefix := strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(p.SerialNumPrefix)
+		mods = append(mods, qm.Where(models.BomInfoTableColumns.SerialNum+" LIKE ?", prefix+"%"))
+	}
+
+	return mods
+}
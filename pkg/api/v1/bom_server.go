@@ -0,0 +1,88 @@
+package serverservice
+
+import (
+	"sort"
+
+	"github.com/google/uuid"
+)
+
+// Slugs of the component types boms are matched against. Inventory
+// collectors report the MAC address of a bmc or nic component as its serial,
+// the serial of the chassis component is the serial printed on the server.
+const (
+	bomChassisComponentType = "chassis"
+	bomBMCComponentType     = "bmc"
+	bomNICComponentType     = "nic"
+)
+
+// observedMACAddress returns the canonical form of a MAC address reported as
+// a component serial, serials that aren't MAC addresses are returned as is
+func observedMACAddress(serial string) string {
+	mac, err := ParseMACAddress(serial)
+	if err != nil {
+		return serial
+	}
+
+	return mac.String()
+}
+
+// BomMismatch lists the differences between the MAC addresses of a bom and
+// the ones observed in the components of the server it's linked to. Missing
+// addresses are listed in the bom but weren't observed, unexpected addresses
+// were observed but aren't listed in the bom.
+type BomMismatch struct {
+	SerialNum                 string    `json:"serial_num"`
+	ServerUUID                uuid.UUID `json:"server_uuid"`
+	MissingBmcMacAddresses    []string  `json:"missing_bmc_mac_addresses,omitempty"`
+	UnexpectedBmcMacAddresses []string  `json:"unexpected_bmc_mac_addresses,omitempty"`
+	MissingAocMacAddresses    []string  `json:"missing_aoc_mac_addresses,omitempty"`
+	UnexpectedAocMacAddresses []string  `json:"unexpected_aoc_mac_addresses,omitempty"`
+}
+
+// compareBomMacAddrs sets the differences between the bom's and the observed
+// BMC and AOC MAC addresses and returns false when there are none. A kind of
+// address that wasn't observed at all isn't compared, the server's inventory
+// may not have been collected yet.
+func (m *BomMismatch) compareBomMacAddrs(bomBMC, observedBMC, bomAOC, observedAOC []string) bool {
+	if len(observedBMC) != 0 {
+		m.MissingBmcMacAddresses, m.UnexpectedBmcMacAddresses = macAddrDiff(bomBMC, observedBMC)
+	}
+
+	if len(observedAOC) != 0 {
+		m.MissingAocMacAddresses, m.UnexpectedAocMacAddresses = macAddrDiff(bomAOC, observedAOC)
+	}
+
+	return len(m.MissingBmcMacAddresses) != 0 || len(m.UnexpectedBmcMacAddresses) != 0 ||
+		len(m.MissingAocMacAddresses) != 0 || len(m.UnexpectedAocMacAddresses) != 0
+}
+
+// macAddrDiff returns the addresses only in expected and the ones only in
+// observed, sorted
+func macAddrDiff(expected, observed []string) (missing, unexpected []string) {
+	inExpected := map[string]bool{}
+	for _, a := range expected {
+		inExpected[a] = true
+	}
+
+	inObserved := map[string]bool{}
+	for _, a := range observed {
+		inObserved[a] = true
+	}
+
+	for a := range inExpected {
+		if !inObserved[a] {
+			missing = append(missing, a)
+		}
+	}
+
+	for a := range inObserved {
+		if !inExpected[a] {
+			unexpected = append(unexpected, a)
+		}
+	}
+
+	sort.Strings(missing)
+	sort.Strings(unexpected)
+
+	return missing, unexpected
+}
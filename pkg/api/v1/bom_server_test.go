@@ -0,0 +1,69 @@
+package serverservice
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBomMismatchCompareBomMacAddrs(t *testing.T) {
+	testCases := []struct {
+		testName    string
+		bomBMC      []string
+		observedBMC []string
+		bomAOC      []string
+		observedAOC []string
+		expected    BomMismatch
+		mismatch    bool
+	}{
+		{
+			"matching addresses",
+			[]string{"bmc1"},
+			[]string{"bmc1"},
+			[]string{"aoc1", "aoc2"},
+			[]string{"aoc2", "aoc1"},
+			BomMismatch{},
+			false,
+		},
+		{
+			"nothing observed isn't a mismatch",
+			[]string{"bmc1"},
+			nil,
+			[]string{"aoc1"},
+			nil,
+			BomMismatch{},
+			false,
+		},
+		{
+			"missing and unexpected addresses",
+			[]string{"bmc1"},
+			[]string{"bmc2"},
+			[]string{"aoc3", "aoc1", "aoc2"},
+			[]string{"aoc1", "aoc4"},
+			BomMismatch{
+				MissingBmcMacAddresses:    []string{"bmc1"},
+				UnexpectedBmcMacAddresses: []string{"bmc2"},
+				MissingAocMacAddresses:    []string{"aoc2", "aoc3"},
+				UnexpectedAocMacAddresses: []string{"aoc4"},
+			},
+			true,
+		},
+		{
+			"only the observed kind is compared",
+			[]string{"bmc1"},
+			[]string{"bmc1"},
+			[]string{"aoc1"},
+			nil,
+			BomMismatch{},
+			false,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.testName, func(t *testing.T) {
+			m := BomMismatch{}
+			assert.Equal(t, tt.mismatch, m.compareBomMacAddrs(tt.bomBMC, tt.observedBMC, tt.bomAOC, tt.observedAOC))
+			assert.Equal(t, tt.expected, m)
+		})
+	}
+}
@@ -13,6 +13,33 @@ var (
 	ErrNoNextPage = errors.New("no next page found")
 	// ErrUUIDParse is returned when the UUID is invalid.
 	ErrUUIDParse = errors.New("UUID parse error")
+	// ErrInvalidCursor is returned when a pagination cursor can't be decoded
+	ErrInvalidCursor = errors.New("invalid pagination cursor")
+	// ErrInvalidMACAddress is returned when a value isn't a valid MAC address
+	ErrInvalidMACAddress = errors.New("invalid MAC address")
+	// ErrInvalidServerLifecycle is returned when a server lifecycle refers to
+	// states it doesn't define
+	ErrInvalidServerLifecycle = errors.New("invalid server lifecycle")
+	// ErrInvalidLabel is returned when a label key or value isn't valid
+	ErrInvalidLabel = errors.New("invalid label")
+	// ErrInvalidLabelSelector is returned when a label selector can't be parsed
+	ErrInvalidLabelSelector = errors.New("invalid label selector")
+	// ErrExportIncomplete is returned when a server export ends without its
+	// status record, the connection was cut before the export completed
+	ErrExportIncomplete = errors.New("server export was cut short")
+	// ErrExportFailed is returned when the server reports that an export
+	// failed part way through
+	ErrExportFailed = errors.New("server export failed")
+	// ErrCredentialsIncomplete is returned when a bulk credential response
+	// ends without its status line, the connection was cut before all the
+	// credentials were sent
+	ErrCredentialsIncomplete = errors.New("server credentials response was cut short")
+	// ErrCredentialsFailed is returned when the server reports that a bulk
+	// credential request failed part way through
+	ErrCredentialsFailed = errors.New("server credentials request failed")
+	// ErrHistoryDisabled is returned when asking for changes that are only
+	// known from the recorded history while history isn't recorded
+	ErrHistoryDisabled = errors.New("server history is not recorded")
 )
 
 // ClientError is returned when invalid arguments are provided to the client
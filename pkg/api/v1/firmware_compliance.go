@@ -0,0 +1,200 @@
+package serverservice
+
+import (
+	"encoding/json"
+	"net/url"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"go.hollow.sh/serverservice/internal/models"
+)
+
+// Firmware compliance statuses of a component
+const (
+	FirmwareComplianceStatusCompliant = "compliant"
+	FirmwareComplianceStatusOutdated  = "outdated"
+	FirmwareComplianceStatusUnknown   = "unknown"
+)
+
+// DefaultFirmwareStatusNamespace is the component versioned attributes
+// namespace the installed firmware version is read from when none is given,
+// the data is expected to look like {"firmware": {"installed": "1.2.3"}}.
+const DefaultFirmwareStatusNamespace = "sh.hollow.alloy.outofband.status"
+
+// ComponentFirmwareCompliance is the firmware installed on a component
+// compared with the firmware the firmware set expects for it.
+type ComponentFirmwareCompliance struct {
+	ComponentUUID     uuid.UUID                 `json:"component_uuid"`
+	ComponentTypeSlug string                    `json:"component_type_slug"`
+	Vendor            string                    `json:"vendor"`
+	Model             string                    `json:"model"`
+	Serial            string                    `json:"serial"`
+	InstalledVersion  string                    `json:"installed_version,omitempty"`
+	Desired           *ComponentFirmwareVersion `json:"desired,omitempty"`
+	Status            string                    `json:"status"`
+}
+
+// ServerFirmwareCompliance is the firmware compliance of each component of a
+// server. The status is outdated when any component has outdated firmware,
+// unknown when the firmware of none of the components could be compared and
+// compliant otherwise. Compliant is true only when the status is compliant.
+type ServerFirmwareCompliance struct {
+	ServerUUID      uuid.UUID                     `json:"server_uuid"`
+	FirmwareSetUUID uuid.UUID                     `json:"firmware_set_uuid"`
+	Status          string                        `json:"status"`
+	Compliant       bool                          `json:"compliant"`
+	Components      []ComponentFirmwareCompliance `json:"components"`
+}
+
+// FirmwareComplianceParams selects the firmware set to compare against and,
+// for fleet wide reports, filters and paginates the servers included like the
+// server list does. The firmware set is optional for a single server, its
+// effective firmware set is used instead.
+type FirmwareComplianceParams struct {
+	FirmwareSetID    uuid.UUID `form:"-"`
+	Namespace        string    `form:"namespace"`
+	ServerListParams `form:"-"`
+}
+
+func (p *FirmwareComplianceParams) setQuery(q url.Values) {
+	if p == nil {
+		return
+	}
+
+	if p.FirmwareSetID != uuid.Nil {
+		q.Set("firmware_set", p.FirmwareSetID.String())
+	}
+
+	if p.Namespace != "" {
+		q.Set("namespace", p.Namespace)
+	}
+
+	p.ServerListParams.setQuery(q)
+}
+
+// firmwareStatusData is the part of the firmware status versioned attributes
+// holding the installed version
+type firmwareStatusData struct {
+	Firmware struct {
+		Installed string `json:"installed"`
+	} `json:"firmware"`
+}
+
+// normalizeFirmwareModel returns the model in lower case without the spaces,
+// dashes and underscores that are used inconsistently between vendor tools,
+// so "X11DPH-T" and "x11dph t" compare equal.
+func normalizeFirmwareModel(model string) string {
+	return strings.Map(func(r rune) rune {
+		switch r {
+		case ' ', '\t', '-', '_':
+			return -1
+		}
+
+		return r
+	}, strings.ToLower(model))
+}
+
+// firmwareMatches reports whether the firmware applies to the component, the
+// firmware component must match the component type slug, its vendor the
+// component vendor and one of its models the component model once both are
+// normalized.
+func firmwareMatches(fw *models.ComponentFirmwareVersion, sc *models.ServerComponent) bool {
+	if sc.R == nil || sc.R.ServerComponentType == nil {
+		return false
+	}
+
+	if !strings.EqualFold(fw.Component, sc.R.ServerComponentType.Slug) || !strings.EqualFold(fw.Vendor, sc.Vendor.String) {
+		return false
+	}
+
+	model := normalizeFirmwareModel(sc.Model.String)
+	if model == "" {
+		return false
+	}
+
+	for _, m := range fw.Model {
+		if normalizeFirmwareModel(m) == model {
+			return true
+		}
+	}
+
+	return false
+}
+
+// componentFirmwareCompliance compares the installed firmware of the
+// component, taken from the first of its loaded versioned attributes, with
+// the firmware in the set that matches it.
+func componentFirmwareCompliance(sc *models.ServerComponent, firmwares []*models.ComponentFirmwareVersion) (ComponentFirmwareCompliance, error) {
+	var err error
+
+	cc := ComponentFirmwareCompliance{
+		Vendor: sc.Vendor.String,
+		Model:  sc.Model.String,
+		Serial: sc.Serial.String,
+		Status: FirmwareComplianceStatusUnknown,
+	}
+
+	cc.ComponentUUID, err = uuid.Parse(sc.ID)
+	if err != nil {
+		return cc, err
+	}
+
+	if sc.R == nil {
+		return cc, nil
+	}
+
+	if sc.R.ServerComponentType != nil {
+		cc.ComponentTypeSlug = sc.R.ServerComponentType.Slug
+	}
+
+	if len(sc.R.VersionedAttributes) > 0 {
+		var status firmwareStatusData
+
+		// data that doesn't hold a firmware status leaves the version unknown
+		if json.Unmarshal(sc.R.VersionedAttributes[0].Data, &status) == nil {
+			cc.InstalledVersion = status.Firmware.Installed
+		}
+	}
+
+	for _, fw := range firmwares {
+		if !firmwareMatches(fw, sc) {
+			continue
+		}
+
+		cc.Desired = &ComponentFirmwareVersion{}
+		if err := cc.Desired.fromDBModel(fw); err != nil {
+			return cc, err
+		}
+
+		break
+	}
+
+	switch {
+	case cc.Desired == nil || cc.InstalledVersion == "":
+		cc.Status = FirmwareComplianceStatusUnknown
+	case cc.InstalledVersion == cc.Desired.Version:
+		cc.Status = FirmwareComplianceStatusCompliant
+	default:
+		cc.Status = FirmwareComplianceStatusOutdated
+	}
+
+	return cc, nil
+}
+
+// serverFirmwareStatus returns the status of a server from the status of its
+// components.
+func serverFirmwareStatus(components []ComponentFirmwareCompliance) string {
+	status := FirmwareComplianceStatusUnknown
+
+	for _, cc := range components {
+		switch cc.Status {
+		case FirmwareComplianceStatusOutdated:
+			return FirmwareComplianceStatusOutdated
+		case FirmwareComplianceStatusCompliant:
+			status = FirmwareComplianceStatusCompliant
+		}
+	}
+
+	return status
+}
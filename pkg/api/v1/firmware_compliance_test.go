@@ -0,0 +1,143 @@
+package serverservice
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/types"
+
+	"go.hollow.sh/serverservice/internal/models"
+)
+
+func TestComponentFirmwareCompliance(t *testing.T) {
+	bmc := &models.ServerComponentType{ID: uuid.NewString(), Slug: "bmc"}
+
+	firmwares := []*models.ComponentFirmwareVersion{
+		{ID: uuid.NewString(), Component: "bios", Vendor: "dell", Model: types.StringArray{"r640"}, Version: "2.4.4"},
+		{ID: uuid.NewString(), Component: "bmc", Vendor: "dell", Model: types.StringArray{"r6515", "r640"}, Version: "5.10.00.00"},
+	}
+
+	testCases := []struct {
+		name            string
+		vendor          string
+		model           string
+		status          *models.VersionedAttribute
+		expectedStatus  string
+		expectedDesired bool
+	}{
+		{
+			"compliant",
+			"Dell",
+			"R640",
+			&models.VersionedAttribute{Data: types.JSON(`{"firmware":{"installed":"5.10.00.00"}}`)},
+			FirmwareComplianceStatusCompliant,
+			true,
+		},
+		{
+			"outdated",
+			"Dell",
+			"R640",
+			&models.VersionedAttribute{Data: types.JSON(`{"firmware":{"installed":"4.40.00.00"}}`)},
+			FirmwareComplianceStatusOutdated,
+			true,
+		},
+		{
+			"installed version not reported",
+			"Dell",
+			"R640",
+			nil,
+			FirmwareComplianceStatusUnknown,
+			true,
+		},
+		{
+			"model normalized",
+			"Dell",
+			" r-640",
+			&models.VersionedAttribute{Data: types.JSON(`{"firmware":{"installed":"5.10.00.00"}}`)},
+			FirmwareComplianceStatusCompliant,
+			true,
+		},
+		{
+			"model only containing a firmware model",
+			"Dell",
+			"R6400",
+			&models.VersionedAttribute{Data: types.JSON(`{"firmware":{"installed":"5.10.00.00"}}`)},
+			FirmwareComplianceStatusUnknown,
+			false,
+		},
+		{
+			"no firmware for the model",
+			"Dell",
+			"R750",
+			&models.VersionedAttribute{Data: types.JSON(`{"firmware":{"installed":"5.10.00.00"}}`)},
+			FirmwareComplianceStatusUnknown,
+			false,
+		},
+		{
+			"no firmware for the vendor",
+			"Supermicro",
+			"X11DPH-T",
+			&models.VersionedAttribute{Data: types.JSON(`{"firmware":{"installed":"1.0"}}`)},
+			FirmwareComplianceStatusUnknown,
+			false,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			sc := &models.ServerComponent{
+				ID:                    uuid.NewString(),
+				ServerComponentTypeID: bmc.ID,
+				Vendor:                null.StringFrom(tt.vendor),
+				Model:                 null.StringFrom(tt.model),
+			}
+
+			sc.R = sc.R.NewStruct()
+			sc.R.ServerComponentType = bmc
+
+			if tt.status != nil {
+				sc.R.VersionedAttributes = models.VersionedAttributeSlice{tt.status}
+			}
+
+			cc, err := componentFirmwareCompliance(sc, firmwares)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.expectedStatus, cc.Status)
+			assert.Equal(t, "bmc", cc.ComponentTypeSlug)
+
+			if !tt.expectedDesired {
+				assert.Nil(t, cc.Desired)
+				return
+			}
+
+			require.NotNil(t, cc.Desired)
+			assert.Equal(t, "5.10.00.00", cc.Desired.Version)
+		})
+	}
+}
+
+func TestServerFirmwareStatus(t *testing.T) {
+	compliant := ComponentFirmwareCompliance{Status: FirmwareComplianceStatusCompliant}
+	outdated := ComponentFirmwareCompliance{Status: FirmwareComplianceStatusOutdated}
+	unknown := ComponentFirmwareCompliance{Status: FirmwareComplianceStatusUnknown}
+
+	testCases := []struct {
+		name       string
+		components []ComponentFirmwareCompliance
+		expected   string
+	}{
+		{"no components", nil, FirmwareComplianceStatusUnknown},
+		{"no evaluable components", []ComponentFirmwareCompliance{unknown, unknown}, FirmwareComplianceStatusUnknown},
+		{"compliant", []ComponentFirmwareCompliance{unknown, compliant}, FirmwareComplianceStatusCompliant},
+		{"outdated", []ComponentFirmwareCompliance{compliant, outdated, unknown}, FirmwareComplianceStatusOutdated},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, serverFirmwareStatus(tt.components))
+		})
+	}
+}
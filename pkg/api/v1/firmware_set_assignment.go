@@ -0,0 +1,199 @@
+package serverservice
+
+import (
+	"encoding/json"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/volatiletech/null/v8"
+
+	"go.hollow.sh/serverservice/internal/models"
+)
+
+// Sources of an effective firmware set
+const (
+	FirmwareSetSourceDirect   = "direct"
+	FirmwareSetSourceSelector = "selector"
+)
+
+var errFirmwareSetAssignment = errors.New("error in firmware set assignment")
+
+// FirmwareSetSelectorAttribute is the attribute selector of a firmware set
+// assignment
+type FirmwareSetSelectorAttribute = AttributeSelector
+
+// FirmwareSetAssignment assigns a firmware set to a single server when
+// ServerUUID is set, otherwise to every server matching the facility code and
+// all of the attribute selectors.
+//
+// A server's direct assignment always takes precedence. Among the selector
+// assignments matching a server the one with the highest priority wins, ties
+// go to the more specific selector and then to the most recently created.
+type FirmwareSetAssignment struct {
+	UUID            uuid.UUID                      `json:"uuid"`
+	FirmwareSetUUID uuid.UUID                      `json:"firmware_set_uuid"`
+	ServerUUID      *uuid.UUID                     `json:"server_uuid,omitempty"`
+	FacilityCode    string                         `json:"facility_code,omitempty"`
+	Attributes      []FirmwareSetSelectorAttribute `json:"attributes,omitempty"`
+	Priority        int64                          `json:"priority"`
+	CreatedAt       time.Time                      `json:"created_at"`
+	UpdatedAt       time.Time                      `json:"updated_at"`
+}
+
+// EffectiveFirmwareSet is the firmware set that applies to a server and the
+// assignment it comes from
+type EffectiveFirmwareSet struct {
+	ServerUUID  uuid.UUID             `json:"server_uuid"`
+	Source      string                `json:"source"`
+	Assignment  FirmwareSetAssignment `json:"assignment"`
+	FirmwareSet ComponentFirmwareSet  `json:"firmware_set"`
+}
+
+func (a *FirmwareSetAssignment) fromDBModel(dbA *models.FirmwareSetAssignment) error {
+	var err error
+
+	a.UUID, err = uuid.Parse(dbA.ID)
+	if err != nil {
+		return err
+	}
+
+	a.FirmwareSetUUID, err = uuid.Parse(dbA.FirmwareSetID)
+	if err != nil {
+		return err
+	}
+
+	if dbA.ServerID.Valid {
+		sID, err := uuid.Parse(dbA.ServerID.String)
+		if err != nil {
+			return err
+		}
+
+		a.ServerUUID = &sID
+	}
+
+	a.FacilityCode = dbA.FacilityCode.String
+	a.Priority = dbA.Priority
+	a.CreatedAt = dbA.CreatedAt.Time
+	a.UpdatedAt = dbA.UpdatedAt.Time
+
+	if dbA.Attributes.Valid {
+		if err := json.Unmarshal(dbA.Attributes.JSON, &a.Attributes); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (a *FirmwareSetAssignment) toDBModel(firmwareSetID string) (*models.FirmwareSetAssignment, error) {
+	dbA := &models.FirmwareSetAssignment{
+		FirmwareSetID: firmwareSetID,
+		FacilityCode:  null.NewString(a.FacilityCode, a.FacilityCode != ""),
+		Priority:      a.Priority,
+	}
+
+	if a.ServerUUID != nil {
+		if a.FacilityCode != "" || len(a.Attributes) > 0 {
+			return nil, errors.Wrap(errFirmwareSetAssignment, "a server assignment can't have selectors")
+		}
+
+		dbA.ServerID = null.StringFrom(a.ServerUUID.String())
+	}
+
+	if len(a.Attributes) > 0 {
+		data, err := json.Marshal(a.Attributes)
+		if err != nil {
+			return nil, err
+		}
+
+		dbA.Attributes = null.JSONFrom(data)
+	}
+
+	return dbA, nil
+}
+
+// firmwareSetSelector is a selector assignment with its attributes decoded
+type firmwareSetSelector struct {
+	assignment *models.FirmwareSetAssignment
+	attributes []FirmwareSetSelectorAttribute
+}
+
+func (s *firmwareSetSelector) specificity() int {
+	n := len(s.attributes)
+	if s.assignment.FacilityCode.Valid {
+		n++
+	}
+
+	return n
+}
+
+func (s *firmwareSetSelector) matches(srv *models.Server, attrs map[string]json.RawMessage) bool {
+	if s.assignment.FacilityCode.Valid && s.assignment.FacilityCode.String != srv.FacilityCode.String {
+		return false
+	}
+
+	return matchAttributeSelectors(s.attributes, attrs)
+}
+
+// firmwareSetResolver resolves the effective firmware set of servers from
+// their direct assignments and the selector assignments.
+type firmwareSetResolver struct {
+	direct    map[string]*models.FirmwareSetAssignment
+	selectors []*firmwareSetSelector
+}
+
+func newFirmwareSetResolver(assignments models.FirmwareSetAssignmentSlice) (*firmwareSetResolver, error) {
+	fr := &firmwareSetResolver{direct: map[string]*models.FirmwareSetAssignment{}}
+
+	for _, a := range assignments {
+		if a.ServerID.Valid {
+			fr.direct[a.ServerID.String] = a
+			continue
+		}
+
+		s := &firmwareSetSelector{assignment: a}
+
+		if a.Attributes.Valid {
+			if err := json.Unmarshal(a.Attributes.JSON, &s.attributes); err != nil {
+				return nil, err
+			}
+		}
+
+		fr.selectors = append(fr.selectors, s)
+	}
+
+	// order the selectors by precedence so the first match wins
+	sort.SliceStable(fr.selectors, func(i, j int) bool {
+		a, b := fr.selectors[i], fr.selectors[j]
+
+		if a.assignment.Priority != b.assignment.Priority {
+			return a.assignment.Priority > b.assignment.Priority
+		}
+
+		if a.specificity() != b.specificity() {
+			return a.specificity() > b.specificity()
+		}
+
+		return a.assignment.CreatedAt.Time.After(b.assignment.CreatedAt.Time)
+	})
+
+	return fr, nil
+}
+
+// resolve returns the assignment providing the effective firmware set of the
+// server, given its attributes by namespace, and where it comes from.
+func (fr *firmwareSetResolver) resolve(srv *models.Server, attrs map[string]json.RawMessage) (*models.FirmwareSetAssignment, string) {
+	if a, ok := fr.direct[srv.ID]; ok {
+		return a, FirmwareSetSourceDirect
+	}
+
+	for _, s := range fr.selectors {
+		if s.matches(srv, attrs) {
+			return s.assignment, FirmwareSetSourceSelector
+		}
+	}
+
+	return nil, ""
+}
@@ -0,0 +1,133 @@
+package serverservice
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/volatiletech/null/v8"
+
+	"go.hollow.sh/serverservice/internal/models"
+)
+
+func TestFirmwareSetResolver(t *testing.T) {
+	now := time.Now()
+
+	srv := &models.Server{ID: uuid.NewString(), FacilityCode: null.StringFrom("ams1")}
+	attrs := map[string]json.RawMessage{
+		"sh.hollow.metadata": json.RawMessage(`{"vendor":"dell","model":{"name":"r640"}}`),
+	}
+
+	facility := &models.FirmwareSetAssignment{
+		ID:           uuid.NewString(),
+		FacilityCode: null.StringFrom("ams1"),
+		CreatedAt:    null.TimeFrom(now),
+	}
+	model := &models.FirmwareSetAssignment{
+		ID:           uuid.NewString(),
+		FacilityCode: null.StringFrom("ams1"),
+		Attributes:   null.JSONFrom([]byte(`[{"namespace":"sh.hollow.metadata","keys":["model","name"],"value":"r640"}]`)),
+		CreatedAt:    null.TimeFrom(now.Add(-time.Hour)),
+	}
+	otherModel := &models.FirmwareSetAssignment{
+		ID:         uuid.NewString(),
+		Attributes: null.JSONFrom([]byte(`[{"namespace":"sh.hollow.metadata","keys":["model","name"],"value":"r6515"}]`)),
+		Priority:   10,
+		CreatedAt:  null.TimeFrom(now),
+	}
+	direct := &models.FirmwareSetAssignment{
+		ID:        uuid.NewString(),
+		ServerID:  null.StringFrom(srv.ID),
+		CreatedAt: null.TimeFrom(now.Add(-2 * time.Hour)),
+	}
+
+	testCases := []struct {
+		testName    string
+		assignments models.FirmwareSetAssignmentSlice
+		attrs       map[string]json.RawMessage
+		expected    *models.FirmwareSetAssignment
+		source      string
+	}{
+		{
+			"nothing assigned",
+			models.FirmwareSetAssignmentSlice{otherModel},
+			attrs,
+			nil,
+			"",
+		},
+		{
+			"more specific selector wins",
+			models.FirmwareSetAssignmentSlice{facility, model, otherModel},
+			attrs,
+			model,
+			FirmwareSetSourceSelector,
+		},
+		{
+			"missing attributes don't match",
+			models.FirmwareSetAssignmentSlice{facility, model},
+			nil,
+			facility,
+			FirmwareSetSourceSelector,
+		},
+		{
+			"direct assignment wins",
+			models.FirmwareSetAssignmentSlice{facility, model, direct},
+			attrs,
+			direct,
+			FirmwareSetSourceDirect,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.testName, func(t *testing.T) {
+			fr, err := newFirmwareSetResolver(tt.assignments)
+			assert.NoError(t, err)
+
+			a, source := fr.resolve(srv, tt.attrs)
+			assert.Equal(t, tt.expected, a)
+			assert.Equal(t, tt.source, source)
+		})
+	}
+
+	// priority overrides specificity
+	prioritized := *facility
+	prioritized.Priority = 5
+
+	fr, err := newFirmwareSetResolver(models.FirmwareSetAssignmentSlice{model, &prioritized})
+	assert.NoError(t, err)
+
+	a, _ := fr.resolve(srv, attrs)
+	assert.Equal(t, &prioritized, a)
+}
+
+func TestFirmwareSetAssignmentToDBModel(t *testing.T) {
+	srvID := uuid.New()
+
+	_, err := (&FirmwareSetAssignment{ServerUUID: &srvID, FacilityCode: "ams1"}).toDBModel(uuid.NewString())
+	assert.ErrorIs(t, err, errFirmwareSetAssignment)
+
+	dbA, err := (&FirmwareSetAssignment{ServerUUID: &srvID}).toDBModel(uuid.NewString())
+	assert.NoError(t, err)
+	assert.Equal(t, srvID.String(), dbA.ServerID.String)
+}
+
+func TestBatchIDs(t *testing.T) {
+	testCases := []struct {
+		name     string
+		ids      []string
+		expected [][]string
+	}{
+		{"none", nil, [][]string{}},
+		{"less than a batch", []string{"a"}, [][]string{{"a"}}},
+		{"full batches", []string{"a", "b", "c", "d"}, [][]string{{"a", "b"}, {"c", "d"}}},
+		{"partial last batch", []string{"a", "b", "c"}, [][]string{{"a", "b"}, {"c"}}},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, batchIDs(tt.ids, 2))
+		})
+	}
+}
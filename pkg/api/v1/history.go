@@ -0,0 +1,45 @@
+package serverservice
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+
+	"go.hollow.sh/serverservice/internal/models"
+)
+
+// History entities are the kinds of records kept in a server's change history
+const (
+	HistoryEntityServer          = "server"
+	HistoryEntityAttributes      = "attributes"
+	HistoryEntityServerComponent = "server-component"
+)
+
+// ServerHistoryRecord is the state of a server, or one of its attributes or
+// components, as it was written at RecordedAt. A nil Snapshot means the
+// entity was removed.
+type ServerHistoryRecord struct {
+	Entity     string          `json:"entity"`
+	EntityID   uuid.UUID       `json:"entity_id"`
+	Snapshot   json.RawMessage `json:"snapshot,omitempty"`
+	RecordedAt time.Time       `json:"recorded_at"`
+}
+
+func (h *ServerHistoryRecord) fromDBModel(dbH *models.ServerHistory) error {
+	var err error
+
+	h.EntityID, err = uuid.Parse(dbH.EntityID)
+	if err != nil {
+		return err
+	}
+
+	h.Entity = dbH.Entity
+	h.RecordedAt = dbH.RecordedAt
+
+	if dbH.Snapshot.Valid {
+		h.Snapshot = json.RawMessage(dbH.Snapshot.JSON)
+	}
+
+	return nil
+}
@@ -0,0 +1,22 @@
+package serverservice
+
+import (
+	"context"
+
+	"gocloud.dev/secrets"
+
+	"go.hollow.sh/serverservice/internal/dbtools"
+)
+
+// Keyring encrypts the server credentials the router stores and decrypts them
+// when they're read
+type Keyring interface {
+	Encrypt(ctx context.Context, plaintext string) (string, error)
+	Decrypt(ctx context.Context, ciphertext string) (string, error)
+}
+
+// NewKeyring returns a keyring that encrypts with the keeper, the key ID is
+// stored with each value so the key can be rotated later
+func NewKeyring(keyID string, keeper *secrets.Keeper) (Keyring, error) {
+	return dbtools.NewKeyring(keyID, keeper)
+}
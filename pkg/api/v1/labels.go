@@ -0,0 +1,283 @@
+package serverservice
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/types"
+)
+
+var (
+	// labelNameRegex matches label names and non empty values, at most 63
+	// alphanumeric characters, dashes, underscores and dots that start and end
+	// with an alphanumeric character
+	labelNameRegex = regexp.MustCompile(`^[A-Za-z0-9]([-A-Za-z0-9_.]{0,61}[A-Za-z0-9])?$`)
+	// labelPrefixRegex matches the DNS subdomain a label key may be prefixed
+	// with, like "hollow.sh/"
+	labelPrefixRegex = regexp.MustCompile(`^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$`)
+	// labelSetRegex matches the set based requirements of a label selector
+	labelSetRegex = regexp.MustCompile(`^(\S+)\s+(in|notin)\s*\((.*)\)$`)
+)
+
+const labelPrefixMaxLength = 253
+
+type labelOperator string
+
+const (
+	labelOpExists    labelOperator = "exists"
+	labelOpNotExists labelOperator = "!"
+	labelOpEquals    labelOperator = "="
+	labelOpNotEquals labelOperator = "!="
+	labelOpIn        labelOperator = "in"
+	labelOpNotIn     labelOperator = "notin"
+)
+
+// labelRequirement is one of the comma separated requirements of a label
+// selector
+type labelRequirement struct {
+	key      string
+	operator labelOperator
+	values   []string
+}
+
+// validateLabelKey checks the key is a name optionally prefixed with a DNS
+// subdomain and a slash, like "role" or "hollow.sh/role"
+func validateLabelKey(key string) error {
+	name := key
+
+	if i := strings.Index(key, "/"); i >= 0 {
+		prefix := key[:i]
+		name = key[i+1:]
+
+		if len(prefix) > labelPrefixMaxLength || !labelPrefixRegex.MatchString(prefix) {
+			return errors.Wrap(ErrInvalidLabel, fmt.Sprintf("key %q has an invalid prefix", key))
+		}
+	}
+
+	if !labelNameRegex.MatchString(name) {
+		return errors.Wrap(ErrInvalidLabel, fmt.Sprintf("key %q has an invalid name", key))
+	}
+
+	return nil
+}
+
+func validateLabelValue(key, value string) error {
+	if value != "" && !labelNameRegex.MatchString(value) {
+		return errors.Wrap(ErrInvalidLabel, fmt.Sprintf("value %q of key %q is invalid", value, key))
+	}
+
+	return nil
+}
+
+func validateLabels(labels map[string]string) error {
+	keys := make([]string, 0, len(labels))
+	for k := range labels {
+		keys = append(keys, k)
+	}
+
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		if err := validateLabelKey(k); err != nil {
+			return err
+		}
+
+		if err := validateLabelValue(k, labels[k]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// labelsToDBModel validates the labels and returns them as stored, nil labels
+// are returned as nil so the column default applies on insert
+func labelsToDBModel(labels map[string]string) (types.JSON, error) {
+	if labels == nil {
+		return nil, nil
+	}
+
+	if err := validateLabels(labels); err != nil {
+		return nil, err
+	}
+
+	data, err := json.Marshal(labels)
+	if err != nil {
+		return nil, err
+	}
+
+	return types.JSON(data), nil
+}
+
+func labelsFromDBModel(data types.JSON) (map[string]string, error) {
+	if len(data) == 0 {
+		return nil, nil
+	}
+
+	labels := map[string]string{}
+	if err := json.Unmarshal(data, &labels); err != nil {
+		return nil, err
+	}
+
+	return labels, nil
+}
+
+// parseLabelSelector parses a comma separated list of label requirements, all
+// of which must be met, like "env=prod,role in (storage,compute),!quarantined".
+// A requirement is one of:
+//
+//	key                   the label is set
+//	!key                  the label isn't set
+//	key=value, key==value the label is set to value
+//	key!=value            the label isn't set to value, or isn't set
+//	key in (v1,v2)        the label is set to one of the values
+//	key notin (v1,v2)     the label isn't set to any of the values, or isn't set
+func parseLabelSelector(selector string) ([]labelRequirement, error) {
+	if strings.TrimSpace(selector) == "" {
+		return nil, nil
+	}
+
+	terms, err := splitLabelSelector(selector)
+	if err != nil {
+		return nil, err
+	}
+
+	reqs := make([]labelRequirement, 0, len(terms))
+
+	for _, term := range terms {
+		req, err := parseLabelRequirement(term)
+		if err != nil {
+			return nil, err
+		}
+
+		reqs = append(reqs, req)
+	}
+
+	return reqs, nil
+}
+
+// splitLabelSelector splits the selector on the commas that aren't part of a
+// set of values
+func splitLabelSelector(selector string) ([]string, error) {
+	terms := []string{}
+	depth := 0
+	start := 0
+
+	for i, r := range selector {
+		switch r {
+		case '(':
+			depth++
+		case ')':
+			depth--
+		case ',':
+			if depth == 0 {
+				terms = append(terms, selector[start:i])
+				start = i + 1
+			}
+		}
+
+		if depth < 0 || depth > 1 {
+			return nil, errors.Wrap(ErrInvalidLabelSelector, "unbalanced parentheses")
+		}
+	}
+
+	if depth != 0 {
+		return nil, errors.Wrap(ErrInvalidLabelSelector, "unbalanced parentheses")
+	}
+
+	return append(terms, selector[start:]), nil
+}
+
+func parseLabelRequirement(term string) (labelRequirement, error) {
+	term = strings.TrimSpace(term)
+	req := labelRequirement{}
+
+	switch {
+	case term == "":
+		return req, errors.Wrap(ErrInvalidLabelSelector, "empty requirement")
+	case strings.HasPrefix(term, "!") && !strings.Contains(term, "="):
+		req.key, req.operator = strings.TrimSpace(term[1:]), labelOpNotExists
+	case labelSetRegex.MatchString(term):
+		m := labelSetRegex.FindStringSubmatch(term)
+		req.key, req.operator = m[1], labelOperator(m[2])
+
+		if strings.TrimSpace(m[3]) == "" {
+			return req, errors.Wrap(ErrInvalidLabelSelector, fmt.Sprintf("requirement %q has no values", term))
+		}
+
+		for _, v := range strings.Split(m[3], ",") {
+			req.values = append(req.values, strings.TrimSpace(v))
+		}
+	case strings.Contains(term, "!="):
+		key, value, _ := strings.Cut(term, "!=")
+		req.key, req.operator, req.values = strings.TrimSpace(key), labelOpNotEquals, []string{strings.TrimSpace(value)}
+	case strings.Contains(term, "="):
+		key, value, _ := strings.Cut(term, "=")
+		value = strings.TrimPrefix(value, "=")
+		req.key, req.operator, req.values = strings.TrimSpace(key), labelOpEquals, []string{strings.TrimSpace(value)}
+	default:
+		req.key, req.operator = term, labelOpExists
+	}
+
+	if err := validateLabelKey(req.key); err != nil {
+		return req, errors.Wrap(ErrInvalidLabelSelector, fmt.Sprintf("requirement %q: %s", term, err.Error()))
+	}
+
+	for _, v := range req.values {
+		if err := validateLabelValue(req.key, v); err != nil {
+			return req, errors.Wrap(ErrInvalidLabelSelector, fmt.Sprintf("requirement %q: %s", term, err.Error()))
+		}
+	}
+
+	return req, nil
+}
+
+// queryMod returns the sql condition of the requirement on the labels stored
+// in column, equality uses containment so the inverted index serves it
+func (r labelRequirement) queryMod(column string) qm.QueryMod {
+	switch r.operator {
+	case labelOpNotExists:
+		return qm.Where(fmt.Sprintf("%s->>?::STRING IS NULL", column), r.key)
+	case labelOpEquals, labelOpNotEquals:
+		// a map of strings always marshals
+		contains, _ := json.Marshal(map[string]string{r.key: r.values[0]})
+
+		if r.operator == labelOpNotEquals {
+			return qm.Where(fmt.Sprintf("NOT %s @> ?::JSONB", column), string(contains))
+		}
+
+		return qm.Where(fmt.Sprintf("%s @> ?::JSONB", column), string(contains))
+	case labelOpIn, labelOpNotIn:
+		args := []interface{}{r.key}
+		for _, v := range r.values {
+			args = append(args, v)
+		}
+
+		set := strings.TrimSuffix(strings.Repeat("?, ", len(r.values)), ", ")
+
+		if r.operator == labelOpNotIn {
+			return qm.Where(fmt.Sprintf("%s->>?::STRING IS NULL OR %s->>?::STRING NOT IN (%s)", column, column, set), append([]interface{}{r.key}, args...)...)
+		}
+
+		return qm.Where(fmt.Sprintf("%s->>?::STRING IN (%s)", column, set), args...)
+	default:
+		return qm.Where(fmt.Sprintf("%s->>?::STRING IS NOT NULL", column), r.key)
+	}
+}
+
+// labelSelectorQueryMods returns the conditions of all the requirements on the
+// labels stored in column
+func labelSelectorQueryMods(column string, reqs []labelRequirement) []qm.QueryMod {
+	mods := []qm.QueryMod{}
+
+	for _, r := range reqs {
+		mods = append(mods, r.queryMod(column))
+	}
+
+	return mods
+}
@@ -0,0 +1,123 @@
+package serverservice
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+
+	"go.hollow.sh/serverservice/internal/models"
+)
+
+func TestValidateLabels(t *testing.T) {
+	testCases := []struct {
+		testName string
+		labels   map[string]string
+		errorMsg string
+	}{
+		{"valid labels", map[string]string{"env": "prod", "hollow.sh/role": "storage", "quarantined": ""}, ""},
+		{"empty key", map[string]string{"": "prod"}, `key "" has an invalid name`},
+		{"key with spaces", map[string]string{"the env": "prod"}, `key "the env" has an invalid name`},
+		{"invalid prefix", map[string]string{"Hollow_sh/role": "storage"}, `key "Hollow_sh/role" has an invalid prefix`},
+		{"value too long", map[string]string{"env": "a123456789a123456789a123456789a123456789a123456789a123456789abcd"}, `of key "env" is invalid`},
+		{"value ends with a dash", map[string]string{"env": "prod-"}, `value "prod-" of key "env" is invalid`},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.testName, func(t *testing.T) {
+			err := validateLabels(tt.labels)
+			if tt.errorMsg == "" {
+				assert.NoError(t, err)
+				return
+			}
+
+			assert.ErrorIs(t, err, ErrInvalidLabel)
+			assert.ErrorContains(t, err, tt.errorMsg)
+		})
+	}
+}
+
+func TestParseLabelSelector(t *testing.T) {
+	testCases := []struct {
+		testName string
+		selector string
+		expected []labelRequirement
+		errorMsg string
+	}{
+		{"empty selector", " ", nil, ""},
+		{
+			"all operators",
+			"env=prod, tier==web,zone != a,role in (storage, compute),rack notin (r1),quarantined,!drained",
+			[]labelRequirement{
+				{key: "env", operator: labelOpEquals, values: []string{"prod"}},
+				{key: "tier", operator: labelOpEquals, values: []string{"web"}},
+				{key: "zone", operator: labelOpNotEquals, values: []string{"a"}},
+				{key: "role", operator: labelOpIn, values: []string{"storage", "compute"}},
+				{key: "rack", operator: labelOpNotIn, values: []string{"r1"}},
+				{key: "quarantined", operator: labelOpExists},
+				{key: "drained", operator: labelOpNotExists},
+			},
+			"",
+		},
+		{"empty value", "env=", []labelRequirement{{key: "env", operator: labelOpEquals, values: []string{""}}}, ""},
+		{"empty requirement", "env=prod,,role=storage", nil, "empty requirement"},
+		{"unbalanced parentheses", "role in (storage,compute", nil, "unbalanced parentheses"},
+		{"nested parentheses", "role in ((storage))", nil, "unbalanced parentheses"},
+		{"empty set", "role in ()", nil, `requirement "role in ()" has no values`},
+		{"invalid key", "env prod", nil, `key "env prod" has an invalid name`},
+		{"invalid value", "env=prod!", nil, `value "prod!" of key "env" is invalid`},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.testName, func(t *testing.T) {
+			reqs, err := parseLabelSelector(tt.selector)
+			if tt.errorMsg != "" {
+				assert.ErrorIs(t, err, ErrInvalidLabelSelector)
+				assert.ErrorContains(t, err, tt.errorMsg)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, reqs)
+		})
+	}
+}
+
+func TestLabelSelectorQueryMods(t *testing.T) {
+	testCases := []struct {
+		testName string
+		selector string
+		where    string
+		args     []interface{}
+	}{
+		{"equals", "env=prod", `(servers.labels @> $1::JSONB)`, []interface{}{`{"env":"prod"}`}},
+		{"not equals", "env!=prod", `(NOT servers.labels @> $1::JSONB)`, []interface{}{`{"env":"prod"}`}},
+		{"exists", "quarantined", `(servers.labels->>$1::STRING IS NOT NULL)`, []interface{}{"quarantined"}},
+		{"not exists", "!quarantined", `(servers.labels->>$1::STRING IS NULL)`, []interface{}{"quarantined"}},
+		{
+			"in",
+			"role in (storage,compute)",
+			`(servers.labels->>$1::STRING IN ($2, $3))`,
+			[]interface{}{"role", "storage", "compute"},
+		},
+		{
+			"not in",
+			"role notin (storage)",
+			`(servers.labels->>$1::STRING IS NULL OR servers.labels->>$2::STRING NOT IN ($3))`,
+			[]interface{}{"role", "role", "storage"},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.testName, func(t *testing.T) {
+			reqs, err := parseLabelSelector(tt.selector)
+			require.NoError(t, err)
+
+			query, args := queries.BuildQuery(models.Servers(labelSelectorQueryMods(models.ServerTableColumns.Labels, reqs)...).Query)
+			assert.Contains(t, query, "WHERE "+tt.where)
+			assert.Equal(t, tt.args, args)
+		})
+	}
+}
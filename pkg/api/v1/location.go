@@ -0,0 +1,244 @@
+package serverservice
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+
+	"go.hollow.sh/serverservice/internal/models"
+)
+
+// defaultRackHeightU is the height of a rack created without one, a full
+// height rack
+const defaultRackHeightU = 42
+
+var (
+	errLocation  = errors.New("invalid location")
+	errPlacement = errors.New("invalid server placement")
+	// errRackUnitTaken is returned when a rack unit is already occupied
+	errRackUnitTaken = errors.New("rack unit is taken")
+	// errLocationMove is returned when a location with servers placed in it
+	// would move to another facility
+	errLocationMove = errors.New("location can't move to another facility")
+)
+
+// Facility is a data center, servers reference it by its code
+type Facility struct {
+	UUID      uuid.UUID `json:"uuid"`
+	Code      string    `json:"code" binding:"required"`
+	Name      string    `json:"name"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// Room is a room in a facility
+type Room struct {
+	UUID         uuid.UUID `json:"uuid"`
+	FacilityUUID uuid.UUID `json:"facility_uuid" binding:"required"`
+	Name         string    `json:"name" binding:"required"`
+	CreatedAt    time.Time `json:"created_at"`
+	UpdatedAt    time.Time `json:"updated_at"`
+}
+
+// Row is a row of racks in a room
+type Row struct {
+	UUID      uuid.UUID `json:"uuid"`
+	RoomUUID  uuid.UUID `json:"room_uuid" binding:"required"`
+	Name      string    `json:"name" binding:"required"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// Rack is a rack in a row, HeightU is the number of rack units it has and
+// defaults to 42
+type Rack struct {
+	UUID      uuid.UUID `json:"uuid"`
+	RowUUID   uuid.UUID `json:"row_uuid" binding:"required"`
+	Name      string    `json:"name" binding:"required"`
+	HeightU   int       `json:"height_u" binding:"gte=0"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// ServerPlacement places a server in a rack, the server occupies HeightU
+// units from PositionU up. Units are numbered from 1 at the bottom of the
+// rack. The location the rack is in is returned along with the placement.
+type ServerPlacement struct {
+	ServerUUID   uuid.UUID `json:"server_uuid"`
+	RackUUID     uuid.UUID `json:"rack_uuid" binding:"required"`
+	PositionU    int       `json:"position_u" binding:"required,gte=1"`
+	HeightU      int       `json:"height_u" binding:"gte=0"`
+	FacilityCode string    `json:"facility_code,omitempty"`
+	RoomName     string    `json:"room_name,omitempty"`
+	RowName      string    `json:"row_name,omitempty"`
+	RackName     string    `json:"rack_name,omitempty"`
+	CreatedAt    time.Time `json:"created_at"`
+	UpdatedAt    time.Time `json:"updated_at"`
+}
+
+// RackElevation lists every unit of a rack from the top down along with the
+// server occupying it
+type RackElevation struct {
+	Rack  Rack       `json:"rack"`
+	Units []RackUnit `json:"units"`
+}
+
+// RackUnit is a unit of a rack, ServerUUID is nil when the unit is free
+type RackUnit struct {
+	Position   int        `json:"position"`
+	ServerUUID *uuid.UUID `json:"server_uuid,omitempty"`
+	ServerName string     `json:"server_name,omitempty"`
+}
+
+func (f *Facility) fromDBModel(dbF *models.Facility) error {
+	var err error
+
+	f.UUID, err = uuid.Parse(dbF.ID)
+	if err != nil {
+		return err
+	}
+
+	f.Code = dbF.Code
+	f.Name = dbF.Name.String
+	f.CreatedAt = dbF.CreatedAt.Time
+	f.UpdatedAt = dbF.UpdatedAt.Time
+
+	return nil
+}
+
+func (r *Room) fromDBModel(dbR *models.Room) error {
+	var err error
+
+	r.UUID, err = uuid.Parse(dbR.ID)
+	if err != nil {
+		return err
+	}
+
+	r.FacilityUUID, err = uuid.Parse(dbR.FacilityID)
+	if err != nil {
+		return err
+	}
+
+	r.Name = dbR.Name
+	r.CreatedAt = dbR.CreatedAt.Time
+	r.UpdatedAt = dbR.UpdatedAt.Time
+
+	return nil
+}
+
+func (r *Row) fromDBModel(dbR *models.RackRow) error {
+	var err error
+
+	r.UUID, err = uuid.Parse(dbR.ID)
+	if err != nil {
+		return err
+	}
+
+	r.RoomUUID, err = uuid.Parse(dbR.RoomID)
+	if err != nil {
+		return err
+	}
+
+	r.Name = dbR.Name
+	r.CreatedAt = dbR.CreatedAt.Time
+	r.UpdatedAt = dbR.UpdatedAt.Time
+
+	return nil
+}
+
+func (r *Rack) fromDBModel(dbR *models.Rack) error {
+	var err error
+
+	r.UUID, err = uuid.Parse(dbR.ID)
+	if err != nil {
+		return err
+	}
+
+	r.RowUUID, err = uuid.Parse(dbR.RowID)
+	if err != nil {
+		return err
+	}
+
+	r.Name = dbR.Name
+	r.HeightU = int(dbR.HeightU)
+	r.CreatedAt = dbR.CreatedAt.Time
+	r.UpdatedAt = dbR.UpdatedAt.Time
+
+	return nil
+}
+
+// fromDBModel converts the placement, the location names are set when the
+// rack was loaded along with its row, room and facility
+func (p *ServerPlacement) fromDBModel(dbP *models.ServerPlacement) error {
+	var err error
+
+	p.ServerUUID, err = uuid.Parse(dbP.ServerID)
+	if err != nil {
+		return err
+	}
+
+	p.RackUUID, err = uuid.Parse(dbP.RackID)
+	if err != nil {
+		return err
+	}
+
+	p.PositionU = int(dbP.PositionU)
+	p.HeightU = int(dbP.HeightU)
+	p.CreatedAt = dbP.CreatedAt.Time
+	p.UpdatedAt = dbP.UpdatedAt.Time
+
+	rack := dbP.R.GetRack()
+	if rack == nil {
+		return nil
+	}
+
+	p.RackName = rack.Name
+
+	if row := rack.R.GetRow(); row != nil {
+		p.RowName = row.Name
+
+		if room := row.R.GetRoom(); room != nil {
+			p.RoomName = room.Name
+
+			if facility := room.R.GetFacility(); facility != nil {
+				p.FacilityCode = facility.Code
+			}
+		}
+	}
+
+	return nil
+}
+
+// topU returns the highest unit the placement occupies
+func (p *ServerPlacement) topU() int {
+	return p.PositionU + p.HeightU - 1
+}
+
+// newRackElevation returns the units of the rack from the top down with the
+// servers placed in it, names maps server IDs to their names
+func newRackElevation(rack Rack, placements []ServerPlacement, names map[uuid.UUID]string) RackElevation {
+	occupant := map[int]uuid.UUID{}
+
+	for _, p := range placements {
+		for u := p.PositionU; u <= p.topU(); u++ {
+			occupant[u] = p.ServerUUID
+		}
+	}
+
+	e := RackElevation{Rack: rack, Units: make([]RackUnit, 0, rack.HeightU)}
+
+	for u := rack.HeightU; u >= 1; u-- {
+		unit := RackUnit{Position: u}
+
+		if id, ok := occupant[u]; ok {
+			id := id
+			unit.ServerUUID = &id
+			unit.ServerName = names[id]
+		}
+
+		e.Units = append(e.Units, unit)
+	}
+
+	return e
+}
@@ -0,0 +1,84 @@
+package serverservice
+
+import (
+	"net/url"
+
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+
+	"go.hollow.sh/serverservice/internal/models"
+)
+
+// LocationListParams allows you to filter rooms, rows and racks by the
+// location they are in. Filters for a level at or below the one listed are
+// ignored, e.g. rooms can only be filtered by facility.
+type LocationListParams struct {
+	FacilityCode string `form:"facility_code"`
+	RoomUUID     string `form:"room_uuid" binding:"omitempty,uuid"`
+	RowUUID      string `form:"row_uuid" binding:"omitempty,uuid"`
+	Pagination   *PaginationParams
+}
+
+func (p *LocationListParams) setQuery(q url.Values) {
+	if p == nil {
+		return
+	}
+
+	if p.FacilityCode != "" {
+		q.Set("facility_code", p.FacilityCode)
+	}
+
+	if p.RoomUUID != "" {
+		q.Set("room_uuid", p.RoomUUID)
+	}
+
+	if p.RowUUID != "" {
+		q.Set("row_uuid", p.RowUUID)
+	}
+
+	p.Pagination.setQuery(q)
+}
+
+// roomQueryMods converts the list params into sql conditions on rooms
+func (p *LocationListParams) roomQueryMods() []qm.QueryMod {
+	mods := []qm.QueryMod{}
+
+	if p.FacilityCode != "" {
+		mods = append(mods, qm.Where("rooms.facility_id IN (SELECT id FROM facilities WHERE code = ?)", p.FacilityCode))
+	}
+
+	return mods
+}
+
+// rowQueryMods converts the list params into sql conditions on rows
+func (p *LocationListParams) rowQueryMods() []qm.QueryMod {
+	mods := []qm.QueryMod{}
+
+	if p.FacilityCode != "" {
+		mods = append(mods, qm.Where("rack_rows.room_id IN (SELECT rooms.id FROM rooms JOIN facilities ON facilities.id = rooms.facility_id WHERE facilities.code = ?)", p.FacilityCode))
+	}
+
+	if p.RoomUUID != "" {
+		mods = append(mods, models.RackRowWhere.RoomID.EQ(p.RoomUUID))
+	}
+
+	return mods
+}
+
+// rackQueryMods converts the list params into sql conditions on racks
+func (p *LocationListParams) rackQueryMods() []qm.QueryMod {
+	mods := []qm.QueryMod{}
+
+	if p.FacilityCode != "" {
+		mods = append(mods, qm.Where("racks.row_id IN (SELECT rack_rows.id FROM rack_rows JOIN rooms ON rooms.id = rack_rows.room_id JOIN facilities ON facilities.id = rooms.facility_id WHERE facilities.code = ?)", p.FacilityCode))
+	}
+
+	if p.RoomUUID != "" {
+		mods = append(mods, qm.Where("racks.row_id IN (SELECT id FROM rack_rows WHERE room_id = ?)", p.RoomUUID))
+	}
+
+	if p.RowUUID != "" {
+		mods = append(mods, models.RackWhere.RowID.EQ(p.RowUUID))
+	}
+
+	return mods
+}
@@ -0,0 +1,253 @@
+package serverservice
+
+import (
+	"context"
+	"path"
+
+	"github.com/google/uuid"
+)
+
+const (
+	facilitiesEndpoint      = "facilities"
+	roomsEndpoint           = "rooms"
+	rowsEndpoint            = "rows"
+	racksEndpoint           = "racks"
+	rackElevationEndpoint   = "elevation"
+	serverPlacementEndpoint = "placement"
+)
+
+// CreateFacility will attempt to create a facility and return its UUID
+func (c *Client) CreateFacility(ctx context.Context, facility Facility) (*uuid.UUID, *ServerResponse, error) {
+	resp, err := c.post(ctx, facilitiesEndpoint, facility)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	u, err := uuid.Parse(resp.Slug)
+	if err != nil {
+		return nil, resp, nil
+	}
+
+	return &u, resp, nil
+}
+
+// ListFacilities will return the facilities with optional params
+func (c *Client) ListFacilities(ctx context.Context, params *PaginationParams) ([]Facility, *ServerResponse, error) {
+	facilities := &[]Facility{}
+	resp := ServerResponse{Records: facilities}
+
+	if err := c.list(ctx, facilitiesEndpoint, params, &resp); err != nil {
+		return nil, nil, err
+	}
+
+	return *facilities, &resp, nil
+}
+
+// GetFacility will return the facility with the UUID
+func (c *Client) GetFacility(ctx context.Context, facilityUUID uuid.UUID) (*Facility, *ServerResponse, error) {
+	facility := &Facility{}
+	resp := ServerResponse{Record: facility}
+
+	if err := c.get(ctx, path.Join(facilitiesEndpoint, facilityUUID.String()), &resp); err != nil {
+		return nil, nil, err
+	}
+
+	return facility, &resp, nil
+}
+
+// UpdateFacility will update the facility with the UUID, its code can't be
+// changed
+func (c *Client) UpdateFacility(ctx context.Context, facilityUUID uuid.UUID, facility Facility) (*ServerResponse, error) {
+	return c.put(ctx, path.Join(facilitiesEndpoint, facilityUUID.String()), facility)
+}
+
+// DeleteFacility will delete the facility with the UUID, it fails while it has rooms
+func (c *Client) DeleteFacility(ctx context.Context, facilityUUID uuid.UUID) (*ServerResponse, error) {
+	return c.delete(ctx, path.Join(facilitiesEndpoint, facilityUUID.String()))
+}
+
+// CreateRoom will attempt to create a room in the facility and return its UUID
+func (c *Client) CreateRoom(ctx context.Context, room Room) (*uuid.UUID, *ServerResponse, error) {
+	resp, err := c.post(ctx, roomsEndpoint, room)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	u, err := uuid.Parse(resp.Slug)
+	if err != nil {
+		return nil, resp, nil
+	}
+
+	return &u, resp, nil
+}
+
+// ListRooms will return the rooms with optional params
+func (c *Client) ListRooms(ctx context.Context, params *LocationListParams) ([]Room, *ServerResponse, error) {
+	rooms := &[]Room{}
+	resp := ServerResponse{Records: rooms}
+
+	if err := c.list(ctx, roomsEndpoint, params, &resp); err != nil {
+		return nil, nil, err
+	}
+
+	return *rooms, &resp, nil
+}
+
+// GetRoom will return the room with the UUID
+func (c *Client) GetRoom(ctx context.Context, roomUUID uuid.UUID) (*Room, *ServerResponse, error) {
+	room := &Room{}
+	resp := ServerResponse{Record: room}
+
+	if err := c.get(ctx, path.Join(roomsEndpoint, roomUUID.String()), &resp); err != nil {
+		return nil, nil, err
+	}
+
+	return room, &resp, nil
+}
+
+// UpdateRoom will update the room with the UUID
+func (c *Client) UpdateRoom(ctx context.Context, roomUUID uuid.UUID, room Room) (*ServerResponse, error) {
+	return c.put(ctx, path.Join(roomsEndpoint, roomUUID.String()), room)
+}
+
+// DeleteRoom will delete the room with the UUID, it fails while it has rows
+func (c *Client) DeleteRoom(ctx context.Context, roomUUID uuid.UUID) (*ServerResponse, error) {
+	return c.delete(ctx, path.Join(roomsEndpoint, roomUUID.String()))
+}
+
+// CreateRow will attempt to create a row in the room and return its UUID
+func (c *Client) CreateRow(ctx context.Context, row Row) (*uuid.UUID, *ServerResponse, error) {
+	resp, err := c.post(ctx, rowsEndpoint, row)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	u, err := uuid.Parse(resp.Slug)
+	if err != nil {
+		return nil, resp, nil
+	}
+
+	return &u, resp, nil
+}
+
+// ListRows will return the rows with optional params
+func (c *Client) ListRows(ctx context.Context, params *LocationListParams) ([]Row, *ServerResponse, error) {
+	rows := &[]Row{}
+	resp := ServerResponse{Records: rows}
+
+	if err := c.list(ctx, rowsEndpoint, params, &resp); err != nil {
+		return nil, nil, err
+	}
+
+	return *rows, &resp, nil
+}
+
+// GetRow will return the row with the UUID
+func (c *Client) GetRow(ctx context.Context, rowUUID uuid.UUID) (*Row, *ServerResponse, error) {
+	row := &Row{}
+	resp := ServerResponse{Record: row}
+
+	if err := c.get(ctx, path.Join(rowsEndpoint, rowUUID.String()), &resp); err != nil {
+		return nil, nil, err
+	}
+
+	return row, &resp, nil
+}
+
+// UpdateRow will update the row with the UUID
+func (c *Client) UpdateRow(ctx context.Context, rowUUID uuid.UUID, row Row) (*ServerResponse, error) {
+	return c.put(ctx, path.Join(rowsEndpoint, rowUUID.String()), row)
+}
+
+// DeleteRow will delete the row with the UUID, it fails while it has racks
+func (c *Client) DeleteRow(ctx context.Context, rowUUID uuid.UUID) (*ServerResponse, error) {
+	return c.delete(ctx, path.Join(rowsEndpoint, rowUUID.String()))
+}
+
+// CreateRack will attempt to create a rack in the row and return its UUID
+func (c *Client) CreateRack(ctx context.Context, rack Rack) (*uuid.UUID, *ServerResponse, error) {
+	resp, err := c.post(ctx, racksEndpoint, rack)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	u, err := uuid.Parse(resp.Slug)
+	if err != nil {
+		return nil, resp, nil
+	}
+
+	return &u, resp, nil
+}
+
+// ListRacks will return the racks with optional params
+func (c *Client) ListRacks(ctx context.Context, params *LocationListParams) ([]Rack, *ServerResponse, error) {
+	racks := &[]Rack{}
+	resp := ServerResponse{Records: racks}
+
+	if err := c.list(ctx, racksEndpoint, params, &resp); err != nil {
+		return nil, nil, err
+	}
+
+	return *racks, &resp, nil
+}
+
+// GetRack will return the rack with the UUID
+func (c *Client) GetRack(ctx context.Context, rackUUID uuid.UUID) (*Rack, *ServerResponse, error) {
+	rack := &Rack{}
+	resp := ServerResponse{Record: rack}
+
+	if err := c.get(ctx, path.Join(racksEndpoint, rackUUID.String()), &resp); err != nil {
+		return nil, nil, err
+	}
+
+	return rack, &resp, nil
+}
+
+// UpdateRack will update the rack with the UUID, its height can't be
+// lowered below a server placed in it
+func (c *Client) UpdateRack(ctx context.Context, rackUUID uuid.UUID, rack Rack) (*ServerResponse, error) {
+	return c.put(ctx, path.Join(racksEndpoint, rackUUID.String()), rack)
+}
+
+// DeleteRack will delete the rack with the UUID, it fails while servers are
+// placed in it
+func (c *Client) DeleteRack(ctx context.Context, rackUUID uuid.UUID) (*ServerResponse, error) {
+	return c.delete(ctx, path.Join(racksEndpoint, rackUUID.String()))
+}
+
+// GetRackElevation will return the units of the rack with the UUID from the
+// top down along with the servers placed in them
+func (c *Client) GetRackElevation(ctx context.Context, rackUUID uuid.UUID) (*RackElevation, *ServerResponse, error) {
+	e := &RackElevation{}
+	resp := ServerResponse{Record: e}
+
+	if err := c.get(ctx, path.Join(racksEndpoint, rackUUID.String(), rackElevationEndpoint), &resp); err != nil {
+		return nil, nil, err
+	}
+
+	return e, &resp, nil
+}
+
+// GetServerPlacement will return the rack units the server occupies and the
+// location of the rack
+func (c *Client) GetServerPlacement(ctx context.Context, srvUUID uuid.UUID) (*ServerPlacement, *ServerResponse, error) {
+	p := &ServerPlacement{}
+	resp := ServerResponse{Record: p}
+
+	if err := c.get(ctx, path.Join(serversEndpoint, srvUUID.String(), serverPlacementEndpoint), &resp); err != nil {
+		return nil, nil, err
+	}
+
+	return p, &resp, nil
+}
+
+// SetServerPlacement will place the server in a rack or move it, it fails when
+// the units are taken by another server
+func (c *Client) SetServerPlacement(ctx context.Context, srvUUID uuid.UUID, p ServerPlacement) (*ServerResponse, error) {
+	return c.put(ctx, path.Join(serversEndpoint, srvUUID.String(), serverPlacementEndpoint), p)
+}
+
+// DeleteServerPlacement will remove the server from its rack
+func (c *Client) DeleteServerPlacement(ctx context.Context, srvUUID uuid.UUID) (*ServerResponse, error) {
+	return c.delete(ctx, path.Join(serversEndpoint, srvUUID.String(), serverPlacementEndpoint))
+}
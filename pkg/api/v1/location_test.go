@@ -0,0 +1,30 @@
+package serverservice
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewRackElevation(t *testing.T) {
+	nemo := uuid.New()
+	dory := uuid.New()
+
+	rack := Rack{Name: "r01", HeightU: 5}
+	placements := []ServerPlacement{
+		{ServerUUID: nemo, PositionU: 1, HeightU: 2},
+		{ServerUUID: dory, PositionU: 4, HeightU: 1},
+	}
+
+	e := newRackElevation(rack, placements, map[uuid.UUID]string{nemo: "Nemo"})
+
+	assert.Equal(t, rack, e.Rack)
+	assert.Equal(t, []RackUnit{
+		{Position: 5},
+		{Position: 4, ServerUUID: &dory},
+		{Position: 3},
+		{Position: 2, ServerUUID: &nemo, ServerName: "Nemo"},
+		{Position: 1, ServerUUID: &nemo, ServerName: "Nemo"},
+	}, e.Units)
+}
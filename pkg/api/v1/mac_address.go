@@ -0,0 +1,99 @@
+package serverservice
+
+import (
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// MACAddress is a 48 bit MAC address in its canonical form, lower case hex
+// pairs separated by colons like "aa:bb:cc:dd:ee:ff"
+type MACAddress string
+
+// ParseMACAddress returns the canonical form of a MAC address written with
+// colons or hyphens ("AA-BB-CC-DD-EE-FF"), as dotted groups of four hex digits
+// ("aabb.ccdd.eeff") or as 12 hex digits with no separator.
+func ParseMACAddress(s string) (MACAddress, error) {
+	s = strings.TrimSpace(s)
+	v := s
+
+	if len(v) == 12 && !strings.ContainsAny(v, ":-.") {
+		pairs := make([]string, 0, 6)
+		for i := 0; i < len(v); i += 2 {
+			pairs = append(pairs, v[i:i+2])
+		}
+
+		v = strings.Join(pairs, ":")
+	}
+
+	hw, err := net.ParseMAC(v)
+	if err != nil || len(hw) != 6 {
+		return "", errors.Wrap(ErrInvalidMACAddress, fmt.Sprintf("%q", s))
+	}
+
+	return MACAddress(hw.String()), nil
+}
+
+// String returns the MAC address in its canonical form
+func (m MACAddress) String() string {
+	return string(m)
+}
+
+// UnmarshalText parses the MAC address in any of the formats ParseMACAddress
+// accepts
+func (m *MACAddress) UnmarshalText(text []byte) error {
+	mac, err := ParseMACAddress(string(text))
+	if err != nil {
+		return err
+	}
+
+	*m = mac
+
+	return nil
+}
+
+// parseMACAddressList returns the canonical form of the comma separated MAC
+// addresses, without duplicates. field names the list in errors.
+func parseMACAddressList(field, list string) ([]MACAddress, error) {
+	macs := []MACAddress{}
+	seen := map[MACAddress]bool{}
+
+	for _, s := range strings.Split(list, ",") {
+		if strings.TrimSpace(s) == "" {
+			continue
+		}
+
+		mac, err := ParseMACAddress(s)
+		if err != nil {
+			return nil, errors.Wrap(err, field)
+		}
+
+		if !seen[mac] {
+			seen[mac] = true
+			macs = append(macs, mac)
+		}
+	}
+
+	return macs, nil
+}
+
+// joinMACAddresses returns the MAC addresses as a comma separated list
+func joinMACAddresses(macs []MACAddress) string {
+	s := make([]string, 0, len(macs))
+	for _, mac := range macs {
+		s = append(s, mac.String())
+	}
+
+	return strings.Join(s, ",")
+}
+
+// isMACAttributeKey returns true for attribute keys that hold a MAC address,
+// like "mac", "macaddress", "mac_address" or "bmc-mac", whose filter values
+// are compared in the canonical MAC address form
+func isMACAttributeKey(key string) bool {
+	k := strings.NewReplacer("_", "", "-", "").Replace(strings.ToLower(key))
+
+	return strings.HasSuffix(k, "mac") || strings.HasSuffix(k, "macaddress") || strings.HasSuffix(k, "macaddr")
+}
@@ -0,0 +1,62 @@
+package serverservice
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseMACAddress(t *testing.T) {
+	testCases := []struct {
+		testName string
+		value    string
+		expected MACAddress
+		err      bool
+	}{
+		{"canonical", "aa:bb:cc:dd:ee:ff", "aa:bb:cc:dd:ee:ff", false},
+		{"upper case", "AA:BB:CC:DD:EE:FF", "aa:bb:cc:dd:ee:ff", false},
+		{"hyphens", "AA-BB-CC-DD-EE-FF", "aa:bb:cc:dd:ee:ff", false},
+		{"dotted groups", "aabb.ccdd.eeff", "aa:bb:cc:dd:ee:ff", false},
+		{"no separator", "AABBCCDDEEFF", "aa:bb:cc:dd:ee:ff", false},
+		{"surrounding spaces", " aa:bb:cc:dd:ee:ff\t", "aa:bb:cc:dd:ee:ff", false},
+		{"not hex", "gg:bb:cc:dd:ee:ff", "", true},
+		{"too short", "aa:bb:cc:dd:ee", "", true},
+		{"64 bit address", "aa:bb:cc:dd:ee:ff:00:11", "", true},
+		{"empty", "", "", true},
+		{"not a mac address", "fakeAocMacAddress1", "", true},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.testName, func(t *testing.T) {
+			mac, err := ParseMACAddress(tt.value)
+			if tt.err {
+				assert.ErrorIs(t, err, ErrInvalidMACAddress)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, mac)
+		})
+	}
+}
+
+func TestParseMACAddressList(t *testing.T) {
+	macs, err := parseMACAddressList("aoc-mac-address", "AA-BB-CC-DD-EE-FF, aabb.ccdd.eef0,,aa:bb:cc:dd:ee:ff")
+	require.NoError(t, err)
+	assert.Equal(t, []MACAddress{"aa:bb:cc:dd:ee:ff", "aa:bb:cc:dd:ee:f0"}, macs)
+
+	_, err = parseMACAddressList("aoc-mac-address", "aa:bb:cc:dd:ee:ff,nope")
+	assert.ErrorIs(t, err, ErrInvalidMACAddress)
+	assert.EqualError(t, err, `aoc-mac-address: "nope": invalid MAC address`)
+}
+
+func TestIsMACAttributeKey(t *testing.T) {
+	for _, k := range []string{"mac", "MAC", "macaddress", "mac_address", "bmc-mac", "MacAddr"} {
+		assert.True(t, isMACAttributeKey(k), k)
+	}
+
+	for _, k := range []string{"machine", "serial", "mac_vendor"} {
+		assert.False(t, isMACAttributeKey(k), k)
+	}
+}
@@ -0,0 +1,221 @@
+package serverservice
+
+import (
+	"encoding/json"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/types"
+
+	"go.hollow.sh/serverservice/internal/models"
+)
+
+var (
+	errMaintenanceWindow = errors.New("invalid maintenance window")
+	// errMaintenanceWindowStarted is returned when changing what can't change
+	// once a window started
+	errMaintenanceWindowStarted = errors.New("maintenance window started")
+)
+
+// MaintenanceWindow is a period during which servers may be taken out of
+// service, for example for BIOS and BMC updates. It applies to the servers
+// listed in ServerUUIDs, otherwise to every server matching the facility code
+// and all of the attribute selectors.
+//
+// Events are sent when a window starts and ends. Once a window started only
+// its reason and end can be changed, it can't be changed after it ended.
+type MaintenanceWindow struct {
+	UUID         uuid.UUID           `json:"uuid"`
+	Reason       string              `json:"reason" binding:"required"`
+	StartsAt     time.Time           `json:"starts_at" binding:"required"`
+	EndsAt       time.Time           `json:"ends_at" binding:"required,gtfield=StartsAt"`
+	ServerUUIDs  []uuid.UUID         `json:"server_uuids,omitempty"`
+	FacilityCode string              `json:"facility_code,omitempty"`
+	Attributes   []AttributeSelector `json:"attributes,omitempty" binding:"dive"`
+	CreatedAt    time.Time           `json:"created_at"`
+	UpdatedAt    time.Time           `json:"updated_at"`
+}
+
+// ServerMaintenance tells whether a server is in a maintenance window. Current
+// is the active window that ends last and Next the first one starting later.
+type ServerMaintenance struct {
+	ServerUUID    uuid.UUID          `json:"server_uuid"`
+	InMaintenance bool               `json:"in_maintenance"`
+	Current       *MaintenanceWindow `json:"current,omitempty"`
+	Next          *MaintenanceWindow `json:"next,omitempty"`
+}
+
+// fromDBModel converts the window, the servers it lists are set when they were
+// loaded along with it
+func (w *MaintenanceWindow) fromDBModel(dbW *models.MaintenanceWindow) error {
+	var err error
+
+	w.UUID, err = uuid.Parse(dbW.ID)
+	if err != nil {
+		return err
+	}
+
+	w.Reason = dbW.Reason
+	w.StartsAt = dbW.StartsAt
+	w.EndsAt = dbW.EndsAt
+	w.FacilityCode = dbW.FacilityCode.String
+	w.CreatedAt = dbW.CreatedAt.Time
+	w.UpdatedAt = dbW.UpdatedAt.Time
+
+	if dbW.Attributes.Valid {
+		if err := json.Unmarshal(dbW.Attributes.JSON, &w.Attributes); err != nil {
+			return err
+		}
+	}
+
+	for _, s := range dbW.R.GetMaintenanceWindowServers() {
+		sID, err := uuid.Parse(s.ServerID)
+		if err != nil {
+			return err
+		}
+
+		w.ServerUUIDs = append(w.ServerUUIDs, sID)
+	}
+
+	return nil
+}
+
+// toDBModel returns the window and the IDs of the servers it lists, sorted
+// and without duplicates
+func (w *MaintenanceWindow) toDBModel() (*models.MaintenanceWindow, []string, error) {
+	if len(w.ServerUUIDs) == 0 && w.FacilityCode == "" && len(w.Attributes) == 0 {
+		return nil, nil, errors.Wrap(errMaintenanceWindow, "it needs server UUIDs, a facility code or attribute selectors")
+	}
+
+	if len(w.ServerUUIDs) > 0 && (w.FacilityCode != "" || len(w.Attributes) > 0) {
+		return nil, nil, errors.Wrap(errMaintenanceWindow, "a window listing servers can't have selectors")
+	}
+
+	dbW := &models.MaintenanceWindow{
+		Reason:       w.Reason,
+		StartsAt:     w.StartsAt,
+		EndsAt:       w.EndsAt,
+		FacilityCode: null.NewString(w.FacilityCode, w.FacilityCode != ""),
+	}
+
+	if len(w.Attributes) > 0 {
+		data, err := json.Marshal(w.Attributes)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		dbW.Attributes = null.JSONFrom(data)
+	}
+
+	seen := map[string]bool{}
+	serverIDs := []string{}
+
+	for _, u := range w.ServerUUIDs {
+		if !seen[u.String()] {
+			seen[u.String()] = true
+
+			serverIDs = append(serverIDs, u.String())
+		}
+	}
+
+	sort.Strings(serverIDs)
+
+	return dbW, serverIDs, nil
+}
+
+// maintenanceWindowServerIDs returns the sorted IDs of the servers the window
+// lists, they must have been loaded along with it
+func maintenanceWindowServerIDs(dbW *models.MaintenanceWindow) []string {
+	ids := []string{}
+
+	for _, s := range dbW.R.GetMaintenanceWindowServers() {
+		ids = append(ids, s.ServerID)
+	}
+
+	sort.Strings(ids)
+
+	return ids
+}
+
+// checkMaintenanceWindowUpdate returns an error when the update changes what
+// can't change once the window started, only the reason and end may change
+// then. serverIDs are the servers the update lists.
+func checkMaintenanceWindowUpdate(current, update *models.MaintenanceWindow, serverIDs []string) error {
+	if current.EndedEventAt.Valid {
+		return errors.Wrap(errMaintenanceWindowStarted, "the window ended and can't be changed")
+	}
+
+	if !current.StartedEventAt.Valid {
+		return nil
+	}
+
+	if !current.StartsAt.Equal(update.StartsAt) {
+		return errors.Wrap(errMaintenanceWindowStarted, "the start can't be changed")
+	}
+
+	sameAttributes := current.Attributes.Valid == update.Attributes.Valid &&
+		(!current.Attributes.Valid || areEqualJSON(types.JSON(current.Attributes.JSON), types.JSON(update.Attributes.JSON)))
+
+	sameScope := current.FacilityCode == update.FacilityCode && sameAttributes &&
+		sameServerIDs(maintenanceWindowServerIDs(current), serverIDs)
+
+	if !sameScope {
+		return errors.Wrap(errMaintenanceWindowStarted, "the servers it applies to can't be changed")
+	}
+
+	return nil
+}
+
+// sameServerIDs returns true when both lists hold the same server IDs, in
+// whatever order and however often
+func sameServerIDs(a, b []string) bool {
+	inA := map[string]bool{}
+	for _, id := range a {
+		inA[id] = true
+	}
+
+	inB := map[string]bool{}
+
+	for _, id := range b {
+		if !inA[id] {
+			return false
+		}
+
+		inB[id] = true
+	}
+
+	return len(inA) == len(inB)
+}
+
+// maintenanceWindowAppliesTo returns true when the window applies to the
+// server, given its attributes by namespace. The servers the window lists must
+// have been loaded along with it.
+func maintenanceWindowAppliesTo(dbW *models.MaintenanceWindow, srv *models.Server, attrs map[string]json.RawMessage) (bool, error) {
+	for _, s := range dbW.R.GetMaintenanceWindowServers() {
+		if s.ServerID == srv.ID {
+			return true, nil
+		}
+	}
+
+	// a window that listed servers that have all been deleted applies to none
+	if !dbW.FacilityCode.Valid && !dbW.Attributes.Valid {
+		return false, nil
+	}
+
+	if dbW.FacilityCode.Valid && dbW.FacilityCode.String != srv.FacilityCode.String {
+		return false, nil
+	}
+
+	var selectors []AttributeSelector
+
+	if dbW.Attributes.Valid {
+		if err := json.Unmarshal(dbW.Attributes.JSON, &selectors); err != nil {
+			return false, err
+		}
+	}
+
+	return matchAttributeSelectors(selectors, attrs), nil
+}
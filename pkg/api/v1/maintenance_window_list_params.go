@@ -0,0 +1,72 @@
+package serverservice
+
+import (
+	"net/url"
+	"time"
+
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+
+	"go.hollow.sh/serverservice/internal/models"
+)
+
+// MaintenanceWindowListParams allows you to filter maintenance windows. The
+// windows overlapping a period are those ending after its start and starting
+// before its end. ServerUUID only matches windows listing the server, the
+// windows applying to a server through selectors are found with
+// GetServerMaintenance.
+type MaintenanceWindowListParams struct {
+	ServerUUID   string    `form:"server_uuid" binding:"omitempty,uuid"`
+	FacilityCode string    `form:"facility_code"`
+	EndsAfter    time.Time `form:"ends_after" time_format:"2006-01-02T15:04:05Z07:00"`
+	StartsBefore time.Time `form:"starts_before" time_format:"2006-01-02T15:04:05Z07:00"`
+	Pagination   *PaginationParams
+}
+
+// setQuery implements the queryParams interface
+func (p *MaintenanceWindowListParams) setQuery(q url.Values) {
+	if p == nil {
+		return
+	}
+
+	if p.ServerUUID != "" {
+		q.Set("server_uuid", p.ServerUUID)
+	}
+
+	if p.FacilityCode != "" {
+		q.Set("facility_code", p.FacilityCode)
+	}
+
+	if !p.EndsAfter.IsZero() {
+		q.Set("ends_after", p.EndsAfter.Format(time.RFC3339))
+	}
+
+	if !p.StartsBefore.IsZero() {
+		q.Set("starts_before", p.StartsBefore.Format(time.RFC3339))
+	}
+
+	p.Pagination.setQuery(q)
+}
+
+// queryMods converts the list params into sql conditions that can be added to sql queries
+func (p *MaintenanceWindowListParams) queryMods() []qm.QueryMod {
+	mods := []qm.QueryMod{}
+
+	if p.ServerUUID != "" {
+		mods = append(mods, qm.Where("maintenance_windows.id IN (SELECT maintenance_window_id FROM maintenance_window_servers WHERE server_id = ?)", p.ServerUUID))
+	}
+
+	if p.FacilityCode != "" {
+		mods = append(mods, models.MaintenanceWindowWhere.FacilityCode.EQ(null.StringFrom(p.FacilityCode)))
+	}
+
+	if !p.EndsAfter.IsZero() {
+		mods = append(mods, models.MaintenanceWindowWhere.EndsAt.GT(p.EndsAfter))
+	}
+
+	if !p.StartsBefore.IsZero() {
+		mods = append(mods, models.MaintenanceWindowWhere.StartsAt.LT(p.StartsBefore))
+	}
+
+	return mods
+}
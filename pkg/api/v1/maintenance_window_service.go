@@ -0,0 +1,79 @@
+package serverservice
+
+import (
+	"context"
+	"path"
+
+	"github.com/google/uuid"
+)
+
+const (
+	maintenanceWindowsEndpoint = "maintenance-windows"
+	serverMaintenanceEndpoint  = "maintenance"
+)
+
+// CreateMaintenanceWindow will attempt to create a maintenance window and
+// return its UUID
+func (c *Client) CreateMaintenanceWindow(ctx context.Context, w MaintenanceWindow) (*uuid.UUID, *ServerResponse, error) {
+	resp, err := c.post(ctx, maintenanceWindowsEndpoint, w)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	u, err := uuid.Parse(resp.Slug)
+	if err != nil {
+		return nil, resp, nil
+	}
+
+	return &u, resp, nil
+}
+
+// ListMaintenanceWindows will return the maintenance windows with optional
+// params, the most recent first
+func (c *Client) ListMaintenanceWindows(ctx context.Context, params *MaintenanceWindowListParams) ([]MaintenanceWindow, *ServerResponse, error) {
+	windows := &[]MaintenanceWindow{}
+	resp := ServerResponse{Records: windows}
+
+	if err := c.list(ctx, maintenanceWindowsEndpoint, params, &resp); err != nil {
+		return nil, nil, err
+	}
+
+	return *windows, &resp, nil
+}
+
+// GetMaintenanceWindow will return the maintenance window with the UUID
+func (c *Client) GetMaintenanceWindow(ctx context.Context, windowUUID uuid.UUID) (*MaintenanceWindow, *ServerResponse, error) {
+	w := &MaintenanceWindow{}
+	resp := ServerResponse{Record: w}
+
+	if err := c.get(ctx, path.Join(maintenanceWindowsEndpoint, windowUUID.String()), &resp); err != nil {
+		return nil, nil, err
+	}
+
+	return w, &resp, nil
+}
+
+// UpdateMaintenanceWindow will replace the maintenance window with the UUID,
+// once it started only its reason and end can be changed
+func (c *Client) UpdateMaintenanceWindow(ctx context.Context, windowUUID uuid.UUID, w MaintenanceWindow) (*ServerResponse, error) {
+	return c.put(ctx, path.Join(maintenanceWindowsEndpoint, windowUUID.String()), w)
+}
+
+// DeleteMaintenanceWindow will delete the maintenance window with the UUID, a
+// window that is in progress ends
+func (c *Client) DeleteMaintenanceWindow(ctx context.Context, windowUUID uuid.UUID) (*ServerResponse, error) {
+	return c.delete(ctx, path.Join(maintenanceWindowsEndpoint, windowUUID.String()))
+}
+
+// GetServerMaintenance will return whether the server is in a maintenance
+// window and the next window that applies to it
+func (c *Client) GetServerMaintenance(ctx context.Context, srvUUID uuid.UUID) (*ServerMaintenance, *ServerResponse, error) {
+	sm := &ServerMaintenance{}
+	resp := ServerResponse{Record: sm}
+
+	if err := c.get(ctx, path.Join(serversEndpoint, srvUUID.String(), serverMaintenanceEndpoint), &resp); err != nil {
+		return nil, nil, err
+	}
+
+	return sm, &resp, nil
+}
@@ -0,0 +1,168 @@
+package serverservice
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
+
+	"go.hollow.sh/serverservice/internal/models"
+)
+
+func TestMaintenanceWindowToDBModel(t *testing.T) {
+	srvA := uuid.MustParse("00000000-0000-0000-0000-00000000000a")
+	srvB := uuid.MustParse("00000000-0000-0000-0000-00000000000b")
+	clown := []AttributeSelector{{Namespace: "hollow.other_data", Keys: []string{"type"}, Value: "clown"}}
+
+	testCases := []struct {
+		testName     string
+		window       MaintenanceWindow
+		serverIDs    []string
+		errorMessage string
+	}{
+		{
+			"servers are deduplicated and sorted",
+			MaintenanceWindow{ServerUUIDs: []uuid.UUID{srvB, srvA, srvB}},
+			[]string{srvA.String(), srvB.String()},
+			"",
+		},
+		{
+			"facility and attributes",
+			MaintenanceWindow{FacilityCode: "Ocean", Attributes: clown},
+			[]string{},
+			"",
+		},
+		{
+			"no scope",
+			MaintenanceWindow{},
+			nil,
+			"it needs server UUIDs, a facility code or attribute selectors",
+		},
+		{
+			"servers with selectors",
+			MaintenanceWindow{ServerUUIDs: []uuid.UUID{srvA}, FacilityCode: "Ocean"},
+			nil,
+			"a window listing servers can't have selectors",
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.testName, func(t *testing.T) {
+			_, serverIDs, err := tt.window.toDBModel()
+			if tt.errorMessage != "" {
+				assert.ErrorIs(t, err, errMaintenanceWindow)
+				assert.ErrorContains(t, err, tt.errorMessage)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.serverIDs, serverIDs)
+		})
+	}
+}
+
+func TestMaintenanceWindowAppliesTo(t *testing.T) {
+	srv := &models.Server{ID: uuid.NewString(), FacilityCode: null.StringFrom("Ocean")}
+	attrs := map[string]json.RawMessage{"hollow.other_data": json.RawMessage(`{"type": "clown"}`)}
+
+	window := func(facility string, attributes string, serverIDs ...string) *models.MaintenanceWindow {
+		w := &models.MaintenanceWindow{FacilityCode: null.NewString(facility, facility != "")}
+		if attributes != "" {
+			w.Attributes = null.JSONFrom([]byte(attributes))
+		}
+
+		w.R = w.R.NewStruct()
+		for _, id := range serverIDs {
+			w.R.MaintenanceWindowServers = append(w.R.MaintenanceWindowServers, &models.MaintenanceWindowServer{ServerID: id})
+		}
+
+		return w
+	}
+
+	testCases := []struct {
+		testName string
+		window   *models.MaintenanceWindow
+		applies  bool
+	}{
+		{"listing the server", window("", "", uuid.NewString(), srv.ID), true},
+		{"listing other servers", window("", "", uuid.NewString()), false},
+		{"listed servers were deleted", window("", ""), false},
+		{"facility", window("Ocean", ""), true},
+		{"other facility", window("Sydney", ""), false},
+		{"facility and attributes", window("Ocean", `[{"namespace": "hollow.other_data", "keys": ["type"], "value": "clown"}]`), true},
+		{"attributes not matching", window("", `[{"namespace": "hollow.other_data", "keys": ["type"], "value": "blue-tang"}]`), false},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.testName, func(t *testing.T) {
+			applies, err := maintenanceWindowAppliesTo(tt.window, srv, attrs)
+			require.NoError(t, err)
+			assert.Equal(t, tt.applies, applies)
+		})
+	}
+}
+
+func TestCheckMaintenanceWindowUpdate(t *testing.T) {
+	start := time.Now().Add(-time.Hour)
+
+	current := &models.MaintenanceWindow{
+		StartsAt:       start,
+		EndsAt:         start.Add(2 * time.Hour),
+		FacilityCode:   null.StringFrom("Ocean"),
+		Attributes:     null.JSONFrom([]byte(`[{"namespace": "hollow.other_data", "keys": ["type"], "value": "clown"}]`)),
+		StartedEventAt: null.TimeFrom(start),
+	}
+
+	w := MaintenanceWindow{
+		Reason:       "extended",
+		StartsAt:     start,
+		EndsAt:       start.Add(3 * time.Hour),
+		FacilityCode: "Ocean",
+		Attributes:   []AttributeSelector{{Namespace: "hollow.other_data", Keys: []string{"type"}, Value: "clown"}},
+	}
+
+	update, serverIDs, err := w.toDBModel()
+	require.NoError(t, err)
+	assert.NoError(t, checkMaintenanceWindowUpdate(current, update, serverIDs))
+
+	moved := *update
+	moved.StartsAt = start.Add(time.Minute)
+	assert.ErrorContains(t, checkMaintenanceWindowUpdate(current, &moved, serverIDs), "the start can't be changed")
+
+	rescoped := *update
+	rescoped.FacilityCode = null.StringFrom("Sydney")
+	assert.ErrorContains(t, checkMaintenanceWindowUpdate(current, &rescoped, serverIDs), "the servers it applies to can't be changed")
+
+	// the servers a window lists may be given in any order
+	srvA := uuid.MustParse("00000000-0000-0000-0000-00000000000a")
+	srvB := uuid.MustParse("00000000-0000-0000-0000-00000000000b")
+	srvC := uuid.MustParse("00000000-0000-0000-0000-00000000000c")
+
+	listing := *current
+	listing.FacilityCode = null.String{}
+	listing.Attributes = null.JSON{}
+	listing.R = listing.R.NewStruct()
+	listing.R.MaintenanceWindowServers = models.MaintenanceWindowServerSlice{
+		{ServerID: srvA.String()},
+		{ServerID: srvB.String()},
+	}
+
+	reordered := &models.MaintenanceWindow{StartsAt: start}
+	assert.NoError(t, checkMaintenanceWindowUpdate(&listing, reordered, []string{srvB.String(), srvA.String()}))
+	assert.ErrorContains(t, checkMaintenanceWindowUpdate(&listing, reordered, []string{srvA.String(), srvC.String()}), "the servers it applies to can't be changed")
+	assert.ErrorContains(t, checkMaintenanceWindowUpdate(&listing, reordered, []string{srvA.String()}), "the servers it applies to can't be changed")
+
+	// anything may change before the window starts
+	notStarted := *current
+	notStarted.StartedEventAt = null.Time{}
+	assert.NoError(t, checkMaintenanceWindowUpdate(&notStarted, &rescoped, serverIDs))
+
+	ended := *current
+	ended.EndedEventAt = null.TimeFrom(time.Now())
+	assert.ErrorIs(t, checkMaintenanceWindowUpdate(&ended, update, serverIDs), errMaintenanceWindowStarted)
+}
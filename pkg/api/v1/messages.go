@@ -17,7 +17,30 @@ var (
 	ErrBadJSONIn  = errors.New("object deserializaion failed")
 )
 
-// MsgMetadata captures some message-type agnostic descriptive data a consumer might need
+// Event subjects, these are published under the configured NATS stream prefix.
+const (
+	SubjectServerCreate                    = "server.create"
+	SubjectServerUpdate                    = "server.update"
+	SubjectServerDelete                    = "server.delete"
+	SubjectServerComponentCreate           = "server.component.create"
+	SubjectServerComponentUpdate           = "server.component.update"
+	SubjectServerComponentDelete           = "server.component.delete"
+	SubjectServerAttributesCreate          = "server.attributes.create"
+	SubjectServerAttributesUpdate          = "server.attributes.update"
+	SubjectServerAttributesDelete          = "server.attributes.delete"
+	SubjectServerVersionedAttributesCreate = "server.versioned-attributes.create"
+	SubjectServerCredentialUpdate          = "server.credential.update"
+	SubjectServerCredentialDelete          = "server.credential.delete"
+	SubjectServerCredentialRead            = "server.credential.read"
+	SubjectServerFirmwareSetUpdate         = "server.firmware-set.update"
+	SubjectServerStateUpdate               = "server.state.update"
+	SubjectMaintenanceWindowStart          = "maintenance-window.start"
+	SubjectMaintenanceWindowEnd            = "maintenance-window.end"
+)
+
+// MsgMetadata captures some message-type agnostic descriptive data a consumer might need.
+// Events of concurrent changes to a server may be published out of order,
+// consumers must order them by UpdatedAt, or CreatedAt when it's the only one set.
 type MsgMetadata struct {
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	UpdatedAt time.Time `json:"updated_at,omitempty"`
@@ -31,6 +54,144 @@ type CreateServer struct {
 	ID           string       `json:"id"`
 }
 
+// UpdateServer is published via NATS when a server's fields are updated
+type UpdateServer struct {
+	Metadata     *MsgMetadata `json:"metadata,omitempty"`
+	Name         null.String  `json:"name"`
+	FacilityCode null.String  `json:"facility_code"`
+	ID           string       `json:"id"`
+}
+
+// DeleteServer is published via NATS when a server is deleted
+type DeleteServer struct {
+	Metadata *MsgMetadata `json:"metadata,omitempty"`
+	ID       string       `json:"id"`
+}
+
+// ComponentMsg describes a single component in a server component message
+type ComponentMsg struct {
+	ID              string      `json:"id"`
+	ComponentTypeID string      `json:"component_type_id"`
+	Name            null.String `json:"name"`
+	Vendor          null.String `json:"vendor"`
+	Model           null.String `json:"model"`
+	Serial          null.String `json:"serial"`
+}
+
+// CreateServerComponents is published via NATS when components are added to a server
+type CreateServerComponents struct {
+	Metadata   *MsgMetadata   `json:"metadata,omitempty"`
+	ServerID   string         `json:"server_id"`
+	Components []ComponentMsg `json:"components"`
+}
+
+// UpdateServerComponents is published via NATS when the components of a server are updated
+type UpdateServerComponents struct {
+	Metadata   *MsgMetadata   `json:"metadata,omitempty"`
+	ServerID   string         `json:"server_id"`
+	Components []ComponentMsg `json:"components"`
+}
+
+// DeleteServerComponents is published via NATS when the components of a server are deleted
+type DeleteServerComponents struct {
+	Metadata *MsgMetadata `json:"metadata,omitempty"`
+	ServerID string       `json:"server_id"`
+}
+
+// ServerAttributesMsg is published via NATS when a server attributes namespace
+// is created, updated or deleted, the subject identifies the operation.
+type ServerAttributesMsg struct {
+	Metadata  *MsgMetadata    `json:"metadata,omitempty"`
+	ServerID  string          `json:"server_id"`
+	Namespace string          `json:"namespace"`
+	Data      json.RawMessage `json:"data,omitempty"`
+}
+
+// CreateServerVersionedAttributes is published via NATS when a versioned attribute is reported for a server
+type CreateServerVersionedAttributes struct {
+	Metadata  *MsgMetadata    `json:"metadata,omitempty"`
+	ServerID  string          `json:"server_id"`
+	Namespace string          `json:"namespace"`
+	Data      json.RawMessage `json:"data"`
+	Tally     int64           `json:"tally"`
+}
+
+// ServerCredentialMsg is published via NATS when a server credential is
+// rotated or deleted, the secret value itself is never included.
+type ServerCredentialMsg struct {
+	Metadata   *MsgMetadata `json:"metadata,omitempty"`
+	ServerID   string       `json:"server_id"`
+	SecretType string       `json:"secret_type"`
+	Username   string       `json:"username,omitempty"`
+	// Actor and Justification are only set on reads
+	Actor         string `json:"actor,omitempty"`
+	Justification string `json:"justification,omitempty"`
+}
+
+// ServerFirmwareSetMsg is published via NATS when the effective firmware set
+// of a server changes, an empty FirmwareSetID means none applies anymore.
+type ServerFirmwareSetMsg struct {
+	Metadata              *MsgMetadata `json:"metadata,omitempty"`
+	ServerID              string       `json:"server_id"`
+	FirmwareSetID         string       `json:"firmware_set_id,omitempty"`
+	PreviousFirmwareSetID string       `json:"previous_firmware_set_id,omitempty"`
+}
+
+// ServerStateMsg is published via NATS when a server moves to another
+// lifecycle state
+type ServerStateMsg struct {
+	Metadata  *MsgMetadata `json:"metadata,omitempty"`
+	ServerID  string       `json:"server_id"`
+	FromState string       `json:"from_state"`
+	ToState   string       `json:"to_state"`
+	Reason    string       `json:"reason"`
+	Actor     string       `json:"actor,omitempty"`
+}
+
+// MaintenanceWindowMsg is published via NATS when a maintenance window starts
+// and when it ends. A window applies to the servers listed in ServerIDs,
+// otherwise to the servers matching the facility code and attribute selectors.
+type MaintenanceWindowMsg struct {
+	Metadata     *MsgMetadata        `json:"metadata,omitempty"`
+	ID           string              `json:"id"`
+	Reason       string              `json:"reason"`
+	StartsAt     time.Time           `json:"starts_at"`
+	EndsAt       time.Time           `json:"ends_at"`
+	ServerIDs    []string            `json:"server_ids,omitempty"`
+	FacilityCode string              `json:"facility_code,omitempty"`
+	Attributes   []AttributeSelector `json:"attributes,omitempty"`
+}
+
+func serializeMsg(msg interface{}) ([]byte, error) {
+	byt, err := json.Marshal(msg)
+	if err != nil {
+		return nil, errors.Wrap(ErrBadJSONOut, err.Error())
+	}
+	return byt, nil
+}
+
+func deserializeMsg(inc []byte, msg interface{}) error {
+	if err := json.Unmarshal(inc, msg); err != nil {
+		return errors.Wrap(ErrBadJSONIn, err.Error())
+	}
+	return nil
+}
+
+func newComponentMsgs(dbComponents models.ServerComponentSlice) []ComponentMsg {
+	cmps := make([]ComponentMsg, 0, len(dbComponents))
+	for _, dbC := range dbComponents {
+		cmps = append(cmps, ComponentMsg{
+			ID:              dbC.ID,
+			ComponentTypeID: dbC.ServerComponentTypeID,
+			Name:            dbC.Name,
+			Vendor:          dbC.Vendor,
+			Model:           dbC.Model,
+			Serial:          dbC.Serial,
+		})
+	}
+	return cmps
+}
+
 // NewCreateServerMessage composes a CreateServer message for NATS
 func NewCreateServerMessage(srv *models.Server) ([]byte, error) {
 	if srv == nil {
@@ -44,18 +205,291 @@ func NewCreateServerMessage(srv *models.Server) ([]byte, error) {
 		FacilityCode: srv.FacilityCode,
 		ID:           srv.ID,
 	}
-	byt, err := json.Marshal(cs)
-	if err != nil {
-		return nil, errors.Wrap(ErrBadJSONOut, err.Error())
-	}
-	return byt, err
+	return serializeMsg(cs)
 }
 
 // DeserializeCreateServer reconstitutes a CreateServer from raw bytes
 func DeserializeCreateServer(inc []byte) (*CreateServer, error) {
 	cs := &CreateServer{}
-	if err := json.Unmarshal(inc, cs); err != nil {
-		return nil, errors.Wrap(ErrBadJSONIn, err.Error())
+	if err := deserializeMsg(inc, cs); err != nil {
+		return nil, err
 	}
 	return cs, nil
 }
+
+// NewUpdateServerMessage composes an UpdateServer message for NATS
+func NewUpdateServerMessage(srv *models.Server) ([]byte, error) {
+	if srv == nil {
+		return nil, ErrNilServer
+	}
+	us := &UpdateServer{
+		Metadata: &MsgMetadata{
+			UpdatedAt: time.Now(),
+		},
+		Name:         srv.Name,
+		FacilityCode: srv.FacilityCode,
+		ID:           srv.ID,
+	}
+	return serializeMsg(us)
+}
+
+// DeserializeUpdateServer reconstitutes an UpdateServer from raw bytes
+func DeserializeUpdateServer(inc []byte) (*UpdateServer, error) {
+	us := &UpdateServer{}
+	if err := deserializeMsg(inc, us); err != nil {
+		return nil, err
+	}
+	return us, nil
+}
+
+// NewDeleteServerMessage composes a DeleteServer message for NATS
+func NewDeleteServerMessage(srv *models.Server) ([]byte, error) {
+	if srv == nil {
+		return nil, ErrNilServer
+	}
+	ds := &DeleteServer{
+		Metadata: &MsgMetadata{
+			UpdatedAt: time.Now(),
+		},
+		ID: srv.ID,
+	}
+	return serializeMsg(ds)
+}
+
+// DeserializeDeleteServer reconstitutes a DeleteServer from raw bytes
+func DeserializeDeleteServer(inc []byte) (*DeleteServer, error) {
+	ds := &DeleteServer{}
+	if err := deserializeMsg(inc, ds); err != nil {
+		return nil, err
+	}
+	return ds, nil
+}
+
+// NewCreateServerComponentsMessage composes a CreateServerComponents message for NATS
+func NewCreateServerComponentsMessage(srvID string, dbComponents models.ServerComponentSlice) ([]byte, error) {
+	cc := &CreateServerComponents{
+		Metadata: &MsgMetadata{
+			CreatedAt: time.Now(),
+		},
+		ServerID:   srvID,
+		Components: newComponentMsgs(dbComponents),
+	}
+	return serializeMsg(cc)
+}
+
+// DeserializeCreateServerComponents reconstitutes a CreateServerComponents from raw bytes
+func DeserializeCreateServerComponents(inc []byte) (*CreateServerComponents, error) {
+	cc := &CreateServerComponents{}
+	if err := deserializeMsg(inc, cc); err != nil {
+		return nil, err
+	}
+	return cc, nil
+}
+
+// NewUpdateServerComponentsMessage composes an UpdateServerComponents message for NATS
+func NewUpdateServerComponentsMessage(srvID string, dbComponents models.ServerComponentSlice) ([]byte, error) {
+	uc := &UpdateServerComponents{
+		Metadata: &MsgMetadata{
+			UpdatedAt: time.Now(),
+		},
+		ServerID:   srvID,
+		Components: newComponentMsgs(dbComponents),
+	}
+	return serializeMsg(uc)
+}
+
+// DeserializeUpdateServerComponents reconstitutes an UpdateServerComponents from raw bytes
+func DeserializeUpdateServerComponents(inc []byte) (*UpdateServerComponents, error) {
+	uc := &UpdateServerComponents{}
+	if err := deserializeMsg(inc, uc); err != nil {
+		return nil, err
+	}
+	return uc, nil
+}
+
+// NewDeleteServerComponentsMessage composes a DeleteServerComponents message for NATS
+func NewDeleteServerComponentsMessage(srvID string) ([]byte, error) {
+	dc := &DeleteServerComponents{
+		Metadata: &MsgMetadata{
+			UpdatedAt: time.Now(),
+		},
+		ServerID: srvID,
+	}
+	return serializeMsg(dc)
+}
+
+// DeserializeDeleteServerComponents reconstitutes a DeleteServerComponents from raw bytes
+func DeserializeDeleteServerComponents(inc []byte) (*DeleteServerComponents, error) {
+	dc := &DeleteServerComponents{}
+	if err := deserializeMsg(inc, dc); err != nil {
+		return nil, err
+	}
+	return dc, nil
+}
+
+// NewServerAttributesMessage composes a ServerAttributesMsg for NATS, data is
+// omitted for delete events.
+func NewServerAttributesMessage(srvID, namespace string, data json.RawMessage) ([]byte, error) {
+	am := &ServerAttributesMsg{
+		Metadata: &MsgMetadata{
+			UpdatedAt: time.Now(),
+		},
+		ServerID:  srvID,
+		Namespace: namespace,
+		Data:      data,
+	}
+	return serializeMsg(am)
+}
+
+// DeserializeServerAttributes reconstitutes a ServerAttributesMsg from raw bytes
+func DeserializeServerAttributes(inc []byte) (*ServerAttributesMsg, error) {
+	am := &ServerAttributesMsg{}
+	if err := deserializeMsg(inc, am); err != nil {
+		return nil, err
+	}
+	return am, nil
+}
+
+// NewCreateServerVersionedAttributesMessage composes a CreateServerVersionedAttributes message for NATS
+func NewCreateServerVersionedAttributesMessage(srvID string, dbVA *models.VersionedAttribute) ([]byte, error) {
+	if dbVA == nil {
+		return nil, errors.Wrap(ErrBadJSONOut, "nil versioned attributes")
+	}
+	va := &CreateServerVersionedAttributes{
+		Metadata: &MsgMetadata{
+			CreatedAt: time.Now(),
+		},
+		ServerID:  srvID,
+		Namespace: dbVA.Namespace,
+		Data:      json.RawMessage(dbVA.Data),
+		Tally:     dbVA.Tally,
+	}
+	return serializeMsg(va)
+}
+
+// DeserializeCreateServerVersionedAttributes reconstitutes a CreateServerVersionedAttributes from raw bytes
+func DeserializeCreateServerVersionedAttributes(inc []byte) (*CreateServerVersionedAttributes, error) {
+	va := &CreateServerVersionedAttributes{}
+	if err := deserializeMsg(inc, va); err != nil {
+		return nil, err
+	}
+	return va, nil
+}
+
+// NewServerCredentialMessage composes a ServerCredentialMsg for NATS
+func NewServerCredentialMessage(srvID, secretType, username string) ([]byte, error) {
+	cm := &ServerCredentialMsg{
+		Metadata: &MsgMetadata{
+			UpdatedAt: time.Now(),
+		},
+		ServerID:   srvID,
+		SecretType: secretType,
+		Username:   username,
+	}
+	return serializeMsg(cm)
+}
+
+// NewServerCredentialReadMessage composes a ServerCredentialMsg for NATS
+// recording who decrypted a credential and why
+func NewServerCredentialReadMessage(srvID, secretType, username, actor, justification string) ([]byte, error) {
+	cm := &ServerCredentialMsg{
+		Metadata: &MsgMetadata{
+			UpdatedAt: time.Now(),
+		},
+		ServerID:      srvID,
+		SecretType:    secretType,
+		Username:      username,
+		Actor:         actor,
+		Justification: justification,
+	}
+	return serializeMsg(cm)
+}
+
+// DeserializeServerCredential reconstitutes a ServerCredentialMsg from raw bytes
+func DeserializeServerCredential(inc []byte) (*ServerCredentialMsg, error) {
+	cm := &ServerCredentialMsg{}
+	if err := deserializeMsg(inc, cm); err != nil {
+		return nil, err
+	}
+	return cm, nil
+}
+
+// NewServerFirmwareSetMessage composes a ServerFirmwareSetMsg for NATS
+func NewServerFirmwareSetMessage(srvID, firmwareSetID, previousFirmwareSetID string) ([]byte, error) {
+	fm := &ServerFirmwareSetMsg{
+		Metadata: &MsgMetadata{
+			UpdatedAt: time.Now(),
+		},
+		ServerID:              srvID,
+		FirmwareSetID:         firmwareSetID,
+		PreviousFirmwareSetID: previousFirmwareSetID,
+	}
+	return serializeMsg(fm)
+}
+
+// DeserializeServerFirmwareSet reconstitutes a ServerFirmwareSetMsg from raw bytes
+func DeserializeServerFirmwareSet(inc []byte) (*ServerFirmwareSetMsg, error) {
+	fm := &ServerFirmwareSetMsg{}
+	if err := deserializeMsg(inc, fm); err != nil {
+		return nil, err
+	}
+	return fm, nil
+}
+
+// NewServerStateMessage composes a ServerStateMsg for NATS
+func NewServerStateMessage(dbT *models.ServerStateTransition) ([]byte, error) {
+	sm := &ServerStateMsg{
+		Metadata: &MsgMetadata{
+			CreatedAt: dbT.CreatedAt,
+		},
+		ServerID:  dbT.ServerID,
+		FromState: dbT.FromState,
+		ToState:   dbT.ToState,
+		Reason:    dbT.Reason,
+		Actor:     dbT.ActorSubject.String,
+	}
+	return serializeMsg(sm)
+}
+
+// DeserializeServerState reconstitutes a ServerStateMsg from raw bytes
+func DeserializeServerState(inc []byte) (*ServerStateMsg, error) {
+	sm := &ServerStateMsg{}
+	if err := deserializeMsg(inc, sm); err != nil {
+		return nil, err
+	}
+	return sm, nil
+}
+
+// NewMaintenanceWindowMessage composes a MaintenanceWindowMsg for NATS, the
+// servers the window lists must have been loaded along with it
+func NewMaintenanceWindowMessage(dbW *models.MaintenanceWindow) ([]byte, error) {
+	mm := &MaintenanceWindowMsg{
+		Metadata: &MsgMetadata{
+			CreatedAt: dbW.CreatedAt.Time,
+			UpdatedAt: dbW.UpdatedAt.Time,
+		},
+		ID:           dbW.ID,
+		Reason:       dbW.Reason,
+		StartsAt:     dbW.StartsAt,
+		EndsAt:       dbW.EndsAt,
+		FacilityCode: dbW.FacilityCode.String,
+	}
+	for _, s := range dbW.R.GetMaintenanceWindowServers() {
+		mm.ServerIDs = append(mm.ServerIDs, s.ServerID)
+	}
+	if dbW.Attributes.Valid {
+		if err := json.Unmarshal(dbW.Attributes.JSON, &mm.Attributes); err != nil {
+			return nil, errors.Wrap(ErrBadJSONIn, err.Error())
+		}
+	}
+	return serializeMsg(mm)
+}
+
+// DeserializeMaintenanceWindow reconstitutes a MaintenanceWindowMsg from raw bytes
+func DeserializeMaintenanceWindow(inc []byte) (*MaintenanceWindowMsg, error) {
+	mm := &MaintenanceWindowMsg{}
+	if err := deserializeMsg(inc, mm); err != nil {
+		return nil, err
+	}
+	return mm, nil
+}
@@ -40,3 +40,158 @@ func TestSerialization(t *testing.T) {
 	require.Equal(t, exp.FacilityCode, cs.FacilityCode, "good deserialize facility")
 	require.Equal(t, exp.ID, cs.ID, "good deserialize id")
 }
+
+func TestServerMessageSerialization(t *testing.T) {
+	srv := &models.Server{
+		Name:         null.StringFrom("server-name"),
+		FacilityCode: null.StringFrom("fc13"),
+		ID:           "some-uuid-str",
+	}
+
+	_, err := NewUpdateServerMessage(nil)
+	require.ErrorIs(t, err, ErrNilServer, "nil update input")
+
+	byt, err := NewUpdateServerMessage(srv)
+	require.NoError(t, err)
+
+	us, err := DeserializeUpdateServer(byt)
+	require.NoError(t, err)
+	require.Equal(t, srv.Name, us.Name)
+	require.Equal(t, srv.FacilityCode, us.FacilityCode)
+	require.Equal(t, srv.ID, us.ID)
+
+	_, err = NewDeleteServerMessage(nil)
+	require.ErrorIs(t, err, ErrNilServer, "nil delete input")
+
+	byt, err = NewDeleteServerMessage(srv)
+	require.NoError(t, err)
+
+	ds, err := DeserializeDeleteServer(byt)
+	require.NoError(t, err)
+	require.Equal(t, srv.ID, ds.ID)
+
+	_, err = DeserializeDeleteServer([]byte("bogus"))
+	require.ErrorIs(t, err, ErrBadJSONIn, "bogus deserialize")
+}
+
+func TestComponentMessageSerialization(t *testing.T) {
+	cmps := models.ServerComponentSlice{
+		{
+			ID:                    "component-uuid-str",
+			ServerComponentTypeID: "type-uuid-str",
+			Name:                  null.StringFrom("NIC"),
+			Vendor:                null.StringFrom("mellanox"),
+			Serial:                null.StringFrom("1234"),
+		},
+	}
+
+	byt, err := NewCreateServerComponentsMessage("some-uuid-str", cmps)
+	require.NoError(t, err)
+
+	cc, err := DeserializeCreateServerComponents(byt)
+	require.NoError(t, err)
+	require.Equal(t, "some-uuid-str", cc.ServerID)
+	require.Len(t, cc.Components, 1)
+	require.Equal(t, "component-uuid-str", cc.Components[0].ID)
+	require.Equal(t, "type-uuid-str", cc.Components[0].ComponentTypeID)
+	require.Equal(t, null.StringFrom("mellanox"), cc.Components[0].Vendor)
+	require.False(t, cc.Components[0].Model.Valid)
+
+	byt, err = NewUpdateServerComponentsMessage("some-uuid-str", cmps)
+	require.NoError(t, err)
+
+	uc, err := DeserializeUpdateServerComponents(byt)
+	require.NoError(t, err)
+	require.Equal(t, cc.Components, uc.Components)
+
+	byt, err = NewDeleteServerComponentsMessage("some-uuid-str")
+	require.NoError(t, err)
+
+	dc, err := DeserializeDeleteServerComponents(byt)
+	require.NoError(t, err)
+	require.Equal(t, "some-uuid-str", dc.ServerID)
+}
+
+func TestAttributesMessageSerialization(t *testing.T) {
+	byt, err := NewServerAttributesMessage("some-uuid-str", "hollow.test", []byte(`{"k":"v"}`))
+	require.NoError(t, err)
+
+	am, err := DeserializeServerAttributes(byt)
+	require.NoError(t, err)
+	require.Equal(t, "some-uuid-str", am.ServerID)
+	require.Equal(t, "hollow.test", am.Namespace)
+	require.JSONEq(t, `{"k":"v"}`, string(am.Data))
+
+	byt, err = NewServerAttributesMessage("some-uuid-str", "hollow.test", nil)
+	require.NoError(t, err)
+	require.NotContains(t, string(byt), `"data"`, "delete events carry no data")
+
+	_, err = NewCreateServerVersionedAttributesMessage("some-uuid-str", nil)
+	require.ErrorIs(t, err, ErrBadJSONOut, "nil versioned attributes")
+
+	byt, err = NewCreateServerVersionedAttributesMessage("some-uuid-str", &models.VersionedAttribute{
+		Namespace: "hollow.versioned",
+		Data:      []byte(`{"k":"v"}`),
+		Tally:     3,
+	})
+	require.NoError(t, err)
+
+	va, err := DeserializeCreateServerVersionedAttributes(byt)
+	require.NoError(t, err)
+	require.Equal(t, "hollow.versioned", va.Namespace)
+	require.Equal(t, int64(3), va.Tally)
+	require.JSONEq(t, `{"k":"v"}`, string(va.Data))
+}
+
+func TestCredentialMessageSerialization(t *testing.T) {
+	byt, err := NewServerCredentialMessage("some-uuid-str", "bmc", "root")
+	require.NoError(t, err)
+
+	cm, err := DeserializeServerCredential(byt)
+	require.NoError(t, err)
+	require.Equal(t, "some-uuid-str", cm.ServerID)
+	require.Equal(t, "bmc", cm.SecretType)
+	require.Equal(t, "root", cm.Username)
+}
+
+func TestCredentialReadMessageSerialization(t *testing.T) {
+	byt, err := NewServerCredentialReadMessage("some-uuid-str", "bmc", "root", "oncall", "INC-42 host unreachable")
+	require.NoError(t, err)
+
+	cm, err := DeserializeServerCredential(byt)
+	require.NoError(t, err)
+	require.Equal(t, "some-uuid-str", cm.ServerID)
+	require.Equal(t, "oncall", cm.Actor)
+	require.Equal(t, "INC-42 host unreachable", cm.Justification)
+}
+
+func TestFirmwareSetMessageSerialization(t *testing.T) {
+	byt, err := NewServerFirmwareSetMessage("some-uuid-str", "new-set", "old-set")
+	require.NoError(t, err)
+
+	fm, err := DeserializeServerFirmwareSet(byt)
+	require.NoError(t, err)
+	require.Equal(t, "some-uuid-str", fm.ServerID)
+	require.Equal(t, "new-set", fm.FirmwareSetID)
+	require.Equal(t, "old-set", fm.PreviousFirmwareSetID)
+}
+
+func TestMaintenanceWindowMessageSerialization(t *testing.T) {
+	dbW := &models.MaintenanceWindow{
+		ID:         "some-uuid-str",
+		Reason:     "BMC update",
+		Attributes: null.JSONFrom([]byte(`[{"namespace": "hollow.other_data", "keys": ["type"], "value": "clown"}]`)),
+	}
+	dbW.R = dbW.R.NewStruct()
+	dbW.R.MaintenanceWindowServers = models.MaintenanceWindowServerSlice{{ServerID: "server-uuid-str"}}
+
+	byt, err := NewMaintenanceWindowMessage(dbW)
+	require.NoError(t, err)
+
+	mm, err := DeserializeMaintenanceWindow(byt)
+	require.NoError(t, err)
+	require.Equal(t, "some-uuid-str", mm.ID)
+	require.Equal(t, "BMC update", mm.Reason)
+	require.Equal(t, []string{"server-uuid-str"}, mm.ServerIDs)
+	require.Equal(t, []AttributeSelector{{Namespace: "hollow.other_data", Keys: []string{"type"}, Value: "clown"}}, mm.Attributes)
+}
@@ -1,8 +1,12 @@
 package serverservice
 
 import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
 	"net/url"
 	"strconv"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/volatiletech/sqlboiler/v4/queries/qm"
@@ -17,11 +21,14 @@ var (
 	defaultPaginationSize = 100
 )
 
-// PaginationParams allow you to paginate the results
+// PaginationParams allow you to paginate the results. Lists are paginated by
+// page number unless Keyset is set or a Cursor is given, the first page of a
+// cursor paginated list is asked for with Keyset and no Cursor.
 type PaginationParams struct {
 	Limit   int    `json:"limit,omitempty"`
 	Page    int    `json:"page,omitempty"`
 	Cursor  string `json:"cursor,omitempty"`
+	Keyset  bool   `json:"keyset,omitempty"`
 	Preload bool   `json:"preload,omitempty"`
 	OrderBy string `json:"orderby,omitempty"`
 }
@@ -30,12 +37,51 @@ type paginationData struct {
 	pageCount  int
 	totalCount int64
 	pager      PaginationParams
+	nextCursor string
 }
 
+// paginationCursor is the decoded form of the opaque cursor handed out in the
+// next link of keyset paginated lists, it holds the sort key and ID of the last
+// record on the previous page.
+type paginationCursor struct {
+	Key string `json:"k"`
+	ID  string `json:"id"`
+}
+
+func encodeCursor(key, id string) string {
+	// nolint:errchkjson // marshaling a struct of strings can't fail
+	b, _ := json.Marshal(paginationCursor{Key: key, ID: id})
+
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+// encodeTimeCursor returns a cursor for lists ordered by a timestamp
+func encodeTimeCursor(t time.Time, id string) string {
+	return encodeCursor(t.Format(time.RFC3339Nano), id)
+}
+
+func decodeCursor(s string) (*paginationCursor, error) {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+
+	cur := &paginationCursor{}
+	if err := json.Unmarshal(b, cur); err != nil || cur.ID == "" {
+		return nil, ErrInvalidCursor
+	}
+
+	return cur, nil
+}
+
+// parsePagination reads the pagination query parameters, lists that support it
+// use keyset pagination when the cursor parameter is given, even empty.
 func parsePagination(c *gin.Context) PaginationParams {
 	// Initializing default
 	limit := defaultPaginationSize
 	page := 1
+	cursor := ""
+	keyset := false
 	query := c.Request.URL.Query()
 
 	for key, value := range query {
@@ -46,13 +92,60 @@ func parsePagination(c *gin.Context) PaginationParams {
 			limit, _ = strconv.Atoi(queryValue)
 		case "page":
 			page, _ = strconv.Atoi(queryValue)
+		case "cursor":
+			cursor = queryValue
+			keyset = true
 		}
 	}
 
 	return PaginationParams{
-		Limit: limit,
-		Page:  page,
+		Limit:  limit,
+		Page:   page,
+		Cursor: cursor,
+		Keyset: keyset,
+	}
+}
+
+// keyset returns true when the list should be paginated by cursor instead of
+// by page number.
+func (p *PaginationParams) keyset() bool {
+	return p.Keyset || p.Cursor != ""
+}
+
+// keysetQueryMods returns the query mods to load the page following the
+// cursor, ordered by column descending with the ID as a tie breaker. One more
+// record than the page size is requested so the caller can tell if there is a
+// next page, see hasNextKeysetPage.
+//
+// The cursor bound is applied as a join condition rather than a where clause
+// so it can't be short circuited by OR'ed attribute filters.
+func (p *PaginationParams) keysetQueryMods(table, column, columnType string) ([]qm.QueryMod, error) {
+	mods := []qm.QueryMod{
+		qm.OrderBy(fmt.Sprintf("%s.%s DESC, %s.id DESC", table, column, table)),
+		qm.Limit(p.limitUsed() + 1),
+	}
+
+	if p.Cursor == "" {
+		return mods, nil
 	}
+
+	cur, err := decodeCursor(p.Cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	join := fmt.Sprintf(
+		"(SELECT ?::%s AS k, ?::UUID AS id) AS page_cursor ON (%s.%s, %s.id) < (page_cursor.k, page_cursor.id)",
+		columnType, table, column, table,
+	)
+
+	return append(mods, qm.InnerJoin(join, cur.Key, cur.ID)), nil
+}
+
+// hasNextKeysetPage returns true when more records were loaded than fit in a
+// page, the caller should drop the extra one.
+func (p *PaginationParams) hasNextKeysetPage(loaded int) bool {
+	return loaded > p.limitUsed()
 }
 
 // queryMods converts the list params into sql conditions that can be added to sql queries
@@ -85,15 +178,14 @@ func (p *PaginationParams) serverQueryMods() []qm.QueryMod {
 
 	mods := []qm.QueryMod{}
 
-	mods = append(mods, qm.Limit(p.limitUsed()))
-
-	if p.Page != 0 {
-		mods = append(mods, qm.Offset(p.offset()))
-	}
+	// keyset paginated lists get their ordering and limit from keysetQueryMods
+	if !p.keyset() {
+		mods = append(mods, qm.Limit(p.limitUsed()), qm.Offset(p.offset()))
 
-	// match the old functionality for now...will handle order and load as params later
-	if p.OrderBy != "" {
-		mods = append(mods, qm.OrderBy(p.OrderBy))
+		// match the old functionality for now...will handle order and load as params later
+		if p.OrderBy != "" {
+			mods = append(mods, qm.OrderBy(p.OrderBy))
+		}
 	}
 
 	if p.Preload {
@@ -117,14 +209,15 @@ func (p *PaginationParams) serverComponentsQueryMods() []qm.QueryMod {
 
 	mods := []qm.QueryMod{}
 
-	mods = append(mods, qm.Limit(p.limitUsed()))
-
-	if p.Page != 0 {
-		mods = append(mods, qm.Offset(p.offset()))
+	// keyset paginated lists get their ordering and limit from keysetQueryMods
+	if !p.keyset() {
+		mods = append(mods,
+			qm.Limit(p.limitUsed()),
+			qm.Offset(p.offset()),
+			qm.OrderBy(models.ServerComponentTableColumns.CreatedAt+" DESC"),
+		)
 	}
 
-	mods = append(mods, qm.OrderBy(models.ServerComponentTableColumns.CreatedAt+" DESC"))
-
 	preload := []qm.QueryMod{
 		qm.Load("Attributes"),
 		qm.Load("VersionedAttributes", qm.Where("(server_component_id, namespace, created_at) IN (select server_component_id, namespace, max(created_at) from versioned_attributes group by server_component_id, namespace)")),
@@ -140,7 +233,7 @@ func (p *PaginationParams) setQuery(q url.Values) {
 		return
 	}
 
-	if p.Cursor != "" {
+	if p.keyset() {
 		q.Set("cursor", p.Cursor)
 	}
 
@@ -0,0 +1,85 @@
+package serverservice
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+
+	"go.hollow.sh/serverservice/internal/models"
+)
+
+func TestPaginationCursor(t *testing.T) {
+	ts := time.Date(2023, 1, 2, 3, 4, 5, 6000, time.UTC)
+
+	cur, err := decodeCursor(encodeTimeCursor(ts, "some-uuid-str"))
+	require.NoError(t, err)
+	assert.Equal(t, "some-uuid-str", cur.ID)
+
+	got, err := time.Parse(time.RFC3339Nano, cur.Key)
+	require.NoError(t, err)
+	assert.True(t, ts.Equal(got))
+
+	for _, bogus := range []string{"not base64!", "bm90IGpzb24", encodeCursor("key", "")} {
+		_, err := decodeCursor(bogus)
+		assert.ErrorIs(t, err, ErrInvalidCursor, bogus)
+	}
+}
+
+func TestParsePagination(t *testing.T) {
+	testCases := []struct {
+		query    string
+		expected PaginationParams
+		keyset   bool
+		testName string
+	}{
+		{"", PaginationParams{Limit: defaultPaginationSize, Page: 1}, false, "defaults to the first page"},
+		{"cursor=", PaginationParams{Limit: defaultPaginationSize, Page: 1, Keyset: true}, true, "first keyset page"},
+		{"limit=10&cursor=abc", PaginationParams{Limit: 10, Page: 1, Cursor: "abc", Keyset: true}, true, "cursor"},
+		{"limit=10&page=3", PaginationParams{Limit: 10, Page: 3}, false, "page number"},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.testName, func(t *testing.T) {
+			c, _ := gin.CreateTestContext(httptest.NewRecorder())
+			c.Request, _ = http.NewRequest(http.MethodGet, "/?"+tt.query, nil)
+
+			p := parsePagination(c)
+			assert.Equal(t, tt.expected, p)
+			assert.Equal(t, tt.keyset, p.keyset())
+		})
+	}
+}
+
+func TestKeysetQueryMods(t *testing.T) {
+	p := &PaginationParams{Limit: 2}
+
+	mods, err := p.keysetQueryMods("servers", "created_at", "TIMESTAMPTZ")
+	require.NoError(t, err)
+
+	sql, args := queries.BuildQuery(models.Servers(mods...).Query)
+	assert.Contains(t, sql, "ORDER BY servers.created_at DESC, servers.id DESC LIMIT 3")
+	assert.NotContains(t, sql, "page_cursor")
+	assert.Empty(t, args)
+
+	p.Cursor = encodeCursor("2023-01-02T03:04:05Z", "some-uuid-str")
+
+	mods, err = p.keysetQueryMods("servers", "created_at", "TIMESTAMPTZ")
+	require.NoError(t, err)
+
+	sql, args = queries.BuildQuery(models.Servers(mods...).Query)
+	assert.Contains(t, sql, "(servers.created_at, servers.id) < (page_cursor.k, page_cursor.id)")
+	assert.Equal(t, []interface{}{"2023-01-02T03:04:05Z", "some-uuid-str"}, args)
+
+	assert.False(t, p.hasNextKeysetPage(2))
+	assert.True(t, p.hasNextKeysetPage(3))
+
+	p.Cursor = "bogus"
+	_, err = p.keysetQueryMods("servers", "created_at", "TIMESTAMPTZ")
+	assert.ErrorIs(t, err, ErrInvalidCursor)
+}
@@ -1,9 +1,11 @@
 package serverservice
 
 import (
+	"bufio"
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -41,6 +43,23 @@ func newPostRequest(ctx context.Context, uri, path string, body interface{}) (*h
 	return http.NewRequestWithContext(ctx, http.MethodPost, requestURL.String(), buf)
 }
 
+// newRawPostRequest returns a POST of a body that is already encoded
+func newRawPostRequest(ctx context.Context, uri, path, contentType string, body io.Reader) (*http.Request, error) {
+	requestURL, err := url.Parse(fmt.Sprintf("%s/api/%s/%s", uri, apiVersion, path))
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, requestURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("Content-Type", contentType)
+
+	return req, nil
+}
+
 func newPutRequest(ctx context.Context, uri, path string, body interface{}) (*http.Request, error) {
 	requestURL, err := url.Parse(fmt.Sprintf("%s/api/%s/%s", uri, apiVersion, path))
 	if err != nil {
@@ -74,10 +93,22 @@ func userAgentString() string {
 	return fmt.Sprintf("go-hollow-client (%s)", version.String())
 }
 
-func (c *Client) do(req *http.Request, result interface{}) error {
+func (c *Client) setHeaders(req *http.Request) {
 	req.Header.Set("Authorization", fmt.Sprintf("bearer %s", c.authToken))
 	req.Header.Set("User-Agent", userAgentString())
 
+	if justification := accessJustification(req.Context()); justification != "" {
+		req.Header.Set(AccessJustificationHeader, justification)
+	}
+
+	if holder := serverLeaseHolder(req.Context()); holder != "" {
+		req.Header.Set(ServerLeaseHolderHeader, holder)
+	}
+}
+
+func (c *Client) do(req *http.Request, result interface{}) error {
+	c.setHeaders(req)
+
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
 		return err
@@ -96,3 +127,76 @@ func (c *Client) do(req *http.Request, result interface{}) error {
 
 	return json.Unmarshal(data, result)
 }
+
+// open makes the request and returns the response body for the caller to
+// read as it arrives and close
+func (c *Client) open(req *http.Request) (io.ReadCloser, error) {
+	c.setHeaders(req)
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ensureValidServerResponse(resp); err != nil {
+		return nil, err
+	}
+
+	return resp.Body, nil
+}
+
+// streamExport makes the export request and copies the response body to w
+// as it arrives, line by line. The status record ending the export is held
+// back from w and turned into an error when the export failed or was cut
+// short.
+func (c *Client) streamExport(req *http.Request, format string, w io.Writer) error {
+	body, err := c.open(req)
+	if err != nil {
+		return err
+	}
+
+	defer body.Close()
+
+	br := bufio.NewReader(body)
+
+	var last []byte
+
+	for {
+		line, err := br.ReadBytes('\n')
+		if len(line) > 0 {
+			if last != nil {
+				if _, werr := w.Write(last); werr != nil {
+					return werr
+				}
+			}
+
+			last = line
+		}
+
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	status, ok := parseServerExportStatus(format, bytes.TrimSpace(last))
+	if !ok {
+		// the line read last was a record of the export, not its status
+		if last != nil {
+			if _, err := w.Write(last); err != nil {
+				return err
+			}
+		}
+
+		return ErrExportIncomplete
+	}
+
+	if status.Status != ServerExportStatusComplete {
+		return fmt.Errorf("%w: %s", ErrExportFailed, status.Error)
+	}
+
+	return nil
+}
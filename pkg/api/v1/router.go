@@ -4,28 +4,41 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
-	"strings"
+	"net/http"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
 	"github.com/jmoiron/sqlx"
 	"github.com/pkg/errors"
 	"github.com/volatiletech/sqlboiler/v4/boil"
-	"go.hollow.sh/toolbox/events"
 	"go.hollow.sh/toolbox/ginjwt"
 	"go.uber.org/zap"
-	"gocloud.dev/secrets"
 
 	"go.hollow.sh/serverservice/internal/models"
+	"go.hollow.sh/serverservice/internal/outbox"
 )
 
+// streamTimeout is how long a request streaming servers or their credentials
+// may take
+var streamTimeout = time.Hour
+
 // Router provides a router for the v1 API
 type Router struct {
-	AuthMW        *ginjwt.Middleware
-	DB            *sqlx.DB
-	SecretsKeeper *secrets.Keeper
-	Logger        *zap.Logger
-	EventStream   events.Stream
+	AuthMW  *ginjwt.Middleware
+	DB      *sqlx.DB
+	Keyring Keyring
+	Logger  *zap.Logger
+	// RecordHistory keeps a snapshot of servers, their attributes and
+	// components on every change so they can be viewed as of a point in time
+	RecordHistory bool
+	// Lifecycle is the graph of states servers move through, the default
+	// lifecycle is used when it's nil
+	Lifecycle *ServerLifecycle
+	// EventsEnabled writes events to the outbox for the relay to publish, it's
+	// set when an event stream is configured, reachable or not. Without one
+	// nothing drains the outbox so events aren't written at all.
+	EventsEnabled bool
 }
 
 // Routes will add the routes for this API version to a router group
@@ -42,56 +55,97 @@ func (r *Router) Routes(rg *gin.RouterGroup) {
 		srvs.POST("", amw.RequiredScopes(createScopes("server")), r.serverCreate)
 
 		srvs.GET("/components", amw.RequiredScopes(readScopes("server:component")), r.serverComponentList)
+		srvs.POST("/bulk", amw.RequiredScopes(createScopes("server")), longRunning, r.serverBulkImport)
+		srvs.GET("/export", amw.RequiredScopes(readScopes("server")), longRunning, r.serverExport)
+		srvs.POST("/credentials/:slug", credentialScopes(amw, "read"), longRunning, r.serverCredentialsBulkGet)
+
+		// /servers/:uuid/lease isn't subject to lease enforcement so a holder
+		// can acquire a lease and release one that expired
+		srvLease := srvs.Group("/:uuid/lease")
+		{
+			srvLease.GET("", amw.RequiredScopes(readScopes("server", "server:lease")), r.serverLeaseGet)
+			srvLease.POST("", amw.RequiredScopes(createScopes("server", "server:lease")), r.serverLeaseAcquire)
+			srvLease.PUT("", amw.RequiredScopes(updateScopes("server", "server:lease")), r.serverLeaseRenew)
+			srvLease.DELETE("", amw.RequiredScopes(deleteScopes("server", "server:lease")), r.serverLeaseRelease)
+		}
 
-		// /servers/:uuid
+		// /servers/:uuid, the routes that change a server check its lease
 		srv := srvs.Group("/:uuid")
 		{
 			srv.GET("", amw.RequiredScopes(readScopes("server")), r.serverGet)
-			srv.PUT("", amw.RequiredScopes(updateScopes("server")), r.serverUpdate)
-			srv.DELETE("", amw.RequiredScopes(deleteScopes("server")), r.serverDelete)
+			srv.PUT("", amw.RequiredScopes(updateScopes("server")), r.serverLeaseEnforced, r.serverUpdate)
+			srv.DELETE("", amw.RequiredScopes(deleteScopes("server")), r.serverLeaseEnforced, r.serverDelete)
+			srv.GET("/history", amw.RequiredScopes(readScopes("server")), r.serverHistoryList)
+			srv.GET("/firmware-set", amw.RequiredScopes(readScopes("server", "server-component-firmware-sets")), r.serverFirmwareSetGet)
+			srv.GET("/bom", amw.RequiredScopes(readScopes("server", "bill-of-materials")), r.serverBomGet)
+			srv.GET("/transitions", amw.RequiredScopes(readScopes("server")), r.serverStateTransitionList)
+			srv.POST("/transitions", amw.RequiredScopes(updateScopes("server")), r.serverLeaseEnforced, r.serverStateTransitionCreate)
+			srv.GET("/placement", amw.RequiredScopes(readScopes("server", "racks")), r.serverPlacementGet)
+			srv.PUT("/placement", amw.RequiredScopes(updateScopes("server", "racks")), r.serverLeaseEnforced, r.serverPlacementSet)
+			srv.DELETE("/placement", amw.RequiredScopes(deleteScopes("server", "racks")), r.serverLeaseEnforced, r.serverPlacementDelete)
+			srv.GET("/maintenance", amw.RequiredScopes(readScopes("server", "maintenance-windows")), r.serverMaintenanceGet)
+			srv.GET("/firmware-compliance", amw.RequiredScopes(readScopes("server", "server-component-firmware-sets")), r.serverFirmwareCompliance)
 
 			// /servers/:uuid/attributes
 			srvAttrs := srv.Group("/attributes")
 			{
 				srvAttrs.GET("", amw.RequiredScopes(readScopes("server", "server:attributes")), r.serverAttributesList)
-				srvAttrs.POST("", amw.RequiredScopes(createScopes("server", "server:attributes")), r.serverAttributesCreate)
+				srvAttrs.POST("", amw.RequiredScopes(createScopes("server", "server:attributes")), r.serverLeaseEnforced, r.serverAttributesCreate)
 				srvAttrs.GET("/:namespace", amw.RequiredScopes(readScopes("server", "server:attributes")), r.serverAttributesGet)
-				srvAttrs.PUT("/:namespace", amw.RequiredScopes(updateScopes("server", "server:attributes")), r.serverAttributesUpdate)
-				srvAttrs.DELETE("/:namespace", amw.RequiredScopes(deleteScopes("server", "server:attributes")), r.serverAttributesDelete)
+				srvAttrs.PUT("/:namespace", amw.RequiredScopes(updateScopes("server", "server:attributes")), r.serverLeaseEnforced, r.serverAttributesUpdate)
+				srvAttrs.DELETE("/:namespace", amw.RequiredScopes(deleteScopes("server", "server:attributes")), r.serverLeaseEnforced, r.serverAttributesDelete)
 			}
 
 			// /servers/:uuid/components
 			srvComponents := srv.Group("/components")
 			{
-				srvComponents.POST("", amw.RequiredScopes(createScopes("server", "server:component")), r.serverComponentsCreate)
+				srvComponents.POST("", amw.RequiredScopes(createScopes("server", "server:component")), r.serverLeaseEnforced, r.serverComponentsCreate)
 				srvComponents.GET("", amw.RequiredScopes(readScopes("server", "server:component")), r.serverComponentGet)
-				srvComponents.PUT("", amw.RequiredScopes(updateScopes("server", "server:component")), r.serverComponentUpdate)
-				srvComponents.DELETE("", amw.RequiredScopes(deleteScopes("server", "server:component")), r.serverComponentDelete)
+				srvComponents.PUT("", amw.RequiredScopes(updateScopes("server", "server:component")), r.serverLeaseEnforced, r.serverComponentUpdate)
+				srvComponents.DELETE("", amw.RequiredScopes(deleteScopes("server", "server:component")), r.serverLeaseEnforced, r.serverComponentDelete)
+				srvComponents.GET("/changes", amw.RequiredScopes(readScopes("server", "server:component")), r.serverComponentChanges)
 			}
 
 			// /servers/:uuid/credentials/:slug
 			svrCreds := srv.Group("credentials/:slug")
 			{
-				svrCreds.GET("", amw.RequiredScopes([]string{"read:server:credentials"}), r.serverCredentialGet)
-				svrCreds.PUT("", amw.RequiredScopes([]string{"write:server:credentials"}), r.serverCredentialUpsert)
-				svrCreds.DELETE("", amw.RequiredScopes([]string{"write:server:credentials"}), r.serverCredentialDelete)
+				svrCreds.GET("", credentialScopes(amw, "read"), r.serverCredentialGet)
+				svrCreds.PUT("", credentialScopes(amw, "write"), r.serverLeaseEnforced, r.serverCredentialUpsert)
+				svrCreds.DELETE("", credentialScopes(amw, "write"), r.serverLeaseEnforced, r.serverCredentialDelete)
+				svrCreds.POST("/generate", credentialScopes(amw, "write"), r.serverLeaseEnforced, r.serverCredentialGenerate)
+				svrCreds.GET("/versions", credentialScopes(amw, "read"), r.serverCredentialVersionsList)
+				svrCreds.GET("/versions/:version", credentialScopes(amw, "read"), r.serverCredentialVersionGet)
+				svrCreds.POST("/versions/:version/restore", credentialScopes(amw, "write"), r.serverLeaseEnforced, r.serverCredentialVersionRestore)
 			}
 
 			// /servers/:uuid/versioned-attributes
 			srvVerAttrs := srv.Group("/versioned-attributes")
 			{
 				srvVerAttrs.GET("", amw.RequiredScopes(readScopes("server", "server:versioned-attributes")), r.serverVersionedAttributesList)
-				srvVerAttrs.POST("", amw.RequiredScopes(createScopes("server", "server:versioned-attributes")), r.serverVersionedAttributesCreate)
+				srvVerAttrs.POST("", amw.RequiredScopes(createScopes("server", "server:versioned-attributes")), r.serverLeaseEnforced, r.serverVersionedAttributesCreate)
 				srvVerAttrs.GET("/:namespace", amw.RequiredScopes(readScopes("server", "server:versioned-attributes")), r.serverVersionedAttributesGet)
 			}
 		}
 	}
 
+	// /maintenance-windows
+	maintenanceWindows := rg.Group("/maintenance-windows")
+	{
+		maintenanceWindows.GET("", amw.RequiredScopes(readScopes("maintenance-windows")), r.maintenanceWindowList)
+		maintenanceWindows.POST("", amw.RequiredScopes(createScopes("maintenance-windows")), r.maintenanceWindowCreate)
+		maintenanceWindows.GET("/:uuid", amw.RequiredScopes(readScopes("maintenance-windows")), r.maintenanceWindowGet)
+		maintenanceWindows.PUT("/:uuid", amw.RequiredScopes(updateScopes("maintenance-windows")), r.maintenanceWindowUpdate)
+		maintenanceWindows.DELETE("/:uuid", amw.RequiredScopes(deleteScopes("maintenance-windows")), r.maintenanceWindowDelete)
+	}
+
 	// /server-component-types
 	srvCmpntType := rg.Group("/server-component-types")
 	{
 		srvCmpntType.GET("", amw.RequiredScopes(readScopes("server-component-types")), r.serverComponentTypeList)
 		srvCmpntType.POST("", amw.RequiredScopes(updateScopes("server-component-types")), r.serverComponentTypeCreate)
+		srvCmpntType.GET("/:slug", amw.RequiredScopes(readScopes("server-component-types")), r.serverComponentTypeGet)
+		srvCmpntType.PUT("/:slug", amw.RequiredScopes(updateScopes("server-component-types")), r.serverComponentTypeUpdate)
+		srvCmpntType.DELETE("/:slug", amw.RequiredScopes(deleteScopes("server-component-types")), r.serverComponentTypeDelete)
 	}
 
 	// /server-component-firmwares
@@ -109,6 +163,9 @@ func (r *Router) Routes(rg *gin.RouterGroup) {
 	{
 		srvCredentialTypes.GET("", amw.RequiredScopes(readScopes("server-credential-types")), r.serverCredentialTypesList)
 		srvCredentialTypes.POST("", amw.RequiredScopes(createScopes("server-credential-types")), r.serverCredentialTypesCreate)
+		srvCredentialTypes.GET("/:slug", amw.RequiredScopes(readScopes("server-credential-types")), r.serverCredentialTypeGet)
+		srvCredentialTypes.PUT("/:slug", amw.RequiredScopes(updateScopes("server-credential-types")), r.serverCredentialTypeUpdate)
+		srvCredentialTypes.DELETE("/:slug", amw.RequiredScopes(deleteScopes("server-credential-types")), r.serverCredentialTypeDelete)
 	}
 
 	// /server-component-firmware-sets
@@ -119,16 +176,76 @@ func (r *Router) Routes(rg *gin.RouterGroup) {
 		srvCmpntFwSets.GET("/:uuid", amw.RequiredScopes(readScopes("server-component-firmware-sets")), r.serverComponentFirmwareSetGet)
 		srvCmpntFwSets.PUT("/:uuid", amw.RequiredScopes(updateScopes("server-component-firmware-sets")), r.serverComponentFirmwareSetUpdate)
 		srvCmpntFwSets.DELETE("/:uuid", amw.RequiredScopes(deleteScopes("server-component-firmware-sets")), r.serverComponentFirmwareSetDelete)
+		srvCmpntFwSets.GET("/:uuid/assignments", amw.RequiredScopes(readScopes("server-component-firmware-sets")), r.firmwareSetAssignmentList)
+		srvCmpntFwSets.POST("/:uuid/assignments", amw.RequiredScopes(createScopes("server-component-firmware-sets")), r.firmwareSetAssignmentCreate)
+		srvCmpntFwSets.DELETE("/:uuid/assignments/:assignment", amw.RequiredScopes(deleteScopes("server-component-firmware-sets")), r.firmwareSetAssignmentDelete)
 		srvCmpntFwSets.POST("/:uuid/remove-firmware", amw.RequiredScopes(deleteScopes("server-component-firmware-sets")), r.serverComponentFirmwareSetRemoveFirmware)
 	}
 
+	// /server-lifecycle
+	rg.GET("/server-lifecycle", amw.RequiredScopes(readScopes("server")), r.serverLifecycleGet)
+
+	// /facilities
+	facilities := rg.Group("/facilities")
+	{
+		facilities.GET("", amw.RequiredScopes(readScopes("facilities")), r.facilityList)
+		facilities.POST("", amw.RequiredScopes(createScopes("facilities")), r.facilityCreate)
+		facilities.GET("/:uuid", amw.RequiredScopes(readScopes("facilities")), r.facilityGet)
+		facilities.PUT("/:uuid", amw.RequiredScopes(updateScopes("facilities")), r.facilityUpdate)
+		facilities.DELETE("/:uuid", amw.RequiredScopes(deleteScopes("facilities")), r.facilityDelete)
+	}
+
+	// /rooms
+	rooms := rg.Group("/rooms")
+	{
+		rooms.GET("", amw.RequiredScopes(readScopes("rooms")), r.roomList)
+		rooms.POST("", amw.RequiredScopes(createScopes("rooms")), r.roomCreate)
+		rooms.GET("/:uuid", amw.RequiredScopes(readScopes("rooms")), r.roomGet)
+		rooms.PUT("/:uuid", amw.RequiredScopes(updateScopes("rooms")), r.roomUpdate)
+		rooms.DELETE("/:uuid", amw.RequiredScopes(deleteScopes("rooms")), r.roomDelete)
+	}
+
+	// /rows
+	rows := rg.Group("/rows")
+	{
+		rows.GET("", amw.RequiredScopes(readScopes("rows")), r.rowList)
+		rows.POST("", amw.RequiredScopes(createScopes("rows")), r.rowCreate)
+		rows.GET("/:uuid", amw.RequiredScopes(readScopes("rows")), r.rowGet)
+		rows.PUT("/:uuid", amw.RequiredScopes(updateScopes("rows")), r.rowUpdate)
+		rows.DELETE("/:uuid", amw.RequiredScopes(deleteScopes("rows")), r.rowDelete)
+	}
+
+	// /racks
+	racks := rg.Group("/racks")
+	{
+		racks.GET("", amw.RequiredScopes(readScopes("racks")), r.rackList)
+		racks.POST("", amw.RequiredScopes(createScopes("racks")), r.rackCreate)
+		racks.GET("/:uuid", amw.RequiredScopes(readScopes("racks")), r.rackGet)
+		racks.PUT("/:uuid", amw.RequiredScopes(updateScopes("racks")), r.rackUpdate)
+		racks.DELETE("/:uuid", amw.RequiredScopes(deleteScopes("racks")), r.rackDelete)
+		racks.GET("/:uuid/elevation", amw.RequiredScopes(readScopes("racks", "server")), r.rackElevation)
+	}
+
+	// /firmware-compliance
+	rg.GET("/firmware-compliance", amw.RequiredScopes(readScopes("server", "server-component-firmware-sets")), r.firmwareComplianceList)
+
+	// /audit
+	rg.GET("/audit", amw.RequiredScopes(readScopes("audit")), r.auditEventList)
+
 	// /bill-of-materials
 	srvBoms := rg.Group("/bill-of-materials")
 	{
+		srvBoms.GET("", amw.RequiredScopes(readScopes("bill-of-materials")), r.bomsList)
+		srvBoms.GET("/mismatches", amw.RequiredScopes(readScopes("bill-of-materials")), r.bomMismatchList)
+		srvBoms.GET("/:serial_num", amw.RequiredScopes(readScopes("bill-of-materials")), r.bomGet)
+		srvBoms.PUT("/:serial_num", amw.RequiredScopes(updateScopes("bill-of-materials")), r.bomUpdate)
+		srvBoms.DELETE("/:serial_num", amw.RequiredScopes(deleteScopes("bill-of-materials")), r.bomDelete)
+
 		// /bill-of-materials/batch-boms-upload
 		uploadFile := srvBoms.Group("/batch-upload")
 		{
 			uploadFile.POST("", amw.RequiredScopes(createScopes("batch-upload")), r.bomsUpload)
+			uploadFile.POST("/csv", amw.RequiredScopes(createScopes("batch-upload")), r.bomsCSVUpload)
 		}
 
 		// /bill-of-materials/aoc-mac-address
@@ -145,6 +262,21 @@ func (r *Router) Routes(rg *gin.RouterGroup) {
 	}
 }
 
+// longRunning extends the read and write deadlines of requests that stream
+// servers or their credentials past the server wide timeouts, up to
+// streamTimeout. The request context still ends them when the client goes
+// away.
+func longRunning(c *gin.Context) {
+	rc := http.NewResponseController(c.Writer)
+	deadline := time.Now().Add(streamTimeout)
+
+	// writers without deadlines, like test recorders, have nothing to extend
+	_ = rc.SetReadDeadline(deadline)
+	_ = rc.SetWriteDeadline(deadline)
+
+	c.Next()
+}
+
 func createScopes(items ...string) []string {
 	s := []string{"write", "create"}
 	for _, i := range items {
@@ -154,6 +286,16 @@ func createScopes(items ...string) []string {
 	return s
 }
 
+// credentialScopes requires the scope for the action on all server
+// credentials, or on the credential type of the slug param alone, e.g.
+// read:server:credentials:bmc.
+func credentialScopes(amw *ginjwt.Middleware, action string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		scope := fmt.Sprintf("%s:server:credentials", action)
+		amw.RequiredScopes([]string{scope, fmt.Sprintf("%s:%s", scope, c.Param("slug"))})(c)
+	}
+}
+
 func readScopes(items ...string) []string {
 	s := []string{"read"}
 	for _, i := range items {
@@ -214,7 +356,7 @@ func (r *Router) loadOrCreateServerFromParams(c *gin.Context) (*models.Server, e
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			srv = &models.Server{ID: u.String()}
-			if err := srv.Insert(c.Request.Context(), r.DB, boil.Infer()); err != nil {
+			if err := r.createServerTx(c, srv); err != nil {
 				dbErrorResponse(c, err)
 				return nil, err
 			}
@@ -230,6 +372,57 @@ func (r *Router) loadOrCreateServerFromParams(c *gin.Context) (*models.Server, e
 	return srv, nil
 }
 
+// createServerTx inserts a server along with its create event and audit record
+func (r *Router) createServerTx(c *gin.Context, srv *models.Server) error {
+	ctx := c.Request.Context()
+
+	tx, err := r.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	// nolint:errcheck // TODO(joel): log error
+	defer tx.Rollback()
+
+	if err := r.insertServer(ctx, tx, srv); err != nil {
+		return err
+	}
+
+	if err := r.audit(c, tx, auditEntry{
+		action:       AuditActionCreate,
+		resourceType: AuditResourceServer,
+		resourceID:   srv.ID,
+		serverID:     srv.ID,
+		after:        srv,
+	}); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// insertServer inserts a server in the initial lifecycle state, records its
+// history and enqueues the create event
+func (r *Router) insertServer(ctx context.Context, tx boil.ContextExecutor, srv *models.Server) error {
+	if err := checkServerFacility(ctx, tx, srv.FacilityCode.String); err != nil {
+		return err
+	}
+
+	srv.State = r.serverLifecycle().InitialState
+
+	if err := srv.Insert(ctx, tx, boil.Infer()); err != nil {
+		return err
+	}
+
+	if err := r.recordHistory(ctx, tx, serverHistory(nil, srv)); err != nil {
+		return err
+	}
+
+	return r.enqueueEvent(ctx, tx, SubjectServerCreate, srv.ID, func() ([]byte, error) {
+		return NewCreateServerMessage(srv)
+	})
+}
+
 func (r *Router) loadComponentFirmwareVersionFromParams(c *gin.Context) (*models.ComponentFirmwareVersion, error) {
 	u, err := r.parseUUID(c)
 	if err != nil {
@@ -246,22 +439,18 @@ func (r *Router) loadComponentFirmwareVersionFromParams(c *gin.Context) (*models
 	return firmware, nil
 }
 
-// publish a CreateServer message to the event stream. if the publish fails...?
-//
-//nolint:wsl
-func (r *Router) publishCreateServerMessage(ctx context.Context, srv *models.Server) {
-	if r.EventStream == nil {
-		r.Logger.Error("Event publish skipped, eventStream not connected")
-		return
+// enqueueEvent writes the message built by newMsg to the event outbox as part
+// of the given transaction, the outbox relay publishes it once committed.
+// Events are ordered per server.
+func (r *Router) enqueueEvent(ctx context.Context, exec boil.ContextExecutor, subject, serverID string, newMsg func() ([]byte, error)) error {
+	if !r.EventsEnabled {
+		return nil
 	}
-	subject := strings.Join([]string{"server", "create"}, ".")
-	payload, err := NewCreateServerMessage(srv)
+
+	payload, err := newMsg()
 	if err != nil {
-		r.Logger.With(zap.Error(err)).Error("unable to create a create-server message")
-		return
-	}
-	if err := r.EventStream.Publish(ctx, subject, payload); err != nil {
-		r.Logger.With(zap.Error(err)).Error("unable to publish create-server message")
-		return
+		return err
 	}
+
+	return outbox.Enqueue(ctx, exec, subject, serverID, payload)
 }
@@ -0,0 +1,181 @@
+package serverservice
+
+import (
+	"encoding/json"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"go.hollow.sh/toolbox/ginjwt"
+
+	"go.hollow.sh/serverservice/internal/models"
+)
+
+// auditEntry describes a single audited call, before and after hold the state
+// of the resource around the change and are left nil when there is none.
+type auditEntry struct {
+	action       string
+	resourceType string
+	resourceID   string
+	serverID     string
+	before       interface{}
+	after        interface{}
+	// justification is the reason given for the request, it's only
+	// recorded for credential reads
+	justification string
+}
+
+// audit records the entry in the audit log along with the identity and route
+// of the request. exec should be the transaction the change is written in so
+// the change and its audit record are committed together.
+func (r *Router) audit(c *gin.Context, exec boil.ContextExecutor, e auditEntry) error {
+	before, err := auditJSON(e.before)
+	if err != nil {
+		return err
+	}
+
+	after, err := auditJSON(e.after)
+	if err != nil {
+		return err
+	}
+
+	dbA := &models.AuditEvent{
+		ActorSubject: null.NewString(ginjwt.GetSubject(c), ginjwt.GetSubject(c) != ""),
+		ActorUser:    null.NewString(ginjwt.GetUser(c), ginjwt.GetUser(c) != ""),
+		Method:       c.Request.Method,
+		Route:        c.FullPath(),
+		Action:       e.action,
+		ResourceType: e.resourceType,
+		ResourceID:   null.NewString(e.resourceID, e.resourceID != ""),
+		ServerID:     null.NewString(e.serverID, e.serverID != ""),
+		Before:       before,
+		After:        after,
+
+		Justification: null.NewString(e.justification, e.justification != ""),
+	}
+
+	return dbA.Insert(c.Request.Context(), exec, boil.Infer())
+}
+
+// auditedTx runs write in a transaction and records the audit entry returned
+// by newEntry along with it, the entry is built after the write so it can
+// refer to generated values like IDs.
+func (r *Router) auditedTx(c *gin.Context, write func(tx boil.ContextExecutor) error, newEntry func() auditEntry) error {
+	tx, err := r.DB.BeginTx(c.Request.Context(), nil)
+	if err != nil {
+		return err
+	}
+
+	// nolint:errcheck // rollback is a no-op when the transaction is successful
+	defer tx.Rollback()
+
+	if err := write(tx); err != nil {
+		return err
+	}
+
+	if err := r.audit(c, tx, newEntry()); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func auditJSON(v interface{}) (null.JSON, error) {
+	if v == nil {
+		return null.JSON{}, nil
+	}
+
+	b, err := json.Marshal(v)
+	if err != nil {
+		return null.JSON{}, err
+	}
+
+	if string(b) == "null" {
+		return null.JSON{}, nil
+	}
+
+	return null.JSONFrom(b), nil
+}
+
+// auditCredential is the audited state of a server credential, the secret
+// itself is never recorded.
+type auditCredential struct {
+	SecretType string `json:"secret_type"`
+	Username   string `json:"username"`
+}
+
+func (r *Router) auditEventList(c *gin.Context) {
+	pager := parsePagination(c)
+
+	var params AuditEventListParams
+	if err := c.ShouldBindQuery(&params); err != nil {
+		badRequestResponse(c, "invalid filter payload: AuditEventListParams{}", err)
+		return
+	}
+
+	if params.ServerID != "" {
+		if _, err := uuid.Parse(params.ServerID); err != nil {
+			badRequestResponse(c, "invalid server_id", err)
+			return
+		}
+	}
+
+	mods := params.queryMods()
+
+	count, err := models.AuditEvents(mods...).Count(c.Request.Context(), r.DB)
+	if err != nil {
+		dbErrorResponse(c, err)
+		return
+	}
+
+	// add pagination
+	pager.Preload = false
+	pager.OrderBy = models.AuditEventTableColumns.CreatedAt + " DESC"
+	mods = append(mods, pager.serverQueryMods()...)
+
+	if pager.keyset() {
+		keysetMods, err := pager.keysetQueryMods(models.TableNames.AuditEvents, models.AuditEventColumns.CreatedAt, "TIMESTAMPTZ")
+		if err != nil {
+			badRequestResponse(c, "", err)
+			return
+		}
+
+		mods = append(mods, keysetMods...)
+	}
+
+	dbEvents, err := models.AuditEvents(mods...).All(c.Request.Context(), r.DB)
+	if err != nil {
+		dbErrorResponse(c, err)
+		return
+	}
+
+	nextCursor := ""
+
+	if pager.keyset() && pager.hasNextKeysetPage(len(dbEvents)) {
+		dbEvents = dbEvents[:pager.limitUsed()]
+		last := dbEvents[len(dbEvents)-1]
+		nextCursor = encodeTimeCursor(last.CreatedAt, last.ID)
+	}
+
+	auditEvents := make([]AuditEvent, 0, len(dbEvents))
+
+	for _, dbA := range dbEvents {
+		a := AuditEvent{}
+		if err := a.fromDBModel(dbA); err != nil {
+			failedConvertingToVersioned(c, err)
+			return
+		}
+
+		auditEvents = append(auditEvents, a)
+	}
+
+	pd := paginationData{
+		pageCount:  len(auditEvents),
+		totalCount: count,
+		pager:      pager,
+		nextCursor: nextCursor,
+	}
+
+	listResponse(c, auditEvents, pd)
+}
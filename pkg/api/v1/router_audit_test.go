@@ -0,0 +1,77 @@
+package serverservice_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.hollow.sh/serverservice/internal/dbtools"
+	serverservice "go.hollow.sh/serverservice/pkg/api/v1"
+)
+
+func TestIntegrationAuditEventList(t *testing.T) {
+	s := serverTest(t)
+	s.Client.SetToken(validToken(adminScopes))
+
+	ctx := context.TODO()
+	srvID := uuid.MustParse(dbtools.FixtureDory.ID)
+
+	_, err := s.Client.Update(ctx, srvID, serverservice.Server{Name: "The Audited Dory"})
+	require.NoError(t, err)
+
+	realClientTests(t, func(ctx context.Context, authToken string, respCode int, expectError bool) error {
+		s.Client.SetToken(authToken)
+
+		events, _, err := s.Client.ListAuditEvents(ctx, &serverservice.AuditEventListParams{
+			ServerID:     srvID.String(),
+			ResourceType: serverservice.AuditResourceServer,
+		})
+		if !expectError {
+			require.NoError(t, err)
+			require.Len(t, events, 1)
+
+			e := events[0]
+			assert.Equal(t, "test-user", e.ActorSubject)
+			assert.Equal(t, serverservice.AuditActionUpdate, e.Action)
+			assert.Equal(t, "PUT", e.Method)
+			assert.Equal(t, "/api/v1/servers/:uuid", e.Route)
+			require.NotNil(t, e.ServerID)
+			assert.Equal(t, srvID, *e.ServerID)
+
+			var before, after map[string]interface{}
+			require.NoError(t, json.Unmarshal(e.Before, &before))
+			require.NoError(t, json.Unmarshal(e.After, &after))
+			assert.Equal(t, dbtools.FixtureDory.Name, before["name"])
+			assert.Equal(t, "The Audited Dory", after["name"])
+		}
+
+		return err
+	})
+}
+
+func TestIntegrationAuditCredentialRead(t *testing.T) {
+	s := serverTest(t)
+	s.Client.SetToken(validToken(adminScopes))
+
+	ctx := context.TODO()
+	srvID := uuid.MustParse(dbtools.FixtureNemo.ID)
+
+	_, err := s.Client.SetCredential(ctx, srvID, serverservice.ServerCredentialTypeBMC, "admin", "secret")
+	require.NoError(t, err)
+
+	_, _, err = s.Client.GetCredential(ctx, srvID, serverservice.ServerCredentialTypeBMC)
+	require.NoError(t, err)
+
+	events, _, err := s.Client.ListAuditEvents(ctx, &serverservice.AuditEventListParams{
+		ServerID:     srvID.String(),
+		ResourceType: serverservice.AuditResourceServerCredential,
+		Action:       serverservice.AuditActionRead,
+	})
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	assert.NotContains(t, string(events[0].After), "secret\"")
+}
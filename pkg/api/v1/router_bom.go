@@ -5,12 +5,168 @@ import (
 
 	"github.com/cockroachdb/cockroach-go/v2/crdb"
 	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
 	"github.com/volatiletech/sqlboiler/v4/boil"
 	"github.com/volatiletech/sqlboiler/v4/queries/qm"
 
 	"go.hollow.sh/serverservice/internal/models"
 )
 
+func (r *Router) bomsList(c *gin.Context) {
+	pager := parsePagination(c)
+
+	var params BomListParams
+	if err := c.ShouldBindQuery(&params); err != nil {
+		badRequestResponse(c, "invalid filter payload: BomListParams{}", err)
+		return
+	}
+
+	mods := params.queryMods()
+
+	count, err := models.BomInfos(mods...).Count(c.Request.Context(), r.DB)
+	if err != nil {
+		dbErrorResponse(c, err)
+		return
+	}
+
+	// bom_info is keyed by serial number and has no ID to build a keyset
+	// cursor from, it's paginated by page number only
+	if pager.Page == 0 {
+		pager.Page = 1
+	}
+
+	pager.OrderBy = models.BomInfoTableColumns.SerialNum
+
+	dbBoms, err := models.BomInfos(append(mods, pager.queryMods()...)...).All(c.Request.Context(), r.DB)
+	if err != nil {
+		dbErrorResponse(c, err)
+		return
+	}
+
+	boms := make([]Bom, 0, len(dbBoms))
+
+	for _, dbBom := range dbBoms {
+		bom := Bom{}
+		if err := bom.fromDBModel(dbBom); err != nil {
+			failedConvertingToVersioned(c, err)
+			return
+		}
+
+		boms = append(boms, bom)
+	}
+
+	pd := paginationData{
+		pageCount:  len(boms),
+		totalCount: count,
+		pager:      pager,
+	}
+
+	listResponse(c, boms, pd)
+}
+
+func (r *Router) bomGet(c *gin.Context) {
+	dbBom, err := models.FindBomInfo(c.Request.Context(), r.DB, c.Param("serial_num"))
+	if err != nil {
+		dbErrorResponse(c, err)
+		return
+	}
+
+	bom := Bom{}
+	if err := bom.fromDBModel(dbBom); err != nil {
+		failedConvertingToVersioned(c, err)
+		return
+	}
+
+	itemResponse(c, bom)
+}
+
+// bomUpdate replaces the bom with the serial number, its MAC addresses are
+// reconciled the same way as on upload. The serial number can't be changed.
+func (r *Router) bomUpdate(c *gin.Context) {
+	serialNum := c.Param("serial_num")
+
+	var bom Bom
+	if err := c.ShouldBindJSON(&bom); err != nil {
+		badRequestResponse(c, "invalid payload: Bom{}", err)
+		return
+	}
+
+	if bom.SerialNum != "" && bom.SerialNum != serialNum {
+		badRequestResponse(c, "invalid payload: Bom{}", errors.Wrap(errBom, "the serial number can't be changed"))
+		return
+	}
+
+	bom.SerialNum = serialNum
+
+	if err := bom.validate(); err != nil {
+		badRequestResponse(c, "invalid payload: Bom{}", err)
+		return
+	}
+
+	err := crdb.ExecuteTx(c.Request.Context(), r.DB.DB, nil, func(tx *sql.Tx) error {
+		exists, err := models.BomInfoExists(c.Request.Context(), tx, serialNum)
+		if err != nil {
+			return err
+		}
+
+		if !exists {
+			return sql.ErrNoRows
+		}
+
+		_, err = r.upsertBom(c, tx, bom)
+
+		return err
+	})
+	if err != nil {
+		if errors.Is(err, errBomMacAddressOwned) {
+			conflictResponse(c, "mac address is in use", err)
+			return
+		}
+
+		dbErrorResponse(c, err)
+
+		return
+	}
+
+	updatedResponse(c, serialNum)
+}
+
+// bomDelete deletes the bom with the serial number along with its MAC
+// addresses
+func (r *Router) bomDelete(c *gin.Context) {
+	dbBom, err := models.FindBomInfo(c.Request.Context(), r.DB, c.Param("serial_num"))
+	if err != nil {
+		dbErrorResponse(c, err)
+		return
+	}
+
+	before := Bom{}
+	if err := before.fromDBModel(dbBom); err != nil {
+		failedConvertingToVersioned(c, err)
+		return
+	}
+
+	err = r.auditedTx(c, func(tx boil.ContextExecutor) error {
+		_, err := dbBom.Delete(c.Request.Context(), tx)
+		return err
+	}, func() auditEntry {
+		return auditEntry{
+			action:       AuditActionDelete,
+			resourceType: AuditResourceBillOfMaterials,
+			resourceID:   dbBom.SerialNum,
+			before:       before,
+		}
+	})
+	if err != nil {
+		dbErrorResponse(c, err)
+		return
+	}
+
+	deletedResponse(c)
+}
+
+// bomsUpload writes the boms of a JSON request in a single transaction.
+// Uploading a bom again updates it, so a vendor manifest can be re-uploaded.
 func (r *Router) bomsUpload(c *gin.Context) {
 	var boms []Bom
 	if err := c.ShouldBindJSON(&boms); err != nil {
@@ -18,52 +174,272 @@ func (r *Router) bomsUpload(c *gin.Context) {
 		return
 	}
 
+	for i := range boms {
+		if err := boms[i].validate(); err != nil {
+			badRequestResponse(c, "invalid payload: []Bom{}", err)
+			return
+		}
+	}
+
 	err := crdb.ExecuteTx(c.Request.Context(), r.DB.DB, nil, func(tx *sql.Tx) error {
 		for _, bom := range boms {
-			dbBomInfo, err := (bom).toDBModel()
-			if err != nil {
+			if _, err := r.upsertBom(c, tx, bom); err != nil {
 				return err
 			}
+		}
 
-			if err := dbBomInfo.Insert(c.Request.Context(), r.DB, boil.Infer()); err != nil {
-				return err
-			}
+		return nil
+	})
+	if err != nil {
+		if errors.Is(err, errBomMacAddressOwned) {
+			conflictResponse(c, "mac address is in use", err)
+			return
+		}
 
-			dbAocMacAddrsBoms, err := (bom).toAocMacAddressDBModels()
-			if err != nil {
-				return err
-			}
+		dbErrorResponse(c, err)
 
-			for _, dbAocMacAddrsBom := range dbAocMacAddrsBoms {
-				if err := dbAocMacAddrsBom.Insert(c.Request.Context(), r.DB, boil.Infer()); err != nil {
-					return err
-				}
-			}
+		return
+	}
 
-			dbBmcMacAddrsBoms, err := (bom).toBmcMacAddressDBModels()
-			if err != nil {
-				return err
-			}
+	createdResponse(c, "")
+}
+
+// bomsCSVUpload writes the boms of a vendor CSV file sent as the "file" part
+// of a multipart form. Each row is written on its own, rows that fail are
+// reported without failing the rest of the file.
+func (r *Router) bomsCSVUpload(c *gin.Context) {
+	file, err := c.FormFile("file")
+	if err != nil {
+		badRequestResponse(c, "invalid bom upload, expected a multipart form with a file", err)
+		return
+	}
 
-			for _, dbBmcMacAddrsBom := range dbBmcMacAddrsBoms {
-				if err := dbBmcMacAddrsBom.Insert(c.Request.Context(), r.DB, boil.Infer()); err != nil {
-					return err
-				}
+	f, err := file.Open()
+	if err != nil {
+		badRequestResponse(c, "invalid bom upload", err)
+		return
+	}
+
+	defer f.Close()
+
+	rows, err := parseBomCSV(f)
+	if err != nil {
+		badRequestResponse(c, "invalid bom csv", err)
+		return
+	}
+
+	ctx := c.Request.Context()
+
+	tx, err := r.DB.BeginTx(ctx, nil)
+	if err != nil {
+		dbErrorResponse(c, err)
+		return
+	}
+
+	// rollback is a no-op when the transaction is successful
+	// nolint:errcheck // TODO(joel): log error
+	defer tx.Rollback()
+
+	updated := make([]bool, len(rows))
+	errs := make([]error, len(rows))
+
+	for i, row := range rows {
+		if row.err != nil {
+			errs[i] = row.err
+			continue
+		}
+
+		if _, err := tx.ExecContext(ctx, "SAVEPOINT bom_upload"); err != nil {
+			dbErrorResponse(c, err)
+			return
+		}
+
+		updated[i], errs[i] = r.upsertBom(c, tx, row.bom)
+
+		if errs[i] != nil {
+			if _, err := tx.ExecContext(ctx, "ROLLBACK TO SAVEPOINT bom_upload"); err != nil {
+				dbErrorResponse(c, err)
+				return
 			}
+
+			continue
 		}
-		return nil
-	})
-	if err != nil {
+
+		if _, err := tx.ExecContext(ctx, "RELEASE SAVEPOINT bom_upload"); err != nil {
+			dbErrorResponse(c, err)
+			return
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
 		dbErrorResponse(c, err)
 		return
 	}
 
-	createdResponse(c, "")
+	report := &BomUploadReport{Results: []BomUploadResult{}}
+
+	for i, row := range rows {
+		report.add(row.number, row.bom.SerialNum, updated[i], errs[i])
+	}
+
+	itemResponse(c, report)
+}
+
+// upsertBom writes the bom and reconciles its MAC addresses with the ones
+// stored for its serial: addresses no longer listed are removed and new ones
+// are added. A listed address stored under another serial fails the bom with
+// errBomMacAddressOwned, it has to be removed from the other bom first. The
+// bom is linked to the server it matches, if any. It reports whether the bom
+// already existed.
+func (r *Router) upsertBom(c *gin.Context, tx boil.ContextExecutor, bom Bom) (bool, error) {
+	ctx := c.Request.Context()
+
+	if err := bom.normalize(); err != nil {
+		return false, err
+	}
+
+	dbBomInfo, err := bom.toDBModel()
+	if err != nil {
+		return false, err
+	}
+
+	dbAocMacAddrs, err := bom.toAocMacAddressDBModels()
+	if err != nil {
+		return false, err
+	}
+
+	dbBmcMacAddrs, err := bom.toBmcMacAddressDBModels()
+	if err != nil {
+		return false, err
+	}
+
+	current, err := models.BomInfos(models.BomInfoWhere.SerialNum.EQ(bom.SerialNum), qm.For("UPDATE")).One(ctx, tx)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return false, err
+	}
+
+	bmcMacAddrs := make([]string, 0, len(dbBmcMacAddrs))
+	for _, dbB := range dbBmcMacAddrs {
+		bmcMacAddrs = append(bmcMacAddrs, dbB.BMCMacAddress)
+	}
+
+	dbBomInfo.ServerID, err = bomServerID(ctx, tx, bom.SerialNum, bmcMacAddrs)
+	if err != nil {
+		return false, err
+	}
+
+	if current == nil {
+		err = dbBomInfo.Insert(ctx, tx, boil.Infer())
+	} else {
+		_, err = dbBomInfo.Update(ctx, tx, boil.Infer())
+	}
+
+	if err != nil {
+		return false, err
+	}
+
+	aocMacAddrs := make([]string, 0, len(dbAocMacAddrs))
+	for _, dbA := range dbAocMacAddrs {
+		aocMacAddrs = append(aocMacAddrs, dbA.AocMacAddress)
+	}
+
+	storedAocMacAddrs, err := models.AocMacAddresses(models.AocMacAddressWhere.AocMacAddress.IN(aocMacAddrs)).All(ctx, tx)
+	if err != nil {
+		return false, err
+	}
+
+	stored := map[string]bool{}
+
+	for _, dbA := range storedAocMacAddrs {
+		if dbA.SerialNum != bom.SerialNum {
+			return false, errors.Wrapf(errBomMacAddressOwned, "aoc mac address %s belongs to bom %s", dbA.AocMacAddress, dbA.SerialNum)
+		}
+
+		stored[dbA.AocMacAddress] = true
+	}
+
+	for _, dbA := range dbAocMacAddrs {
+		if stored[dbA.AocMacAddress] {
+			continue
+		}
+
+		if err := dbA.Insert(ctx, tx, boil.Infer()); err != nil {
+			return false, err
+		}
+	}
+
+	if _, err := models.AocMacAddresses(
+		models.AocMacAddressWhere.SerialNum.EQ(bom.SerialNum),
+		models.AocMacAddressWhere.AocMacAddress.NIN(aocMacAddrs),
+	).DeleteAll(ctx, tx); err != nil {
+		return false, err
+	}
+
+	storedBmcMacAddrs, err := models.BMCMacAddresses(models.BMCMacAddressWhere.BMCMacAddress.IN(bmcMacAddrs)).All(ctx, tx)
+	if err != nil {
+		return false, err
+	}
+
+	stored = map[string]bool{}
+
+	for _, dbB := range storedBmcMacAddrs {
+		if dbB.SerialNum != bom.SerialNum {
+			return false, errors.Wrapf(errBomMacAddressOwned, "bmc mac address %s belongs to bom %s", dbB.BMCMacAddress, dbB.SerialNum)
+		}
+
+		stored[dbB.BMCMacAddress] = true
+	}
+
+	for _, dbB := range dbBmcMacAddrs {
+		if stored[dbB.BMCMacAddress] {
+			continue
+		}
+
+		if err := dbB.Insert(ctx, tx, boil.Infer()); err != nil {
+			return false, err
+		}
+	}
+
+	if _, err := models.BMCMacAddresses(
+		models.BMCMacAddressWhere.SerialNum.EQ(bom.SerialNum),
+		models.BMCMacAddressWhere.BMCMacAddress.NIN(bmcMacAddrs),
+	).DeleteAll(ctx, tx); err != nil {
+		return false, err
+	}
+
+	entry := auditEntry{
+		action:       AuditActionCreate,
+		resourceType: AuditResourceBillOfMaterials,
+		resourceID:   bom.SerialNum,
+		after:        bom,
+	}
+
+	if current != nil {
+		before := Bom{}
+		if err := before.fromDBModel(current); err != nil {
+			return false, err
+		}
+
+		entry.action = AuditActionUpdate
+		entry.before = before
+	}
+
+	if err := r.audit(c, tx, entry); err != nil {
+		return false, err
+	}
+
+	return current != nil, nil
 }
 
 func (r *Router) getBomFromAocMacAddress(c *gin.Context) {
+	mac, err := ParseMACAddress(c.Param("aoc_mac_address"))
+	if err != nil {
+		badRequestResponse(c, "invalid aoc mac address", err)
+		return
+	}
+
 	mods := []qm.QueryMod{
-		qm.Where("aoc_mac_address=?", c.Param("aoc_mac_address")),
+		qm.Where("aoc_mac_address=?", mac.String()),
 	}
 
 	aocMacAddr, err := models.AocMacAddresses(mods...).One(c.Request.Context(), r.DB)
@@ -92,8 +468,14 @@ func (r *Router) getBomFromAocMacAddress(c *gin.Context) {
 }
 
 func (r *Router) getBomFromBmcMacAddress(c *gin.Context) {
+	mac, err := ParseMACAddress(c.Param("bmc_mac_address"))
+	if err != nil {
+		badRequestResponse(c, "invalid bmc mac address", err)
+		return
+	}
+
 	mods := []qm.QueryMod{
-		qm.Where("bmc_mac_address=?", c.Param("bmc_mac_address")),
+		qm.Where("bmc_mac_address=?", mac.String()),
 	}
 
 	bmcMacAddr, err := models.BMCMacAddresses(mods...).One(c.Request.Context(), r.DB)
@@ -0,0 +1,271 @@
+package serverservice
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+
+	"go.hollow.sh/serverservice/internal/models"
+)
+
+// observedComponentMods returns the query mods for the components of the
+// given types on servers that aren't deleted, with their type loaded
+func observedComponentMods(slugs ...string) []qm.QueryMod {
+	s := make([]interface{}, 0, len(slugs))
+	for _, slug := range slugs {
+		s = append(s, slug)
+	}
+
+	return []qm.QueryMod{
+		qm.InnerJoin("server_component_types on server_component_types.id = server_components.server_component_type_id"),
+		qm.InnerJoin("servers on servers.id = server_components.server_id"),
+		qm.WhereIn("server_component_types.slug IN ?", s...),
+		qm.Where("servers.deleted_at IS NULL"),
+		models.ServerComponentWhere.Serial.IsNotNull(),
+		qm.Load(models.ServerComponentRels.ServerComponentType),
+	}
+}
+
+// bomServerID returns the server a bom should be linked to, the server with a
+// chassis serial matching the bom's serial number or else a server with a BMC
+// MAC address listed in the bom. Servers already linked to another bom aren't
+// considered. An invalid null.String is returned when no server matches.
+func bomServerID(ctx context.Context, exec boil.ContextExecutor, serialNum string, bmcMacAddrs []string) (null.String, error) {
+	unlinked := qm.Where("NOT EXISTS (SELECT 1 FROM bom_info WHERE bom_info.server_id = server_components.server_id AND bom_info.serial_num <> ?)", serialNum)
+
+	component, err := models.ServerComponents(append(observedComponentMods(bomChassisComponentType),
+		models.ServerComponentWhere.Serial.EQ(null.StringFrom(serialNum)),
+		unlinked,
+	)...).One(ctx, exec)
+	if err == nil {
+		return null.StringFrom(component.ServerID), nil
+	}
+
+	if !errors.Is(err, sql.ErrNoRows) {
+		return null.String{}, err
+	}
+
+	if len(bmcMacAddrs) == 0 {
+		return null.String{}, nil
+	}
+
+	macs := make([]interface{}, 0, len(bmcMacAddrs))
+	for _, mac := range bmcMacAddrs {
+		macs = append(macs, mac)
+	}
+
+	component, err = models.ServerComponents(append(observedComponentMods(bomBMCComponentType),
+		qm.WhereIn("server_components.serial IN ?", macs...),
+		unlinked,
+	)...).One(ctx, exec)
+	if err == nil {
+		return null.StringFrom(component.ServerID), nil
+	}
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return null.String{}, nil
+	}
+
+	return null.String{}, err
+}
+
+// linkServerBom links the server to a bom that isn't linked yet, matching the
+// chassis serial or BMC MAC addresses in the server's components. It's called
+// whenever the components of a server are written, a server that is already
+// linked keeps its bom.
+func linkServerBom(ctx context.Context, exec boil.ContextExecutor, serverID string) error {
+	linked, err := models.BomInfos(models.BomInfoWhere.ServerID.EQ(null.StringFrom(serverID))).Exists(ctx, exec)
+	if err != nil || linked {
+		return err
+	}
+
+	components, err := models.ServerComponents(append(
+		observedComponentMods(bomChassisComponentType, bomBMCComponentType),
+		models.ServerComponentWhere.ServerID.EQ(serverID),
+	)...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	serials := []interface{}{}
+	bmcMacAddrs := []interface{}{}
+
+	for _, component := range components {
+		if component.R.ServerComponentType.Slug == bomChassisComponentType {
+			serials = append(serials, component.Serial.String)
+		} else {
+			bmcMacAddrs = append(bmcMacAddrs, observedMACAddress(component.Serial.String))
+		}
+	}
+
+	var dbBom *models.BomInfo
+
+	if len(serials) != 0 {
+		dbBom, err = models.BomInfos(
+			qm.WhereIn("serial_num IN ?", serials...),
+			models.BomInfoWhere.ServerID.IsNull(),
+		).One(ctx, exec)
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			return err
+		}
+	}
+
+	if dbBom == nil && len(bmcMacAddrs) != 0 {
+		dbBom, err = models.BomInfos(
+			qm.WhereIn("serial_num IN (SELECT serial_num FROM bmc_mac_address WHERE bmc_mac_address IN ?)", bmcMacAddrs...),
+			models.BomInfoWhere.ServerID.IsNull(),
+		).One(ctx, exec)
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			return err
+		}
+	}
+
+	if dbBom == nil {
+		return nil
+	}
+
+	dbBom.ServerID = null.StringFrom(serverID)
+	_, err = dbBom.Update(ctx, exec, boil.Whitelist(models.BomInfoColumns.ServerID))
+
+	return err
+}
+
+func (r *Router) serverBomGet(c *gin.Context) {
+	srv, err := r.loadServerFromParams(c)
+	if err != nil {
+		if errors.Is(err, ErrUUIDParse) {
+			badRequestResponse(c, "", err)
+			return
+		}
+
+		dbErrorResponse(c, err)
+
+		return
+	}
+
+	dbBom, err := models.BomInfos(models.BomInfoWhere.ServerID.EQ(null.StringFrom(srv.ID))).One(c.Request.Context(), r.DB)
+	if err != nil {
+		dbErrorResponse(c, err)
+		return
+	}
+
+	bom := Bom{}
+	if err := bom.fromDBModel(dbBom); err != nil {
+		failedConvertingToVersioned(c, err)
+		return
+	}
+
+	itemResponse(c, bom)
+}
+
+// bomMismatchList reports the boms linked to a server whose MAC addresses
+// differ from the ones observed in the server's components. The boms can be
+// filtered like the bom list. Pages are cut from the linked boms, so the
+// total count is the number of linked boms checked and a page holds the
+// mismatches among the boms of that page.
+func (r *Router) bomMismatchList(c *gin.Context) {
+	pager := parsePagination(c)
+	ctx := c.Request.Context()
+
+	var params BomListParams
+	if err := c.ShouldBindQuery(&params); err != nil {
+		badRequestResponse(c, "invalid filter payload: BomListParams{}", err)
+		return
+	}
+
+	mods := append(params.queryMods(), models.BomInfoWhere.ServerID.IsNotNull())
+
+	count, err := models.BomInfos(mods...).Count(ctx, r.DB)
+	if err != nil {
+		dbErrorResponse(c, err)
+		return
+	}
+
+	// bom_info is keyed by serial number and has no ID to build a keyset
+	// cursor from, it's paginated by page number only
+	if pager.Page == 0 {
+		pager.Page = 1
+	}
+
+	pager.OrderBy = models.BomInfoTableColumns.SerialNum
+
+	mods = append(mods, pager.queryMods()...)
+	mods = append(mods,
+		qm.Load(models.BomInfoRels.SerialNumAocMacAddresses),
+		qm.Load(models.BomInfoRels.SerialNumBMCMacAddresses),
+	)
+
+	dbBoms, err := models.BomInfos(mods...).All(ctx, r.DB)
+	if err != nil {
+		dbErrorResponse(c, err)
+		return
+	}
+
+	serverIDs := make([]interface{}, 0, len(dbBoms))
+	for _, dbBom := range dbBoms {
+		serverIDs = append(serverIDs, dbBom.ServerID.String)
+	}
+
+	observedBMC := map[string][]string{}
+	observedAOC := map[string][]string{}
+
+	if len(serverIDs) != 0 {
+		components, err := models.ServerComponents(append(
+			observedComponentMods(bomBMCComponentType, bomNICComponentType),
+			qm.WhereIn("server_components.server_id IN ?", serverIDs...),
+		)...).All(ctx, r.DB)
+		if err != nil {
+			dbErrorResponse(c, err)
+			return
+		}
+
+		for _, component := range components {
+			mac := observedMACAddress(component.Serial.String)
+
+			if component.R.ServerComponentType.Slug == bomBMCComponentType {
+				observedBMC[component.ServerID] = append(observedBMC[component.ServerID], mac)
+			} else {
+				observedAOC[component.ServerID] = append(observedAOC[component.ServerID], mac)
+			}
+		}
+	}
+
+	mismatches := []BomMismatch{}
+
+	for _, dbBom := range dbBoms {
+		serverID, err := uuid.Parse(dbBom.ServerID.String)
+		if err != nil {
+			failedConvertingToVersioned(c, err)
+			return
+		}
+
+		bomAOC := []string{}
+		for _, a := range dbBom.R.SerialNumAocMacAddresses {
+			bomAOC = append(bomAOC, a.AocMacAddress)
+		}
+
+		bomBMC := []string{}
+		for _, b := range dbBom.R.SerialNumBMCMacAddresses {
+			bomBMC = append(bomBMC, b.BMCMacAddress)
+		}
+
+		m := BomMismatch{SerialNum: dbBom.SerialNum, ServerUUID: serverID}
+		if m.compareBomMacAddrs(bomBMC, observedBMC[dbBom.ServerID.String], bomAOC, observedAOC[dbBom.ServerID.String]) {
+			mismatches = append(mismatches, m)
+		}
+	}
+
+	pd := paginationData{
+		pageCount:  len(mismatches),
+		totalCount: count,
+		pager:      pager,
+	}
+
+	listResponse(c, mismatches, pd)
+}
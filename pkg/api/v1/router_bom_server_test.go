@@ -0,0 +1,158 @@
+package serverservice_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.hollow.sh/serverservice/internal/dbtools"
+	serverservice "go.hollow.sh/serverservice/pkg/api/v1"
+)
+
+// bomTestComponents returns components of the given type slugs and serials,
+// creating the types on first use
+func bomTestComponents(t *testing.T, s *integrationServer, srvUUID uuid.UUID, slugSerials ...string) serverservice.ServerComponentSlice {
+	t.Helper()
+
+	components := serverservice.ServerComponentSlice{}
+
+	for i := 0; i < len(slugSerials); i += 2 {
+		slug, serial := slugSerials[i], slugSerials[i+1]
+
+		ct, _, err := s.Client.GetServerComponentType(context.TODO(), slug)
+		if err != nil {
+			_, err = s.Client.CreateServerComponentType(context.TODO(), serverservice.ServerComponentType{Name: slug, Slug: slug})
+			require.NoError(t, err)
+
+			ct, _, err = s.Client.GetServerComponentType(context.TODO(), slug)
+			require.NoError(t, err)
+		}
+
+		components = append(components, serverservice.ServerComponent{
+			ServerUUID:        srvUUID,
+			Name:              slug,
+			Serial:            serial,
+			ComponentTypeID:   ct.ID,
+			ComponentTypeName: ct.Name,
+			ComponentTypeSlug: ct.Slug,
+		})
+	}
+
+	return components
+}
+
+func TestIntegrationServerBomLinkedByChassisSerial(t *testing.T) {
+	s := serverTest(t)
+	s.Client.SetToken(validToken(adminScopes))
+
+	srvUUID := uuid.MustParse(dbtools.FixtureMarlin.ID)
+
+	_, err := s.Client.CreateComponents(context.TODO(), srvUUID, bomTestComponents(t, s, srvUUID, "chassis", "MARLIN-001"))
+	require.NoError(t, err)
+
+	_, err = s.Client.BillOfMaterialsBatchUpload(context.TODO(), []serverservice.Bom{
+		{SerialNum: "MARLIN-001", AocMacAddress: "00:00:00:00:0a:01", BmcMacAddress: "00:00:00:00:0b:01", Metro: "da"},
+	})
+	require.NoError(t, err)
+
+	realClientTests(t, func(ctx context.Context, authToken string, respCode int, expectError bool) error {
+		s.Client.SetToken(authToken)
+
+		bom, _, err := s.Client.GetServerBom(ctx, srvUUID)
+		if !expectError {
+			require.NoError(t, err)
+			assert.Equal(t, "MARLIN-001", bom.SerialNum)
+			require.NotNil(t, bom.ServerUUID)
+			assert.Equal(t, srvUUID, *bom.ServerUUID)
+		}
+
+		return err
+	})
+
+	s.Client.SetToken(validToken(adminScopes))
+
+	_, _, err = s.Client.GetServerBom(context.TODO(), uuid.MustParse(dbtools.FixtureNemo.ID))
+	assert.ErrorContains(t, err, "no rows in result set")
+}
+
+func TestIntegrationServerBomLinkedByBMCMacAddress(t *testing.T) {
+	s := serverTest(t)
+	s.Client.SetToken(validToken(adminScopes))
+
+	srvUUID := uuid.MustParse(dbtools.FixtureMarlin.ID)
+
+	// the bom is uploaded before the server's inventory is
+	_, err := s.Client.BillOfMaterialsBatchUpload(context.TODO(), []serverservice.Bom{
+		{SerialNum: "MARLIN-001", AocMacAddress: "00:00:00:00:0a:01", BmcMacAddress: "00:00:00:00:0b:01,00:00:00:00:0b:02", Metro: "da"},
+	})
+	require.NoError(t, err)
+
+	bom, _, err := s.Client.GetBom(context.TODO(), "MARLIN-001")
+	require.NoError(t, err)
+	assert.Nil(t, bom.ServerUUID)
+
+	_, err = s.Client.CreateComponents(context.TODO(), srvUUID, bomTestComponents(t, s, srvUUID, "bmc", "00:00:00:00:0b:02"))
+	require.NoError(t, err)
+
+	bom, _, err = s.Client.GetServerBom(context.TODO(), srvUUID)
+	require.NoError(t, err)
+	assert.Equal(t, "MARLIN-001", bom.SerialNum)
+}
+
+func TestIntegrationBomMismatches(t *testing.T) {
+	s := serverTest(t)
+	s.Client.SetToken(validToken(adminScopes))
+
+	marlin := uuid.MustParse(dbtools.FixtureMarlin.ID)
+	nemo := uuid.MustParse(dbtools.FixtureNemo.ID)
+
+	_, err := s.Client.CreateComponents(context.TODO(), marlin, bomTestComponents(t, s, marlin,
+		"chassis", "MARLIN-001", "bmc", "00:00:00:00:0b:01", "nic", "00:00:00:00:0a:01", "nic", "00:00:00:00:0a:03",
+	))
+	require.NoError(t, err)
+
+	_, err = s.Client.CreateComponents(context.TODO(), nemo, bomTestComponents(t, s, nemo,
+		"chassis", "NEMO-001", "bmc", "00:00:00:00:0b:02", "nic", "00:00:00:00:0a:02",
+	))
+	require.NoError(t, err)
+
+	_, err = s.Client.BillOfMaterialsBatchUpload(context.TODO(), []serverservice.Bom{
+		{SerialNum: "MARLIN-001", AocMacAddress: "00:00:00:00:0a:01,00:00:00:00:0a:05", BmcMacAddress: "00:00:00:00:0b:01", Metro: "da"},
+		{SerialNum: "NEMO-001", AocMacAddress: "00:00:00:00:0a:02", BmcMacAddress: "00:00:00:00:0b:02", Metro: "da"},
+		{SerialNum: "UNLINKED-001", AocMacAddress: "00:00:00:00:0a:04", BmcMacAddress: "00:00:00:00:0b:04", Metro: "da"},
+	})
+	require.NoError(t, err)
+
+	realClientTests(t, func(ctx context.Context, authToken string, respCode int, expectError bool) error {
+		s.Client.SetToken(authToken)
+
+		mismatches, _, err := s.Client.ListBomMismatches(ctx, &serverservice.BomListParams{Metro: "da"})
+		if !expectError {
+			require.NoError(t, err)
+			assert.Equal(t, []serverservice.BomMismatch{
+				{
+					SerialNum:                 "MARLIN-001",
+					ServerUUID:                marlin,
+					MissingAocMacAddresses:    []string{"00:00:00:00:0a:05"},
+					UnexpectedAocMacAddresses: []string{"00:00:00:00:0a:03"},
+				},
+			}, mismatches)
+		}
+
+		return err
+	})
+
+	s.Client.SetToken(validToken(adminScopes))
+
+	// the second page holds NEMO-001, which matches its server
+	mismatches, resp, err := s.Client.ListBomMismatches(context.TODO(), &serverservice.BomListParams{
+		Metro:      "da",
+		Pagination: &serverservice.PaginationParams{Limit: 1, Page: 2},
+	})
+	require.NoError(t, err)
+	assert.Empty(t, mismatches)
+	assert.EqualValues(t, 2, resp.TotalRecordCount)
+}
@@ -6,6 +6,9 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
 	serverservice "go.hollow.sh/serverservice/pkg/api/v1"
 )
 
@@ -23,8 +26,8 @@ func TestIntegrationBomUpload(t *testing.T) {
 			uploadBoms: []serverservice.Bom{
 				{
 					SerialNum:     "fakeSerialNum1",
-					AocMacAddress: "fakeAocMacAddress1,fakeAocMacAddress2",
-					BmcMacAddress: "fakeBmcMacAddress1,fakeBmcMacAddress2",
+					AocMacAddress: "00:00:00:00:0a:01,00:00:00:00:0a:02",
+					BmcMacAddress: "00:00:00:00:0b:01,00:00:00:00:0b:02",
 					NumDefiPmi:    "fakeNumDefipmi1",
 					NumDefPWD:     "fakeNumDefpwd1",
 					Metro:         "fakeMetro1",
@@ -32,7 +35,7 @@ func TestIntegrationBomUpload(t *testing.T) {
 			},
 			expectedUploadErr:          false,
 			expectedUploadErrorMsg:     "",
-			aocMacAddress:              "fakeAocMacAddress1",
+			aocMacAddress:              "00:00:00:00:0a:01",
 			expectedAocMacAddressError: false,
 		},
 		{
@@ -40,16 +43,16 @@ func TestIntegrationBomUpload(t *testing.T) {
 			uploadBoms: []serverservice.Bom{
 				{
 					SerialNum:     "fakeSerialNum1",
-					AocMacAddress: "fakeAocMacAddress1,fakeAocMacAddress2",
-					BmcMacAddress: "fakeBmcMacAddress1,fakeBmcMacAddress2",
+					AocMacAddress: "00:00:00:00:0a:01,00:00:00:00:0a:02",
+					BmcMacAddress: "00:00:00:00:0b:01,00:00:00:00:0b:02",
 					NumDefiPmi:    "fakeNumDefipmi1",
 					NumDefPWD:     "fakeNumDefpwd1",
 					Metro:         "fakeMetro1",
 				},
 				{
 					SerialNum:     "fakeSerialNum2",
-					AocMacAddress: "fakeAocMacAddress3,fakeAocMacAddress4",
-					BmcMacAddress: "fakeBmcMacAddress3,fakeBmcMacAddress4",
+					AocMacAddress: "00:00:00:00:0a:03,00:00:00:00:0a:04",
+					BmcMacAddress: "00:00:00:00:0b:03,00:00:00:00:0b:04",
 					NumDefiPmi:    "fakeNumDefipmi2",
 					NumDefPWD:     "fakeNumDefpwd2",
 					Metro:         "fakeMetro2",
@@ -57,32 +60,32 @@ func TestIntegrationBomUpload(t *testing.T) {
 			},
 			expectedUploadErr:          false,
 			expectedUploadErrorMsg:     "",
-			aocMacAddress:              "fakeAocMacAddress3",
+			aocMacAddress:              "00:00:00:00:0a:03",
 			expectedAocMacAddressError: false,
 		},
 		{
-			testName: "upload duplicate serial number",
+			testName: "upload duplicate serial number updates the bom",
 			uploadBoms: []serverservice.Bom{
 				{
 					SerialNum:     "fakeSerialNum1",
-					AocMacAddress: "fakeAocMacAddress1,fakeAocMacAddress2",
-					BmcMacAddress: "fakeBmcMacAddress1,fakeBmcMacAddress2",
+					AocMacAddress: "00:00:00:00:0a:01,00:00:00:00:0a:02",
+					BmcMacAddress: "00:00:00:00:0b:01,00:00:00:00:0b:02",
 					NumDefiPmi:    "fakeNumDefipmi1",
 					NumDefPWD:     "fakeNumDefpwd1",
 					Metro:         "fakeMetro1",
 				},
 				{
 					SerialNum:     "fakeSerialNum1",
-					AocMacAddress: "fakeAocMacAddress3,fakeAocMacAddress4",
-					BmcMacAddress: "fakeBmcMacAddress3,fakeBmcMacAddress4",
+					AocMacAddress: "00:00:00:00:0a:03,00:00:00:00:0a:04",
+					BmcMacAddress: "00:00:00:00:0b:03,00:00:00:00:0b:04",
 					NumDefiPmi:    "fakeNumDefipmi2",
 					NumDefPWD:     "fakeNumDefpwd2",
 					Metro:         "fakeMetro2",
 				},
 			},
-			expectedUploadErr:          true,
-			expectedUploadErrorMsg:     "unable to insert into bom_info: pq: duplicate key value violates unique constraint",
-			aocMacAddress:              "fakeAocMacAddress3",
+			expectedUploadErr:          false,
+			expectedUploadErrorMsg:     "",
+			aocMacAddress:              "00:00:00:00:0a:03",
 			expectedAocMacAddressError: false,
 		},
 		{
@@ -90,24 +93,24 @@ func TestIntegrationBomUpload(t *testing.T) {
 			uploadBoms: []serverservice.Bom{
 				{
 					SerialNum:     "fakeSerialNum1",
-					AocMacAddress: "fakeAocMacAddress1,fakeAocMacAddress2",
-					BmcMacAddress: "fakeBmcMacAddress1,fakeBmcMacAddress2",
+					AocMacAddress: "00:00:00:00:0a:01,00:00:00:00:0a:02",
+					BmcMacAddress: "00:00:00:00:0b:01,00:00:00:00:0b:02",
 					NumDefiPmi:    "fakeNumDefipmi1",
 					NumDefPWD:     "fakeNumDefpwd1",
 					Metro:         "fakeMetro1",
 				},
 				{
 					SerialNum:     "fakeSerialNum2",
-					AocMacAddress: "fakeAocMacAddress1,fakeAocMacAddress3",
-					BmcMacAddress: "fakeBmcMacAddress3,fakeBmcMacAddress4",
+					AocMacAddress: "00:00:00:00:0a:01,00:00:00:00:0a:03",
+					BmcMacAddress: "00:00:00:00:0b:03,00:00:00:00:0b:04",
 					NumDefiPmi:    "fakeNumDefipmi2",
 					NumDefPWD:     "fakeNumDefpwd2",
 					Metro:         "fakeMetro2",
 				},
 			},
 			expectedUploadErr:          true,
-			expectedUploadErrorMsg:     "unable to insert into aoc_mac_address: pq: duplicate key value violates unique constraint",
-			aocMacAddress:              "fakeAocMacAddress3",
+			expectedUploadErrorMsg:     "aoc mac address 00:00:00:00:0a:01 belongs to bom fakeSerialNum1",
+			aocMacAddress:              "00:00:00:00:0a:01",
 			expectedAocMacAddressError: false,
 		},
 		{
@@ -115,24 +118,58 @@ func TestIntegrationBomUpload(t *testing.T) {
 			uploadBoms: []serverservice.Bom{
 				{
 					SerialNum:     "fakeSerialNum1",
-					AocMacAddress: "fakeAocMacAddress1,fakeAocMacAddress2",
-					BmcMacAddress: "fakeBmcMacAddress1,fakeBmcMacAddress2",
+					AocMacAddress: "00:00:00:00:0a:01,00:00:00:00:0a:02",
+					BmcMacAddress: "00:00:00:00:0b:01,00:00:00:00:0b:02",
 					NumDefiPmi:    "fakeNumDefipmi1",
 					NumDefPWD:     "fakeNumDefpwd1",
 					Metro:         "fakeMetro1",
 				},
 				{
 					SerialNum:     "fakeSerialNum2",
-					AocMacAddress: "fakeAocMacAddress3,fakeAocMacAddress4",
-					BmcMacAddress: "fakeBmcMacAddress1,fakeBmcMacAddress3",
+					AocMacAddress: "00:00:00:00:0a:03,00:00:00:00:0a:04",
+					BmcMacAddress: "00:00:00:00:0b:01,00:00:00:00:0b:03",
 					NumDefiPmi:    "fakeNumDefipmi2",
 					NumDefPWD:     "fakeNumDefpwd2",
 					Metro:         "fakeMetro2",
 				},
 			},
 			expectedUploadErr:          true,
-			expectedUploadErrorMsg:     "unable to insert into bmc_mac_address: pq: duplicate key value violates unique constraint",
-			aocMacAddress:              "fakeBmcMacAddress3",
+			expectedUploadErrorMsg:     "bmc mac address 00:00:00:00:0b:01 belongs to bom fakeSerialNum1",
+			aocMacAddress:              "00:00:00:00:0a:03",
+			expectedAocMacAddressError: false,
+		},
+		{
+			testName: "upload mac addresses in other formats",
+			uploadBoms: []serverservice.Bom{
+				{
+					SerialNum:     "fakeSerialNum1",
+					AocMacAddress: "00-00-00-00-0A-01, 0000.0000.0a02",
+					BmcMacAddress: "000000000B01",
+					NumDefiPmi:    "fakeNumDefipmi1",
+					NumDefPWD:     "fakeNumDefpwd1",
+					Metro:         "fakeMetro1",
+				},
+			},
+			expectedUploadErr:          false,
+			expectedUploadErrorMsg:     "",
+			aocMacAddress:              "00:00:00:00:0a:02",
+			expectedAocMacAddressError: false,
+		},
+		{
+			testName: "upload invalid AocMacAddress",
+			uploadBoms: []serverservice.Bom{
+				{
+					SerialNum:     "fakeSerialNum1",
+					AocMacAddress: "00:00:00:00:0a:01,fakeAocMacAddress2",
+					BmcMacAddress: "00:00:00:00:0b:01",
+					NumDefiPmi:    "fakeNumDefipmi1",
+					NumDefPWD:     "fakeNumDefpwd1",
+					Metro:         "fakeMetro1",
+				},
+			},
+			expectedUploadErr:          true,
+			expectedUploadErrorMsg:     `aoc-mac-address: "fakeAocMacAddress2": invalid MAC address`,
+			aocMacAddress:              "00:00:00:00:0a:01",
 			expectedAocMacAddressError: false,
 		},
 		{
@@ -140,8 +177,8 @@ func TestIntegrationBomUpload(t *testing.T) {
 			uploadBoms: []serverservice.Bom{
 				{
 					SerialNum:     "",
-					AocMacAddress: "fakeAocMacAddress1,fakeAocMacAddress2",
-					BmcMacAddress: "fakeBmcMacAddress1,fakeBmcMacAddress2",
+					AocMacAddress: "00:00:00:00:0a:01,00:00:00:00:0a:02",
+					BmcMacAddress: "00:00:00:00:0b:01,00:00:00:00:0b:02",
 					NumDefiPmi:    "fakeNumDefipmi1",
 					NumDefPWD:     "fakeNumDefpwd1",
 					Metro:         "fakeMetro1",
@@ -149,7 +186,7 @@ func TestIntegrationBomUpload(t *testing.T) {
 			},
 			expectedUploadErr:          true,
 			expectedUploadErrorMsg:     "the primary key serial-num can not be blank",
-			aocMacAddress:              "fakeAocMacAddress3",
+			aocMacAddress:              "00:00:00:00:0a:03",
 			expectedAocMacAddressError: false,
 		},
 		{
@@ -158,7 +195,7 @@ func TestIntegrationBomUpload(t *testing.T) {
 				{
 					SerialNum:     "fakeSerialNum1",
 					AocMacAddress: "",
-					BmcMacAddress: "fakeBmcMacAddress1,fakeBmcMacAddress2",
+					BmcMacAddress: "00:00:00:00:0b:01,00:00:00:00:0b:02",
 					NumDefiPmi:    "fakeNumDefipmi1",
 					NumDefPWD:     "fakeNumDefpwd1",
 					Metro:         "fakeMetro1",
@@ -166,7 +203,7 @@ func TestIntegrationBomUpload(t *testing.T) {
 			},
 			expectedUploadErr:          true,
 			expectedUploadErrorMsg:     "the primary key aoc-mac-address can not be blank",
-			aocMacAddress:              "fakeAocMacAddress3",
+			aocMacAddress:              "00:00:00:00:0a:03",
 			expectedAocMacAddressError: false,
 		},
 		{
@@ -174,7 +211,7 @@ func TestIntegrationBomUpload(t *testing.T) {
 			uploadBoms: []serverservice.Bom{
 				{
 					SerialNum:     "fakeSerialNum1",
-					AocMacAddress: "fakeAocMacAddress1,fakeAocMacAddress2",
+					AocMacAddress: "00:00:00:00:0a:01,00:00:00:00:0a:02",
 					BmcMacAddress: "",
 					NumDefiPmi:    "fakeNumDefipmi1",
 					NumDefPWD:     "fakeNumDefpwd1",
@@ -183,7 +220,7 @@ func TestIntegrationBomUpload(t *testing.T) {
 			},
 			expectedUploadErr:          true,
 			expectedUploadErrorMsg:     "the primary key bmc-mac-address can not be blank",
-			aocMacAddress:              "fakeAocMacAddress3",
+			aocMacAddress:              "00:00:00:00:0a:03",
 			expectedAocMacAddressError: false,
 		},
 	}
@@ -230,22 +267,22 @@ func TestUploadInOneTransaction(t *testing.T) {
 		[]serverservice.Bom{
 			{
 				SerialNum:     "fakeSerialNum1",
-				AocMacAddress: "fakeAocMacAddress1,fakeAocMacAddress2",
-				BmcMacAddress: "fakeBmcMacAddress1,fakeBmcMacAddress2",
+				AocMacAddress: "00:00:00:00:0a:01,00:00:00:00:0a:02",
+				BmcMacAddress: "00:00:00:00:0b:01,00:00:00:00:0b:02",
 				NumDefiPmi:    "fakeNumDefipmi1",
 				NumDefPWD:     "fakeNumDefpwd1",
 				Metro:         "fakeMetro1",
 			},
 			{
 				SerialNum:     "fakeSerialNum2",
-				AocMacAddress: "fakeAocMacAddress1,fakeAocMacAddress3",
-				BmcMacAddress: "fakeBmcMacAddress3,fakeBmcMacAddress4",
+				AocMacAddress: "00:00:00:00:0a:03,00:00:00:00:0a:04",
+				BmcMacAddress: "",
 				NumDefiPmi:    "fakeNumDefipmi2",
 				NumDefPWD:     "fakeNumDefpwd2",
 				Metro:         "fakeMetro2",
 			},
 		}
-	expectedUploadErrorMsg := "unable to insert into aoc_mac_address: pq: duplicate key value violates unique constraint"
+	expectedUploadErrorMsg := "the primary key bmc-mac-address can not be blank"
 	expectedGetMsg := "no rows in result set"
 
 	s := serverTest(t)
@@ -269,16 +306,16 @@ func TestIntegrationGetBomByAocMacAddr(t *testing.T) {
 	uploadBoms := []serverservice.Bom{
 		{
 			SerialNum:     "fakeSerialNum1",
-			AocMacAddress: "fakeAocMacAddress1,fakeAocMacAddress2",
-			BmcMacAddress: "fakeBmcMacAddress1,fakeBmcMacAddress2",
+			AocMacAddress: "00:00:00:00:0a:01,00:00:00:00:0a:02",
+			BmcMacAddress: "00:00:00:00:0b:01,00:00:00:00:0b:02",
 			NumDefiPmi:    "fakeNumDefipmi1",
 			NumDefPWD:     "fakeNumDefpwd1",
 			Metro:         "fakeMetro1",
 		},
 		{
 			SerialNum:     "fakeSerialNum2",
-			AocMacAddress: "fakeAocMacAddress3,fakeAocMacAddress4",
-			BmcMacAddress: "fakeBmcMacAddress3,fakeBmcMacAddress4",
+			AocMacAddress: "00:00:00:00:0a:03,00:00:00:00:0a:04",
+			BmcMacAddress: "00:00:00:00:0b:03,00:00:00:00:0b:04",
 			NumDefiPmi:    "fakeNumDefipmi2",
 			NumDefPWD:     "fakeNumDefpwd2",
 			Metro:         "fakeMetro2",
@@ -302,45 +339,61 @@ func TestIntegrationGetBomByAocMacAddr(t *testing.T) {
 	}{
 		{
 			testName:                      "get first bom by first aoc mac address",
-			aocMacAddress:                 "fakeAocMacAddress1",
+			aocMacAddress:                 "00:00:00:00:0a:01",
 			expectedBom:                   uploadBoms[0],
 			expectedAocMacAddressError:    false,
 			expectedAocMacAddressErrorMsg: "",
 		},
 		{
 			testName:                      "get first bom by second aoc mac address",
-			aocMacAddress:                 "fakeAocMacAddress2",
+			aocMacAddress:                 "00:00:00:00:0a:02",
 			expectedBom:                   uploadBoms[0],
 			expectedAocMacAddressError:    false,
 			expectedAocMacAddressErrorMsg: "",
 		},
 		{
 			testName:                      "get second bom by first aoc mac address",
-			aocMacAddress:                 "fakeAocMacAddress3",
+			aocMacAddress:                 "00:00:00:00:0a:03",
 			expectedBom:                   uploadBoms[1],
 			expectedAocMacAddressError:    false,
 			expectedAocMacAddressErrorMsg: "",
 		},
 		{
 			testName:                      "get second bom by second aoc mac address",
-			aocMacAddress:                 "fakeAocMacAddress3",
+			aocMacAddress:                 "00:00:00:00:0a:03",
 			expectedBom:                   uploadBoms[1],
 			expectedAocMacAddressError:    false,
 			expectedAocMacAddressErrorMsg: "",
 		},
+		{
+			testName:                      "get first bom by aoc mac address in another format",
+			aocMacAddress:                 "00-00-00-00-0A-01",
+			expectedBom:                   uploadBoms[0],
+			expectedAocMacAddressError:    false,
+			expectedAocMacAddressErrorMsg: "",
+		},
 		{
 			testName:                      "non-exist aoc mac address",
-			aocMacAddress:                 "random",
+			aocMacAddress:                 "00:00:00:00:0f:ff",
 			expectedBom:                   uploadBoms[1],
 			expectedAocMacAddressError:    true,
 			expectedAocMacAddressErrorMsg: "sql: no rows in result set",
 		},
 		{
+			testName:                      "invalid aoc mac address",
+			aocMacAddress:                 "random",
+			expectedBom:                   uploadBoms[1],
+			expectedAocMacAddressError:    true,
+			expectedAocMacAddressErrorMsg: "invalid MAC address",
+		},
+		{
+			// the trailing slash is dropped and the path is looked up as the
+			// serial number "aoc-mac-address"
 			testName:                      "empty aoc mac address",
 			aocMacAddress:                 "",
 			expectedBom:                   uploadBoms[1],
 			expectedAocMacAddressError:    true,
-			expectedAocMacAddressErrorMsg: "route not found",
+			expectedAocMacAddressErrorMsg: "no rows in result set",
 		},
 	}
 
@@ -374,16 +427,16 @@ func TestIntegrationGetBomByBmcMacAddr(t *testing.T) {
 	uploadBoms := []serverservice.Bom{
 		{
 			SerialNum:     "fakeSerialNum1",
-			AocMacAddress: "fakeAocMacAddress1,fakeAocMacAddress2",
-			BmcMacAddress: "fakeBmcMacAddress1,fakeBmcMacAddress2",
+			AocMacAddress: "00:00:00:00:0a:01,00:00:00:00:0a:02",
+			BmcMacAddress: "00:00:00:00:0b:01,00:00:00:00:0b:02",
 			NumDefiPmi:    "fakeNumDefipmi1",
 			NumDefPWD:     "fakeNumDefpwd1",
 			Metro:         "fakeMetro1",
 		},
 		{
 			SerialNum:     "fakeSerialNum2",
-			AocMacAddress: "fakeAocMacAddress3,fakeAocMacAddress4",
-			BmcMacAddress: "fakeBmcMacAddress3,fakeBmcMacAddress4",
+			AocMacAddress: "00:00:00:00:0a:03,00:00:00:00:0a:04",
+			BmcMacAddress: "00:00:00:00:0b:03,00:00:00:00:0b:04",
 			NumDefiPmi:    "fakeNumDefipmi2",
 			NumDefPWD:     "fakeNumDefpwd2",
 			Metro:         "fakeMetro2",
@@ -407,45 +460,61 @@ func TestIntegrationGetBomByBmcMacAddr(t *testing.T) {
 	}{
 		{
 			testName:                      "get first bom by first bmc mac address",
-			bmcMacAddress:                 "fakeBmcMacAddress1",
+			bmcMacAddress:                 "00:00:00:00:0b:01",
 			expectedBom:                   uploadBoms[0],
 			expectedBmcMacAddressError:    false,
 			expectedBmcMacAddressErrorMsg: "",
 		},
 		{
 			testName:                      "get first bom by second bmc mac address",
-			bmcMacAddress:                 "fakeBmcMacAddress2",
+			bmcMacAddress:                 "00:00:00:00:0b:02",
 			expectedBom:                   uploadBoms[0],
 			expectedBmcMacAddressError:    false,
 			expectedBmcMacAddressErrorMsg: "",
 		},
 		{
 			testName:                      "get second bom by first bmc mac address",
-			bmcMacAddress:                 "fakeBmcMacAddress3",
+			bmcMacAddress:                 "00:00:00:00:0b:03",
 			expectedBom:                   uploadBoms[1],
 			expectedBmcMacAddressError:    false,
 			expectedBmcMacAddressErrorMsg: "",
 		},
 		{
 			testName:                      "get second bom by second bmc mac address",
-			bmcMacAddress:                 "fakeBmcMacAddress3",
+			bmcMacAddress:                 "00:00:00:00:0b:03",
 			expectedBom:                   uploadBoms[1],
 			expectedBmcMacAddressError:    false,
 			expectedBmcMacAddressErrorMsg: "",
 		},
+		{
+			testName:                      "get first bom by bmc mac address in another format",
+			bmcMacAddress:                 "00-00-00-00-0B-01",
+			expectedBom:                   uploadBoms[0],
+			expectedBmcMacAddressError:    false,
+			expectedBmcMacAddressErrorMsg: "",
+		},
 		{
 			testName:                      "non-exist bmc mac address",
-			bmcMacAddress:                 "random",
+			bmcMacAddress:                 "00:00:00:00:0f:ff",
 			expectedBom:                   uploadBoms[1],
 			expectedBmcMacAddressError:    true,
 			expectedBmcMacAddressErrorMsg: "sql: no rows in result set",
 		},
 		{
+			testName:                      "invalid bmc mac address",
+			bmcMacAddress:                 "random",
+			expectedBom:                   uploadBoms[1],
+			expectedBmcMacAddressError:    true,
+			expectedBmcMacAddressErrorMsg: "invalid MAC address",
+		},
+		{
+			// the trailing slash is dropped and the path is looked up as the
+			// serial number "bmc-mac-address"
 			testName:                      "empty bmc mac address",
 			bmcMacAddress:                 "",
 			expectedBom:                   uploadBoms[1],
 			expectedBmcMacAddressError:    true,
-			expectedBmcMacAddressErrorMsg: "route not found",
+			expectedBmcMacAddressErrorMsg: "no rows in result set",
 		},
 	}
 
@@ -472,3 +541,246 @@ func TestIntegrationGetBomByBmcMacAddr(t *testing.T) {
 		})
 	}
 }
+
+func TestIntegrationBomReupload(t *testing.T) {
+	s := serverTest(t)
+	s.Client.SetToken(validToken(adminScopes))
+
+	bom := serverservice.Bom{
+		SerialNum:     "fakeSerialNum1",
+		AocMacAddress: "00:00:00:00:0a:01,00:00:00:00:0a:02",
+		BmcMacAddress: "00:00:00:00:0b:01",
+		NumDefiPmi:    "fakeNumDefipmi1",
+		NumDefPWD:     "fakeNumDefpwd1",
+		Metro:         "fakeMetro1",
+	}
+
+	_, err := s.Client.BillOfMaterialsBatchUpload(context.TODO(), []serverservice.Bom{bom})
+	require.NoError(t, err)
+
+	// uploading the same manifest again is a no-op
+	_, err = s.Client.BillOfMaterialsBatchUpload(context.TODO(), []serverservice.Bom{bom})
+	require.NoError(t, err)
+
+	// a changed MAC list replaces the stored one
+	bom.AocMacAddress = "00:00:00:00:0a:02,00:00:00:00:0a:03"
+	bom.Metro = "fakeMetro2"
+
+	_, err = s.Client.BillOfMaterialsBatchUpload(context.TODO(), []serverservice.Bom{bom})
+	require.NoError(t, err)
+
+	got, _, err := s.Client.GetBomInfoByAOCMacAddr(context.TODO(), "00:00:00:00:0a:03")
+	require.NoError(t, err)
+	assert.Equal(t, "fakeMetro2", got.Metro)
+
+	_, _, err = s.Client.GetBomInfoByAOCMacAddr(context.TODO(), "00:00:00:00:0a:01")
+	assert.ErrorContains(t, err, "no rows in result set")
+
+	_, _, err = s.Client.GetBomInfoByAOCMacAddr(context.TODO(), "00:00:00:00:0a:02")
+	assert.NoError(t, err)
+}
+
+func TestIntegrationBomCSVUpload(t *testing.T) {
+	s := serverTest(t)
+
+	realClientTests(t, func(ctx context.Context, authToken string, respCode int, expectError bool) error {
+		s.Client.SetToken(authToken)
+
+		csv := "Serial Number,AOC MAC,BMC MAC,Metro\n" +
+			"fakeSerialNum1,\"00:00:00:00:0a:01, 00:00:00:00:0a:02\",00:00:00:00:0b:01,fakeMetro1\n" +
+			"fakeSerialNum2,,00:00:00:00:0b:02,fakeMetro1\n" +
+			"fakeSerialNum3,00:00:00:00:0a:03,00:00:00:00:0b:03,fakeMetro1\n" +
+			"fakeSerialNum4,00:00:00:00:0a:03,00:00:00:00:0b:04,fakeMetro1\n"
+
+		report, _, err := s.Client.BillOfMaterialsCSVUpload(ctx, "manifest.csv", strings.NewReader(csv))
+		if !expectError {
+			require.NoError(t, err)
+			assert.Equal(t, 2, report.Created)
+			assert.Equal(t, 2, report.Failed)
+			require.Len(t, report.Results, 4)
+			assert.Equal(t, 3, report.Results[1].Row)
+			assert.Equal(t, serverservice.BomUploadStatusFailed, report.Results[1].Status)
+			assert.Contains(t, report.Results[1].Error, "aoc-mac-address can not be blank")
+			assert.Equal(t, serverservice.BomUploadStatusFailed, report.Results[3].Status)
+			assert.Contains(t, report.Results[3].Error, "belongs to bom fakeSerialNum3")
+
+			// the address stays with the bom it belonged to
+			bom, _, err := s.Client.GetBomInfoByAOCMacAddr(ctx, "00:00:00:00:0a:03")
+			require.NoError(t, err)
+			assert.Equal(t, "fakeSerialNum3", bom.SerialNum)
+
+			bom, _, err = s.Client.GetBomInfoByAOCMacAddr(ctx, "00:00:00:00:0a:02")
+			require.NoError(t, err)
+			assert.Equal(t, "fakeSerialNum1", bom.SerialNum)
+
+			// the same file again updates the rows that were written
+			report, _, err = s.Client.BillOfMaterialsCSVUpload(ctx, "manifest.csv", strings.NewReader(csv))
+			require.NoError(t, err)
+			assert.Equal(t, 2, report.Updated)
+			assert.Equal(t, 2, report.Failed)
+		}
+
+		return err
+	})
+}
+
+func uploadTestBoms(t *testing.T, s *integrationServer) []serverservice.Bom {
+	t.Helper()
+
+	boms := []serverservice.Bom{
+		{SerialNum: "ABC-001", AocMacAddress: "00:00:00:00:0a:01", BmcMacAddress: "00:00:00:00:0b:01", Metro: "da"},
+		{SerialNum: "ABC-002", AocMacAddress: "00:00:00:00:0a:02", BmcMacAddress: "00:00:00:00:0b:02", Metro: "sv"},
+		{SerialNum: "ABD_003", AocMacAddress: "00:00:00:00:0a:03", BmcMacAddress: "00:00:00:00:0b:03", Metro: "da"},
+	}
+
+	s.Client.SetToken(validToken(adminScopes))
+
+	_, err := s.Client.BillOfMaterialsBatchUpload(context.TODO(), boms)
+	require.NoError(t, err)
+
+	return boms
+}
+
+func TestIntegrationBomList(t *testing.T) {
+	s := serverTest(t)
+	boms := uploadTestBoms(t, s)
+
+	realClientTests(t, func(ctx context.Context, authToken string, respCode int, expectError bool) error {
+		s.Client.SetToken(authToken)
+
+		res, resp, err := s.Client.ListBoms(ctx, nil)
+		if !expectError {
+			require.NoError(t, err)
+			assert.Equal(t, boms, res)
+			assert.EqualValues(t, 3, resp.TotalRecordCount)
+		}
+
+		return err
+	})
+
+	testCases := []struct {
+		testName string
+		params   *serverservice.BomListParams
+		expected []serverservice.Bom
+	}{
+		{
+			"filter by metro",
+			&serverservice.BomListParams{Metro: "da"},
+			[]serverservice.Bom{boms[0], boms[2]},
+		},
+		{
+			"filter by serial number prefix",
+			&serverservice.BomListParams{SerialNumPrefix: "ABC-"},
+			[]serverservice.Bom{boms[0], boms[1]},
+		},
+		{
+			"wildcards in the prefix are matched literally",
+			&serverservice.BomListParams{SerialNumPrefix: "AB_"},
+			[]serverservice.Bom{},
+		},
+		{
+			"metro and prefix",
+			&serverservice.BomListParams{Metro: "da", SerialNumPrefix: "ABD"},
+			[]serverservice.Bom{boms[2]},
+		},
+		{
+			"second page",
+			&serverservice.BomListParams{Pagination: &serverservice.PaginationParams{Limit: 2, Page: 2}},
+			[]serverservice.Bom{boms[2]},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.testName, func(t *testing.T) {
+			res, _, err := s.Client.ListBoms(context.TODO(), tt.params)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, res)
+		})
+	}
+}
+
+func TestIntegrationBomGet(t *testing.T) {
+	s := serverTest(t)
+	boms := uploadTestBoms(t, s)
+
+	realClientTests(t, func(ctx context.Context, authToken string, respCode int, expectError bool) error {
+		s.Client.SetToken(authToken)
+
+		res, _, err := s.Client.GetBom(ctx, boms[1].SerialNum)
+		if !expectError {
+			require.NoError(t, err)
+			assert.Equal(t, boms[1], *res)
+		}
+
+		return err
+	})
+
+	_, _, err := s.Client.GetBom(context.TODO(), "unknown")
+	assert.ErrorContains(t, err, "no rows in result set")
+}
+
+func TestIntegrationBomUpdate(t *testing.T) {
+	s := serverTest(t)
+	boms := uploadTestBoms(t, s)
+
+	realClientTests(t, func(ctx context.Context, authToken string, respCode int, expectError bool) error {
+		s.Client.SetToken(authToken)
+
+		bom := boms[0]
+		bom.AocMacAddress = "00:00:00:00:0a:04"
+		bom.Metro = "sv"
+
+		_, err := s.Client.UpdateBom(ctx, bom.SerialNum, bom)
+		if !expectError {
+			require.NoError(t, err)
+
+			res, _, err := s.Client.GetBomInfoByAOCMacAddr(ctx, "00:00:00:00:0a:04")
+			require.NoError(t, err)
+			assert.Equal(t, bom, *res)
+
+			_, _, err = s.Client.GetBomInfoByAOCMacAddr(ctx, "00:00:00:00:0a:01")
+			assert.ErrorContains(t, err, "no rows in result set")
+		}
+
+		return err
+	})
+
+	s.Client.SetToken(validToken(adminScopes))
+
+	_, err := s.Client.UpdateBom(context.TODO(), "unknown", boms[0])
+	assert.ErrorContains(t, err, "the serial number can't be changed")
+
+	_, err = s.Client.UpdateBom(context.TODO(), "unknown", serverservice.Bom{AocMacAddress: "00:00:00:00:0a:09", BmcMacAddress: "00:00:00:00:0b:09"})
+	assert.ErrorContains(t, err, "no rows in result set")
+
+	_, err = s.Client.UpdateBom(context.TODO(), boms[1].SerialNum, serverservice.Bom{BmcMacAddress: "00:00:00:00:0b:09"})
+	assert.ErrorContains(t, err, "aoc-mac-address can not be blank")
+
+	taken := boms[1]
+	taken.BmcMacAddress = boms[2].BmcMacAddress
+	_, err = s.Client.UpdateBom(context.TODO(), taken.SerialNum, taken)
+	assert.ErrorContains(t, err, "response code: 409")
+	assert.ErrorContains(t, err, "belongs to bom "+boms[2].SerialNum)
+}
+
+func TestIntegrationBomDelete(t *testing.T) {
+	s := serverTest(t)
+	boms := uploadTestBoms(t, s)
+
+	realClientTests(t, func(ctx context.Context, authToken string, respCode int, expectError bool) error {
+		s.Client.SetToken(authToken)
+
+		_, err := s.Client.DeleteBom(ctx, boms[0].SerialNum)
+		if !expectError {
+			require.NoError(t, err)
+
+			_, _, err := s.Client.GetBom(ctx, boms[0].SerialNum)
+			assert.ErrorContains(t, err, "no rows in result set")
+
+			_, _, err = s.Client.GetBomInfoByBMCMacAddr(ctx, boms[0].BmcMacAddress)
+			assert.ErrorContains(t, err, "no rows in result set")
+		}
+
+		return err
+	})
+}
@@ -30,12 +30,30 @@ func (r *Router) serverComponentFirmwareList(c *gin.Context) {
 	pager.OrderBy = models.ComponentFirmwareVersionTableColumns.Vendor + " DESC"
 	mods = append(mods, pager.serverQueryMods()...)
 
+	if pager.keyset() {
+		keysetMods, err := pager.keysetQueryMods(models.TableNames.ComponentFirmwareVersion, models.ComponentFirmwareVersionColumns.Vendor, "STRING")
+		if err != nil {
+			badRequestResponse(c, "", err)
+			return
+		}
+
+		mods = append(mods, keysetMods...)
+	}
+
 	dbFirmwares, err := models.ComponentFirmwareVersions(mods...).All(c.Request.Context(), r.DB)
 	if err != nil {
 		dbErrorResponse(c, err)
 		return
 	}
 
+	nextCursor := ""
+
+	if pager.keyset() && pager.hasNextKeysetPage(len(dbFirmwares)) {
+		dbFirmwares = dbFirmwares[:pager.limitUsed()]
+		last := dbFirmwares[len(dbFirmwares)-1]
+		nextCursor = encodeCursor(last.Vendor, last.ID)
+	}
+
 	firmwares := make([]ComponentFirmwareVersion, 0, count)
 
 	for _, dbF := range dbFirmwares {
@@ -52,6 +70,7 @@ func (r *Router) serverComponentFirmwareList(c *gin.Context) {
 		pageCount:  len(firmwares),
 		totalCount: count,
 		pager:      pager,
+		nextCursor: nextCursor,
 	}
 
 	listResponse(c, firmwares, pd)
@@ -90,7 +109,17 @@ func (r *Router) serverComponentFirmwareCreate(c *gin.Context) {
 		return
 	}
 
-	if err := dbFirmware.Insert(c.Request.Context(), r.DB, boil.Infer()); err != nil {
+	err = r.auditedTx(c, func(tx boil.ContextExecutor) error {
+		return dbFirmware.Insert(c.Request.Context(), tx, boil.Infer())
+	}, func() auditEntry {
+		return auditEntry{
+			action:       AuditActionCreate,
+			resourceType: AuditResourceServerComponentFirmware,
+			resourceID:   dbFirmware.ID,
+			after:        dbFirmware,
+		}
+	})
+	if err != nil {
 		dbErrorResponse(c, err)
 		return
 	}
@@ -104,7 +133,18 @@ func (r *Router) serverComponentFirmwareDelete(c *gin.Context) {
 		return
 	}
 
-	if _, err = dbFirmware.Delete(c.Request.Context(), r.DB); err != nil {
+	err = r.auditedTx(c, func(tx boil.ContextExecutor) error {
+		_, err := dbFirmware.Delete(c.Request.Context(), tx)
+		return err
+	}, func() auditEntry {
+		return auditEntry{
+			action:       AuditActionDelete,
+			resourceType: AuditResourceServerComponentFirmware,
+			resourceID:   dbFirmware.ID,
+			before:       dbFirmware,
+		}
+	})
+	if err != nil {
 		dbErrorResponse(c, err)
 		return
 	}
@@ -124,6 +164,8 @@ func (r *Router) serverComponentFirmwareUpdate(c *gin.Context) {
 		return
 	}
 
+	before := *dbFirmware
+
 	dbFirmware.Vendor = newValues.Vendor
 	dbFirmware.Model = newValues.Model
 	dbFirmware.Filename = newValues.Filename
@@ -135,7 +177,19 @@ func (r *Router) serverComponentFirmwareUpdate(c *gin.Context) {
 
 	cols := boil.Infer()
 
-	if _, err := dbFirmware.Update(c.Request.Context(), r.DB, cols); err != nil {
+	err = r.auditedTx(c, func(tx boil.ContextExecutor) error {
+		_, err := dbFirmware.Update(c.Request.Context(), tx, cols)
+		return err
+	}, func() auditEntry {
+		return auditEntry{
+			action:       AuditActionUpdate,
+			resourceType: AuditResourceServerComponentFirmware,
+			resourceID:   dbFirmware.ID,
+			before:       &before,
+			after:        dbFirmware,
+		}
+	})
+	if err != nil {
 		dbErrorResponse(c, err)
 		return
 	}
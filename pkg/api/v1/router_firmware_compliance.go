@@ -0,0 +1,211 @@
+package serverservice
+
+import (
+	"context"
+	"errors"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+
+	"go.hollow.sh/serverservice/internal/models"
+)
+
+var errFirmwareComplianceRequest = errors.New("error in firmware compliance request")
+
+func (r *Router) serverFirmwareCompliance(c *gin.Context) {
+	srv, err := r.loadServerFromParams(c)
+	if err != nil {
+		if errors.Is(err, ErrUUIDParse) {
+			badRequestResponse(c, "", err)
+			return
+		}
+
+		dbErrorResponse(c, err)
+
+		return
+	}
+
+	params, firmwares, ok := r.firmwareComplianceParams(c, srv)
+	if !ok {
+		return
+	}
+
+	reports, err := r.firmwareCompliance(c.Request.Context(), params, models.ServerSlice{srv}, firmwares)
+	if err != nil {
+		dbErrorResponse(c, err)
+		return
+	}
+
+	itemResponse(c, reports[0])
+}
+
+func (r *Router) firmwareComplianceList(c *gin.Context) {
+	pager := parsePagination(c)
+
+	params, firmwares, ok := r.firmwareComplianceParams(c, nil)
+	if !ok {
+		return
+	}
+
+	// the servers are selected with the filters of the server list
+	serverParams, err := r.serverListParams(c)
+	if err != nil {
+		return
+	}
+
+	params.ServerListParams = serverParams
+
+	mods := params.ServerListParams.queryMods()
+
+	count, err := models.Servers(mods...).Count(c.Request.Context(), r.DB)
+	if err != nil {
+		dbErrorResponse(c, err)
+		return
+	}
+
+	pager.OrderBy = models.ServerTableColumns.CreatedAt + " DESC, " + models.ServerTableColumns.ID
+	mods = append(mods, pager.queryMods()...)
+
+	servers, err := models.Servers(mods...).All(c.Request.Context(), r.DB)
+	if err != nil {
+		dbErrorResponse(c, err)
+		return
+	}
+
+	reports, err := r.firmwareCompliance(c.Request.Context(), params, servers, firmwares)
+	if err != nil {
+		dbErrorResponse(c, err)
+		return
+	}
+
+	pd := paginationData{
+		pageCount:  len(reports),
+		totalCount: count,
+		pager:      pager,
+	}
+
+	listResponse(c, reports, pd)
+}
+
+// firmwareComplianceParams parses the query params and loads the firmware of
+// the requested firmware set, responding to the request on failure. When no
+// firmware set is requested for a server its effective firmware set is used.
+func (r *Router) firmwareComplianceParams(c *gin.Context, srv *models.Server) (*FirmwareComplianceParams, []*models.ComponentFirmwareVersion, bool) {
+	params := &FirmwareComplianceParams{}
+	if err := c.ShouldBindQuery(params); err != nil {
+		badRequestResponse(c, "invalid firmware compliance params", err)
+		return nil, nil, false
+	}
+
+	setID, err := uuid.Parse(c.Query("firmware_set"))
+
+	if c.Query("firmware_set") == "" && srv != nil {
+		resolved, rerr := r.effectiveFirmwareSets(c.Request.Context(), r.DB, models.ServerSlice{srv})
+		if rerr != nil {
+			dbErrorResponse(c, rerr)
+			return nil, nil, false
+		}
+
+		rs, ok := resolved[srv.ID]
+		if !ok {
+			badRequestResponse(c, "no firmware_set given and none is assigned to the server", errFirmwareComplianceRequest)
+			return nil, nil, false
+		}
+
+		setID, err = uuid.Parse(rs.firmwareSetID())
+	}
+
+	if err != nil {
+		badRequestResponse(c, "expected a firmware_set UUID", errFirmwareComplianceRequest)
+		return nil, nil, false
+	}
+
+	params.FirmwareSetID = setID
+
+	if params.Namespace == "" {
+		params.Namespace = DefaultFirmwareStatusNamespace
+	}
+
+	exists, err := models.ComponentFirmwareSetExists(c.Request.Context(), r.DB, setID.String())
+	if err != nil {
+		dbErrorResponse(c, err)
+		return nil, nil, false
+	}
+
+	if !exists {
+		notFoundResponse(c, "firmware set not found: "+setID.String())
+		return nil, nil, false
+	}
+
+	firmwares, err := r.queryFirmwareSetFirmware(c.Request.Context(), setID.String())
+	if err != nil {
+		dbErrorResponse(c, err)
+		return nil, nil, false
+	}
+
+	return params, firmwares, true
+}
+
+// firmwareCompliance builds a compliance report for each of the servers, in
+// the same order, comparing their components against the firmware given.
+func (r *Router) firmwareCompliance(ctx context.Context, params *FirmwareComplianceParams, servers models.ServerSlice, firmwares []*models.ComponentFirmwareVersion) ([]ServerFirmwareCompliance, error) {
+	reports := make([]ServerFirmwareCompliance, 0, len(servers))
+	if len(servers) == 0 {
+		return reports, nil
+	}
+
+	ids := make([]string, 0, len(servers))
+	for _, srv := range servers {
+		ids = append(ids, srv.ID)
+	}
+
+	byServer := map[string][]ComponentFirmwareCompliance{}
+
+	for _, batch := range batchIDs(ids, firmwareSetResolveBatchSize) {
+		components, err := models.ServerComponents(
+			models.ServerComponentWhere.ServerID.IN(batch),
+			qm.Load(models.ServerComponentRels.ServerComponentType),
+			qm.Load(
+				models.ServerComponentRels.VersionedAttributes,
+				models.VersionedAttributeWhere.Namespace.EQ(params.Namespace),
+				qm.OrderBy(models.VersionedAttributeColumns.CreatedAt+" DESC"),
+			),
+			qm.OrderBy(models.ServerComponentColumns.Name+", "+models.ServerComponentColumns.ID),
+		).All(ctx, r.DB)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, sc := range components {
+			cc, err := componentFirmwareCompliance(sc, firmwares)
+			if err != nil {
+				return nil, err
+			}
+
+			byServer[sc.ServerID] = append(byServer[sc.ServerID], cc)
+		}
+	}
+
+	for _, srv := range servers {
+		report := ServerFirmwareCompliance{
+			FirmwareSetUUID: params.FirmwareSetID,
+			Components:      []ComponentFirmwareCompliance{},
+		}
+
+		var err error
+
+		report.ServerUUID, err = uuid.Parse(srv.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		report.Components = append(report.Components, byServer[srv.ID]...)
+		report.Status = serverFirmwareStatus(report.Components)
+		report.Compliant = report.Status == FirmwareComplianceStatusCompliant
+
+		reports = append(reports, report)
+	}
+
+	return reports, nil
+}
@@ -0,0 +1,102 @@
+package serverservice_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+
+	"go.hollow.sh/serverservice/internal/dbtools"
+	"go.hollow.sh/serverservice/internal/models"
+	serverservice "go.hollow.sh/serverservice/pkg/api/v1"
+)
+
+func TestIntegrationServerFirmwareCompliance(t *testing.T) {
+	s := serverTest(t)
+	s.Client.SetToken(validToken(adminScopes))
+
+	db := dbtools.DatabaseTest(t)
+	ctx := context.TODO()
+
+	bmc := &models.ServerComponentType{Name: "BMC", Slug: "bmc"}
+	require.NoError(t, bmc.Insert(ctx, db, boil.Infer()))
+
+	srvID := uuid.MustParse(dbtools.FixtureNemo.ID)
+
+	_, err := s.Client.CreateComponents(ctx, srvID, serverservice.ServerComponentSlice{
+		{
+			Name:              "iDRAC",
+			Vendor:            "dell",
+			Model:             "R640",
+			Serial:            "bmc-1",
+			ComponentTypeID:   bmc.ID,
+			ComponentTypeSlug: bmc.Slug,
+			VersionedAttributes: []serverservice.VersionedAttributes{
+				{
+					Namespace: serverservice.DefaultFirmwareStatusNamespace,
+					Data:      json.RawMessage(`{"firmware":{"installed":"4.40.00.00"}}`),
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	params := &serverservice.FirmwareComplianceParams{FirmwareSetID: uuid.MustParse(dbtools.FixtureFirmwareSetR640.ID)}
+
+	realClientTests(t, func(ctx context.Context, authToken string, respCode int, expectError bool) error {
+		s.Client.SetToken(authToken)
+
+		report, _, err := s.Client.GetServerFirmwareCompliance(ctx, srvID, params)
+		if !expectError {
+			require.NoError(t, err)
+			assert.False(t, report.Compliant)
+			assert.Equal(t, serverservice.FirmwareComplianceStatusOutdated, report.Status)
+
+			var bmcReport *serverservice.ComponentFirmwareCompliance
+
+			for i, cc := range report.Components {
+				if cc.ComponentTypeSlug == "bmc" {
+					bmcReport = &report.Components[i]
+					continue
+				}
+
+				assert.Equal(t, serverservice.FirmwareComplianceStatusUnknown, cc.Status)
+			}
+
+			require.NotNil(t, bmcReport)
+			assert.Equal(t, serverservice.FirmwareComplianceStatusOutdated, bmcReport.Status)
+			assert.Equal(t, "4.40.00.00", bmcReport.InstalledVersion)
+			require.NotNil(t, bmcReport.Desired)
+			assert.Equal(t, dbtools.FixtureDellR640BMC.Version, bmcReport.Desired.Version)
+		}
+
+		return err
+	})
+
+	reports, _, err := s.Client.ListFirmwareCompliance(ctx, params)
+	require.NoError(t, err)
+
+	for _, r := range reports {
+		assert.False(t, r.Compliant)
+
+		// the fixture servers have no components the firmware set applies to
+		if r.ServerUUID != srvID {
+			assert.Equal(t, serverservice.FirmwareComplianceStatusUnknown, r.Status)
+		}
+	}
+
+	// the fleet report takes the filters of the server list
+	params.ServerListParams = serverservice.ServerListParams{FacilityCode: dbtools.FixtureNemo.FacilityCode.String}
+
+	reports, _, err = s.Client.ListFirmwareCompliance(ctx, params)
+	require.NoError(t, err)
+	require.Len(t, reports, 1)
+	assert.Equal(t, srvID, reports[0].ServerUUID)
+
+	_, _, err = s.Client.GetServerFirmwareCompliance(ctx, srvID, &serverservice.FirmwareComplianceParams{FirmwareSetID: uuid.New()})
+	assert.ErrorContains(t, err, "response code: 404")
+}
@@ -50,7 +50,18 @@ func (r *Router) serverComponentFirmwareSetList(c *gin.Context) {
 
 	// add pagination
 	pager.Preload = false
-	pager.OrderBy = models.ComponentFirmwareSetColumns.CreatedAt + " DESC"
+	pager.OrderBy = models.ComponentFirmwareSetTableColumns.CreatedAt + " DESC"
+	mods = append(mods, pager.serverQueryMods()...)
+
+	if pager.keyset() {
+		keysetMods, err := pager.keysetQueryMods(models.TableNames.ComponentFirmwareSet, models.ComponentFirmwareSetColumns.CreatedAt, "TIMESTAMPTZ")
+		if err != nil {
+			badRequestResponse(c, "", err)
+			return
+		}
+
+		mods = append(mods, keysetMods...)
+	}
 
 	// load firmware sets
 	dbFirmwareSets, err := models.ComponentFirmwareSets(mods...).All(c.Request.Context(), r.DB)
@@ -59,6 +70,14 @@ func (r *Router) serverComponentFirmwareSetList(c *gin.Context) {
 		return
 	}
 
+	nextCursor := ""
+
+	if pager.keyset() && pager.hasNextKeysetPage(len(dbFirmwareSets)) {
+		dbFirmwareSets = dbFirmwareSets[:pager.limitUsed()]
+		last := dbFirmwareSets[len(dbFirmwareSets)-1]
+		nextCursor = encodeTimeCursor(last.CreatedAt.Time, last.ID)
+	}
+
 	firmwareSets := make([]ComponentFirmwareSet, 0, count)
 
 	// load firmware set mappings
@@ -83,6 +102,7 @@ func (r *Router) serverComponentFirmwareSetList(c *gin.Context) {
 		pageCount:  len(firmwareSets),
 		totalCount: count,
 		pager:      pager,
+		nextCursor: nextCursor,
 	}
 
 	listResponse(c, firmwareSets, pd)
@@ -199,7 +219,13 @@ func (r *Router) serverComponentFirmwareSetCreate(c *gin.Context) {
 		return
 	}
 
-	err = r.firmwareSetCreateTx(c.Request.Context(), dbFirmwareSet, firmwareSetPayload.Attributes, firmwareUUIDs)
+	entry := auditEntry{
+		action:       AuditActionCreate,
+		resourceType: AuditResourceServerComponentFirmwareSet,
+		after:        firmwareSetPayload,
+	}
+
+	err = r.firmwareSetCreateTx(c, dbFirmwareSet, firmwareSetPayload.Attributes, firmwareUUIDs, entry)
 	if err != nil {
 		dbErrorResponse(c, err)
 		return
@@ -251,7 +277,9 @@ func (r *Router) firmwareSetVetFirmwareUUIDsForCreate(c *gin.Context, firmwareUU
 	return vetted, nil
 }
 
-func (r *Router) firmwareSetCreateTx(ctx context.Context, dbFirmwareSet *models.ComponentFirmwareSet, attrs []Attributes, firmwareUUIDs []uuid.UUID) error {
+func (r *Router) firmwareSetCreateTx(c *gin.Context, dbFirmwareSet *models.ComponentFirmwareSet, attrs []Attributes, firmwareUUIDs []uuid.UUID, entry auditEntry) error {
+	ctx := c.Request.Context()
+
 	// being transaction to insert a new firmware set and its references
 	tx, err := r.DB.BeginTx(ctx, nil)
 	if err != nil {
@@ -287,6 +315,12 @@ func (r *Router) firmwareSetCreateTx(ctx context.Context, dbFirmwareSet *models.
 		}
 	}
 
+	entry.resourceID = dbFirmwareSet.ID
+
+	if err := r.audit(c, tx, entry); err != nil {
+		return err
+	}
+
 	// commit
 	return tx.Commit()
 }
@@ -347,7 +381,15 @@ func (r *Router) serverComponentFirmwareSetUpdate(c *gin.Context) {
 		}
 	}
 
-	err = r.firmwareSetUpdateTx(c.Request.Context(), dbFirmwareSet, dbAttributesFirmwareSet, firmwareUUIDs)
+	entry := auditEntry{
+		action:       AuditActionUpdate,
+		resourceType: AuditResourceServerComponentFirmwareSet,
+		resourceID:   dbFirmware.ID,
+		before:       dbFirmware,
+		after:        newValues,
+	}
+
+	err = r.firmwareSetUpdateTx(c, dbFirmwareSet, dbAttributesFirmwareSet, firmwareUUIDs, entry)
 	if err != nil {
 		dbErrorResponse(c, err)
 		return
@@ -436,7 +478,9 @@ func (r *Router) firmwareSetMap(ctx context.Context, firmwareSet *models.Compone
 	return m, nil
 }
 
-func (r *Router) firmwareSetUpdateTx(ctx context.Context, fwSetUpdate *models.ComponentFirmwareSet, attrsUpdate models.AttributesFirmwareSetSlice, firmwareUUIDs []uuid.UUID) error {
+func (r *Router) firmwareSetUpdateTx(c *gin.Context, fwSetUpdate *models.ComponentFirmwareSet, attrsUpdate models.AttributesFirmwareSetSlice, firmwareUUIDs []uuid.UUID, entry auditEntry) error {
+	ctx := c.Request.Context()
+
 	// being transaction to update a firmware set and its references
 	tx, err := r.DB.BeginTx(ctx, nil)
 	if err != nil {
@@ -477,6 +521,10 @@ func (r *Router) firmwareSetUpdateTx(ctx context.Context, fwSetUpdate *models.Co
 		}
 	}
 
+	if err := r.audit(c, tx, entry); err != nil {
+		return err
+	}
+
 	// commit
 	return tx.Commit()
 }
@@ -585,7 +633,14 @@ func (r *Router) serverComponentFirmwareSetRemoveFirmware(c *gin.Context) {
 		removeMappings = append(removeMappings, setMap...)
 	}
 
-	err = r.firmwareSetDeleteMappingTx(c.Request.Context(), firmwareSet, removeMappings)
+	entry := auditEntry{
+		action:       AuditActionUpdate,
+		resourceType: AuditResourceServerComponentFirmwareSet,
+		resourceID:   firmwareSet.ID,
+		before:       removeMappings,
+	}
+
+	err = r.firmwareSetDeleteMappingTx(c, firmwareSet, removeMappings, entry)
 	if err != nil {
 		dbErrorResponse(c, err)
 		return
@@ -601,7 +656,39 @@ func (r *Router) serverComponentFirmwareSetDelete(c *gin.Context) {
 		return
 	}
 
-	if _, err = dbFirmware.Delete(c.Request.Context(), r.DB); err != nil {
+	err = r.auditedTx(c, func(tx boil.ContextExecutor) error {
+		ctx := c.Request.Context()
+
+		// the set's assignments are deleted along with it
+		assignments, err := dbFirmware.FirmwareSetFirmwareSetAssignments().All(ctx, tx)
+		if err != nil {
+			return err
+		}
+
+		serverIDs, err := assignmentServers(ctx, tx, assignments...)
+		if err != nil {
+			return err
+		}
+
+		firmwareSets, err := r.firmwareSetsBefore(ctx, tx, serverIDs)
+		if err != nil {
+			return err
+		}
+
+		if _, err := dbFirmware.Delete(ctx, tx); err != nil {
+			return err
+		}
+
+		return r.enqueueFirmwareSetChanges(ctx, tx, firmwareSets)
+	}, func() auditEntry {
+		return auditEntry{
+			action:       AuditActionDelete,
+			resourceType: AuditResourceServerComponentFirmwareSet,
+			resourceID:   dbFirmware.ID,
+			before:       dbFirmware,
+		}
+	})
+	if err != nil {
 		dbErrorResponse(c, err)
 		return
 	}
@@ -627,7 +714,9 @@ func (r *Router) componentFirmwareSetFromParams(c *gin.Context) (*models.Compone
 	return firmwareSet, nil
 }
 
-func (r *Router) firmwareSetDeleteMappingTx(ctx context.Context, _ *models.ComponentFirmwareSet, removeMappings []*models.ComponentFirmwareSetMap) error {
+func (r *Router) firmwareSetDeleteMappingTx(c *gin.Context, _ *models.ComponentFirmwareSet, removeMappings []*models.ComponentFirmwareSetMap, entry auditEntry) error {
+	ctx := c.Request.Context()
+
 	// being transaction to insert a new firmware set and its mapping
 	tx, err := r.DB.BeginTx(ctx, nil)
 	if err != nil {
@@ -638,10 +727,14 @@ func (r *Router) firmwareSetDeleteMappingTx(ctx context.Context, _ *models.Compo
 	defer tx.Rollback()
 
 	for _, mapping := range removeMappings {
-		if _, err := mapping.Delete(ctx, r.DB); err != nil {
+		if _, err := mapping.Delete(ctx, tx); err != nil {
 			return err
 		}
 	}
 
+	if err := r.audit(c, tx, entry); err != nil {
+		return err
+	}
+
 	return tx.Commit()
 }
@@ -0,0 +1,492 @@
+package serverservice
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+
+	"go.hollow.sh/serverservice/internal/models"
+)
+
+// firmwareSetResolveBatchSize is the number of servers whose effective
+// firmware set is resolved at once, it bounds the IN lists of the queries when
+// an assignment applies to a whole facility or the whole fleet.
+const firmwareSetResolveBatchSize = 500
+
+// batchIDs splits the IDs into batches of at most size IDs
+func batchIDs(ids []string, size int) [][]string {
+	batches := make([][]string, 0, (len(ids)+size-1)/size)
+
+	for len(ids) > size {
+		batches = append(batches, ids[:size])
+		ids = ids[size:]
+	}
+
+	if len(ids) > 0 {
+		batches = append(batches, ids)
+	}
+
+	return batches
+}
+
+// resolvedFirmwareSet is the assignment providing a server's effective
+// firmware set and where it comes from
+type resolvedFirmwareSet struct {
+	assignment *models.FirmwareSetAssignment
+	source     string
+}
+
+func (rs resolvedFirmwareSet) firmwareSetID() string {
+	if rs.assignment == nil {
+		return ""
+	}
+
+	return rs.assignment.FirmwareSetID
+}
+
+// effectiveFirmwareSets resolves the effective firmware set of each of the
+// servers, servers without one are left out. Callers with an unbounded number
+// of servers should use firmwareSetsBefore which resolves them in batches.
+func (r *Router) effectiveFirmwareSets(ctx context.Context, exec boil.ContextExecutor, servers models.ServerSlice) (map[string]resolvedFirmwareSet, error) {
+	resolved := map[string]resolvedFirmwareSet{}
+	if len(servers) == 0 {
+		return resolved, nil
+	}
+
+	ids := make([]interface{}, 0, len(servers))
+	for _, srv := range servers {
+		ids = append(ids, srv.ID)
+	}
+
+	assignments, err := models.FirmwareSetAssignments(
+		qm.Where(models.FirmwareSetAssignmentColumns.ServerID+" IS NULL"),
+		qm.Or2(qm.WhereIn(models.FirmwareSetAssignmentColumns.ServerID+" IN ?", ids...)),
+	).All(ctx, exec)
+	if err != nil {
+		return nil, err
+	}
+
+	resolver, err := newFirmwareSetResolver(assignments)
+	if err != nil {
+		return nil, err
+	}
+
+	dbAttrs, err := models.Attributes(
+		qm.WhereIn("server_id IN ?", ids...),
+		qm.Where("server_component_id IS NULL"),
+	).All(ctx, exec)
+	if err != nil {
+		return nil, err
+	}
+
+	attrs := map[string]map[string]json.RawMessage{}
+
+	for _, a := range dbAttrs {
+		if attrs[a.ServerID.String] == nil {
+			attrs[a.ServerID.String] = map[string]json.RawMessage{}
+		}
+
+		attrs[a.ServerID.String][a.Namespace] = json.RawMessage(a.Data)
+	}
+
+	for _, srv := range servers {
+		if a, source := resolver.resolve(srv, attrs[srv.ID]); a != nil {
+			resolved[srv.ID] = resolvedFirmwareSet{assignment: a, source: source}
+		}
+	}
+
+	return resolved, nil
+}
+
+// firmwareSetsBefore returns the effective firmware set ID of each server
+// before a change, to be handed to enqueueFirmwareSetChanges once the change
+// has been made in the same transaction.
+func (r *Router) firmwareSetsBefore(ctx context.Context, exec boil.ContextExecutor, serverIDs []string) (map[string]string, error) {
+	before := make(map[string]string, len(serverIDs))
+
+	for _, batch := range batchIDs(serverIDs, firmwareSetResolveBatchSize) {
+		servers, err := models.Servers(models.ServerWhere.ID.IN(batch)).All(ctx, exec)
+		if err != nil {
+			return nil, err
+		}
+
+		resolved, err := r.effectiveFirmwareSets(ctx, exec, servers)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, id := range batch {
+			before[id] = resolved[id].firmwareSetID()
+		}
+	}
+
+	return before, nil
+}
+
+// enqueueFirmwareSetChanges enqueues an event for each of the servers whose
+// effective firmware set differs from the one it had before.
+func (r *Router) enqueueFirmwareSetChanges(ctx context.Context, exec boil.ContextExecutor, before map[string]string) error {
+	ids := make([]string, 0, len(before))
+	for id := range before {
+		ids = append(ids, id)
+	}
+
+	after, err := r.firmwareSetsBefore(ctx, exec, ids)
+	if err != nil {
+		return err
+	}
+
+	for _, id := range ids {
+		if before[id] == after[id] {
+			continue
+		}
+
+		previous, current := before[id], after[id]
+
+		if err := r.enqueueEvent(ctx, exec, SubjectServerFirmwareSetUpdate, id, func() ([]byte, error) {
+			return NewServerFirmwareSetMessage(id, current, previous)
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// assignmentServers returns the IDs of the servers an assignment can apply
+// to, a selector assignment is narrowed down to the servers in its facility
+// that have attributes in each of its namespaces.
+func assignmentServers(ctx context.Context, exec boil.ContextExecutor, assignments ...*models.FirmwareSetAssignment) ([]string, error) {
+	ids := []string{}
+	seen := map[string]bool{}
+
+	add := func(id string) {
+		if !seen[id] {
+			seen[id] = true
+
+			ids = append(ids, id)
+		}
+	}
+
+	for _, a := range assignments {
+		if a.ServerID.Valid {
+			add(a.ServerID.String)
+			continue
+		}
+
+		mods := []qm.QueryMod{qm.Select(models.ServerColumns.ID)}
+
+		if a.FacilityCode.Valid {
+			mods = append(mods, models.ServerWhere.FacilityCode.EQ(a.FacilityCode))
+		}
+
+		if a.Attributes.Valid {
+			var selectors []FirmwareSetSelectorAttribute
+			if err := json.Unmarshal(a.Attributes.JSON, &selectors); err != nil {
+				return nil, err
+			}
+
+			for _, s := range selectors {
+				mods = append(mods, qm.Where("EXISTS (SELECT 1 FROM attributes WHERE attributes.server_id = servers.id AND attributes.server_component_id IS NULL AND attributes.namespace = ?)", s.Namespace))
+			}
+		}
+
+		servers, err := models.Servers(mods...).All(ctx, exec)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, srv := range servers {
+			add(srv.ID)
+		}
+	}
+
+	return ids, nil
+}
+
+func (r *Router) firmwareSetAssignmentCreate(c *gin.Context) {
+	firmwareSet, err := r.componentFirmwareSetFromParams(c)
+	if err != nil {
+		dbErrorResponse(c, err)
+		return
+	}
+
+	var assignment FirmwareSetAssignment
+	if err := c.ShouldBindJSON(&assignment); err != nil {
+		badRequestResponse(c, "invalid payload: FirmwareSetAssignment{}", err)
+		return
+	}
+
+	dbAssignment, err := assignment.toDBModel(firmwareSet.ID)
+	if err != nil {
+		badRequestResponse(c, "", err)
+		return
+	}
+
+	ctx := c.Request.Context()
+
+	if dbAssignment.ServerID.Valid {
+		exists, err := models.ServerExists(ctx, r.DB, dbAssignment.ServerID.String)
+		if err != nil {
+			dbErrorResponse(c, err)
+			return
+		}
+
+		if !exists {
+			badRequestResponse(c, "", errors.Wrap(errFirmwareSetAssignment, "server does not exist: "+dbAssignment.ServerID.String))
+			return
+		}
+	}
+
+	tx, err := r.DB.BeginTx(ctx, nil)
+	if err != nil {
+		dbErrorResponse(c, err)
+		return
+	}
+
+	// rollback is a no-op when the transaction is successful
+	// nolint:errcheck // TODO(joel): log error
+	defer tx.Rollback()
+
+	serverIDs, err := assignmentServers(ctx, tx, dbAssignment)
+	if err != nil {
+		dbErrorResponse(c, err)
+		return
+	}
+
+	before, err := r.firmwareSetsBefore(ctx, tx, serverIDs)
+	if err != nil {
+		dbErrorResponse(c, err)
+		return
+	}
+
+	// a server has a single direct assignment, a new one replaces it
+	if dbAssignment.ServerID.Valid {
+		if _, err := models.FirmwareSetAssignments(
+			models.FirmwareSetAssignmentWhere.ServerID.EQ(dbAssignment.ServerID),
+		).DeleteAll(ctx, tx); err != nil {
+			dbErrorResponse(c, err)
+			return
+		}
+	}
+
+	if err := dbAssignment.Insert(ctx, tx, boil.Infer()); err != nil {
+		dbErrorResponse(c, err)
+		return
+	}
+
+	if err := r.enqueueFirmwareSetChanges(ctx, tx, before); err != nil {
+		dbErrorResponse(c, err)
+		return
+	}
+
+	if err := r.audit(c, tx, auditEntry{
+		action:       AuditActionCreate,
+		resourceType: AuditResourceFirmwareSetAssignment,
+		resourceID:   dbAssignment.ID,
+		serverID:     dbAssignment.ServerID.String,
+		after:        dbAssignment,
+	}); err != nil {
+		dbErrorResponse(c, err)
+		return
+	}
+
+	if err := tx.Commit(); err != nil {
+		dbErrorResponse(c, err)
+		return
+	}
+
+	createdResponse(c, dbAssignment.ID)
+}
+
+func (r *Router) firmwareSetAssignmentList(c *gin.Context) {
+	firmwareSet, err := r.componentFirmwareSetFromParams(c)
+	if err != nil {
+		dbErrorResponse(c, err)
+		return
+	}
+
+	pager := parsePagination(c)
+
+	mods := []qm.QueryMod{models.FirmwareSetAssignmentWhere.FirmwareSetID.EQ(firmwareSet.ID)}
+
+	count, err := models.FirmwareSetAssignments(mods...).Count(c.Request.Context(), r.DB)
+	if err != nil {
+		dbErrorResponse(c, err)
+		return
+	}
+
+	pager.OrderBy = models.FirmwareSetAssignmentColumns.CreatedAt + " DESC"
+	mods = append(mods, pager.queryMods()...)
+
+	dbAssignments, err := models.FirmwareSetAssignments(mods...).All(c.Request.Context(), r.DB)
+	if err != nil {
+		dbErrorResponse(c, err)
+		return
+	}
+
+	assignments := []FirmwareSetAssignment{}
+
+	for _, dbA := range dbAssignments {
+		a := FirmwareSetAssignment{}
+		if err := a.fromDBModel(dbA); err != nil {
+			failedConvertingToVersioned(c, err)
+			return
+		}
+
+		assignments = append(assignments, a)
+	}
+
+	pd := paginationData{
+		pageCount:  len(assignments),
+		totalCount: count,
+		pager:      pager,
+	}
+
+	listResponse(c, assignments, pd)
+}
+
+func (r *Router) firmwareSetAssignmentDelete(c *gin.Context) {
+	firmwareSet, err := r.componentFirmwareSetFromParams(c)
+	if err != nil {
+		dbErrorResponse(c, err)
+		return
+	}
+
+	assignmentID, err := uuid.Parse(c.Param("assignment"))
+	if err != nil {
+		badRequestResponse(c, "invalid assignment UUID", err)
+		return
+	}
+
+	ctx := c.Request.Context()
+
+	tx, err := r.DB.BeginTx(ctx, nil)
+	if err != nil {
+		dbErrorResponse(c, err)
+		return
+	}
+
+	// rollback is a no-op when the transaction is successful
+	// nolint:errcheck // TODO(joel): log error
+	defer tx.Rollback()
+
+	dbAssignment, err := models.FirmwareSetAssignments(
+		models.FirmwareSetAssignmentWhere.ID.EQ(assignmentID.String()),
+		models.FirmwareSetAssignmentWhere.FirmwareSetID.EQ(firmwareSet.ID),
+	).One(ctx, tx)
+	if err != nil {
+		dbErrorResponse(c, err)
+		return
+	}
+
+	serverIDs, err := assignmentServers(ctx, tx, dbAssignment)
+	if err != nil {
+		dbErrorResponse(c, err)
+		return
+	}
+
+	before, err := r.firmwareSetsBefore(ctx, tx, serverIDs)
+	if err != nil {
+		dbErrorResponse(c, err)
+		return
+	}
+
+	if _, err := dbAssignment.Delete(ctx, tx); err != nil {
+		dbErrorResponse(c, err)
+		return
+	}
+
+	if err := r.enqueueFirmwareSetChanges(ctx, tx, before); err != nil {
+		dbErrorResponse(c, err)
+		return
+	}
+
+	if err := r.audit(c, tx, auditEntry{
+		action:       AuditActionDelete,
+		resourceType: AuditResourceFirmwareSetAssignment,
+		resourceID:   dbAssignment.ID,
+		serverID:     dbAssignment.ServerID.String,
+		before:       dbAssignment,
+	}); err != nil {
+		dbErrorResponse(c, err)
+		return
+	}
+
+	if err := tx.Commit(); err != nil {
+		dbErrorResponse(c, err)
+		return
+	}
+
+	deletedResponse(c)
+}
+
+// serverFirmwareSetGet returns the effective firmware set of the server
+func (r *Router) serverFirmwareSetGet(c *gin.Context) {
+	srv, err := r.loadServerFromParams(c)
+	if err != nil {
+		if errors.Is(err, ErrUUIDParse) {
+			badRequestResponse(c, "", err)
+			return
+		}
+
+		dbErrorResponse(c, err)
+
+		return
+	}
+
+	ctx := c.Request.Context()
+
+	resolved, err := r.effectiveFirmwareSets(ctx, r.DB, models.ServerSlice{srv})
+	if err != nil {
+		dbErrorResponse(c, err)
+		return
+	}
+
+	rs, ok := resolved[srv.ID]
+	if !ok {
+		dbErrorResponse(c, sql.ErrNoRows)
+		return
+	}
+
+	dbFirmwareSet, err := models.ComponentFirmwareSets(
+		models.ComponentFirmwareSetWhere.ID.EQ(rs.assignment.FirmwareSetID),
+		qm.Load(models.ComponentFirmwareSetRels.FirmwareSetAttributesFirmwareSets),
+	).One(ctx, r.DB)
+	if err != nil {
+		dbErrorResponse(c, err)
+		return
+	}
+
+	firmwares, err := r.queryFirmwareSetFirmware(ctx, dbFirmwareSet.ID)
+	if err != nil {
+		dbErrorResponse(c, err)
+		return
+	}
+
+	effective := EffectiveFirmwareSet{Source: rs.source}
+
+	effective.ServerUUID, err = uuid.Parse(srv.ID)
+	if err != nil {
+		failedConvertingToVersioned(c, err)
+		return
+	}
+
+	if err := effective.Assignment.fromDBModel(rs.assignment); err != nil {
+		failedConvertingToVersioned(c, err)
+		return
+	}
+
+	if err := effective.FirmwareSet.fromDBModel(dbFirmwareSet, firmwares); err != nil {
+		failedConvertingToVersioned(c, err)
+		return
+	}
+
+	itemResponse(c, effective)
+}
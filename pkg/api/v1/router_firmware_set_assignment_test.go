@@ -0,0 +1,97 @@
+package serverservice_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+
+	"go.hollow.sh/serverservice/internal/dbtools"
+	"go.hollow.sh/serverservice/internal/models"
+	serverservice "go.hollow.sh/serverservice/pkg/api/v1"
+)
+
+func TestIntegrationFirmwareSetAssignments(t *testing.T) {
+	s := serverTest(t)
+	s.Client.SetToken(validToken(adminScopes))
+
+	db := dbtools.DatabaseTest(t)
+	ctx := context.TODO()
+
+	srvID := uuid.MustParse(dbtools.FixtureNemo.ID)
+	r640 := uuid.MustParse(dbtools.FixtureFirmwareSetR640.ID)
+	r6515 := uuid.MustParse(dbtools.FixtureFirmwareSetR6515.ID)
+
+	_, _, err := s.Client.GetServerFirmwareSet(ctx, srvID)
+	assert.ErrorContains(t, err, "response code: 404")
+
+	realClientTests(t, func(ctx context.Context, authToken string, respCode int, expectError bool) error {
+		s.Client.SetToken(authToken)
+
+		_, _, err := s.Client.CreateFirmwareSetAssignment(ctx, r6515, serverservice.FirmwareSetAssignment{
+			FacilityCode: dbtools.FixtureNemo.FacilityCode.String,
+			Attributes: []serverservice.FirmwareSetSelectorAttribute{
+				{Namespace: dbtools.FixtureNamespaceMetadata, Keys: []string{"location"}, Value: "Fishbowl"},
+			},
+		})
+
+		return err
+	})
+
+	s.Client.SetToken(validToken(adminScopes))
+
+	effective, _, err := s.Client.GetServerFirmwareSet(ctx, srvID)
+	require.NoError(t, err)
+	assert.Equal(t, serverservice.FirmwareSetSourceSelector, effective.Source)
+	assert.Equal(t, r6515, effective.FirmwareSet.UUID)
+
+	// a direct assignment overrides the selector
+	directID, _, err := s.Client.CreateFirmwareSetAssignment(ctx, r640, serverservice.FirmwareSetAssignment{ServerUUID: &srvID})
+	require.NoError(t, err)
+
+	effective, _, err = s.Client.GetServerFirmwareSet(ctx, srvID)
+	require.NoError(t, err)
+	assert.Equal(t, serverservice.FirmwareSetSourceDirect, effective.Source)
+	assert.Equal(t, r640, effective.FirmwareSet.UUID)
+
+	assignments, _, err := s.Client.ListFirmwareSetAssignments(ctx, r640, nil)
+	require.NoError(t, err)
+	require.Len(t, assignments, 1)
+	assert.Equal(t, srvID, *assignments[0].ServerUUID)
+
+	_, err = s.Client.DeleteFirmwareSetAssignment(ctx, r640, *directID)
+	require.NoError(t, err)
+
+	evts, err := models.EventOutboxes(
+		models.EventOutboxWhere.Subject.EQ(serverservice.SubjectServerFirmwareSetUpdate),
+		models.EventOutboxWhere.PartitionKey.EQ(srvID.String()),
+		qm.OrderBy("seq ASC"),
+	).All(ctx, db)
+	require.NoError(t, err)
+	require.Len(t, evts, 3)
+
+	msg, err := serverservice.DeserializeServerFirmwareSet(evts[1].Payload)
+	require.NoError(t, err)
+	assert.Equal(t, r640.String(), msg.FirmwareSetID)
+	assert.Equal(t, r6515.String(), msg.PreviousFirmwareSetID)
+
+	msg, err = serverservice.DeserializeServerFirmwareSet(evts[2].Payload)
+	require.NoError(t, err)
+	assert.Equal(t, r6515.String(), msg.FirmwareSetID)
+}
+
+func TestIntegrationFirmwareSetAssignmentValidation(t *testing.T) {
+	s := serverTest(t)
+	s.Client.SetToken(validToken(adminScopes))
+
+	srvID := uuid.MustParse(dbtools.FixtureNemo.ID)
+
+	_, _, err := s.Client.CreateFirmwareSetAssignment(context.TODO(), uuid.MustParse(dbtools.FixtureFirmwareSetR640.ID), serverservice.FirmwareSetAssignment{
+		ServerUUID:   &srvID,
+		FacilityCode: "Sydney",
+	})
+	assert.ErrorContains(t, err, "response code: 400")
+}
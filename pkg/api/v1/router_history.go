@@ -0,0 +1,420 @@
+package serverservice
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+
+	"go.hollow.sh/serverservice/internal/models"
+)
+
+// historyChange describes a row that is being written. before is nil when the
+// row is new and after is nil when the row is removed.
+type historyChange struct {
+	serverID string
+	entity   string
+	entityID string
+	before   interface{}
+	beforeAt time.Time
+	after    interface{}
+}
+
+func serverHistory(before, after *models.Server) historyChange {
+	ch := historyChange{entity: HistoryEntityServer}
+
+	if before != nil {
+		ch.serverID, ch.entityID = before.ID, before.ID
+		ch.before, ch.beforeAt = before, lastWritten(before.CreatedAt, before.UpdatedAt)
+	}
+
+	if after != nil {
+		ch.serverID, ch.entityID = after.ID, after.ID
+		ch.after = after
+	}
+
+	return ch
+}
+
+func attributeHistory(serverID string, before, after *models.Attribute) historyChange {
+	ch := historyChange{serverID: serverID, entity: HistoryEntityAttributes}
+
+	if before != nil {
+		ch.entityID = before.ID
+		ch.before, ch.beforeAt = before, lastWritten(before.CreatedAt, before.UpdatedAt)
+	}
+
+	if after != nil {
+		ch.entityID = after.ID
+		ch.after = after
+	}
+
+	return ch
+}
+
+func componentHistory(serverID string, before, after *models.ServerComponent) historyChange {
+	ch := historyChange{serverID: serverID, entity: HistoryEntityServerComponent}
+
+	if before != nil {
+		ch.entityID = before.ID
+		ch.before, ch.beforeAt = before, lastWritten(before.CreatedAt, before.UpdatedAt)
+	}
+
+	if after != nil {
+		ch.entityID = after.ID
+		ch.after = after
+	}
+
+	return ch
+}
+
+func lastWritten(created, updated null.Time) time.Time {
+	if updated.Valid {
+		return updated.Time
+	}
+
+	return created.Time
+}
+
+// recordHistory stores a snapshot of each change when history is enabled.
+// The first time an entity changes the state it had before is stored too,
+// as of when it was last written, so that rows which predate history being
+// enabled can still be viewed as they were.
+func (r *Router) recordHistory(ctx context.Context, exec boil.ContextExecutor, changes ...historyChange) error {
+	if !r.RecordHistory {
+		return nil
+	}
+
+	for _, ch := range changes {
+		if ch.before != nil && !ch.beforeAt.IsZero() {
+			exists, err := models.ServerHistories(
+				models.ServerHistoryWhere.Entity.EQ(ch.entity),
+				models.ServerHistoryWhere.EntityID.EQ(ch.entityID),
+			).Exists(ctx, exec)
+			if err != nil {
+				return err
+			}
+
+			if !exists {
+				if err := insertHistory(ctx, exec, ch, ch.before, ch.beforeAt); err != nil {
+					return err
+				}
+			}
+		}
+
+		// a zero time lets the database stamp the record
+		if err := insertHistory(ctx, exec, ch, ch.after, time.Time{}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func insertHistory(ctx context.Context, exec boil.ContextExecutor, ch historyChange, snapshot interface{}, at time.Time) error {
+	h := &models.ServerHistory{
+		ServerID:   ch.serverID,
+		Entity:     ch.entity,
+		EntityID:   ch.entityID,
+		RecordedAt: at,
+	}
+
+	if snapshot != nil {
+		data, err := json.Marshal(snapshot)
+		if err != nil {
+			return err
+		}
+
+		h.Snapshot = null.JSONFrom(data)
+	}
+
+	return h.Insert(ctx, exec, boil.Infer())
+}
+
+type historyKey struct {
+	entity string
+	id     string
+}
+
+// historyAsOf is the recorded history of a server's entities at a point in
+// time, with the latest snapshot of each entity in the order they first
+// appeared.
+type historyAsOf struct {
+	at      time.Time
+	tracked map[historyKey]bool
+	order   []historyKey
+	latest  map[historyKey]*models.ServerHistory
+}
+
+func (r *Router) loadHistoryAsOf(ctx context.Context, id string, asOf time.Time) (*historyAsOf, error) {
+	tracked, err := models.ServerHistories(
+		qm.Select("DISTINCT "+models.ServerHistoryColumns.Entity+", "+models.ServerHistoryColumns.EntityID),
+		models.ServerHistoryWhere.ServerID.EQ(id),
+	).All(ctx, r.DB)
+	if err != nil {
+		return nil, err
+	}
+
+	records, err := models.ServerHistories(
+		models.ServerHistoryWhere.ServerID.EQ(id),
+		models.ServerHistoryWhere.RecordedAt.LTE(asOf),
+		qm.OrderBy(models.ServerHistoryColumns.RecordedAt+", "+models.ServerHistoryColumns.Seq),
+	).All(ctx, r.DB)
+	if err != nil {
+		return nil, err
+	}
+
+	h := &historyAsOf{
+		at:      asOf,
+		tracked: map[historyKey]bool{},
+		latest:  map[historyKey]*models.ServerHistory{},
+	}
+
+	for _, t := range tracked {
+		h.tracked[historyKey{t.Entity, t.EntityID}] = true
+	}
+
+	for _, rec := range records {
+		k := historyKey{rec.Entity, rec.EntityID}
+		if _, ok := h.latest[k]; !ok {
+			h.order = append(h.order, k)
+		}
+
+		h.latest[k] = rec
+	}
+
+	return h, nil
+}
+
+// existed reports whether a current row without recorded history should be
+// included, which is when it had already been created.
+func (h *historyAsOf) existed(entity, id string, created null.Time) bool {
+	return !h.tracked[historyKey{entity, id}] && !created.Time.After(h.at)
+}
+
+// snapshots unmarshals the latest snapshot of each entity of the given kind
+// that still existed, newModel returns the value to unmarshal into.
+func (h *historyAsOf) snapshots(entity string, newModel func() interface{}) error {
+	for _, k := range h.order {
+		rec := h.latest[k]
+		if k.entity != entity || !rec.Snapshot.Valid {
+			continue
+		}
+
+		if err := json.Unmarshal(rec.Snapshot.JSON, newModel()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// serverAsOf rebuilds a server with its attributes, latest versioned
+// attributes and components as they were at the given time. Entities with
+// recorded history are taken from their latest snapshot at that time, the
+// others from their current row when it already existed then.
+func (r *Router) serverAsOf(ctx context.Context, id string, asOf time.Time) (*models.Server, error) {
+	h, err := r.loadHistoryAsOf(ctx, id, asOf)
+	if err != nil {
+		return nil, err
+	}
+
+	srv, err := r.serverAsOfRow(ctx, id, h)
+	if err != nil {
+		return nil, err
+	}
+
+	attrs, err := r.attributesAsOf(ctx, id, h)
+	if err != nil {
+		return nil, err
+	}
+
+	components, err := r.componentsAsOf(ctx, id, h)
+	if err != nil {
+		return nil, err
+	}
+
+	versioned, err := models.VersionedAttributes(
+		qm.Where("server_id=?", id),
+		qm.Where("(namespace, created_at) IN (select namespace, max(created_at) from versioned_attributes where server_id=? and created_at <= ? group by namespace)", id, asOf),
+	).All(ctx, r.DB)
+	if err != nil {
+		return nil, err
+	}
+
+	srv.R = srv.R.NewStruct()
+	srv.R.Attributes = attrs
+	srv.R.ServerComponents = components
+	srv.R.VersionedAttributes = versioned
+
+	return srv, nil
+}
+
+func (r *Router) serverAsOfRow(ctx context.Context, id string, h *historyAsOf) (*models.Server, error) {
+	k := historyKey{HistoryEntityServer, id}
+
+	if h.tracked[k] {
+		rec, ok := h.latest[k]
+		if !ok || !rec.Snapshot.Valid {
+			return nil, sql.ErrNoRows
+		}
+
+		srv := &models.Server{}
+		if err := json.Unmarshal(rec.Snapshot.JSON, srv); err != nil {
+			return nil, err
+		}
+
+		return srv, nil
+	}
+
+	srv, err := models.Servers(models.ServerWhere.ID.EQ(id), qm.WithDeleted()).One(ctx, r.DB)
+	if err != nil {
+		return nil, err
+	}
+
+	if !h.existed(HistoryEntityServer, id, srv.CreatedAt) {
+		return nil, sql.ErrNoRows
+	}
+
+	if srv.DeletedAt.Valid && srv.DeletedAt.Time.After(h.at) {
+		srv.DeletedAt = null.Time{}
+	}
+
+	return srv, nil
+}
+
+func (r *Router) attributesAsOf(ctx context.Context, id string, h *historyAsOf) (models.AttributeSlice, error) {
+	current, err := models.Attributes(qm.Where("server_id=?", id)).All(ctx, r.DB)
+	if err != nil {
+		return nil, err
+	}
+
+	attrs := models.AttributeSlice{}
+
+	for _, a := range current {
+		if h.existed(HistoryEntityAttributes, a.ID, a.CreatedAt) {
+			attrs = append(attrs, a)
+		}
+	}
+
+	err = h.snapshots(HistoryEntityAttributes, func() interface{} {
+		a := &models.Attribute{}
+		attrs = append(attrs, a)
+
+		return a
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return attrs, nil
+}
+
+// componentsAsOf returns the server components, with their component type
+// loaded, as they were at the time of the history.
+func (r *Router) componentsAsOf(ctx context.Context, id string, h *historyAsOf) (models.ServerComponentSlice, error) {
+	current, err := models.ServerComponents(models.ServerComponentWhere.ServerID.EQ(id)).All(ctx, r.DB)
+	if err != nil {
+		return nil, err
+	}
+
+	components := models.ServerComponentSlice{}
+
+	for _, sc := range current {
+		if h.existed(HistoryEntityServerComponent, sc.ID, sc.CreatedAt) {
+			components = append(components, sc)
+		}
+	}
+
+	err = h.snapshots(HistoryEntityServerComponent, func() interface{} {
+		sc := &models.ServerComponent{}
+		components = append(components, sc)
+
+		return sc
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(components) == 0 {
+		return components, nil
+	}
+
+	typeIDs := []string{}
+	for _, sc := range components {
+		typeIDs = append(typeIDs, sc.ServerComponentTypeID)
+	}
+
+	types, err := models.ServerComponentTypes(models.ServerComponentTypeWhere.ID.IN(typeIDs)).All(ctx, r.DB)
+	if err != nil {
+		return nil, err
+	}
+
+	byID := map[string]*models.ServerComponentType{}
+	for _, t := range types {
+		byID[t.ID] = t
+	}
+
+	for _, sc := range components {
+		sc.R = sc.R.NewStruct()
+		sc.R.ServerComponentType = byID[sc.ServerComponentTypeID]
+	}
+
+	return components, nil
+}
+
+func (r *Router) serverHistoryList(c *gin.Context) {
+	// history outlives the server, so deleted servers are not looked up
+	u, err := r.parseUUID(c)
+	if err != nil {
+		return
+	}
+
+	pager := parsePagination(c)
+
+	mods := []qm.QueryMod{models.ServerHistoryWhere.ServerID.EQ(u.String())}
+
+	if entity := c.Query("entity"); entity != "" {
+		mods = append(mods, models.ServerHistoryWhere.Entity.EQ(entity))
+	}
+
+	count, err := models.ServerHistories(mods...).Count(c.Request.Context(), r.DB)
+	if err != nil {
+		dbErrorResponse(c, err)
+		return
+	}
+
+	pager.OrderBy = models.ServerHistoryColumns.RecordedAt + " DESC, " + models.ServerHistoryColumns.Seq + " DESC"
+	mods = append(mods, pager.queryMods()...)
+
+	dbRecords, err := models.ServerHistories(mods...).All(c.Request.Context(), r.DB)
+	if err != nil {
+		dbErrorResponse(c, err)
+		return
+	}
+
+	records := []ServerHistoryRecord{}
+
+	for _, dbH := range dbRecords {
+		h := ServerHistoryRecord{}
+		if err := h.fromDBModel(dbH); err != nil {
+			failedConvertingToVersioned(c, err)
+			return
+		}
+
+		records = append(records, h)
+	}
+
+	pd := paginationData{
+		pageCount:  len(records),
+		totalCount: count,
+		pager:      pager,
+	}
+
+	listResponse(c, records, pd)
+}
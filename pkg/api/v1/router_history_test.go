@@ -0,0 +1,96 @@
+package serverservice_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.hollow.sh/serverservice/internal/dbtools"
+	serverservice "go.hollow.sh/serverservice/pkg/api/v1"
+)
+
+func TestIntegrationServerGetAsOf(t *testing.T) {
+	s := serverTest(t)
+	s.Client.SetToken(validToken(adminScopes))
+
+	ctx := context.TODO()
+	srvID := uuid.MustParse(dbtools.FixtureDory.ID)
+
+	testFacility(t, s, "Reef")
+
+	asOf := time.Now()
+
+	_, err := s.Client.Update(ctx, srvID, serverservice.Server{Name: "The Forgetful Dory", FacilityCode: "Reef"})
+	require.NoError(t, err)
+
+	_, err = s.Client.UpdateAttributes(ctx, srvID, dbtools.FixtureNamespaceMetadata, json.RawMessage(`{"age":13}`))
+	require.NoError(t, err)
+
+	_, err = s.Client.DeleteAttributes(ctx, srvID, dbtools.FixtureNamespaceOtherdata)
+	require.NoError(t, err)
+
+	_, err = s.Client.DeleteServerComponents(ctx, srvID)
+	require.NoError(t, err)
+
+	realClientTests(t, func(ctx context.Context, authToken string, respCode int, expectError bool) error {
+		s.Client.SetToken(authToken)
+
+		srv, _, err := s.Client.GetAsOf(ctx, srvID, asOf)
+		if !expectError {
+			require.NoError(t, err)
+			assert.Equal(t, dbtools.FixtureDory.Name.String, srv.Name)
+			assert.Equal(t, dbtools.FixtureDory.FacilityCode.String, srv.FacilityCode)
+			assert.Len(t, srv.Components, 2)
+
+			require.Len(t, srv.Attributes, 2)
+
+			for _, a := range srv.Attributes {
+				if a.Namespace == dbtools.FixtureNamespaceMetadata {
+					assert.JSONEq(t, string(dbtools.FixtureDoryMetadata.Data), string(a.Data))
+				}
+			}
+		}
+
+		return err
+	})
+
+	srv, _, err := s.Client.Get(ctx, srvID)
+	require.NoError(t, err)
+	assert.Equal(t, "The Forgetful Dory", srv.Name)
+	assert.Len(t, srv.Attributes, 1)
+	assert.Len(t, srv.Components, 0)
+
+	// each changed entity has the state before its first change and the change itself
+	records, _, err := s.Client.ListServerHistory(ctx, srvID, nil)
+	require.NoError(t, err)
+	assert.Len(t, records, 10)
+	assert.Equal(t, serverservice.HistoryEntityServerComponent, records[0].Entity)
+	assert.Nil(t, records[0].Snapshot)
+}
+
+func TestIntegrationServerGetAsOfBeforeCreated(t *testing.T) {
+	s := serverTest(t)
+	s.Client.SetToken(validToken(adminScopes))
+
+	ctx := context.TODO()
+
+	testFacility(t, s, "int")
+
+	asOf := time.Now()
+
+	id, _, err := s.Client.Create(ctx, serverservice.Server{UUID: uuid.New(), Name: "new-server", FacilityCode: "int"})
+	require.NoError(t, err)
+
+	_, _, err = s.Client.GetAsOf(ctx, *id, asOf)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "response code: 404")
+
+	srv, _, err := s.Client.GetAsOf(ctx, *id, time.Now())
+	require.NoError(t, err)
+	assert.Equal(t, "new-server", srv.Name)
+}
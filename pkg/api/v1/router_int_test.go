@@ -24,6 +24,12 @@ type integrationServer struct {
 }
 
 func serverTest(t *testing.T) *integrationServer {
+	return serverTestWith(t, func(*httpsrv.Server) {})
+}
+
+// serverTestWith returns an integration server after opts changed its
+// configuration
+func serverTestWith(t *testing.T, opts func(*httpsrv.Server)) *integrationServer {
 	jwksURI := ginjwt.TestHelperJWKSProvider(ginjwt.TestPrivRSAKey1ID, ginjwt.TestPrivRSAKey2ID)
 
 	db := dbtools.DatabaseTest(t)
@@ -40,8 +46,12 @@ func serverTest(t *testing.T) *integrationServer {
 			JWKSURI:    jwksURI,
 			RolesClaim: "userPerms",
 		},
-		SecretsKeeper: dbtools.TestSecretKeeper(t),
+		Keyring:       dbtools.TestKeyring(t),
+		RecordHistory: true,
+		EventsEnabled: true,
 	}
+	opts(&hs)
+
 	s := hs.NewServer()
 
 	ts := &integrationServer{
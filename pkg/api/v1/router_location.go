@@ -0,0 +1,1246 @@
+package serverservice
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+
+	"go.hollow.sh/serverservice/internal/models"
+)
+
+// locationPageMods returns the query mods to load a page of the locations in
+// table, ordered by column
+func locationPageMods(pager *PaginationParams, table, column string) ([]qm.QueryMod, error) {
+	pager.Preload = false
+	pager.OrderBy = table + "." + column + " DESC"
+
+	mods := append([]qm.QueryMod{qm.Select(table + ".*")}, pager.serverQueryMods()...)
+
+	if !pager.keyset() {
+		return mods, nil
+	}
+
+	keysetMods, err := pager.keysetQueryMods(table, column, "STRING")
+	if err != nil {
+		return nil, err
+	}
+
+	return append(mods, keysetMods...), nil
+}
+
+func (r *Router) facilityList(c *gin.Context) {
+	pager := parsePagination(c)
+	ctx := c.Request.Context()
+
+	count, err := models.Facilities().Count(ctx, r.DB)
+	if err != nil {
+		dbErrorResponse(c, err)
+		return
+	}
+
+	mods, err := locationPageMods(&pager, models.TableNames.Facilities, models.FacilityColumns.Code)
+	if err != nil {
+		badRequestResponse(c, "", err)
+		return
+	}
+
+	dbFacilities, err := models.Facilities(mods...).All(ctx, r.DB)
+	if err != nil {
+		dbErrorResponse(c, err)
+		return
+	}
+
+	nextCursor := ""
+
+	if pager.keyset() && pager.hasNextKeysetPage(len(dbFacilities)) {
+		dbFacilities = dbFacilities[:pager.limitUsed()]
+		last := dbFacilities[len(dbFacilities)-1]
+		nextCursor = encodeCursor(last.Code, last.ID)
+	}
+
+	facilities := make([]Facility, 0, len(dbFacilities))
+
+	for _, dbF := range dbFacilities {
+		f := Facility{}
+		if err := f.fromDBModel(dbF); err != nil {
+			failedConvertingToVersioned(c, err)
+			return
+		}
+
+		facilities = append(facilities, f)
+	}
+
+	pd := paginationData{
+		pageCount:  len(facilities),
+		totalCount: count,
+		pager:      pager,
+		nextCursor: nextCursor,
+	}
+
+	listResponse(c, facilities, pd)
+}
+
+func (r *Router) facilityGet(c *gin.Context) {
+	dbF, err := r.loadFacilityFromParams(c)
+	if err != nil {
+		return
+	}
+
+	var f Facility
+	if err := f.fromDBModel(dbF); err != nil {
+		failedConvertingToVersioned(c, err)
+		return
+	}
+
+	itemResponse(c, f)
+}
+
+func (r *Router) facilityCreate(c *gin.Context) {
+	var f Facility
+	if err := c.ShouldBindJSON(&f); err != nil {
+		badRequestResponse(c, "invalid payload: Facility{}", err)
+		return
+	}
+
+	dbF := &models.Facility{
+		Code: f.Code,
+		Name: null.NewString(f.Name, f.Name != ""),
+	}
+
+	err := r.auditedTx(c, func(tx boil.ContextExecutor) error {
+		return dbF.Insert(c.Request.Context(), tx, boil.Infer())
+	}, func() auditEntry {
+		return auditEntry{
+			action:       AuditActionCreate,
+			resourceType: AuditResourceFacility,
+			resourceID:   dbF.ID,
+			after:        dbF,
+		}
+	})
+	if err != nil {
+		dbErrorResponse(c, err)
+		return
+	}
+
+	createdResponse(c, dbF.ID)
+}
+
+func (r *Router) facilityUpdate(c *gin.Context) {
+	dbF, err := r.loadFacilityFromParams(c)
+	if err != nil {
+		return
+	}
+
+	var newValues Facility
+	if err := c.ShouldBindJSON(&newValues); err != nil {
+		badRequestResponse(c, "invalid payload: Facility{}", err)
+		return
+	}
+
+	// servers refer to the facility by its code
+	if newValues.Code != dbF.Code {
+		badRequestResponse(c, "invalid payload: Facility{}", errors.Wrap(errLocation, "the facility code can't be changed"))
+		return
+	}
+
+	before := *dbF
+
+	dbF.Name = null.NewString(newValues.Name, newValues.Name != "")
+
+	err = r.auditedTx(c, func(tx boil.ContextExecutor) error {
+		_, err := dbF.Update(c.Request.Context(), tx, boil.Infer())
+		return err
+	}, func() auditEntry {
+		return auditEntry{
+			action:       AuditActionUpdate,
+			resourceType: AuditResourceFacility,
+			resourceID:   dbF.ID,
+			before:       &before,
+			after:        dbF,
+		}
+	})
+	if err != nil {
+		dbErrorResponse(c, err)
+		return
+	}
+
+	updatedResponse(c, dbF.ID)
+}
+
+func (r *Router) facilityDelete(c *gin.Context) {
+	dbF, err := r.loadFacilityFromParams(c)
+	if err != nil {
+		return
+	}
+
+	inUse, err := models.Rooms(models.RoomWhere.FacilityID.EQ(dbF.ID)).Exists(c.Request.Context(), r.DB)
+	if err != nil {
+		dbErrorResponse(c, err)
+		return
+	}
+
+	if inUse {
+		conflictResponse(c, "facility is in use", errors.Wrap(errLocation, "the facility "+dbF.Code+" has rooms"))
+		return
+	}
+
+	err = r.auditedTx(c, func(tx boil.ContextExecutor) error {
+		_, err := dbF.Delete(c.Request.Context(), tx)
+		return err
+	}, func() auditEntry {
+		return auditEntry{
+			action:       AuditActionDelete,
+			resourceType: AuditResourceFacility,
+			resourceID:   dbF.ID,
+			before:       dbF,
+		}
+	})
+	if err != nil {
+		dbErrorResponse(c, err)
+		return
+	}
+
+	deletedResponse(c)
+}
+
+func (r *Router) roomList(c *gin.Context) {
+	pager := parsePagination(c)
+	ctx := c.Request.Context()
+
+	var params LocationListParams
+	if err := c.ShouldBindQuery(&params); err != nil {
+		badRequestResponse(c, "invalid filter payload: LocationListParams{}", err)
+		return
+	}
+
+	mods := params.roomQueryMods()
+
+	count, err := models.Rooms(mods...).Count(ctx, r.DB)
+	if err != nil {
+		dbErrorResponse(c, err)
+		return
+	}
+
+	pageMods, err := locationPageMods(&pager, models.TableNames.Rooms, models.RoomColumns.Name)
+	if err != nil {
+		badRequestResponse(c, "", err)
+		return
+	}
+
+	dbRooms, err := models.Rooms(append(mods, pageMods...)...).All(ctx, r.DB)
+	if err != nil {
+		dbErrorResponse(c, err)
+		return
+	}
+
+	nextCursor := ""
+
+	if pager.keyset() && pager.hasNextKeysetPage(len(dbRooms)) {
+		dbRooms = dbRooms[:pager.limitUsed()]
+		last := dbRooms[len(dbRooms)-1]
+		nextCursor = encodeCursor(last.Name, last.ID)
+	}
+
+	rooms := make([]Room, 0, len(dbRooms))
+
+	for _, dbR := range dbRooms {
+		room := Room{}
+		if err := room.fromDBModel(dbR); err != nil {
+			failedConvertingToVersioned(c, err)
+			return
+		}
+
+		rooms = append(rooms, room)
+	}
+
+	pd := paginationData{
+		pageCount:  len(rooms),
+		totalCount: count,
+		pager:      pager,
+		nextCursor: nextCursor,
+	}
+
+	listResponse(c, rooms, pd)
+}
+
+func (r *Router) roomGet(c *gin.Context) {
+	dbR, err := r.loadRoomFromParams(c)
+	if err != nil {
+		return
+	}
+
+	var room Room
+	if err := room.fromDBModel(dbR); err != nil {
+		failedConvertingToVersioned(c, err)
+		return
+	}
+
+	itemResponse(c, room)
+}
+
+func (r *Router) roomCreate(c *gin.Context) {
+	var room Room
+	if err := c.ShouldBindJSON(&room); err != nil {
+		badRequestResponse(c, "invalid payload: Room{}", err)
+		return
+	}
+
+	if !r.locationParentExists(c, "facility", models.FacilityExists, room.FacilityUUID.String()) {
+		return
+	}
+
+	dbR := &models.Room{
+		FacilityID: room.FacilityUUID.String(),
+		Name:       room.Name,
+	}
+
+	err := r.auditedTx(c, func(tx boil.ContextExecutor) error {
+		return dbR.Insert(c.Request.Context(), tx, boil.Infer())
+	}, func() auditEntry {
+		return auditEntry{
+			action:       AuditActionCreate,
+			resourceType: AuditResourceRoom,
+			resourceID:   dbR.ID,
+			after:        dbR,
+		}
+	})
+	if err != nil {
+		dbErrorResponse(c, err)
+		return
+	}
+
+	createdResponse(c, dbR.ID)
+}
+
+func (r *Router) roomUpdate(c *gin.Context) {
+	dbR, err := r.loadRoomFromParams(c)
+	if err != nil {
+		return
+	}
+
+	var newValues Room
+	if err := c.ShouldBindJSON(&newValues); err != nil {
+		badRequestResponse(c, "invalid payload: Room{}", err)
+		return
+	}
+
+	if !r.locationParentExists(c, "facility", models.FacilityExists, newValues.FacilityUUID.String()) {
+		return
+	}
+
+	before := *dbR
+
+	dbR.FacilityID = newValues.FacilityUUID.String()
+	dbR.Name = newValues.Name
+
+	err = r.auditedTx(c, func(tx boil.ContextExecutor) error {
+		ctx := c.Request.Context()
+
+		if err := checkLocationMove(ctx, tx, before.FacilityID, dbR.FacilityID,
+			qm.Where("rack_id IN (SELECT racks.id FROM racks JOIN rack_rows ON rack_rows.id = racks.row_id WHERE rack_rows.room_id = ?)", dbR.ID),
+		); err != nil {
+			return err
+		}
+
+		_, err := dbR.Update(ctx, tx, boil.Infer())
+
+		return err
+	}, func() auditEntry {
+		return auditEntry{
+			action:       AuditActionUpdate,
+			resourceType: AuditResourceRoom,
+			resourceID:   dbR.ID,
+			before:       &before,
+			after:        dbR,
+		}
+	})
+	if err != nil {
+		locationUpdateErrorResponse(c, err)
+		return
+	}
+
+	updatedResponse(c, dbR.ID)
+}
+
+func (r *Router) roomDelete(c *gin.Context) {
+	dbR, err := r.loadRoomFromParams(c)
+	if err != nil {
+		return
+	}
+
+	inUse, err := models.RackRows(models.RackRowWhere.RoomID.EQ(dbR.ID)).Exists(c.Request.Context(), r.DB)
+	if err != nil {
+		dbErrorResponse(c, err)
+		return
+	}
+
+	if inUse {
+		conflictResponse(c, "room is in use", errors.Wrap(errLocation, "the room "+dbR.Name+" has rows"))
+		return
+	}
+
+	err = r.auditedTx(c, func(tx boil.ContextExecutor) error {
+		_, err := dbR.Delete(c.Request.Context(), tx)
+		return err
+	}, func() auditEntry {
+		return auditEntry{
+			action:       AuditActionDelete,
+			resourceType: AuditResourceRoom,
+			resourceID:   dbR.ID,
+			before:       dbR,
+		}
+	})
+	if err != nil {
+		dbErrorResponse(c, err)
+		return
+	}
+
+	deletedResponse(c)
+}
+
+func (r *Router) rowList(c *gin.Context) {
+	pager := parsePagination(c)
+	ctx := c.Request.Context()
+
+	var params LocationListParams
+	if err := c.ShouldBindQuery(&params); err != nil {
+		badRequestResponse(c, "invalid filter payload: LocationListParams{}", err)
+		return
+	}
+
+	mods := params.rowQueryMods()
+
+	count, err := models.RackRows(mods...).Count(ctx, r.DB)
+	if err != nil {
+		dbErrorResponse(c, err)
+		return
+	}
+
+	pageMods, err := locationPageMods(&pager, models.TableNames.RackRows, models.RackRowColumns.Name)
+	if err != nil {
+		badRequestResponse(c, "", err)
+		return
+	}
+
+	dbRows, err := models.RackRows(append(mods, pageMods...)...).All(ctx, r.DB)
+	if err != nil {
+		dbErrorResponse(c, err)
+		return
+	}
+
+	nextCursor := ""
+
+	if pager.keyset() && pager.hasNextKeysetPage(len(dbRows)) {
+		dbRows = dbRows[:pager.limitUsed()]
+		last := dbRows[len(dbRows)-1]
+		nextCursor = encodeCursor(last.Name, last.ID)
+	}
+
+	rows := make([]Row, 0, len(dbRows))
+
+	for _, dbR := range dbRows {
+		row := Row{}
+		if err := row.fromDBModel(dbR); err != nil {
+			failedConvertingToVersioned(c, err)
+			return
+		}
+
+		rows = append(rows, row)
+	}
+
+	pd := paginationData{
+		pageCount:  len(rows),
+		totalCount: count,
+		pager:      pager,
+		nextCursor: nextCursor,
+	}
+
+	listResponse(c, rows, pd)
+}
+
+func (r *Router) rowGet(c *gin.Context) {
+	dbR, err := r.loadRowFromParams(c)
+	if err != nil {
+		return
+	}
+
+	var row Row
+	if err := row.fromDBModel(dbR); err != nil {
+		failedConvertingToVersioned(c, err)
+		return
+	}
+
+	itemResponse(c, row)
+}
+
+func (r *Router) rowCreate(c *gin.Context) {
+	var row Row
+	if err := c.ShouldBindJSON(&row); err != nil {
+		badRequestResponse(c, "invalid payload: Row{}", err)
+		return
+	}
+
+	if !r.locationParentExists(c, "room", models.RoomExists, row.RoomUUID.String()) {
+		return
+	}
+
+	dbR := &models.RackRow{
+		RoomID: row.RoomUUID.String(),
+		Name:   row.Name,
+	}
+
+	err := r.auditedTx(c, func(tx boil.ContextExecutor) error {
+		return dbR.Insert(c.Request.Context(), tx, boil.Infer())
+	}, func() auditEntry {
+		return auditEntry{
+			action:       AuditActionCreate,
+			resourceType: AuditResourceRow,
+			resourceID:   dbR.ID,
+			after:        dbR,
+		}
+	})
+	if err != nil {
+		dbErrorResponse(c, err)
+		return
+	}
+
+	createdResponse(c, dbR.ID)
+}
+
+func (r *Router) rowUpdate(c *gin.Context) {
+	dbR, err := r.loadRowFromParams(c)
+	if err != nil {
+		return
+	}
+
+	var newValues Row
+	if err := c.ShouldBindJSON(&newValues); err != nil {
+		badRequestResponse(c, "invalid payload: Row{}", err)
+		return
+	}
+
+	if !r.locationParentExists(c, "room", models.RoomExists, newValues.RoomUUID.String()) {
+		return
+	}
+
+	before := *dbR
+
+	dbR.RoomID = newValues.RoomUUID.String()
+	dbR.Name = newValues.Name
+
+	err = r.auditedTx(c, func(tx boil.ContextExecutor) error {
+		ctx := c.Request.Context()
+
+		if before.RoomID != dbR.RoomID {
+			from, err := roomFacilityID(ctx, tx, before.RoomID)
+			if err != nil {
+				return err
+			}
+
+			to, err := roomFacilityID(ctx, tx, dbR.RoomID)
+			if err != nil {
+				return err
+			}
+
+			if err := checkLocationMove(ctx, tx, from, to,
+				qm.Where("rack_id IN (SELECT id FROM racks WHERE row_id = ?)", dbR.ID),
+			); err != nil {
+				return err
+			}
+		}
+
+		_, err := dbR.Update(ctx, tx, boil.Infer())
+
+		return err
+	}, func() auditEntry {
+		return auditEntry{
+			action:       AuditActionUpdate,
+			resourceType: AuditResourceRow,
+			resourceID:   dbR.ID,
+			before:       &before,
+			after:        dbR,
+		}
+	})
+	if err != nil {
+		locationUpdateErrorResponse(c, err)
+		return
+	}
+
+	updatedResponse(c, dbR.ID)
+}
+
+func (r *Router) rowDelete(c *gin.Context) {
+	dbR, err := r.loadRowFromParams(c)
+	if err != nil {
+		return
+	}
+
+	inUse, err := models.Racks(models.RackWhere.RowID.EQ(dbR.ID)).Exists(c.Request.Context(), r.DB)
+	if err != nil {
+		dbErrorResponse(c, err)
+		return
+	}
+
+	if inUse {
+		conflictResponse(c, "row is in use", errors.Wrap(errLocation, "the row "+dbR.Name+" has racks"))
+		return
+	}
+
+	err = r.auditedTx(c, func(tx boil.ContextExecutor) error {
+		_, err := dbR.Delete(c.Request.Context(), tx)
+		return err
+	}, func() auditEntry {
+		return auditEntry{
+			action:       AuditActionDelete,
+			resourceType: AuditResourceRow,
+			resourceID:   dbR.ID,
+			before:       dbR,
+		}
+	})
+	if err != nil {
+		dbErrorResponse(c, err)
+		return
+	}
+
+	deletedResponse(c)
+}
+
+func (r *Router) rackList(c *gin.Context) {
+	pager := parsePagination(c)
+	ctx := c.Request.Context()
+
+	var params LocationListParams
+	if err := c.ShouldBindQuery(&params); err != nil {
+		badRequestResponse(c, "invalid filter payload: LocationListParams{}", err)
+		return
+	}
+
+	mods := params.rackQueryMods()
+
+	count, err := models.Racks(mods...).Count(ctx, r.DB)
+	if err != nil {
+		dbErrorResponse(c, err)
+		return
+	}
+
+	pageMods, err := locationPageMods(&pager, models.TableNames.Racks, models.RackColumns.Name)
+	if err != nil {
+		badRequestResponse(c, "", err)
+		return
+	}
+
+	dbRacks, err := models.Racks(append(mods, pageMods...)...).All(ctx, r.DB)
+	if err != nil {
+		dbErrorResponse(c, err)
+		return
+	}
+
+	nextCursor := ""
+
+	if pager.keyset() && pager.hasNextKeysetPage(len(dbRacks)) {
+		dbRacks = dbRacks[:pager.limitUsed()]
+		last := dbRacks[len(dbRacks)-1]
+		nextCursor = encodeCursor(last.Name, last.ID)
+	}
+
+	racks := make([]Rack, 0, len(dbRacks))
+
+	for _, dbR := range dbRacks {
+		rack := Rack{}
+		if err := rack.fromDBModel(dbR); err != nil {
+			failedConvertingToVersioned(c, err)
+			return
+		}
+
+		racks = append(racks, rack)
+	}
+
+	pd := paginationData{
+		pageCount:  len(racks),
+		totalCount: count,
+		pager:      pager,
+		nextCursor: nextCursor,
+	}
+
+	listResponse(c, racks, pd)
+}
+
+func (r *Router) rackGet(c *gin.Context) {
+	dbR, err := r.loadRackFromParams(c)
+	if err != nil {
+		return
+	}
+
+	var rack Rack
+	if err := rack.fromDBModel(dbR); err != nil {
+		failedConvertingToVersioned(c, err)
+		return
+	}
+
+	itemResponse(c, rack)
+}
+
+func (r *Router) rackCreate(c *gin.Context) {
+	var rack Rack
+	if err := c.ShouldBindJSON(&rack); err != nil {
+		badRequestResponse(c, "invalid payload: Rack{}", err)
+		return
+	}
+
+	if !r.locationParentExists(c, "row", models.RackRowExists, rack.RowUUID.String()) {
+		return
+	}
+
+	if rack.HeightU == 0 {
+		rack.HeightU = defaultRackHeightU
+	}
+
+	dbR := &models.Rack{
+		RowID:   rack.RowUUID.String(),
+		Name:    rack.Name,
+		HeightU: int64(rack.HeightU),
+	}
+
+	err := r.auditedTx(c, func(tx boil.ContextExecutor) error {
+		return dbR.Insert(c.Request.Context(), tx, boil.Infer())
+	}, func() auditEntry {
+		return auditEntry{
+			action:       AuditActionCreate,
+			resourceType: AuditResourceRack,
+			resourceID:   dbR.ID,
+			after:        dbR,
+		}
+	})
+	if err != nil {
+		dbErrorResponse(c, err)
+		return
+	}
+
+	createdResponse(c, dbR.ID)
+}
+
+func (r *Router) rackUpdate(c *gin.Context) {
+	dbR, err := r.loadRackFromParams(c)
+	if err != nil {
+		return
+	}
+
+	var newValues Rack
+	if err := c.ShouldBindJSON(&newValues); err != nil {
+		badRequestResponse(c, "invalid payload: Rack{}", err)
+		return
+	}
+
+	if !r.locationParentExists(c, "row", models.RackRowExists, newValues.RowUUID.String()) {
+		return
+	}
+
+	if newValues.HeightU == 0 {
+		newValues.HeightU = int(dbR.HeightU)
+	}
+
+	before := *dbR
+
+	dbR.RowID = newValues.RowUUID.String()
+	dbR.Name = newValues.Name
+	dbR.HeightU = int64(newValues.HeightU)
+
+	err = r.auditedTx(c, func(tx boil.ContextExecutor) error {
+		ctx := c.Request.Context()
+
+		// servers above the new top of the rack have to be moved first
+		if _, err := models.Racks(models.RackWhere.ID.EQ(dbR.ID), qm.For("UPDATE")).One(ctx, tx); err != nil {
+			return err
+		}
+
+		overflow, err := models.ServerPlacements(
+			models.ServerPlacementWhere.RackID.EQ(dbR.ID),
+			qm.Where("position_u + height_u - 1 > ?", dbR.HeightU),
+		).Exists(ctx, tx)
+		if err != nil {
+			return err
+		}
+
+		if overflow {
+			return errors.Wrapf(errRackUnitTaken, "servers are placed above unit %d", dbR.HeightU)
+		}
+
+		if before.RowID != dbR.RowID {
+			from, err := rowFacilityID(ctx, tx, before.RowID)
+			if err != nil {
+				return err
+			}
+
+			to, err := rowFacilityID(ctx, tx, dbR.RowID)
+			if err != nil {
+				return err
+			}
+
+			if err := checkLocationMove(ctx, tx, from, to, models.ServerPlacementWhere.RackID.EQ(dbR.ID)); err != nil {
+				return err
+			}
+		}
+
+		_, err = dbR.Update(ctx, tx, boil.Infer())
+
+		return err
+	}, func() auditEntry {
+		return auditEntry{
+			action:       AuditActionUpdate,
+			resourceType: AuditResourceRack,
+			resourceID:   dbR.ID,
+			before:       &before,
+			after:        dbR,
+		}
+	})
+	if err != nil {
+		if errors.Is(err, errRackUnitTaken) {
+			conflictResponse(c, "rack is in use", err)
+			return
+		}
+
+		locationUpdateErrorResponse(c, err)
+
+		return
+	}
+
+	updatedResponse(c, dbR.ID)
+}
+
+func (r *Router) rackDelete(c *gin.Context) {
+	dbR, err := r.loadRackFromParams(c)
+	if err != nil {
+		return
+	}
+
+	inUse, err := models.ServerPlacements(models.ServerPlacementWhere.RackID.EQ(dbR.ID)).Exists(c.Request.Context(), r.DB)
+	if err != nil {
+		dbErrorResponse(c, err)
+		return
+	}
+
+	if inUse {
+		conflictResponse(c, "rack is in use", errors.Wrap(errLocation, "servers are placed in the rack "+dbR.Name))
+		return
+	}
+
+	err = r.auditedTx(c, func(tx boil.ContextExecutor) error {
+		_, err := dbR.Delete(c.Request.Context(), tx)
+		return err
+	}, func() auditEntry {
+		return auditEntry{
+			action:       AuditActionDelete,
+			resourceType: AuditResourceRack,
+			resourceID:   dbR.ID,
+			before:       dbR,
+		}
+	})
+	if err != nil {
+		dbErrorResponse(c, err)
+		return
+	}
+
+	deletedResponse(c)
+}
+
+// rackElevation returns the units of the rack from the top down and the
+// servers placed in them
+func (r *Router) rackElevation(c *gin.Context) {
+	dbR, err := r.loadRackFromParams(c)
+	if err != nil {
+		return
+	}
+
+	var rack Rack
+	if err := rack.fromDBModel(dbR); err != nil {
+		failedConvertingToVersioned(c, err)
+		return
+	}
+
+	dbPlacements, err := models.ServerPlacements(
+		models.ServerPlacementWhere.RackID.EQ(dbR.ID),
+		qm.Load(models.ServerPlacementRels.Server),
+	).All(c.Request.Context(), r.DB)
+	if err != nil {
+		dbErrorResponse(c, err)
+		return
+	}
+
+	placements := make([]ServerPlacement, 0, len(dbPlacements))
+
+	names := map[uuid.UUID]string{}
+
+	for _, dbP := range dbPlacements {
+		p := ServerPlacement{}
+		if err := p.fromDBModel(dbP); err != nil {
+			failedConvertingToVersioned(c, err)
+			return
+		}
+
+		if srv := dbP.R.GetServer(); srv != nil {
+			names[p.ServerUUID] = srv.Name.String
+		}
+
+		placements = append(placements, p)
+	}
+
+	itemResponse(c, newRackElevation(rack, placements, names))
+}
+
+func (r *Router) serverPlacementGet(c *gin.Context) {
+	srv, err := r.loadServerFromParams(c)
+	if err != nil {
+		if errors.Is(err, ErrUUIDParse) {
+			badRequestResponse(c, "", err)
+			return
+		}
+
+		dbErrorResponse(c, err)
+
+		return
+	}
+
+	dbP, err := models.ServerPlacements(
+		models.ServerPlacementWhere.ServerID.EQ(srv.ID),
+		qm.Load(models.ServerPlacementRels.Rack+".Row.Room.Facility"),
+	).One(c.Request.Context(), r.DB)
+	if err != nil {
+		dbErrorResponse(c, err)
+		return
+	}
+
+	var p ServerPlacement
+	if err := p.fromDBModel(dbP); err != nil {
+		failedConvertingToVersioned(c, err)
+		return
+	}
+
+	itemResponse(c, p)
+}
+
+// serverPlacementSet places the server in a rack or moves it. The rack is
+// locked while the units are checked so two servers can't be placed in the
+// same unit concurrently.
+func (r *Router) serverPlacementSet(c *gin.Context) {
+	srv, err := r.loadServerFromParams(c)
+	if err != nil {
+		if errors.Is(err, ErrUUIDParse) {
+			badRequestResponse(c, "", err)
+			return
+		}
+
+		dbErrorResponse(c, err)
+
+		return
+	}
+
+	var p ServerPlacement
+	if err := c.ShouldBindJSON(&p); err != nil {
+		badRequestResponse(c, "invalid payload: ServerPlacement{}", err)
+		return
+	}
+
+	if p.HeightU == 0 {
+		p.HeightU = 1
+	}
+
+	var before, dbP *models.ServerPlacement
+
+	err = r.auditedTx(c, func(tx boil.ContextExecutor) error {
+		ctx := c.Request.Context()
+
+		dbRack, err := models.Racks(
+			models.RackWhere.ID.EQ(p.RackUUID.String()),
+			qm.Load(models.RackRels.Row+".Room.Facility"),
+			qm.For("UPDATE"),
+		).One(ctx, tx)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return errors.Wrap(errPlacement, "rack not found")
+			}
+
+			return err
+		}
+
+		facilityCode := dbRack.R.Row.R.Room.R.Facility.Code
+		if srv.FacilityCode.String != facilityCode {
+			return errors.Wrapf(errPlacement, "the server is in facility %q, the rack in %q", srv.FacilityCode.String, facilityCode)
+		}
+
+		if p.topU() > int(dbRack.HeightU) {
+			return errors.Wrapf(errPlacement, "units %d-%d are outside of the rack's %d units", p.PositionU, p.topU(), dbRack.HeightU)
+		}
+
+		taken, err := models.ServerPlacements(
+			models.ServerPlacementWhere.RackID.EQ(dbRack.ID),
+			models.ServerPlacementWhere.ServerID.NEQ(srv.ID),
+			qm.Where("position_u <= ? AND position_u + height_u - 1 >= ?", p.topU(), p.PositionU),
+		).One(ctx, tx)
+		if err == nil {
+			return errors.Wrapf(errRackUnitTaken, "units %d-%d are taken by server %s", taken.PositionU, taken.PositionU+taken.HeightU-1, taken.ServerID)
+		}
+
+		if !errors.Is(err, sql.ErrNoRows) {
+			return err
+		}
+
+		dbP, err = models.ServerPlacements(models.ServerPlacementWhere.ServerID.EQ(srv.ID)).One(ctx, tx)
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			return err
+		}
+
+		if dbP == nil {
+			dbP = &models.ServerPlacement{ServerID: srv.ID}
+		} else {
+			b := *dbP
+			before = &b
+		}
+
+		dbP.RackID = dbRack.ID
+		dbP.PositionU = int64(p.PositionU)
+		dbP.HeightU = int64(p.HeightU)
+
+		if before == nil {
+			return dbP.Insert(ctx, tx, boil.Infer())
+		}
+
+		_, err = dbP.Update(ctx, tx, boil.Infer())
+
+		return err
+	}, func() auditEntry {
+		e := auditEntry{
+			action:       AuditActionCreate,
+			resourceType: AuditResourceServerPlacement,
+			resourceID:   srv.ID,
+			serverID:     srv.ID,
+			after:        dbP,
+		}
+
+		if before != nil {
+			e.action = AuditActionUpdate
+			e.before = before
+		}
+
+		return e
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, errRackUnitTaken):
+			conflictResponse(c, "rack units are taken", err)
+		case errors.Is(err, errPlacement):
+			badRequestResponse(c, "invalid payload: ServerPlacement{}", err)
+		default:
+			dbErrorResponse(c, err)
+		}
+
+		return
+	}
+
+	updatedResponse(c, srv.ID)
+}
+
+func (r *Router) serverPlacementDelete(c *gin.Context) {
+	srv, err := r.loadServerFromParams(c)
+	if err != nil {
+		if errors.Is(err, ErrUUIDParse) {
+			badRequestResponse(c, "", err)
+			return
+		}
+
+		dbErrorResponse(c, err)
+
+		return
+	}
+
+	dbP, err := models.ServerPlacements(models.ServerPlacementWhere.ServerID.EQ(srv.ID)).One(c.Request.Context(), r.DB)
+	if err != nil {
+		dbErrorResponse(c, err)
+		return
+	}
+
+	err = r.auditedTx(c, func(tx boil.ContextExecutor) error {
+		_, err := dbP.Delete(c.Request.Context(), tx)
+		return err
+	}, func() auditEntry {
+		return auditEntry{
+			action:       AuditActionDelete,
+			resourceType: AuditResourceServerPlacement,
+			resourceID:   srv.ID,
+			serverID:     srv.ID,
+			before:       dbP,
+		}
+	})
+	if err != nil {
+		dbErrorResponse(c, err)
+		return
+	}
+
+	deletedResponse(c)
+}
+
+// locationParentExists writes a bad request response and returns false when
+// the parent location with the ID doesn't exist
+func (r *Router) locationParentExists(c *gin.Context, kind string, exists func(context.Context, boil.ContextExecutor, string) (bool, error), id string) bool {
+	ok, err := exists(c.Request.Context(), r.DB, id)
+	if err != nil {
+		dbErrorResponse(c, err)
+		return false
+	}
+
+	if !ok {
+		badRequestResponse(c, "invalid location", errors.Wrap(errLocation, kind+" "+id+" not found"))
+		return false
+	}
+
+	return true
+}
+
+// locationUpdateErrorResponse writes the response of a location update that
+// failed with the error
+func locationUpdateErrorResponse(c *gin.Context, err error) {
+	if errors.Is(err, errLocationMove) {
+		conflictResponse(c, "location is in use", err)
+		return
+	}
+
+	dbErrorResponse(c, err)
+}
+
+// checkLocationMove returns errLocationMove when a location moves from one
+// facility to another while the placements matched by the query mod are in
+// it, the placed servers would otherwise be in another facility than their
+// rack
+func checkLocationMove(ctx context.Context, exec boil.ContextExecutor, fromFacilityID, toFacilityID string, placements qm.QueryMod) error {
+	if fromFacilityID == toFacilityID {
+		return nil
+	}
+
+	placed, err := models.ServerPlacements(placements).Exists(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	if placed {
+		return errors.Wrap(errLocationMove, "servers are placed in it, remove their placements first")
+	}
+
+	return nil
+}
+
+// roomFacilityID returns the ID of the facility the room is in
+func roomFacilityID(ctx context.Context, exec boil.ContextExecutor, roomID string) (string, error) {
+	room, err := models.FindRoom(ctx, exec, roomID, models.RoomColumns.FacilityID)
+	if err != nil {
+		return "", err
+	}
+
+	return room.FacilityID, nil
+}
+
+// rowFacilityID returns the ID of the facility the row is in
+func rowFacilityID(ctx context.Context, exec boil.ContextExecutor, rowID string) (string, error) {
+	row, err := models.FindRackRow(ctx, exec, rowID, models.RackRowColumns.RoomID)
+	if err != nil {
+		return "", err
+	}
+
+	return roomFacilityID(ctx, exec, row.RoomID)
+}
+
+// checkServerFacility returns errLocation when the facility code of a server
+// doesn't name a facility, a server doesn't need a facility code
+func checkServerFacility(ctx context.Context, exec boil.ContextExecutor, facilityCode string) error {
+	if facilityCode == "" {
+		return nil
+	}
+
+	ok, err := models.Facilities(models.FacilityWhere.Code.EQ(facilityCode)).Exists(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	if !ok {
+		return errors.Wrap(errLocation, "facility "+facilityCode+" not found")
+	}
+
+	return nil
+}
+
+func (r *Router) loadFacilityFromParams(c *gin.Context) (*models.Facility, error) {
+	u, err := r.parseUUID(c)
+	if err != nil {
+		return nil, err
+	}
+
+	dbF, err := models.FindFacility(c.Request.Context(), r.DB, u.String())
+	if err != nil {
+		dbErrorResponse(c, err)
+
+		return nil, err
+	}
+
+	return dbF, nil
+}
+
+func (r *Router) loadRoomFromParams(c *gin.Context) (*models.Room, error) {
+	u, err := r.parseUUID(c)
+	if err != nil {
+		return nil, err
+	}
+
+	dbR, err := models.FindRoom(c.Request.Context(), r.DB, u.String())
+	if err != nil {
+		dbErrorResponse(c, err)
+
+		return nil, err
+	}
+
+	return dbR, nil
+}
+
+func (r *Router) loadRowFromParams(c *gin.Context) (*models.RackRow, error) {
+	u, err := r.parseUUID(c)
+	if err != nil {
+		return nil, err
+	}
+
+	dbR, err := models.FindRackRow(c.Request.Context(), r.DB, u.String())
+	if err != nil {
+		dbErrorResponse(c, err)
+
+		return nil, err
+	}
+
+	return dbR, nil
+}
+
+func (r *Router) loadRackFromParams(c *gin.Context) (*models.Rack, error) {
+	u, err := r.parseUUID(c)
+	if err != nil {
+		return nil, err
+	}
+
+	dbR, err := models.FindRack(c.Request.Context(), r.DB, u.String())
+	if err != nil {
+		dbErrorResponse(c, err)
+
+		return nil, err
+	}
+
+	return dbR, nil
+}
+
+// serverPlacedOutside returns true when the server is placed in a rack of a
+// facility other than the one with the code
+func serverPlacedOutside(ctx context.Context, exec boil.ContextExecutor, serverID, facilityCode string) (bool, error) {
+	return models.ServerPlacements(
+		models.ServerPlacementWhere.ServerID.EQ(serverID),
+		qm.Where("rack_id NOT IN (SELECT racks.id FROM racks JOIN rack_rows ON rack_rows.id = racks.row_id JOIN rooms ON rooms.id = rack_rows.room_id JOIN facilities ON facilities.id = rooms.facility_id WHERE facilities.code = ?)", facilityCode),
+	).Exists(ctx, exec)
+}
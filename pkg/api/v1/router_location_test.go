@@ -0,0 +1,279 @@
+package serverservice_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.hollow.sh/serverservice/internal/dbtools"
+	serverservice "go.hollow.sh/serverservice/pkg/api/v1"
+)
+
+// testFacility returns the ID of the facility with the code, the facility is
+// created unless it's one of the fixtures
+func testFacility(t *testing.T, s *integrationServer, facilityCode string) uuid.UUID {
+	t.Helper()
+
+	ctx := context.TODO()
+
+	facilities, _, err := s.Client.ListFacilities(ctx, nil)
+	require.NoError(t, err)
+
+	for _, f := range facilities {
+		if f.Code == facilityCode {
+			return f.UUID
+		}
+	}
+
+	facilityID, _, err := s.Client.CreateFacility(ctx, serverservice.Facility{Code: facilityCode, Name: facilityCode + " data center"})
+	require.NoError(t, err)
+
+	return *facilityID
+}
+
+// createTestRack creates a room, row and rack in the facility with the code,
+// and returns the rack
+func createTestRack(t *testing.T, s *integrationServer, facilityCode string, heightU int) serverservice.Rack {
+	t.Helper()
+
+	ctx := context.TODO()
+
+	roomID, _, err := s.Client.CreateRoom(ctx, serverservice.Room{FacilityUUID: testFacility(t, s, facilityCode), Name: "hall-1"})
+	require.NoError(t, err)
+
+	rowID, _, err := s.Client.CreateRow(ctx, serverservice.Row{RoomUUID: *roomID, Name: "a"})
+	require.NoError(t, err)
+
+	rackID, _, err := s.Client.CreateRack(ctx, serverservice.Rack{RowUUID: *rowID, Name: "a01", HeightU: heightU})
+	require.NoError(t, err)
+
+	rack, _, err := s.Client.GetRack(ctx, *rackID)
+	require.NoError(t, err)
+
+	return *rack
+}
+
+func TestIntegrationLocationCRUD(t *testing.T) {
+	s := serverTest(t)
+	s.Client.SetToken(validToken(adminScopes))
+
+	realClientTests(t, func(ctx context.Context, authToken string, respCode int, expectError bool) error {
+		s.Client.SetToken(authToken)
+
+		_, _, err := s.Client.ListRacks(ctx, nil)
+
+		return err
+	})
+
+	s.Client.SetToken(validToken(adminScopes))
+
+	ctx := context.TODO()
+
+	rack := createTestRack(t, s, "Ocean", 0)
+	assert.Equal(t, 42, rack.HeightU)
+
+	row, _, err := s.Client.GetRow(ctx, rack.RowUUID)
+	require.NoError(t, err)
+
+	room, _, err := s.Client.GetRoom(ctx, row.RoomUUID)
+	require.NoError(t, err)
+
+	facility, _, err := s.Client.GetFacility(ctx, room.FacilityUUID)
+	require.NoError(t, err)
+	assert.Equal(t, "Ocean", facility.Code)
+
+	racks, _, err := s.Client.ListRacks(ctx, &serverservice.LocationListParams{FacilityCode: "Ocean"})
+	require.NoError(t, err)
+	assert.Len(t, racks, 1)
+
+	racks, _, err = s.Client.ListRacks(ctx, &serverservice.LocationListParams{FacilityCode: "Sydney"})
+	require.NoError(t, err)
+	assert.Len(t, racks, 0)
+
+	_, _, err = s.Client.CreateRoom(ctx, serverservice.Room{FacilityUUID: uuid.New(), Name: "hall-2"})
+	assert.ErrorContains(t, err, "facility")
+	assert.ErrorContains(t, err, "not found")
+
+	_, _, err = s.Client.CreateRoom(ctx, serverservice.Room{FacilityUUID: facility.UUID, Name: "hall-1"})
+	assert.ErrorContains(t, err, "duplicate key")
+
+	facility.Code = "Sea"
+	_, err = s.Client.UpdateFacility(ctx, facility.UUID, *facility)
+	assert.ErrorContains(t, err, "the facility code can't be changed")
+
+	rack.Name = "a02"
+	_, err = s.Client.UpdateRack(ctx, rack.UUID, rack)
+	require.NoError(t, err)
+
+	// parents can't be deleted before their children
+	_, err = s.Client.DeleteRow(ctx, row.UUID)
+	assert.ErrorContains(t, err, "409")
+
+	_, err = s.Client.DeleteRack(ctx, rack.UUID)
+	require.NoError(t, err)
+
+	_, err = s.Client.DeleteRow(ctx, row.UUID)
+	require.NoError(t, err)
+
+	_, err = s.Client.DeleteRoom(ctx, room.UUID)
+	require.NoError(t, err)
+
+	_, err = s.Client.DeleteFacility(ctx, facility.UUID)
+	require.NoError(t, err)
+}
+
+func TestIntegrationServerPlacement(t *testing.T) {
+	s := serverTest(t)
+	s.Client.SetToken(validToken(adminScopes))
+
+	ctx := context.TODO()
+
+	rack := createTestRack(t, s, "Ocean", 10)
+	dory := uuid.MustParse(dbtools.FixtureDory.ID)
+	marlin := uuid.MustParse(dbtools.FixtureMarlin.ID)
+	nemo := uuid.MustParse(dbtools.FixtureNemo.ID)
+
+	realClientTests(t, func(ctx context.Context, authToken string, respCode int, expectError bool) error {
+		s.Client.SetToken(authToken)
+
+		_, err := s.Client.SetServerPlacement(ctx, dory, serverservice.ServerPlacement{RackUUID: rack.UUID, PositionU: 1, HeightU: 2})
+
+		return err
+	})
+
+	s.Client.SetToken(validToken(adminScopes))
+
+	p, _, err := s.Client.GetServerPlacement(ctx, dory)
+	require.NoError(t, err)
+	assert.Equal(t, 1, p.PositionU)
+	assert.Equal(t, 2, p.HeightU)
+	assert.Equal(t, "Ocean", p.FacilityCode)
+	assert.Equal(t, "hall-1", p.RoomName)
+	assert.Equal(t, "a", p.RowName)
+	assert.Equal(t, "a01", p.RackName)
+
+	var testCases = []struct {
+		testName     string
+		server       uuid.UUID
+		placement    serverservice.ServerPlacement
+		errorMessage string
+	}{
+		{
+			"overlapping units are taken",
+			marlin,
+			serverservice.ServerPlacement{RackUUID: rack.UUID, PositionU: 2},
+			"units 1-2 are taken by server " + dory.String(),
+		},
+		{
+			"units above the rack",
+			marlin,
+			serverservice.ServerPlacement{RackUUID: rack.UUID, PositionU: 10, HeightU: 2},
+			"units 10-11 are outside of the rack's 10 units",
+		},
+		{
+			"server in another facility",
+			nemo,
+			serverservice.ServerPlacement{RackUUID: rack.UUID, PositionU: 5},
+			`the server is in facility "Sydney", the rack in "Ocean"`,
+		},
+		{
+			"unknown rack",
+			marlin,
+			serverservice.ServerPlacement{RackUUID: uuid.New(), PositionU: 5},
+			"rack not found",
+		},
+		{
+			"adjacent units",
+			marlin,
+			serverservice.ServerPlacement{RackUUID: rack.UUID, PositionU: 3, HeightU: 2},
+			"",
+		},
+		{
+			"moving a server within its own units",
+			dory,
+			serverservice.ServerPlacement{RackUUID: rack.UUID, PositionU: 2, HeightU: 1},
+			"",
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.testName, func(t *testing.T) {
+			_, err := s.Client.SetServerPlacement(ctx, tt.server, tt.placement)
+			if tt.errorMessage != "" {
+				assert.ErrorContains(t, err, tt.errorMessage)
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+
+	elevation, _, err := s.Client.GetRackElevation(ctx, rack.UUID)
+	require.NoError(t, err)
+	require.Len(t, elevation.Units, 10)
+
+	occupied := map[int]string{}
+
+	for _, u := range elevation.Units {
+		if u.ServerUUID != nil {
+			occupied[u.Position] = u.ServerName
+		}
+	}
+
+	assert.Equal(t, map[int]string{2: "Dory", 3: "Marlin", 4: "Marlin"}, occupied)
+
+	// the rack can't be lowered below the servers in it
+	rack.HeightU = 3
+	_, err = s.Client.UpdateRack(ctx, rack.UUID, rack)
+	assert.ErrorContains(t, err, "servers are placed above unit 3")
+
+	srvs, _, err := s.Client.List(ctx, &serverservice.ServerListParams{RackUUID: rack.UUID.String()})
+	require.NoError(t, err)
+	assert.Len(t, srvs, 2)
+
+	srvs, _, err = s.Client.List(ctx, &serverservice.ServerListParams{RowUUID: rack.RowUUID.String()})
+	require.NoError(t, err)
+	assert.Len(t, srvs, 2)
+
+	srvs, _, err = s.Client.List(ctx, &serverservice.ServerListParams{RoomUUID: uuid.NewString()})
+	require.NoError(t, err)
+	assert.Len(t, srvs, 0)
+
+	// neither can the locations servers are placed in
+	sydneyRack := createTestRack(t, s, "Sydney", 10)
+
+	moved := rack
+	moved.RowUUID = sydneyRack.RowUUID
+	_, err = s.Client.UpdateRack(ctx, rack.UUID, moved)
+	assert.ErrorContains(t, err, "remove their placements first")
+
+	row, _, err := s.Client.GetRow(ctx, rack.RowUUID)
+	require.NoError(t, err)
+
+	room, _, err := s.Client.GetRoom(ctx, row.RoomUUID)
+	require.NoError(t, err)
+
+	room.FacilityUUID = uuid.MustParse(dbtools.FixtureFacilitySydney.ID)
+	_, err = s.Client.UpdateRoom(ctx, room.UUID, *room)
+	assert.ErrorContains(t, err, "remove their placements first")
+
+	// a placed server can't move to another facility
+	_, err = s.Client.Update(ctx, marlin, serverservice.Server{Name: "Marlin", FacilityCode: "Sydney"})
+	assert.ErrorContains(t, err, "remove the server's placement")
+
+	_, err = s.Client.DeleteServerPlacement(ctx, marlin)
+	require.NoError(t, err)
+
+	_, _, err = s.Client.GetServerPlacement(ctx, marlin)
+	assert.ErrorContains(t, err, "no rows in result set")
+
+	// deleting a server frees its units
+	_, err = s.Client.Delete(ctx, serverservice.Server{UUID: dory})
+	require.NoError(t, err)
+
+	_, err = s.Client.DeleteRack(ctx, rack.UUID)
+	require.NoError(t, err)
+}
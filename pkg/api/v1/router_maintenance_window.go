@@ -0,0 +1,462 @@
+package serverservice
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+
+	"go.hollow.sh/serverservice/internal/models"
+)
+
+func (r *Router) maintenanceWindowList(c *gin.Context) {
+	pager := parsePagination(c)
+
+	var params MaintenanceWindowListParams
+	if err := c.ShouldBindQuery(&params); err != nil {
+		badRequestResponse(c, "invalid filter", err)
+		return
+	}
+
+	ctx := c.Request.Context()
+	mods := params.queryMods()
+
+	count, err := models.MaintenanceWindows(mods...).Count(ctx, r.DB)
+	if err != nil {
+		dbErrorResponse(c, err)
+		return
+	}
+
+	// add pagination
+	pager.Preload = false
+	pager.OrderBy = models.MaintenanceWindowTableColumns.StartsAt + " DESC"
+	mods = append(mods, qm.Load(models.MaintenanceWindowRels.MaintenanceWindowServers))
+	mods = append(mods, pager.serverQueryMods()...)
+
+	if pager.keyset() {
+		keysetMods, err := pager.keysetQueryMods(models.TableNames.MaintenanceWindows, models.MaintenanceWindowColumns.StartsAt, "TIMESTAMPTZ")
+		if err != nil {
+			badRequestResponse(c, "", err)
+			return
+		}
+
+		mods = append(mods, keysetMods...)
+	}
+
+	dbWindows, err := models.MaintenanceWindows(mods...).All(ctx, r.DB)
+	if err != nil {
+		dbErrorResponse(c, err)
+		return
+	}
+
+	nextCursor := ""
+
+	if pager.keyset() && pager.hasNextKeysetPage(len(dbWindows)) {
+		dbWindows = dbWindows[:pager.limitUsed()]
+		last := dbWindows[len(dbWindows)-1]
+		nextCursor = encodeTimeCursor(last.StartsAt, last.ID)
+	}
+
+	windows := make([]MaintenanceWindow, 0, len(dbWindows))
+
+	for _, dbW := range dbWindows {
+		w := MaintenanceWindow{}
+		if err := w.fromDBModel(dbW); err != nil {
+			failedConvertingToVersioned(c, err)
+			return
+		}
+
+		windows = append(windows, w)
+	}
+
+	pd := paginationData{
+		pageCount:  len(windows),
+		totalCount: count,
+		pager:      pager,
+		nextCursor: nextCursor,
+	}
+
+	listResponse(c, windows, pd)
+}
+
+func (r *Router) maintenanceWindowGet(c *gin.Context) {
+	dbW, err := r.loadMaintenanceWindowFromParams(c, r.DB)
+	if err != nil {
+		return
+	}
+
+	var w MaintenanceWindow
+	if err := w.fromDBModel(dbW); err != nil {
+		failedConvertingToVersioned(c, err)
+		return
+	}
+
+	itemResponse(c, w)
+}
+
+func (r *Router) maintenanceWindowCreate(c *gin.Context) {
+	var w MaintenanceWindow
+	if err := c.ShouldBindJSON(&w); err != nil {
+		badRequestResponse(c, "invalid payload: MaintenanceWindow{}", err)
+		return
+	}
+
+	dbW, serverIDs, err := w.toDBModel()
+	if err != nil {
+		badRequestResponse(c, "invalid payload: MaintenanceWindow{}", err)
+		return
+	}
+
+	if !dbW.EndsAt.After(time.Now()) {
+		badRequestResponse(c, "invalid payload: MaintenanceWindow{}", errors.Wrap(errMaintenanceWindow, "the window already ended"))
+		return
+	}
+
+	if !r.maintenanceWindowServersExist(c, serverIDs) {
+		return
+	}
+
+	ctx := c.Request.Context()
+
+	err = r.auditedTx(c, func(tx boil.ContextExecutor) error {
+		if err := dbW.Insert(ctx, tx, boil.Infer()); err != nil {
+			return err
+		}
+
+		return setMaintenanceWindowServers(ctx, tx, dbW, serverIDs)
+	}, func() auditEntry {
+		return auditEntry{
+			action:       AuditActionCreate,
+			resourceType: AuditResourceMaintenanceWindow,
+			resourceID:   dbW.ID,
+			after:        maintenanceWindowAudit(dbW),
+		}
+	})
+	if err != nil {
+		dbErrorResponse(c, err)
+		return
+	}
+
+	createdResponse(c, dbW.ID)
+}
+
+// maintenanceWindowUpdate replaces the window, once it started only its reason
+// and end can be changed. The window is locked so the events for its start and
+// end aren't enqueued while it changes.
+func (r *Router) maintenanceWindowUpdate(c *gin.Context) {
+	var w MaintenanceWindow
+	if err := c.ShouldBindJSON(&w); err != nil {
+		badRequestResponse(c, "invalid payload: MaintenanceWindow{}", err)
+		return
+	}
+
+	update, serverIDs, err := w.toDBModel()
+	if err != nil {
+		badRequestResponse(c, "invalid payload: MaintenanceWindow{}", err)
+		return
+	}
+
+	ctx := c.Request.Context()
+
+	tx, err := r.DB.BeginTx(ctx, nil)
+	if err != nil {
+		dbErrorResponse(c, err)
+		return
+	}
+
+	// rollback is a no-op when the transaction is successful
+	// nolint:errcheck // TODO(joel): log error
+	defer tx.Rollback()
+
+	dbW, err := r.loadMaintenanceWindowFromParams(c, tx, qm.For("UPDATE"))
+	if err != nil {
+		return
+	}
+
+	if err := checkMaintenanceWindowUpdate(dbW, update, serverIDs); err != nil {
+		conflictResponse(c, "unable to update maintenance window", err)
+		return
+	}
+
+	if !dbW.StartedEventAt.Valid && !update.EndsAt.After(time.Now()) {
+		badRequestResponse(c, "invalid payload: MaintenanceWindow{}", errors.Wrap(errMaintenanceWindow, "the window already ended"))
+		return
+	}
+
+	if !r.maintenanceWindowServersExist(c, serverIDs) {
+		return
+	}
+
+	before := maintenanceWindowAudit(dbW)
+
+	dbW.Reason = update.Reason
+	dbW.StartsAt = update.StartsAt
+	dbW.EndsAt = update.EndsAt
+	dbW.FacilityCode = update.FacilityCode
+	dbW.Attributes = update.Attributes
+
+	if _, err := dbW.Update(ctx, tx, boil.Blacklist(
+		models.MaintenanceWindowColumns.StartedEventAt,
+		models.MaintenanceWindowColumns.EndedEventAt,
+	)); err != nil {
+		dbErrorResponse(c, err)
+		return
+	}
+
+	if err := setMaintenanceWindowServers(ctx, tx, dbW, serverIDs); err != nil {
+		dbErrorResponse(c, err)
+		return
+	}
+
+	if err := r.audit(c, tx, auditEntry{
+		action:       AuditActionUpdate,
+		resourceType: AuditResourceMaintenanceWindow,
+		resourceID:   dbW.ID,
+		before:       before,
+		after:        maintenanceWindowAudit(dbW),
+	}); err != nil {
+		dbErrorResponse(c, err)
+		return
+	}
+
+	if err := tx.Commit(); err != nil {
+		dbErrorResponse(c, err)
+		return
+	}
+
+	updatedResponse(c, dbW.ID)
+}
+
+// maintenanceWindowDelete removes the window, the end of a window that started
+// and hasn't ended yet is sent right away
+func (r *Router) maintenanceWindowDelete(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	tx, err := r.DB.BeginTx(ctx, nil)
+	if err != nil {
+		dbErrorResponse(c, err)
+		return
+	}
+
+	// rollback is a no-op when the transaction is successful
+	// nolint:errcheck // TODO(joel): log error
+	defer tx.Rollback()
+
+	dbW, err := r.loadMaintenanceWindowFromParams(c, tx, qm.For("UPDATE"))
+	if err != nil {
+		return
+	}
+
+	if dbW.StartedEventAt.Valid && !dbW.EndedEventAt.Valid {
+		if err := r.enqueueEvent(ctx, tx, SubjectMaintenanceWindowEnd, dbW.ID, func() ([]byte, error) {
+			return NewMaintenanceWindowMessage(dbW)
+		}); err != nil {
+			dbErrorResponse(c, err)
+			return
+		}
+	}
+
+	if _, err := dbW.Delete(ctx, tx); err != nil {
+		dbErrorResponse(c, err)
+		return
+	}
+
+	if err := r.audit(c, tx, auditEntry{
+		action:       AuditActionDelete,
+		resourceType: AuditResourceMaintenanceWindow,
+		resourceID:   dbW.ID,
+		before:       maintenanceWindowAudit(dbW),
+	}); err != nil {
+		dbErrorResponse(c, err)
+		return
+	}
+
+	if err := tx.Commit(); err != nil {
+		dbErrorResponse(c, err)
+		return
+	}
+
+	deletedResponse(c)
+}
+
+// serverMaintenanceGet tells whether the server is in a maintenance window now
+// and when the next one starts
+func (r *Router) serverMaintenanceGet(c *gin.Context) {
+	srv, err := r.loadServerFromParams(c)
+	if err != nil {
+		if errors.Is(err, ErrUUIDParse) {
+			badRequestResponse(c, "", err)
+			return
+		}
+
+		dbErrorResponse(c, err)
+
+		return
+	}
+
+	ctx := c.Request.Context()
+	now := time.Now()
+
+	// narrow the windows down to those listing the server or selecting its
+	// facility, the attribute selectors are matched below
+	dbWindows, err := models.MaintenanceWindows(
+		models.MaintenanceWindowWhere.EndsAt.GT(now),
+		qm.Expr(
+			qm.Where("maintenance_windows.id IN (SELECT maintenance_window_id FROM maintenance_window_servers WHERE server_id = ?)", srv.ID),
+			qm.Or("(maintenance_windows.facility_code IS NOT NULL OR maintenance_windows.attributes IS NOT NULL) AND (maintenance_windows.facility_code IS NULL OR maintenance_windows.facility_code = ?)", srv.FacilityCode),
+		),
+		qm.Load(models.MaintenanceWindowRels.MaintenanceWindowServers),
+		qm.OrderBy(models.MaintenanceWindowTableColumns.StartsAt+" ASC, "+models.MaintenanceWindowTableColumns.ID+" ASC"),
+	).All(ctx, r.DB)
+	if err != nil {
+		dbErrorResponse(c, err)
+		return
+	}
+
+	dbAttrs, err := models.Attributes(
+		models.AttributeWhere.ServerID.EQ(null.StringFrom(srv.ID)),
+		qm.Where("server_component_id IS NULL"),
+	).All(ctx, r.DB)
+	if err != nil {
+		dbErrorResponse(c, err)
+		return
+	}
+
+	attrs := map[string]json.RawMessage{}
+	for _, a := range dbAttrs {
+		attrs[a.Namespace] = json.RawMessage(a.Data)
+	}
+
+	var current, next *models.MaintenanceWindow
+
+	for _, dbW := range dbWindows {
+		applies, err := maintenanceWindowAppliesTo(dbW, srv, attrs)
+		if err != nil {
+			failedConvertingToVersioned(c, err)
+			return
+		}
+
+		switch {
+		case !applies:
+		case dbW.StartsAt.After(now):
+			if next == nil {
+				next = dbW
+			}
+		case current == nil || dbW.EndsAt.After(current.EndsAt):
+			current = dbW
+		}
+	}
+
+	sm := ServerMaintenance{InMaintenance: current != nil}
+
+	sm.ServerUUID, err = uuid.Parse(srv.ID)
+	if err != nil {
+		failedConvertingToVersioned(c, err)
+		return
+	}
+
+	if current != nil {
+		sm.Current = &MaintenanceWindow{}
+		if err := sm.Current.fromDBModel(current); err != nil {
+			failedConvertingToVersioned(c, err)
+			return
+		}
+	}
+
+	if next != nil {
+		sm.Next = &MaintenanceWindow{}
+		if err := sm.Next.fromDBModel(next); err != nil {
+			failedConvertingToVersioned(c, err)
+			return
+		}
+	}
+
+	itemResponse(c, sm)
+}
+
+// maintenanceWindowServersExist writes a bad request response and returns
+// false when one of the servers doesn't exist
+func (r *Router) maintenanceWindowServersExist(c *gin.Context, serverIDs []string) bool {
+	if len(serverIDs) == 0 {
+		return true
+	}
+
+	servers, err := models.Servers(qm.Select(models.ServerColumns.ID), models.ServerWhere.ID.IN(serverIDs)).All(c.Request.Context(), r.DB)
+	if err != nil {
+		dbErrorResponse(c, err)
+		return false
+	}
+
+	found := map[string]bool{}
+	for _, srv := range servers {
+		found[srv.ID] = true
+	}
+
+	for _, id := range serverIDs {
+		if !found[id] {
+			badRequestResponse(c, "invalid payload: MaintenanceWindow{}", errors.Wrap(errMaintenanceWindow, "server does not exist: "+id))
+			return false
+		}
+	}
+
+	return true
+}
+
+// setMaintenanceWindowServers replaces the servers the window lists
+func setMaintenanceWindowServers(ctx context.Context, exec boil.ContextExecutor, dbW *models.MaintenanceWindow, serverIDs []string) error {
+	if _, err := models.MaintenanceWindowServers(
+		models.MaintenanceWindowServerWhere.MaintenanceWindowID.EQ(dbW.ID),
+	).DeleteAll(ctx, exec); err != nil {
+		return err
+	}
+
+	if dbW.R != nil {
+		dbW.R.MaintenanceWindowServers = nil
+	}
+
+	sort.Strings(serverIDs)
+
+	related := make([]*models.MaintenanceWindowServer, 0, len(serverIDs))
+	for _, id := range serverIDs {
+		related = append(related, &models.MaintenanceWindowServer{ServerID: id})
+	}
+
+	return dbW.AddMaintenanceWindowServers(ctx, exec, true, related...)
+}
+
+// maintenanceWindowAudit returns the window as it's recorded in the audit log,
+// along with the servers it lists
+func maintenanceWindowAudit(dbW *models.MaintenanceWindow) interface{} {
+	return struct {
+		*models.MaintenanceWindow
+		ServerIDs []string `json:"server_ids,omitempty"`
+	}{dbW, maintenanceWindowServerIDs(dbW)}
+}
+
+func (r *Router) loadMaintenanceWindowFromParams(c *gin.Context, exec boil.ContextExecutor, mods ...qm.QueryMod) (*models.MaintenanceWindow, error) {
+	u, err := r.parseUUID(c)
+	if err != nil {
+		return nil, err
+	}
+
+	mods = append(mods,
+		models.MaintenanceWindowWhere.ID.EQ(u.String()),
+		qm.Load(models.MaintenanceWindowRels.MaintenanceWindowServers),
+	)
+
+	dbW, err := models.MaintenanceWindows(mods...).One(c.Request.Context(), exec)
+	if err != nil {
+		dbErrorResponse(c, err)
+
+		return nil, err
+	}
+
+	return dbW, nil
+}
@@ -0,0 +1,178 @@
+package serverservice_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+
+	"go.hollow.sh/serverservice/internal/dbtools"
+	"go.hollow.sh/serverservice/internal/models"
+	serverservice "go.hollow.sh/serverservice/pkg/api/v1"
+)
+
+func TestIntegrationMaintenanceWindowCreate(t *testing.T) {
+	s := serverTest(t)
+
+	realClientTests(t, func(ctx context.Context, authToken string, respCode int, expectError bool) error {
+		s.Client.SetToken(authToken)
+
+		w := serverservice.MaintenanceWindow{
+			Reason:       "BMC update",
+			StartsAt:     time.Now().Add(time.Hour),
+			EndsAt:       time.Now().Add(2 * time.Hour),
+			FacilityCode: "Ocean",
+		}
+
+		_, _, err := s.Client.CreateMaintenanceWindow(ctx, w)
+
+		return err
+	})
+
+	s.Client.SetToken(validToken(adminScopes))
+
+	var testCases = []struct {
+		testName string
+		window   serverservice.MaintenanceWindow
+		errorMsg string
+	}{
+		{
+			"no scope",
+			serverservice.MaintenanceWindow{Reason: "BMC update", StartsAt: time.Now(), EndsAt: time.Now().Add(time.Hour)},
+			"it needs server UUIDs, a facility code or attribute selectors",
+		},
+		{
+			"servers and selectors",
+			serverservice.MaintenanceWindow{
+				Reason:       "BMC update",
+				StartsAt:     time.Now(),
+				EndsAt:       time.Now().Add(time.Hour),
+				ServerUUIDs:  []uuid.UUID{uuid.MustParse(dbtools.FixtureNemo.ID)},
+				FacilityCode: "Ocean",
+			},
+			"a window listing servers can't have selectors",
+		},
+		{
+			"ends before it starts",
+			serverservice.MaintenanceWindow{Reason: "BMC update", StartsAt: time.Now(), EndsAt: time.Now().Add(-time.Hour), FacilityCode: "Ocean"},
+			"invalid payload",
+		},
+		{
+			"already ended",
+			serverservice.MaintenanceWindow{Reason: "BMC update", StartsAt: time.Now().Add(-2 * time.Hour), EndsAt: time.Now().Add(-time.Hour), FacilityCode: "Ocean"},
+			"already ended",
+		},
+		{
+			"unknown server",
+			serverservice.MaintenanceWindow{Reason: "BMC update", StartsAt: time.Now(), EndsAt: time.Now().Add(time.Hour), ServerUUIDs: []uuid.UUID{uuid.New()}},
+			"server does not exist",
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.testName, func(t *testing.T) {
+			_, _, err := s.Client.CreateMaintenanceWindow(context.TODO(), tt.window)
+			assert.ErrorContains(t, err, tt.errorMsg)
+		})
+	}
+}
+
+func TestIntegrationServerMaintenance(t *testing.T) {
+	s := serverTest(t)
+	s.Client.SetToken(validToken(adminScopes))
+
+	ctx := context.TODO()
+
+	// clown fish in the ocean are in maintenance now, nemo will be later
+	current, _, err := s.Client.CreateMaintenanceWindow(ctx, serverservice.MaintenanceWindow{
+		Reason:       "BIOS update",
+		StartsAt:     time.Now().Add(-time.Minute),
+		EndsAt:       time.Now().Add(time.Hour),
+		FacilityCode: "Ocean",
+		Attributes: []serverservice.AttributeSelector{
+			{Namespace: dbtools.FixtureNamespaceOtherdata, Keys: []string{"type"}, Value: "clown"},
+		},
+	})
+	require.NoError(t, err)
+
+	next, _, err := s.Client.CreateMaintenanceWindow(ctx, serverservice.MaintenanceWindow{
+		Reason:      "BMC update",
+		StartsAt:    time.Now().Add(time.Hour),
+		EndsAt:      time.Now().Add(2 * time.Hour),
+		ServerUUIDs: []uuid.UUID{uuid.MustParse(dbtools.FixtureNemo.ID)},
+	})
+	require.NoError(t, err)
+
+	sm, _, err := s.Client.GetServerMaintenance(ctx, uuid.MustParse(dbtools.FixtureMarlin.ID))
+	require.NoError(t, err)
+	assert.True(t, sm.InMaintenance)
+	require.NotNil(t, sm.Current)
+	assert.Equal(t, *current, sm.Current.UUID)
+	assert.Nil(t, sm.Next)
+
+	sm, _, err = s.Client.GetServerMaintenance(ctx, uuid.MustParse(dbtools.FixtureDory.ID))
+	require.NoError(t, err)
+	assert.False(t, sm.InMaintenance)
+	assert.Nil(t, sm.Current)
+
+	sm, _, err = s.Client.GetServerMaintenance(ctx, uuid.MustParse(dbtools.FixtureNemo.ID))
+	require.NoError(t, err)
+	assert.False(t, sm.InMaintenance)
+	require.NotNil(t, sm.Next)
+	assert.Equal(t, *next, sm.Next.UUID)
+	assert.Equal(t, []uuid.UUID{uuid.MustParse(dbtools.FixtureNemo.ID)}, sm.Next.ServerUUIDs)
+
+	windows, _, err := s.Client.ListMaintenanceWindows(ctx, &serverservice.MaintenanceWindowListParams{ServerUUID: dbtools.FixtureNemo.ID})
+	require.NoError(t, err)
+	require.Len(t, windows, 1)
+	assert.Equal(t, *next, windows[0].UUID)
+}
+
+func TestIntegrationMaintenanceWindowStarted(t *testing.T) {
+	s := serverTest(t)
+	s.Client.SetToken(validToken(adminScopes))
+
+	db := dbtools.DatabaseTest(t)
+	ctx := context.TODO()
+
+	dbW := &models.MaintenanceWindow{
+		Reason:         "BIOS update",
+		StartsAt:       time.Now().Add(-time.Minute),
+		EndsAt:         time.Now().Add(time.Hour),
+		FacilityCode:   null.StringFrom("Ocean"),
+		StartedEventAt: null.TimeFrom(time.Now()),
+	}
+	require.NoError(t, dbW.Insert(ctx, db, boil.Infer()))
+
+	wID := uuid.MustParse(dbW.ID)
+
+	w, _, err := s.Client.GetMaintenanceWindow(ctx, wID)
+	require.NoError(t, err)
+
+	w.FacilityCode = "Sydney"
+	_, err = s.Client.UpdateMaintenanceWindow(ctx, wID, *w)
+	assert.ErrorContains(t, err, "the servers it applies to can't be changed")
+
+	w.FacilityCode = "Ocean"
+	w.Reason = "BIOS and BMC update"
+	w.EndsAt = w.EndsAt.Add(time.Hour)
+	_, err = s.Client.UpdateMaintenanceWindow(ctx, wID, *w)
+	require.NoError(t, err)
+
+	_, err = s.Client.DeleteMaintenanceWindow(ctx, wID)
+	require.NoError(t, err)
+
+	evts, err := models.EventOutboxes(models.EventOutboxWhere.PartitionKey.EQ(dbW.ID)).All(ctx, db)
+	require.NoError(t, err)
+	require.Len(t, evts, 1)
+	assert.Equal(t, serverservice.SubjectMaintenanceWindowEnd, evts[0].Subject)
+
+	msg, err := serverservice.DeserializeMaintenanceWindow(evts[0].Payload)
+	require.NoError(t, err)
+	assert.Equal(t, "BIOS and BMC update", msg.Reason)
+}
@@ -56,6 +56,12 @@ func notFoundResponse(c *gin.Context, message string) {
 	c.JSON(http.StatusNotFound, &ServerResponse{Message: message})
 }
 
+// conflictResponse writes a 409 response when a request conflicts with the
+// current state of a resource, e.g. deleting a type that's still in use
+func conflictResponse(c *gin.Context, message string, err error) {
+	c.JSON(http.StatusConflict, &ServerResponse{Message: message, Error: err.Error()})
+}
+
 func badRequestResponse(c *gin.Context, message string, err error) {
 	if err == nil {
 		err = errBadRequest
@@ -128,6 +134,17 @@ func listResponse(c *gin.Context, i interface{}, p paginationData) {
 	r.Page = p.pager.Page
 	r.TotalRecordCount = p.totalCount
 
+	if p.pager.keyset() {
+		keysetLinks(r, *uri, p)
+		c.JSON(http.StatusOK, r)
+
+		return
+	}
+
+	if r.Page == 0 {
+		r.Page = 1
+	}
+
 	r.Links.First = &Link{Href: getURIWithQuerySet(*uri, "page", "1")}
 	r.Links.Last = &Link{Href: getURIWithQuerySet(*uri, "page", strconv.Itoa(r.TotalPages))}
 
@@ -142,6 +159,28 @@ func listResponse(c *gin.Context, i interface{}, p paginationData) {
 	c.JSON(http.StatusOK, r)
 }
 
+// keysetLinks sets the links for a page of a cursor paginated list, there is
+// no way to jump to the previous or last page with a cursor so those are left
+// out.
+func keysetLinks(r *ServerResponse, uri url.URL, p paginationData) {
+	// the page number isn't known past the first page
+	if p.pager.Cursor != "" {
+		r.Page = 0
+	}
+
+	first := uri
+	q := first.Query()
+	q.Set("cursor", "")
+	q.Del("page")
+	first.RawQuery = q.Encode()
+
+	r.Links.First = &Link{Href: first.String()}
+
+	if p.nextCursor != "" {
+		r.Links.Next = &Link{Href: getURIWithQuerySet(uri, "cursor", p.nextCursor)}
+	}
+}
+
 func itemResponse(c *gin.Context, i interface{}) {
 	r := &ServerResponse{
 		Record: i,
@@ -2,10 +2,11 @@ package serverservice
 
 import (
 	"encoding/json"
-	"errors"
 	"reflect"
+	"time"
 
 	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
 	"github.com/volatiletech/null/v8"
 	"github.com/volatiletech/sqlboiler/v4/boil"
 	"github.com/volatiletech/sqlboiler/v4/queries/qm"
@@ -17,31 +18,33 @@ import (
 func (r *Router) serverList(c *gin.Context) {
 	pager := parsePagination(c)
 
-	var params ServerListParams
-	if err := c.ShouldBindQuery(&params); err != nil {
-		badRequestResponse(c, "invalid filter", err)
-		return
-	}
-
-	params.AttributeListParams = parseQueryAttributesListParams(c, "attr")
-	params.VersionedAttributeListParams = parseQueryAttributesListParams(c, "ver_attr")
-
-	sclp, err := parseQueryServerComponentsListParams(c)
+	params, err := r.serverListParams(c)
 	if err != nil {
-		badRequestResponse(c, "invalid server component list params", err)
 		return
 	}
 
-	params.ComponentListParams = sclp
-
 	params.PaginationParams = &pager
 
 	dbSRV, count, err := r.getServers(c, params)
 	if err != nil {
+		if errors.Is(err, ErrInvalidCursor) {
+			badRequestResponse(c, "", err)
+			return
+		}
+
 		dbErrorResponse(c, err)
+
 		return
 	}
 
+	nextCursor := ""
+
+	if pager.keyset() && pager.hasNextKeysetPage(len(dbSRV)) {
+		dbSRV = dbSRV[:pager.limitUsed()]
+		last := dbSRV[len(dbSRV)-1]
+		nextCursor = encodeTimeCursor(last.CreatedAt.Time, last.ID)
+	}
+
 	srvs := []Server{}
 
 	for _, dbS := range dbSRV {
@@ -58,12 +61,48 @@ func (r *Router) serverList(c *gin.Context) {
 		pageCount:  len(srvs),
 		totalCount: count,
 		pager:      pager,
+		nextCursor: nextCursor,
 	}
 
 	listResponse(c, srvs, pd)
 }
 
+// serverListParams reads the server list filters from the query, responding
+// with a bad request when they are invalid.
+func (r *Router) serverListParams(c *gin.Context) (ServerListParams, error) {
+	var params ServerListParams
+	if err := c.ShouldBindQuery(&params); err != nil {
+		badRequestResponse(c, "invalid filter", err)
+		return params, err
+	}
+
+	labelSelector, err := parseLabelSelector(params.Selector)
+	if err != nil {
+		badRequestResponse(c, "invalid filter", err)
+		return params, err
+	}
+
+	params.labelSelector = labelSelector
+	params.AttributeListParams = parseQueryAttributesListParams(c, "attr")
+	params.VersionedAttributeListParams = parseQueryAttributesListParams(c, "ver_attr")
+
+	sclp, err := parseQueryServerComponentsListParams(c)
+	if err != nil {
+		badRequestResponse(c, "invalid server component list params", err)
+		return params, err
+	}
+
+	params.ComponentListParams = sclp
+
+	return params, nil
+}
+
 func (r *Router) serverGet(c *gin.Context) {
+	if c.Query("as_of") != "" {
+		r.serverGetAsOf(c)
+		return
+	}
+
 	mods := []qm.QueryMod{
 		qm.Where("id=?", c.Param("uuid")),
 		qm.Load("Attributes"),
@@ -88,6 +127,35 @@ func (r *Router) serverGet(c *gin.Context) {
 	itemResponse(c, srv)
 }
 
+// serverGetAsOf returns the server as it was at the time given by the as_of
+// query param, an RFC 3339 timestamp.
+func (r *Router) serverGetAsOf(c *gin.Context) {
+	asOf, err := time.Parse(time.RFC3339, c.Query("as_of"))
+	if err != nil {
+		badRequestResponse(c, "invalid as_of timestamp, expected RFC 3339", err)
+		return
+	}
+
+	u, err := r.parseUUID(c)
+	if err != nil {
+		return
+	}
+
+	dbSRV, err := r.serverAsOf(c.Request.Context(), u.String(), asOf)
+	if err != nil {
+		dbErrorResponse(c, err)
+		return
+	}
+
+	var srv Server
+	if err = srv.fromDBModel(dbSRV); err != nil {
+		failedConvertingToVersioned(c, err)
+		return
+	}
+
+	itemResponse(c, srv)
+}
+
 func (r *Router) serverCreate(c *gin.Context) {
 	var srv Server
 
@@ -102,14 +170,17 @@ func (r *Router) serverCreate(c *gin.Context) {
 		return
 	}
 
-	if err := dbSRV.Insert(c.Request.Context(), r.DB, boil.Infer()); err != nil {
+	if err := r.createServerTx(c, dbSRV); err != nil {
+		if errors.Is(err, errLocation) {
+			badRequestResponse(c, "invalid server", err)
+			return
+		}
+
 		dbErrorResponse(c, err)
+
 		return
 	}
 
-	// publish event XXX: this should handle publish failures or otherwise take action if NATS is unavailable
-	r.publishCreateServerMessage(c.Request.Context(), dbSRV)
-
 	createdResponse(c, dbSRV.ID)
 }
 
@@ -126,7 +197,55 @@ func (r *Router) serverDelete(c *gin.Context) {
 		return
 	}
 
-	if _, err = dbSRV.Delete(c.Request.Context(), r.DB, false); err != nil {
+	ctx := c.Request.Context()
+
+	tx, err := r.DB.BeginTx(ctx, nil)
+	if err != nil {
+		dbErrorResponse(c, err)
+		return
+	}
+
+	// rollback is a no-op when the transaction is successful
+	// nolint:errcheck // TODO(joel): log error
+	defer tx.Rollback()
+
+	before := *dbSRV
+
+	if _, err = dbSRV.Delete(ctx, tx, false); err != nil {
+		dbErrorResponse(c, err)
+		return
+	}
+
+	// a deleted server frees the rack units it occupied
+	if _, err := models.ServerPlacements(models.ServerPlacementWhere.ServerID.EQ(dbSRV.ID)).DeleteAll(ctx, tx); err != nil {
+		dbErrorResponse(c, err)
+		return
+	}
+
+	if err := r.recordHistory(ctx, tx, serverHistory(&before, dbSRV)); err != nil {
+		dbErrorResponse(c, err)
+		return
+	}
+
+	if err := r.enqueueEvent(ctx, tx, SubjectServerDelete, dbSRV.ID, func() ([]byte, error) {
+		return NewDeleteServerMessage(dbSRV)
+	}); err != nil {
+		dbErrorResponse(c, err)
+		return
+	}
+
+	if err := r.audit(c, tx, auditEntry{
+		action:       AuditActionDelete,
+		resourceType: AuditResourceServer,
+		resourceID:   dbSRV.ID,
+		serverID:     dbSRV.ID,
+		before:       &before,
+	}); err != nil {
+		dbErrorResponse(c, err)
+		return
+	}
+
+	if err := tx.Commit(); err != nil {
 		dbErrorResponse(c, err)
 		return
 	}
@@ -134,31 +253,125 @@ func (r *Router) serverDelete(c *gin.Context) {
 	deletedResponse(c)
 }
 
+// serverUpdate updates the name, facility and labels of a server. The server
+// is locked while it's updated so a concurrent state transition isn't reverted.
 func (r *Router) serverUpdate(c *gin.Context) {
-	srv, err := r.loadServerFromParams(c)
+	u, err := r.parseUUID(c)
 	if err != nil {
-		if errors.Is(err, ErrUUIDParse) {
-			badRequestResponse(c, "", err)
+		return
+	}
+
+	var newValues Server
+	if err := c.ShouldBindJSON(&newValues); err != nil {
+		badRequestResponse(c, "invalid server", err)
+		return
+	}
+
+	ctx := c.Request.Context()
+
+	tx, err := r.DB.BeginTx(ctx, nil)
+	if err != nil {
+		dbErrorResponse(c, err)
+		return
+	}
+
+	// rollback is a no-op when the transaction is successful
+	// nolint:errcheck // TODO(joel): log error
+	defer tx.Rollback()
+
+	srv, err := models.Servers(models.ServerWhere.ID.EQ(u.String()), qm.For("UPDATE")).One(ctx, tx)
+	if err != nil {
+		dbErrorResponse(c, err)
+		return
+	}
+
+	// servers created before facilities were recorded keep their facility code
+	if newValues.FacilityCode != srv.FacilityCode.String {
+		if err := checkServerFacility(ctx, tx, newValues.FacilityCode); err != nil {
+			if errors.Is(err, errLocation) {
+				badRequestResponse(c, "invalid server", err)
+				return
+			}
+
+			dbErrorResponse(c, err)
+
 			return
 		}
+	}
 
+	placed, err := serverPlacedOutside(ctx, tx, srv.ID, newValues.FacilityCode)
+	if err != nil {
 		dbErrorResponse(c, err)
-
 		return
 	}
 
-	var newValues Server
-	if err := c.ShouldBindJSON(&newValues); err != nil {
-		badRequestResponse(c, "invalid server", err)
+	if placed {
+		conflictResponse(c, "server is placed in another facility", errors.Wrap(errPlacement, "remove the server's placement before moving it to facility "+newValues.FacilityCode))
 		return
 	}
 
+	before := *srv
+
 	srv.Name = null.StringFrom(newValues.Name)
 	srv.FacilityCode = null.StringFrom(newValues.FacilityCode)
 
-	cols := boil.Infer()
+	if newValues.Labels != nil {
+		srv.Labels, err = labelsToDBModel(newValues.Labels)
+		if err != nil {
+			badRequestResponse(c, "invalid server", err)
+			return
+		}
+	}
+
+	cols := boil.Whitelist(
+		models.ServerColumns.Name,
+		models.ServerColumns.FacilityCode,
+		models.ServerColumns.Labels,
+		models.ServerColumns.UpdatedAt,
+	)
+
+	// a new facility code can change the firmware set that applies
+	firmwareSets, err := r.firmwareSetsBefore(ctx, tx, []string{srv.ID})
+	if err != nil {
+		dbErrorResponse(c, err)
+		return
+	}
+
+	if _, err := srv.Update(ctx, tx, cols); err != nil {
+		dbErrorResponse(c, err)
+		return
+	}
 
-	if _, err := srv.Update(c.Request.Context(), r.DB, cols); err != nil {
+	if err := r.enqueueFirmwareSetChanges(ctx, tx, firmwareSets); err != nil {
+		dbErrorResponse(c, err)
+		return
+	}
+
+	if err := r.recordHistory(ctx, tx, serverHistory(&before, srv)); err != nil {
+		dbErrorResponse(c, err)
+		return
+	}
+
+	if err := r.enqueueEvent(ctx, tx, SubjectServerUpdate, srv.ID, func() ([]byte, error) {
+		return NewUpdateServerMessage(srv)
+	}); err != nil {
+		dbErrorResponse(c, err)
+		return
+	}
+
+	if err := r.audit(c, tx, auditEntry{
+		action:       AuditActionUpdate,
+		resourceType: AuditResourceServer,
+		resourceID:   srv.ID,
+		serverID:     srv.ID,
+		before:       &before,
+		after:        srv,
+	}); err != nil {
+		dbErrorResponse(c, err)
+		return
+	}
+
+	if err := tx.Commit(); err != nil {
 		dbErrorResponse(c, err)
 		return
 	}
@@ -274,24 +487,61 @@ func (r *Router) serverVersionedAttributesCreate(c *gin.Context) {
 		return
 	}
 
+	ctx := c.Request.Context()
+
 	// nolint:errcheck If this fails continue on
-	curVA, _ := srv.VersionedAttributes(qm.Where("namespace = ?", va.Namespace), qm.OrderBy("created_at DESC")).One(c.Request.Context(), r.DB)
+	curVA, _ := srv.VersionedAttributes(qm.Where("namespace = ?", va.Namespace), qm.OrderBy("created_at DESC")).One(ctx, r.DB)
+
+	tx, err := r.DB.BeginTx(ctx, nil)
+	if err != nil {
+		dbErrorResponse(c, err)
+		return
+	}
+
+	// rollback is a no-op when the transaction is successful
+	// nolint:errcheck // TODO(joel): log error
+	defer tx.Rollback()
+
+	var before *models.VersionedAttribute
 
 	if curVA != nil && areEqualJSON(dbVA.Data, curVA.Data) {
+		prev := *curVA
+		before = &prev
+
 		curVA.Tally++
 
-		_, err := curVA.Update(c.Request.Context(), r.DB, boil.Whitelist("tally", "updated_at"))
+		_, err := curVA.Update(ctx, tx, boil.Whitelist("tally", "updated_at"))
 		if err != nil {
 			dbErrorResponse(c, err)
 			return
 		}
 
-		createdResponse(c, curVA.Namespace)
+		dbVA = curVA
+	} else if err := srv.AddVersionedAttributes(ctx, tx, true, dbVA); err != nil {
+		dbErrorResponse(c, err)
+		return
+	}
 
+	if err := r.enqueueEvent(ctx, tx, SubjectServerVersionedAttributesCreate, srv.ID, func() ([]byte, error) {
+		return NewCreateServerVersionedAttributesMessage(srv.ID, dbVA)
+	}); err != nil {
+		dbErrorResponse(c, err)
+		return
+	}
+
+	if err := r.audit(c, tx, auditEntry{
+		action:       AuditActionCreate,
+		resourceType: AuditResourceServerVersionedAttributes,
+		resourceID:   dbVA.Namespace,
+		serverID:     srv.ID,
+		before:       before,
+		after:        dbVA,
+	}); err != nil {
+		dbErrorResponse(c, err)
 		return
 	}
 
-	if err := srv.AddVersionedAttributes(c.Request.Context(), r.DB, true, dbVA); err != nil {
+	if err := tx.Commit(); err != nil {
 		dbErrorResponse(c, err)
 		return
 	}
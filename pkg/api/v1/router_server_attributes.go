@@ -2,11 +2,13 @@ package serverservice
 
 import (
 	"database/sql"
+	"encoding/json"
 	"errors"
 	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/types"
 
 	"go.hollow.sh/serverservice/internal/models"
 )
@@ -108,7 +110,59 @@ func (r *Router) serverAttributesCreate(c *gin.Context) {
 		return
 	}
 
-	if err := srv.AddAttributes(c.Request.Context(), r.DB, true, dbAttr); err != nil {
+	ctx := c.Request.Context()
+
+	tx, err := r.DB.BeginTx(ctx, nil)
+	if err != nil {
+		dbErrorResponse(c, err)
+		return
+	}
+
+	// rollback is a no-op when the transaction is successful
+	// nolint:errcheck // TODO(joel): log error
+	defer tx.Rollback()
+
+	// attributes can select the firmware set that applies
+	firmwareSets, err := r.firmwareSetsBefore(ctx, tx, []string{srv.ID})
+	if err != nil {
+		dbErrorResponse(c, err)
+		return
+	}
+
+	if err := srv.AddAttributes(ctx, tx, true, dbAttr); err != nil {
+		dbErrorResponse(c, err)
+		return
+	}
+
+	if err := r.enqueueFirmwareSetChanges(ctx, tx, firmwareSets); err != nil {
+		dbErrorResponse(c, err)
+		return
+	}
+
+	if err := r.recordHistory(ctx, tx, attributeHistory(srv.ID, nil, dbAttr)); err != nil {
+		dbErrorResponse(c, err)
+		return
+	}
+
+	if err := r.enqueueEvent(ctx, tx, SubjectServerAttributesCreate, srv.ID, func() ([]byte, error) {
+		return NewServerAttributesMessage(srv.ID, dbAttr.Namespace, json.RawMessage(dbAttr.Data))
+	}); err != nil {
+		dbErrorResponse(c, err)
+		return
+	}
+
+	if err := r.audit(c, tx, auditEntry{
+		action:       AuditActionCreate,
+		resourceType: AuditResourceServerAttributes,
+		resourceID:   dbAttr.Namespace,
+		serverID:     srv.ID,
+		after:        dbAttr,
+	}); err != nil {
+		dbErrorResponse(c, err)
+		return
+	}
+
+	if err := tx.Commit(); err != nil {
 		dbErrorResponse(c, err)
 		return
 	}
@@ -138,6 +192,22 @@ func (r *Router) serverAttributesUpdate(c *gin.Context) {
 		return
 	}
 
+	before, err := models.Attributes(qm.Where("namespace = ?", ns), qm.Where("server_id = ?", u)).One(ctx, tx)
+	if err != nil {
+		tx.Rollback() //nolint errcheck
+		dbErrorResponse(c, err)
+
+		return
+	}
+
+	firmwareSets, err := r.firmwareSetsBefore(ctx, tx, []string{u.String()})
+	if err != nil {
+		tx.Rollback() //nolint errcheck
+		dbErrorResponse(c, err)
+
+		return
+	}
+
 	rows, err := models.Attributes(qm.Where("namespace = ?", ns), qm.Where("server_id = ?", u)).UpdateAll(ctx, tx, models.M{"data": attr.Data})
 	if err != nil {
 		tx.Rollback() //nolint errcheck
@@ -168,6 +238,46 @@ func (r *Router) serverAttributesUpdate(c *gin.Context) {
 		return
 	}
 
+	if err := r.enqueueEvent(ctx, tx, SubjectServerAttributesUpdate, u.String(), func() ([]byte, error) {
+		return NewServerAttributesMessage(u.String(), ns, attr.Data)
+	}); err != nil {
+		tx.Rollback() //nolint errcheck
+		dbErrorResponse(c, err)
+
+		return
+	}
+
+	if err := r.enqueueFirmwareSetChanges(ctx, tx, firmwareSets); err != nil {
+		tx.Rollback() //nolint errcheck
+		dbErrorResponse(c, err)
+
+		return
+	}
+
+	after := *before
+	after.Data = types.JSON(attr.Data)
+
+	if err := r.recordHistory(ctx, tx, attributeHistory(u.String(), before, &after)); err != nil {
+		tx.Rollback() //nolint errcheck
+		dbErrorResponse(c, err)
+
+		return
+	}
+
+	if err := r.audit(c, tx, auditEntry{
+		action:       AuditActionUpdate,
+		resourceType: AuditResourceServerAttributes,
+		resourceID:   ns,
+		serverID:     u.String(),
+		before:       before,
+		after:        &after,
+	}); err != nil {
+		tx.Rollback() //nolint errcheck
+		dbErrorResponse(c, err)
+
+		return
+	}
+
 	if err := tx.Commit(); err != nil {
 		tx.Rollback() //nolint errcheck
 		dbErrorResponse(c, err)
@@ -182,7 +292,31 @@ func (r *Router) serverAttributesDelete(c *gin.Context) {
 	u := c.Param("uuid")
 	ns := c.Param("namespace")
 
-	rows, err := models.Attributes(qm.Where("namespace = ?", ns), qm.Where("server_id = ?", u)).DeleteAll(c.Request.Context(), r.DB)
+	ctx := c.Request.Context()
+
+	tx, err := r.DB.BeginTx(ctx, nil)
+	if err != nil {
+		dbErrorResponse(c, err)
+		return
+	}
+
+	// rollback is a no-op when the transaction is successful
+	// nolint:errcheck // TODO(joel): log error
+	defer tx.Rollback()
+
+	before, err := models.Attributes(qm.Where("namespace = ?", ns), qm.Where("server_id = ?", u)).All(ctx, tx)
+	if err != nil {
+		dbErrorResponse(c, err)
+		return
+	}
+
+	firmwareSets, err := r.firmwareSetsBefore(ctx, tx, []string{u})
+	if err != nil {
+		dbErrorResponse(c, err)
+		return
+	}
+
+	rows, err := models.Attributes(qm.Where("namespace = ?", ns), qm.Where("server_id = ?", u)).DeleteAll(ctx, tx)
 	if rows == 0 && err == nil {
 		err = sql.ErrNoRows
 	}
@@ -192,5 +326,40 @@ func (r *Router) serverAttributesDelete(c *gin.Context) {
 		return
 	}
 
+	if err := r.enqueueFirmwareSetChanges(ctx, tx, firmwareSets); err != nil {
+		dbErrorResponse(c, err)
+		return
+	}
+
+	if err := r.enqueueEvent(ctx, tx, SubjectServerAttributesDelete, u, func() ([]byte, error) {
+		return NewServerAttributesMessage(u, ns, nil)
+	}); err != nil {
+		dbErrorResponse(c, err)
+		return
+	}
+
+	for _, a := range before {
+		if err := r.recordHistory(ctx, tx, attributeHistory(u, a, nil)); err != nil {
+			dbErrorResponse(c, err)
+			return
+		}
+	}
+
+	if err := r.audit(c, tx, auditEntry{
+		action:       AuditActionDelete,
+		resourceType: AuditResourceServerAttributes,
+		resourceID:   ns,
+		serverID:     u,
+		before:       before,
+	}); err != nil {
+		dbErrorResponse(c, err)
+		return
+	}
+
+	if err := tx.Commit(); err != nil {
+		dbErrorResponse(c, err)
+		return
+	}
+
 	deletedResponse(c)
 }
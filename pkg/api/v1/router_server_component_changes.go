@@ -0,0 +1,269 @@
+package serverservice
+
+import (
+	"bytes"
+	"errors"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+
+	"go.hollow.sh/serverservice/internal/models"
+)
+
+// serverComponentChanges compares the server components as they were at the
+// time given by the since query param with the current ones. Past components
+// are rebuilt from the recorded history, so the changes can't be told when
+// history isn't recorded.
+func (r *Router) serverComponentChanges(c *gin.Context) {
+	if !r.RecordHistory {
+		c.JSON(http.StatusNotImplemented, &ServerResponse{Message: "component changes require history to be enabled", Error: ErrHistoryDisabled.Error()})
+		return
+	}
+
+	srv, err := r.loadServerFromParams(c)
+	if err != nil {
+		if errors.Is(err, ErrUUIDParse) {
+			badRequestResponse(c, "", err)
+			return
+		}
+
+		dbErrorResponse(c, err)
+
+		return
+	}
+
+	since, err := time.Parse(time.RFC3339, c.Query("since"))
+	if err != nil {
+		badRequestResponse(c, "invalid since timestamp, expected RFC 3339", err)
+		return
+	}
+
+	ctx := c.Request.Context()
+
+	h, err := r.loadHistoryAsOf(ctx, srv.ID, since)
+	if err != nil {
+		dbErrorResponse(c, err)
+		return
+	}
+
+	past, err := r.componentsAsOf(ctx, srv.ID, h)
+	if err != nil {
+		dbErrorResponse(c, err)
+		return
+	}
+
+	current, err := srv.ServerComponents(qm.Load("ServerComponentType")).All(ctx, r.DB)
+	if err != nil {
+		dbErrorResponse(c, err)
+		return
+	}
+
+	ids := []interface{}{}
+	for _, sc := range append(past, current...) {
+		ids = append(ids, sc.ID)
+	}
+
+	versioned := componentVersionedAttributes{}
+
+	if len(ids) > 0 {
+		vas, err := models.VersionedAttributes(
+			qm.WhereIn("server_component_id IN ?", ids...),
+			qm.OrderBy(models.VersionedAttributeColumns.CreatedAt),
+		).All(ctx, r.DB)
+		if err != nil {
+			dbErrorResponse(c, err)
+			return
+		}
+
+		for _, va := range vas {
+			versioned.add(va)
+		}
+	}
+
+	changes, err := diffServerComponents(since, past, current, versioned)
+	if err != nil {
+		failedConvertingToVersioned(c, err)
+		return
+	}
+
+	itemResponse(c, changes)
+}
+
+// componentVersionedAttributes holds versioned attributes by component ID and
+// namespace, oldest first.
+type componentVersionedAttributes map[string]map[string]models.VersionedAttributeSlice
+
+func (cv componentVersionedAttributes) add(va *models.VersionedAttribute) {
+	id := va.ServerComponentID.String
+	if cv[id] == nil {
+		cv[id] = map[string]models.VersionedAttributeSlice{}
+	}
+
+	cv[id][va.Namespace] = append(cv[id][va.Namespace], va)
+}
+
+// at returns the latest versioned attributes of the namespace created at or
+// before t, a zero t returns the latest ones.
+func (cv componentVersionedAttributes) at(componentID, ns string, t time.Time) *models.VersionedAttribute {
+	var found *models.VersionedAttribute
+
+	for _, va := range cv[componentID][ns] {
+		if !t.IsZero() && va.CreatedAt.Time.After(t) {
+			break
+		}
+
+		found = va
+	}
+
+	return found
+}
+
+func componentMatchKey(sc *models.ServerComponent) string {
+	slug := ""
+	if sc.R != nil && sc.R.ServerComponentType != nil {
+		slug = sc.R.ServerComponentType.Slug
+	}
+
+	if sc.Serial.String == "" {
+		return slug + "/id:" + sc.ID
+	}
+
+	return slug + "/" + sc.Serial.String
+}
+
+func diffServerComponents(since time.Time, past, current models.ServerComponentSlice, versioned componentVersionedAttributes) (*ServerComponentChanges, error) {
+	changes := &ServerComponentChanges{
+		Since:    since,
+		Added:    []ServerComponent{},
+		Removed:  []ServerComponent{},
+		Modified: []ServerComponentChange{},
+	}
+
+	pastByKey := map[string]*models.ServerComponent{}
+	for _, sc := range past {
+		pastByKey[componentMatchKey(sc)] = sc
+	}
+
+	matched := map[string]bool{}
+
+	for _, now := range current {
+		key := componentMatchKey(now)
+
+		var after ServerComponent
+		if err := after.fromDBModel(now); err != nil {
+			return nil, err
+		}
+
+		before, ok := pastByKey[key]
+		if !ok {
+			changes.Added = append(changes.Added, after)
+			continue
+		}
+
+		matched[key] = true
+
+		change := ServerComponentChange{
+			ComponentTypeSlug:   after.ComponentTypeSlug,
+			Serial:              after.Serial,
+			After:               after,
+			Fields:              changedComponentFields(before, now),
+			VersionedAttributes: changedVersionedAttributes(since, before.ID, now.ID, versioned),
+		}
+
+		if len(change.Fields) == 0 && len(change.VersionedAttributes) == 0 {
+			continue
+		}
+
+		if err := change.Before.fromDBModel(before); err != nil {
+			return nil, err
+		}
+
+		changes.Modified = append(changes.Modified, change)
+	}
+
+	for _, sc := range past {
+		if matched[componentMatchKey(sc)] {
+			continue
+		}
+
+		var removed ServerComponent
+		if err := removed.fromDBModel(sc); err != nil {
+			return nil, err
+		}
+
+		changes.Removed = append(changes.Removed, removed)
+	}
+
+	return changes, nil
+}
+
+func changedComponentFields(before, after *models.ServerComponent) []string {
+	fields := []string{}
+
+	if before.Name != after.Name {
+		fields = append(fields, models.ServerComponentColumns.Name)
+	}
+
+	if before.Vendor != after.Vendor {
+		fields = append(fields, models.ServerComponentColumns.Vendor)
+	}
+
+	if before.Model != after.Model {
+		fields = append(fields, models.ServerComponentColumns.Model)
+	}
+
+	return fields
+}
+
+// changedVersionedAttributes compares the versioned attributes the component
+// had at since with its latest ones, the component ID may differ when a
+// component was removed and reported again.
+func changedVersionedAttributes(since time.Time, beforeID, afterID string, versioned componentVersionedAttributes) []VersionedAttributesChange {
+	namespaces := []string{}
+	seen := map[string]bool{}
+
+	for _, id := range []string{beforeID, afterID} {
+		for ns := range versioned[id] {
+			if !seen[ns] {
+				seen[ns] = true
+
+				namespaces = append(namespaces, ns)
+			}
+		}
+	}
+
+	sort.Strings(namespaces)
+
+	changes := []VersionedAttributesChange{}
+
+	for _, ns := range namespaces {
+		before := versioned.at(beforeID, ns, since)
+		after := versioned.at(afterID, ns, time.Time{})
+
+		if before != nil && after != nil && bytes.Equal(before.Data, after.Data) {
+			continue
+		}
+
+		if before == nil && after == nil {
+			continue
+		}
+
+		change := VersionedAttributesChange{Namespace: ns}
+
+		if before != nil {
+			change.Before = []byte(before.Data)
+		}
+
+		if after != nil {
+			change.After = []byte(after.Data)
+			change.ChangedAt = &after.CreatedAt.Time
+		}
+
+		changes = append(changes, change)
+	}
+
+	return changes
+}
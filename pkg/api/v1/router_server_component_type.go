@@ -2,6 +2,7 @@ package serverservice
 
 import (
 	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
 	"github.com/volatiletech/sqlboiler/v4/boil"
 
 	"go.hollow.sh/serverservice/internal/models"
@@ -20,7 +21,17 @@ func (r *Router) serverComponentTypeCreate(c *gin.Context) {
 		return
 	}
 
-	if err := dbT.Insert(c.Request.Context(), r.DB, boil.Infer()); err != nil {
+	err = r.auditedTx(c, func(tx boil.ContextExecutor) error {
+		return dbT.Insert(c.Request.Context(), tx, boil.Infer())
+	}, func() auditEntry {
+		return auditEntry{
+			action:       AuditActionCreate,
+			resourceType: AuditResourceServerComponentType,
+			resourceID:   dbT.Slug,
+			after:        dbT,
+		}
+	})
+	if err != nil {
 		dbErrorResponse(c, err)
 		return
 	}
@@ -67,3 +78,129 @@ func (r *Router) serverComponentTypeList(c *gin.Context) {
 
 	listResponse(c, types, pd)
 }
+
+func (r *Router) serverComponentTypeGet(c *gin.Context) {
+	dbT, err := models.ServerComponentTypes(models.ServerComponentTypeWhere.Slug.EQ(c.Param("slug"))).One(c.Request.Context(), r.DB)
+	if err != nil {
+		dbErrorResponse(c, err)
+		return
+	}
+
+	t := ServerComponentType{}
+	if err := t.fromDBModel(dbT); err != nil {
+		failedConvertingToVersioned(c, err)
+		return
+	}
+
+	itemResponse(c, t)
+}
+
+func (r *Router) serverComponentTypeUpdate(c *gin.Context) {
+	dbT, err := models.ServerComponentTypes(models.ServerComponentTypeWhere.Slug.EQ(c.Param("slug"))).One(c.Request.Context(), r.DB)
+	if err != nil {
+		dbErrorResponse(c, err)
+		return
+	}
+
+	// fields left out of the request keep their current value
+	t := ServerComponentType{}
+	if err := t.fromDBModel(dbT); err != nil {
+		failedConvertingToVersioned(c, err)
+		return
+	}
+
+	if err := c.ShouldBindJSON(&t); err != nil {
+		badRequestResponse(c, "invalid server component type", err)
+		return
+	}
+
+	// components are matched to their type by slug, e.g. in bulk imports
+	if (t.Slug != "" && t.Slug != dbT.Slug) || (t.ID != "" && t.ID != dbT.ID) {
+		badRequestResponse(c, "invalid server component type", errors.Wrap(errComponentType, "id and slug can't be changed"))
+		return
+	}
+
+	if t.Name == "" {
+		badRequestResponse(c, "invalid server component type", errors.Wrap(errComponentType, "name is required"))
+		return
+	}
+
+	t.ID, t.Slug = dbT.ID, dbT.Slug
+
+	updated, err := t.toDBModel()
+	if err != nil {
+		badRequestResponse(c, "invalid server component type", err)
+		return
+	}
+
+	before := *dbT
+
+	dbT.Name = updated.Name
+	dbT.Description = updated.Description
+	dbT.Deprecated = updated.Deprecated
+
+	err = r.auditedTx(c, func(tx boil.ContextExecutor) error {
+		_, err := dbT.Update(c.Request.Context(), tx, boil.Infer())
+		return err
+	}, func() auditEntry {
+		return auditEntry{
+			action:       AuditActionUpdate,
+			resourceType: AuditResourceServerComponentType,
+			resourceID:   dbT.Slug,
+			before:       &before,
+			after:        dbT,
+		}
+	})
+	if err != nil {
+		dbErrorResponse(c, err)
+		return
+	}
+
+	updatedResponse(c, dbT.Slug)
+}
+
+func (r *Router) serverComponentTypeDelete(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	dbT, err := models.ServerComponentTypes(models.ServerComponentTypeWhere.Slug.EQ(c.Param("slug"))).One(ctx, r.DB)
+	if err != nil {
+		dbErrorResponse(c, err)
+		return
+	}
+
+	// the check is made in the transaction so a component can't be added in
+	// between
+	err = r.auditedTx(c, func(tx boil.ContextExecutor) error {
+		inUse, err := models.ServerComponents(models.ServerComponentWhere.ServerComponentTypeID.EQ(dbT.ID)).Exists(ctx, tx)
+		if err != nil {
+			return err
+		}
+
+		if inUse {
+			return errors.Wrap(errComponentType, "servers have "+dbT.Slug+" components, deprecate the type instead")
+		}
+
+		_, err = dbT.Delete(ctx, tx)
+
+		return err
+	}, func() auditEntry {
+		return auditEntry{
+			action:       AuditActionDelete,
+			resourceType: AuditResourceServerComponentType,
+			resourceID:   dbT.Slug,
+			before:       dbT,
+		}
+	})
+	if err != nil {
+		if errors.Is(err, errComponentType) {
+			conflictResponse(c, "server component type is in use", err)
+			return
+		}
+
+		dbErrorResponse(c, err)
+
+		return
+	}
+
+	deletedResponse(c)
+}
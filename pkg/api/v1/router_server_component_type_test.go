@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -52,3 +53,78 @@ func TestIntegrationListServerComponentTypes(t *testing.T) {
 		return err
 	})
 }
+
+func TestIntegrationUpdateServerComponentType(t *testing.T) {
+	s := serverTest(t)
+
+	realClientTests(t, func(ctx context.Context, authToken string, respCode int, expectError bool) error {
+		s.Client.SetToken(authToken)
+
+		_, err := s.Client.UpdateServerComponentType(ctx, dbtools.FixtureFinType.Slug, serverservice.ServerComponentType{
+			Name:        "Fins",
+			Description: "the fins of a fish",
+			Deprecated:  true,
+		})
+		if !expectError {
+			require.NoError(t, err)
+
+			ct, _, err := s.Client.GetServerComponentType(ctx, dbtools.FixtureFinType.Slug)
+			require.NoError(t, err)
+			assert.Equal(t, "Fins", ct.Name)
+			assert.Equal(t, "the fins of a fish", ct.Description)
+			assert.True(t, ct.Deprecated)
+		}
+
+		return err
+	})
+
+	ctx := context.TODO()
+	s.Client.SetToken(validToken(adminScopes))
+
+	t.Run("the slug can't be changed", func(t *testing.T) {
+		_, err := s.Client.UpdateServerComponentType(ctx, dbtools.FixtureFinType.Slug, serverservice.ServerComponentType{Name: "Fins", Slug: "tail"})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "can't be changed")
+	})
+
+	t.Run("deprecated types can't be used for new components", func(t *testing.T) {
+		_, err := s.Client.CreateComponents(ctx, uuid.MustParse(dbtools.FixtureNemo.ID), serverservice.ServerComponentSlice{
+			{Name: "Fin", Serial: "dorsal", ComponentTypeID: dbtools.FixtureFinType.ID},
+		})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "fins is deprecated")
+	})
+}
+
+func TestIntegrationDeleteServerComponentType(t *testing.T) {
+	s := serverTest(t)
+	s.Client.SetToken(validToken(adminScopes))
+
+	ctx := context.TODO()
+
+	t.Run("types in use can't be deleted", func(t *testing.T) {
+		_, err := s.Client.DeleteServerComponentType(ctx, dbtools.FixtureFinType.Slug)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "409")
+	})
+
+	resp, err := s.Client.CreateServerComponentType(ctx, serverservice.ServerComponentType{Name: "Gills"})
+	require.NoError(t, err)
+
+	realClientTests(t, func(ctx context.Context, authToken string, respCode int, expectError bool) error {
+		s.Client.SetToken(authToken)
+
+		_, err := s.Client.DeleteServerComponentType(ctx, resp.Slug)
+		if !expectError {
+			require.NoError(t, err)
+
+			_, _, err = s.Client.GetServerComponentType(ctx, resp.Slug)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), "not found")
+
+			return nil
+		}
+
+		return err
+	})
+}
@@ -1,6 +1,7 @@
 package serverservice
 
 import (
+	"context"
 	"database/sql"
 
 	"github.com/gin-gonic/gin"
@@ -30,10 +31,24 @@ func (r *Router) serverComponentList(c *gin.Context) {
 
 	dbSC, count, err := r.getServerComponents(c, params, pager)
 	if err != nil {
+		if errors.Is(err, ErrInvalidCursor) {
+			badRequestResponse(c, "", err)
+			return
+		}
+
 		dbErrorResponse(c, err)
+
 		return
 	}
 
+	nextCursor := ""
+
+	if pager.keyset() && pager.hasNextKeysetPage(len(dbSC)) {
+		dbSC = dbSC[:pager.limitUsed()]
+		last := dbSC[len(dbSC)-1]
+		nextCursor = encodeTimeCursor(last.CreatedAt.Time, last.ID)
+	}
+
 	serverComponents := ServerComponentSlice{}
 
 	for _, dbSC := range dbSC {
@@ -50,6 +65,7 @@ func (r *Router) serverComponentList(c *gin.Context) {
 		pageCount:  len(serverComponents),
 		totalCount: count,
 		pager:      pager,
+		nextCursor: nextCursor,
 	}
 
 	listResponse(c, serverComponents, pd)
@@ -156,8 +172,55 @@ func (r *Router) serverComponentsCreate(c *gin.Context) {
 	// nolint:errcheck // TODO(joel): log gerror instead of ignoring
 	defer tx.Rollback()
 
-	for _, srvComponent := range serverComponents {
-		dbSrvComponent := srvComponent.toDBModel(server.ID)
+	if err := r.insertServerComponents(c.Request.Context(), tx, server.ID, serverComponents); err != nil {
+		if errors.Is(err, ErrInvalidLabel) || errors.Is(err, errComponentType) {
+			badRequestResponse(c, "", errors.Wrap(errSrvComponentPayload, err.Error()))
+			return
+		}
+
+		dbErrorResponse(c, err)
+
+		return
+	}
+
+	if err := r.audit(c, tx, auditEntry{
+		action:       AuditActionCreate,
+		resourceType: AuditResourceServerComponents,
+		resourceID:   server.ID,
+		serverID:     server.ID,
+		after:        serverComponents,
+	}); err != nil {
+		dbErrorResponse(c, err)
+		return
+	}
+
+	if err := tx.Commit(); err != nil {
+		dbErrorResponse(c, err)
+		return
+	}
+
+	createdResponse(c, "")
+}
+
+// insertServerComponents inserts the components of a server along with their
+// attributes and versioned attributes, and enqueues the create event.
+func (r *Router) insertServerComponents(ctx context.Context, tx boil.ContextExecutor, serverID string, components ServerComponentSlice) error {
+	typeIDs := make([]string, 0, len(components))
+	for _, component := range components {
+		typeIDs = append(typeIDs, component.ComponentTypeID)
+	}
+
+	if err := checkComponentTypesActive(ctx, tx, typeIDs); err != nil {
+		return err
+	}
+
+	dbSrvComponents := make(models.ServerComponentSlice, 0, len(components))
+
+	for _, component := range components {
+		dbSrvComponent, err := component.toDBModel(serverID)
+		if err != nil {
+			return err
+		}
 
 		// Set server component UUID.
 		//
@@ -178,48 +241,51 @@ func (r *Router) serverComponentsCreate(c *gin.Context) {
 		}
 
 		// insert component
-		err := dbSrvComponent.Insert(c.Request.Context(), tx, boil.Infer())
+		err = dbSrvComponent.Insert(ctx, tx, boil.Infer())
 		if err != nil {
-			dbErrorResponse(c, err)
-			return
+			return err
+		}
+
+		dbSrvComponents = append(dbSrvComponents, dbSrvComponent)
+
+		if err := r.recordHistory(ctx, tx, componentHistory(serverID, nil, dbSrvComponent)); err != nil {
+			return err
 		}
 
 		// insert versioned attributes
-		for _, versionedAttributes := range srvComponent.VersionedAttributes {
+		for _, versionedAttributes := range component.VersionedAttributes {
 			dbVersionedAttributes := versionedAttributes.toDBModel()
 			dbVersionedAttributes.ServerComponentID = null.StringFrom(dbSrvComponent.ID)
 
-			err = dbSrvComponent.AddVersionedAttributes(c.Request.Context(), tx, true, dbVersionedAttributes)
+			err = dbSrvComponent.AddVersionedAttributes(ctx, tx, true, dbVersionedAttributes)
 			if err != nil {
-				dbErrorResponse(c, err)
-				return
+				return err
 			}
 		}
 
 		// insert attributes
-		for _, attributes := range srvComponent.Attributes {
+		for _, attributes := range component.Attributes {
 			dbAttributes, err := attributes.toDBModel()
 			if err != nil {
-				dbErrorResponse(c, err)
-				return
+				return err
 			}
 
 			dbAttributes.ServerComponentID = null.StringFrom(dbSrvComponent.ID)
 
-			err = dbSrvComponent.AddAttributes(c.Request.Context(), tx, true, dbAttributes)
+			err = dbSrvComponent.AddAttributes(ctx, tx, true, dbAttributes)
 			if err != nil {
-				dbErrorResponse(c, err)
-				return
+				return err
 			}
 		}
 	}
 
-	if err := tx.Commit(); err != nil {
-		dbErrorResponse(c, err)
-		return
+	if err := linkServerBom(ctx, tx, serverID); err != nil {
+		return err
 	}
 
-	createdResponse(c, "")
+	return r.enqueueEvent(ctx, tx, SubjectServerComponentCreate, serverID, func() ([]byte, error) {
+		return NewCreateServerComponentsMessage(serverID, dbSrvComponents)
+	})
 }
 
 // serverComponentUpdate updates existing server component attributes
@@ -277,9 +343,16 @@ func (r *Router) serverComponentUpdate(c *gin.Context) {
 	// nolint:errcheck // TODO(joel): log gerror instead of ignoring
 	defer tx.Rollback()
 
+	dbSrvComponents := make(models.ServerComponentSlice, 0, len(serverComponents))
+	beforeComponents := make(models.ServerComponentSlice, 0, len(serverComponents))
+
 	for _, srvComponent := range serverComponents {
 		// convert object to db model type and keep the received component UUID
-		dbSrvComponent := srvComponent.toDBModel(server.ID)
+		dbSrvComponent, err := srvComponent.toDBModel(server.ID)
+		if err != nil {
+			badRequestResponse(c, "", errors.Wrap(errSrvComponentPayload, err.Error()))
+			return
+		}
 
 		// check component ID is non nil
 		if dbSrvComponent.ID == "" || dbSrvComponent.ID == uuid.Nil.String() {
@@ -292,14 +365,14 @@ func (r *Router) serverComponentUpdate(c *gin.Context) {
 			return
 		}
 
-		// check server component exists
-		exists, err := models.ServerComponentExists(c.Request.Context(), tx, srvComponent.UUID.String())
-		if err != nil {
+		// check server component exists, keeping its current state for the audit log
+		current, err := models.FindServerComponent(c.Request.Context(), tx, srvComponent.UUID.String())
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
 			badRequestResponse(c, "check component resource exists error", err)
 			return
 		}
 
-		if !exists {
+		if current == nil {
 			badRequestResponse(
 				c,
 				"",
@@ -312,6 +385,26 @@ func (r *Router) serverComponentUpdate(c *gin.Context) {
 			return
 		}
 
+		beforeComponents = append(beforeComponents, current)
+
+		if dbSrvComponent.ServerComponentTypeID != current.ServerComponentTypeID {
+			if err := checkComponentTypesActive(c.Request.Context(), tx, []string{dbSrvComponent.ServerComponentTypeID}); err != nil {
+				if errors.Is(err, errComponentType) {
+					badRequestResponse(c, "", errors.Wrap(errSrvComponentPayload, err.Error()))
+					return
+				}
+
+				dbErrorResponse(c, err)
+
+				return
+			}
+		}
+
+		// labels are left as they are unless the update sets them
+		if srvComponent.Labels == nil {
+			dbSrvComponent.Labels = current.Labels
+		}
+
 		// update component
 		_, err = dbSrvComponent.Update(c.Request.Context(), tx, boil.Infer())
 		if err != nil {
@@ -319,6 +412,13 @@ func (r *Router) serverComponentUpdate(c *gin.Context) {
 			return
 		}
 
+		dbSrvComponents = append(dbSrvComponents, dbSrvComponent)
+
+		if err := r.recordHistory(c.Request.Context(), tx, componentHistory(server.ID, current, dbSrvComponent)); err != nil {
+			dbErrorResponse(c, err)
+			return
+		}
+
 		// update component versioned attributes
 		for _, versionedAttributes := range srvComponent.VersionedAttributes {
 			dbVersionedAttributes := versionedAttributes.toDBModel()
@@ -392,6 +492,30 @@ func (r *Router) serverComponentUpdate(c *gin.Context) {
 		}
 	}
 
+	if err := linkServerBom(c.Request.Context(), tx, server.ID); err != nil {
+		dbErrorResponse(c, err)
+		return
+	}
+
+	if err := r.enqueueEvent(c.Request.Context(), tx, SubjectServerComponentUpdate, server.ID, func() ([]byte, error) {
+		return NewUpdateServerComponentsMessage(server.ID, dbSrvComponents)
+	}); err != nil {
+		dbErrorResponse(c, err)
+		return
+	}
+
+	if err := r.audit(c, tx, auditEntry{
+		action:       AuditActionUpdate,
+		resourceType: AuditResourceServerComponents,
+		resourceID:   server.ID,
+		serverID:     server.ID,
+		before:       beforeComponents,
+		after:        serverComponents,
+	}); err != nil {
+		dbErrorResponse(c, err)
+		return
+	}
+
 	if err := tx.Commit(); err != nil {
 		dbErrorResponse(c, err)
 		return
@@ -415,11 +539,82 @@ func (r *Router) serverComponentDelete(c *gin.Context) {
 		return
 	}
 
-	if _, err := server.ServerComponents().DeleteAll(c.Request.Context(), r.DB); err != nil {
+	ctx := c.Request.Context()
+
+	tx, err := r.DB.BeginTx(ctx, nil)
+	if err != nil {
+		dbErrorResponse(c, err)
+		return
+	}
+
+	// rollback is a no-op when the transaction is successful
+	// nolint:errcheck // TODO(joel): log error
+	defer tx.Rollback()
+
+	before, err := server.ServerComponents().All(ctx, tx)
+	if err != nil {
+		dbErrorResponse(c, err)
+		return
+	}
+
+	if _, err := server.ServerComponents().DeleteAll(ctx, tx); err != nil {
+		dbErrorResponse(c, err)
+
+		return
+	}
+
+	for _, sc := range before {
+		if err := r.recordHistory(ctx, tx, componentHistory(server.ID, sc, nil)); err != nil {
+			dbErrorResponse(c, err)
+			return
+		}
+	}
+
+	if err := r.enqueueEvent(ctx, tx, SubjectServerComponentDelete, server.ID, func() ([]byte, error) {
+		return NewDeleteServerComponentsMessage(server.ID)
+	}); err != nil {
+		dbErrorResponse(c, err)
+		return
+	}
+
+	if err := r.audit(c, tx, auditEntry{
+		action:       AuditActionDelete,
+		resourceType: AuditResourceServerComponents,
+		resourceID:   server.ID,
+		serverID:     server.ID,
+		before:       before,
+	}); err != nil {
 		dbErrorResponse(c, err)
+		return
+	}
 
+	if err := tx.Commit(); err != nil {
+		dbErrorResponse(c, err)
 		return
 	}
 
 	deletedResponse(c)
 }
+
+// checkComponentTypesActive returns errComponentType when one of the component
+// types is deprecated, the components already stored keep their type but new
+// ones can't use it
+func checkComponentTypesActive(ctx context.Context, exec boil.ContextExecutor, typeIDs []string) error {
+	if len(typeIDs) == 0 {
+		return nil
+	}
+
+	deprecated, err := models.ServerComponentTypes(
+		models.ServerComponentTypeWhere.ID.IN(typeIDs),
+		models.ServerComponentTypeWhere.Deprecated.EQ(true),
+	).One(ctx, exec)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return err
+	}
+
+	if deprecated != nil {
+		return errors.Wrap(errComponentType, deprecated.Slug+" is deprecated")
+	}
+
+	return nil
+}
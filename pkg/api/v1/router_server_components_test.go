@@ -11,6 +11,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"go.hollow.sh/serverservice/internal/dbtools"
+	"go.hollow.sh/serverservice/internal/httpsrv"
 	serverservice "go.hollow.sh/serverservice/pkg/api/v1"
 )
 
@@ -428,6 +429,42 @@ func TestIntegrationServerCreateComponents(t *testing.T) {
 			"",
 			"resource not found",
 		},
+		{
+			"create component with labels and list by Name works",
+			servers[0].UUID,
+			serverservice.ServerComponentSlice{
+				{
+					ServerUUID:        servers[0].UUID,
+					ComponentTypeID:   componentTypeSlice[0].ID,
+					ComponentTypeName: componentTypeSlice[0].Name,
+					ComponentTypeSlug: componentTypeSlice[0].Slug,
+					Name:              "Fin C",
+					Model:             "Normal Fin",
+					Serial:            "Right Upper",
+					Labels:            map[string]string{"side": "right"},
+				},
+			},
+			"resource created",
+			"",
+		},
+		{
+			"create component with invalid labels returns error",
+			servers[0].UUID,
+			serverservice.ServerComponentSlice{
+				{
+					ServerUUID:        servers[0].UUID,
+					ComponentTypeID:   componentTypeSlice[0].ID,
+					ComponentTypeName: componentTypeSlice[0].Name,
+					ComponentTypeSlug: componentTypeSlice[0].Slug,
+					Name:              "Fin D",
+					Model:             "Normal Fin",
+					Serial:            "Right Lower",
+					Labels:            map[string]string{"side": "right!"},
+				},
+			},
+			"",
+			"invalid label",
+		},
 		{
 			"create component validates field constraints",
 			servers[0].UUID,
@@ -809,3 +846,69 @@ func TestIntegrationServerComponentDelete(t *testing.T) {
 		})
 	}
 }
+
+func TestIntegrationServerComponentChanges(t *testing.T) {
+	s := serverTest(t)
+	s.Client.SetToken(validToken(adminScopes))
+
+	ctx := context.TODO()
+	srvID := uuid.MustParse(dbtools.FixtureDory.ID)
+
+	since := time.Now()
+
+	components, _, err := s.Client.GetComponents(ctx, srvID, nil)
+	require.NoError(t, err)
+	require.Len(t, components, 2)
+
+	updated := components[0]
+	updated.Vendor = "Shark Fin Co"
+	updated.VersionedAttributes = []serverservice.VersionedAttributes{
+		{Namespace: "fin.firmware", Data: json.RawMessage(`{"version":"2.0"}`)},
+	}
+
+	_, err = s.Client.UpdateComponents(ctx, srvID, serverservice.ServerComponentSlice{updated})
+	require.NoError(t, err)
+
+	_, err = s.Client.CreateComponents(ctx, srvID, serverservice.ServerComponentSlice{
+		{
+			Name:              "Dorsal Fin",
+			Serial:            "Top",
+			ComponentTypeID:   dbtools.FixtureFinType.ID,
+			ComponentTypeSlug: dbtools.FixtureFinType.Slug,
+		},
+	})
+	require.NoError(t, err)
+
+	realClientTests(t, func(ctx context.Context, authToken string, respCode int, expectError bool) error {
+		s.Client.SetToken(authToken)
+
+		changes, _, err := s.Client.GetComponentChanges(ctx, srvID, since)
+		if !expectError {
+			require.NoError(t, err)
+
+			require.Len(t, changes.Added, 1)
+			assert.Equal(t, "Top", changes.Added[0].Serial)
+			assert.Empty(t, changes.Removed)
+
+			require.Len(t, changes.Modified, 1)
+			assert.Equal(t, updated.Serial, changes.Modified[0].Serial)
+			assert.Equal(t, []string{"vendor"}, changes.Modified[0].Fields)
+			require.Len(t, changes.Modified[0].VersionedAttributes, 1)
+			assert.Nil(t, changes.Modified[0].VersionedAttributes[0].Before)
+		}
+
+		return err
+	})
+}
+
+func TestIntegrationServerComponentChangesHistoryDisabled(t *testing.T) {
+	s := serverTestWith(t, func(hs *httpsrv.Server) { hs.RecordHistory = false })
+	s.Client.SetToken(validToken(adminScopes))
+
+	changes, _, err := s.Client.GetComponentChanges(context.TODO(), uuid.MustParse(dbtools.FixtureDory.ID), time.Now())
+
+	assert.Nil(t, changes)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "501")
+	assert.Contains(t, err.Error(), serverservice.ErrHistoryDisabled.Error())
+}
@@ -0,0 +1,377 @@
+package serverservice
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+
+	"go.hollow.sh/serverservice/internal/models"
+)
+
+// importLine is a server document read from a line of an NDJSON import
+type importLine struct {
+	number int
+	server Server
+}
+
+// serverBulkImport creates the servers in an NDJSON request body, one full
+// server document per line, in transactions of batch_size servers. A line
+// that fails is rolled back on its own and reported without failing the rest
+// of its batch.
+func (r *Router) serverBulkImport(c *gin.Context) {
+	batchSize := defaultImportBatchSize
+
+	if v := c.Query("batch_size"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n <= 0 {
+			badRequestResponse(c, "invalid batch_size", errors.Wrap(errServerImport, "batch_size must be a positive integer"))
+			return
+		}
+
+		if n > maxPaginationSize {
+			n = maxPaginationSize
+		}
+
+		batchSize = n
+	}
+
+	componentTypes, err := r.componentTypeIDsBySlug(c.Request.Context())
+	if err != nil {
+		dbErrorResponse(c, err)
+		return
+	}
+
+	report := &ServerImportReport{Results: []ServerImportResult{}}
+	batch := make([]importLine, 0, batchSize)
+
+	scanner := bufio.NewScanner(c.Request.Body)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxImportLineSize)
+
+	line := 0
+
+	for scanner.Scan() {
+		line++
+
+		data := bytes.TrimSpace(scanner.Bytes())
+		if len(data) == 0 {
+			continue
+		}
+
+		var srv Server
+		if err := json.Unmarshal(data, &srv); err != nil {
+			report.add(line, "", errors.Wrap(errServerImport, "invalid server document: "+err.Error()))
+			continue
+		}
+
+		batch = append(batch, importLine{number: line, server: srv})
+
+		if len(batch) == batchSize {
+			if err := r.importServerBatch(c, batch, componentTypes, report); err != nil {
+				dbErrorResponse(c, err)
+				return
+			}
+
+			batch = batch[:0]
+		}
+	}
+
+	// the line that couldn't be read ends the import, the servers read so far
+	// are still imported
+	if err := scanner.Err(); err != nil {
+		report.add(line+1, "", errors.Wrap(errServerImport, err.Error()))
+	}
+
+	if err := r.importServerBatch(c, batch, componentTypes, report); err != nil {
+		dbErrorResponse(c, err)
+		return
+	}
+
+	itemResponse(c, report)
+}
+
+// importServerBatch imports a batch of servers in a transaction, each server
+// within a savepoint. If the transaction fails to commit every server of the
+// batch is reported as failed.
+func (r *Router) importServerBatch(c *gin.Context, batch []importLine, componentTypes map[string]string, report *ServerImportReport) error {
+	if len(batch) == 0 {
+		return nil
+	}
+
+	ctx := c.Request.Context()
+
+	tx, err := r.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	// rollback is a no-op when the transaction is successful
+	// nolint:errcheck // TODO(joel): log error
+	defer tx.Rollback()
+
+	ids := make([]string, len(batch))
+	errs := make([]error, len(batch))
+
+	for i, l := range batch {
+		if _, err := tx.ExecContext(ctx, "SAVEPOINT server_import"); err != nil {
+			return err
+		}
+
+		ids[i], errs[i] = r.importServer(c, tx, l.server, componentTypes)
+
+		if errs[i] != nil {
+			if _, err := tx.ExecContext(ctx, "ROLLBACK TO SAVEPOINT server_import"); err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		if _, err := tx.ExecContext(ctx, "RELEASE SAVEPOINT server_import"); err != nil {
+			return err
+		}
+	}
+
+	commitErr := tx.Commit()
+
+	for i, l := range batch {
+		err := errs[i]
+		if err == nil {
+			err = commitErr
+		}
+
+		report.add(l.number, ids[i], err)
+	}
+
+	return nil
+}
+
+// importServer creates a server along with its attributes, versioned
+// attributes and components, and returns its ID.
+func (r *Router) importServer(c *gin.Context, tx *sql.Tx, srv Server, componentTypes map[string]string) (string, error) {
+	ctx := c.Request.Context()
+
+	dbSRV, err := srv.toDBModel()
+	if err != nil {
+		return "", err
+	}
+
+	if dbSRV.ID == "" {
+		dbSRV.ID = uuid.NewString()
+	}
+
+	if err := r.insertServer(ctx, tx, dbSRV); err != nil {
+		return dbSRV.ID, err
+	}
+
+	for _, attr := range srv.Attributes {
+		dbAttr, err := attr.toDBModel()
+		if err != nil {
+			return dbSRV.ID, err
+		}
+
+		if err := dbSRV.AddAttributes(ctx, tx, true, dbAttr); err != nil {
+			return dbSRV.ID, err
+		}
+
+		if err := r.recordHistory(ctx, tx, attributeHistory(dbSRV.ID, nil, dbAttr)); err != nil {
+			return dbSRV.ID, err
+		}
+
+		if err := r.enqueueEvent(ctx, tx, SubjectServerAttributesCreate, dbSRV.ID, func() ([]byte, error) {
+			return NewServerAttributesMessage(dbSRV.ID, dbAttr.Namespace, json.RawMessage(dbAttr.Data))
+		}); err != nil {
+			return dbSRV.ID, err
+		}
+	}
+
+	for _, va := range srv.VersionedAttributes {
+		dbVA := va.toDBModel()
+
+		if err := dbSRV.AddVersionedAttributes(ctx, tx, true, dbVA); err != nil {
+			return dbSRV.ID, err
+		}
+
+		if err := r.enqueueEvent(ctx, tx, SubjectServerVersionedAttributesCreate, dbSRV.ID, func() ([]byte, error) {
+			return NewCreateServerVersionedAttributesMessage(dbSRV.ID, dbVA)
+		}); err != nil {
+			return dbSRV.ID, err
+		}
+	}
+
+	if len(srv.Components) > 0 {
+		// documents from another instance may only name the component type
+		for i, sc := range srv.Components {
+			if sc.ComponentTypeID != "" {
+				continue
+			}
+
+			id, ok := componentTypes[sc.ComponentTypeSlug]
+			if !ok {
+				return dbSRV.ID, errors.Wrap(errServerImport, "unknown component type: "+sc.ComponentTypeSlug)
+			}
+
+			srv.Components[i].ComponentTypeID = id
+		}
+
+		if err := r.insertServerComponents(ctx, tx, dbSRV.ID, srv.Components); err != nil {
+			return dbSRV.ID, err
+		}
+	}
+
+	// the attributes may select a firmware set for the new server
+	if err := r.enqueueFirmwareSetChanges(ctx, tx, map[string]string{dbSRV.ID: ""}); err != nil {
+		return dbSRV.ID, err
+	}
+
+	return dbSRV.ID, r.audit(c, tx, auditEntry{
+		action:       AuditActionCreate,
+		resourceType: AuditResourceServer,
+		resourceID:   dbSRV.ID,
+		serverID:     dbSRV.ID,
+		after:        srv,
+	})
+}
+
+// componentTypeIDsBySlug returns the server component type IDs keyed by slug
+func (r *Router) componentTypeIDsBySlug(ctx context.Context) (map[string]string, error) {
+	dbTypes, err := models.ServerComponentTypes().All(ctx, r.DB)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make(map[string]string, len(dbTypes))
+	for _, t := range dbTypes {
+		ids[t.Slug] = t.ID
+	}
+
+	return ids, nil
+}
+
+// serverExport streams the servers matching the server list filters as NDJSON
+// documents, or as CSV of the flat server fields when format=csv.
+func (r *Router) serverExport(c *gin.Context) {
+	params, err := r.serverListParams(c)
+	if err != nil {
+		return
+	}
+
+	format := c.DefaultQuery("format", ExportFormatNDJSON)
+	if format != ExportFormatNDJSON && format != ExportFormatCSV {
+		badRequestResponse(c, "invalid format", errors.Wrap(errServerImport, "unsupported export format: "+format))
+		return
+	}
+
+	ctx := c.Request.Context()
+	pager := &PaginationParams{Limit: maxPaginationSize}
+
+	dbSRVs, err := r.exportServersPage(ctx, params, pager)
+	if err != nil {
+		dbErrorResponse(c, err)
+		return
+	}
+
+	exporter, err := newServerExporter(format, c.Writer)
+	if err != nil {
+		badRequestResponse(c, "invalid format", err)
+		return
+	}
+
+	c.Header("Content-Type", exporter.contentType())
+
+	status := ServerExportStatus{Status: ServerExportStatusComplete}
+
+	// once streaming has started a failure is reported by the status record
+	// that ends the export
+	status.Count, err = exportServers(exporter, c.Writer, dbSRVs, func() (models.ServerSlice, error) {
+		if pager.Cursor == "" {
+			return nil, nil
+		}
+
+		return r.exportServersPage(ctx, params, pager)
+	})
+	if err != nil {
+		_ = c.Error(err)
+
+		status.Status, status.Error = ServerExportStatusFailed, err.Error()
+	}
+
+	if err := exporter.end(status); err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.Writer.Flush()
+}
+
+// exportServers writes the pages of servers until next returns none and
+// returns the number of servers written.
+func exportServers(exporter serverExporter, w http.Flusher, dbSRVs models.ServerSlice, next func() (models.ServerSlice, error)) (int, error) {
+	var err error
+
+	count := 0
+
+	for len(dbSRVs) > 0 {
+		for _, dbS := range dbSRVs {
+			if err := exporter.write(dbS); err != nil {
+				return count, err
+			}
+
+			count++
+		}
+
+		if err := exporter.flush(); err != nil {
+			return count, err
+		}
+
+		w.Flush()
+
+		dbSRVs, err = next()
+		if err != nil {
+			return count, err
+		}
+	}
+
+	return count, nil
+}
+
+// exportServersPage loads the page of servers after the pager's cursor and
+// moves the cursor past it, the cursor is cleared on the last page.
+func (r *Router) exportServersPage(ctx context.Context, params ServerListParams, pager *PaginationParams) (models.ServerSlice, error) {
+	mods := params.queryMods()
+	mods = append(mods,
+		qm.Load(models.ServerRels.Attributes),
+		qm.Load(models.ServerRels.VersionedAttributes, qm.Where("(server_id, namespace, created_at) IN (select server_id, namespace, max(created_at) from versioned_attributes group by server_id, namespace)")),
+		qm.Load("ServerComponents.Attributes"),
+		qm.Load("ServerComponents.VersionedAttributes", qm.Where("(server_component_id, namespace, created_at) IN (select server_component_id, namespace, max(created_at) from versioned_attributes group by server_component_id, namespace)")),
+		qm.Load("ServerComponents.ServerComponentType"),
+	)
+
+	keysetMods, err := pager.keysetQueryMods(models.TableNames.Servers, models.ServerColumns.CreatedAt, "TIMESTAMPTZ")
+	if err != nil {
+		return nil, err
+	}
+
+	dbSRVs, err := models.Servers(append(mods, keysetMods...)...).All(ctx, r.DB)
+	if err != nil {
+		return nil, err
+	}
+
+	pager.Cursor = ""
+
+	if pager.hasNextKeysetPage(len(dbSRVs)) {
+		dbSRVs = dbSRVs[:pager.limitUsed()]
+		last := dbSRVs[len(dbSRVs)-1]
+		pager.Cursor = encodeTimeCursor(last.CreatedAt.Time, last.ID)
+	}
+
+	return dbSRVs, nil
+}
@@ -0,0 +1,146 @@
+package serverservice_test
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
+
+	"go.hollow.sh/serverservice/internal/dbtools"
+	"go.hollow.sh/serverservice/internal/models"
+	serverservice "go.hollow.sh/serverservice/pkg/api/v1"
+)
+
+func TestIntegrationServerImport(t *testing.T) {
+	s := serverTest(t)
+	s.Client.SetToken(validToken(adminScopes))
+
+	db := dbtools.DatabaseTest(t)
+
+	testFacility(t, s, "Bulk")
+
+	newID := uuid.New()
+
+	doc, err := json.Marshal(serverservice.Server{
+		UUID:         newID,
+		Name:         "imported",
+		FacilityCode: "Bulk",
+		Attributes: []serverservice.Attributes{
+			{Namespace: "sh.hollow.import", Data: json.RawMessage(`{"rack":"a1"}`)},
+		},
+		VersionedAttributes: []serverservice.VersionedAttributes{
+			{Namespace: "sh.hollow.import.status", Data: json.RawMessage(`{"state":"new"}`)},
+		},
+		Components: []serverservice.ServerComponent{
+			{Name: "Fin", Serial: "imported-fin", ComponentTypeSlug: dbtools.FixtureFinType.Slug},
+		},
+	})
+	require.NoError(t, err)
+
+	existing, err := json.Marshal(serverservice.Server{UUID: uuid.MustParse(dbtools.FixtureNemo.ID), Name: "Nemo"})
+	require.NoError(t, err)
+
+	unknownType, err := json.Marshal(serverservice.Server{
+		Name:       "unknown-type",
+		Components: []serverservice.ServerComponent{{Name: "Gill", Serial: "gill", ComponentTypeSlug: "gill"}},
+	})
+	require.NoError(t, err)
+
+	ndjson := strings.Join([]string{string(doc), "", "{not json", string(existing), string(unknownType)}, "\n")
+
+	realClientTests(t, func(ctx context.Context, authToken string, respCode int, expectError bool) error {
+		s.Client.SetToken(authToken)
+
+		report, _, err := s.Client.ImportServers(ctx, strings.NewReader(ndjson), 2)
+		if !expectError {
+			require.NoError(t, err)
+			assert.Equal(t, 1, report.Created)
+			assert.Equal(t, 3, report.Failed)
+			require.Len(t, report.Results, 4)
+
+			assert.Equal(t, 1, report.Results[0].Line)
+			assert.Equal(t, serverservice.ServerImportStatusCreated, report.Results[0].Status)
+			assert.Equal(t, newID, *report.Results[0].UUID)
+
+			assert.Equal(t, 3, report.Results[1].Line)
+			assert.Equal(t, serverservice.ServerImportStatusFailed, report.Results[1].Status)
+
+			assert.Equal(t, 4, report.Results[2].Line)
+			assert.NotEmpty(t, report.Results[2].Error)
+
+			assert.Equal(t, 5, report.Results[3].Line)
+			assert.Contains(t, report.Results[3].Error, "unknown component type")
+		}
+
+		return err
+	})
+
+	s.Client.SetToken(validToken(adminScopes))
+
+	srv, _, err := s.Client.Get(context.TODO(), newID)
+	require.NoError(t, err)
+	assert.Equal(t, "imported", srv.Name)
+	assert.Len(t, srv.Attributes, 1)
+	assert.Len(t, srv.VersionedAttributes, 1)
+	require.Len(t, srv.Components, 1)
+	assert.Equal(t, dbtools.FixtureFinType.ID, srv.Components[0].ComponentTypeID)
+
+	// the failed lines were rolled back on their own
+	count, err := models.Servers(models.ServerWhere.Name.EQ(null.StringFrom("unknown-type"))).Count(context.TODO(), db)
+	require.NoError(t, err)
+	assert.Zero(t, count)
+}
+
+func TestIntegrationServerExport(t *testing.T) {
+	s := serverTest(t)
+
+	params := &serverservice.ServerListParams{FacilityCode: "Ocean"}
+
+	realClientTests(t, func(ctx context.Context, authToken string, respCode int, expectError bool) error {
+		s.Client.SetToken(authToken)
+
+		var buf bytes.Buffer
+
+		err := s.Client.ExportServers(ctx, params, serverservice.ExportFormatNDJSON, &buf)
+		if !expectError {
+			require.NoError(t, err)
+
+			names := []string{}
+
+			scanner := bufio.NewScanner(&buf)
+			for scanner.Scan() {
+				var srv serverservice.Server
+				require.NoError(t, json.Unmarshal(scanner.Bytes(), &srv))
+				assert.Equal(t, "Ocean", srv.FacilityCode)
+
+				names = append(names, srv.Name)
+			}
+
+			assert.ElementsMatch(t, []string{dbtools.FixtureDory.Name.String, dbtools.FixtureMarlin.Name.String}, names)
+		}
+
+		return err
+	})
+
+	s.Client.SetToken(validToken(adminScopes))
+
+	var buf bytes.Buffer
+
+	require.NoError(t, s.Client.ExportServers(context.TODO(), params, serverservice.ExportFormatCSV, &buf))
+
+	rows, err := csv.NewReader(&buf).ReadAll()
+	require.NoError(t, err)
+	require.Len(t, rows, 3)
+	assert.Equal(t, []string{"uuid", "name", "facility", "created_at", "updated_at", "deleted_at"}, rows[0])
+
+	err = s.Client.ExportServers(context.TODO(), nil, "xml", &buf)
+	assert.ErrorContains(t, err, "response code: 400")
+}
@@ -0,0 +1,318 @@
+package serverservice
+
+import (
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+
+	"go.hollow.sh/serverservice/internal/models"
+)
+
+// serverLeaseEnforced rejects requests that change a leased server unless
+// they send the lease holder, and requests that send a lease holder unless the
+// holder has an active lease on the server. It's mounted after the scope
+// checks of the routes that change a server so the holder of a lease is only
+// reported to callers allowed to make the change.
+func (r *Router) serverLeaseEnforced(c *gin.Context) {
+	holder := c.GetHeader(ServerLeaseHolderHeader)
+
+	u, err := r.parseUUID(c)
+	if err != nil {
+		c.Abort()
+		return
+	}
+
+	now := time.Now()
+
+	dbL, err := models.ServerLeases(
+		models.ServerLeaseWhere.ServerID.EQ(u.String()),
+		models.ServerLeaseWhere.ExpiresAt.GT(now),
+	).One(c.Request.Context(), r.DB)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		dbErrorResponse(c, err)
+		c.Abort()
+
+		return
+	}
+
+	// servers without an active lease can be changed by anyone
+	if dbL == nil && holder == "" {
+		return
+	}
+
+	if err := checkLeaseHolder(dbL, holder, now); err != nil {
+		conflictResponse(c, "server lease required", err)
+		c.Abort()
+	}
+}
+
+func (r *Router) serverLeaseGet(c *gin.Context) {
+	srv, err := r.loadServerFromParams(c)
+	if err != nil {
+		if errors.Is(err, ErrUUIDParse) {
+			badRequestResponse(c, "", err)
+			return
+		}
+
+		dbErrorResponse(c, err)
+
+		return
+	}
+
+	// an expired lease is reported as missing, the reaper removes it later
+	dbL, err := models.ServerLeases(
+		models.ServerLeaseWhere.ServerID.EQ(srv.ID),
+		models.ServerLeaseWhere.ExpiresAt.GT(time.Now()),
+	).One(c.Request.Context(), r.DB)
+	if err != nil {
+		dbErrorResponse(c, err)
+		return
+	}
+
+	var lease ServerLease
+	if err := lease.fromDBModel(dbL); err != nil {
+		failedConvertingToVersioned(c, err)
+		return
+	}
+
+	itemResponse(c, lease)
+}
+
+// serverLeaseAcquire gives the holder a lease on the server unless another
+// holder has an active one. An expired lease is replaced and the holder of an
+// active lease renews it. The server is locked so concurrent requests are
+// applied one after the other.
+func (r *Router) serverLeaseAcquire(c *gin.Context) {
+	u, err := r.parseUUID(c)
+	if err != nil {
+		return
+	}
+
+	var l ServerLease
+	if err := c.ShouldBindJSON(&l); err != nil {
+		badRequestResponse(c, "invalid payload: ServerLease{}", err)
+		return
+	}
+
+	ctx := c.Request.Context()
+
+	tx, err := r.DB.BeginTx(ctx, nil)
+	if err != nil {
+		dbErrorResponse(c, err)
+		return
+	}
+
+	// rollback is a no-op when the transaction is successful
+	// nolint:errcheck // TODO(joel): log error
+	defer tx.Rollback()
+
+	srv, err := models.Servers(models.ServerWhere.ID.EQ(u.String()), qm.For("UPDATE")).One(ctx, tx)
+	if err != nil {
+		dbErrorResponse(c, err)
+		return
+	}
+
+	dbL, err := models.ServerLeases(models.ServerLeaseWhere.ServerID.EQ(srv.ID)).One(ctx, tx)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		dbErrorResponse(c, err)
+		return
+	}
+
+	now := time.Now()
+
+	err = checkLeaseHolder(dbL, l.Holder, now)
+	if errors.Is(err, errLeaseHeld) {
+		conflictResponse(c, "server is leased", err)
+		return
+	}
+
+	if err == nil {
+		// renewals aren't audited, only the holder changing is
+		dbL.ExpiresAt = now.Add(l.ttl())
+
+		if _, err := dbL.Update(ctx, tx, boil.Whitelist(models.ServerLeaseColumns.ExpiresAt, models.ServerLeaseColumns.UpdatedAt)); err != nil {
+			dbErrorResponse(c, err)
+			return
+		}
+	} else {
+		before := dbL
+
+		if before != nil {
+			if _, err := before.Delete(ctx, tx); err != nil {
+				dbErrorResponse(c, err)
+				return
+			}
+		}
+
+		dbL = &models.ServerLease{
+			ServerID:  srv.ID,
+			Holder:    l.Holder,
+			ExpiresAt: now.Add(l.ttl()),
+		}
+
+		if err := dbL.Insert(ctx, tx, boil.Infer()); err != nil {
+			dbErrorResponse(c, err)
+			return
+		}
+
+		if err := r.audit(c, tx, auditEntry{
+			action:       AuditActionCreate,
+			resourceType: AuditResourceServerLease,
+			resourceID:   dbL.ID,
+			serverID:     srv.ID,
+			before:       before,
+			after:        dbL,
+		}); err != nil {
+			dbErrorResponse(c, err)
+			return
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		dbErrorResponse(c, err)
+		return
+	}
+
+	var lease ServerLease
+	if err := lease.fromDBModel(dbL); err != nil {
+		failedConvertingToVersioned(c, err)
+		return
+	}
+
+	itemResponse(c, lease)
+}
+
+// serverLeaseRenew extends the lease of the holder, it fails once the lease
+// expired as another holder may have acquired the server since
+func (r *Router) serverLeaseRenew(c *gin.Context) {
+	srv, err := r.loadServerFromParams(c)
+	if err != nil {
+		if errors.Is(err, ErrUUIDParse) {
+			badRequestResponse(c, "", err)
+			return
+		}
+
+		dbErrorResponse(c, err)
+
+		return
+	}
+
+	var l ServerLease
+	if err := c.ShouldBindJSON(&l); err != nil {
+		badRequestResponse(c, "invalid payload: ServerLease{}", err)
+		return
+	}
+
+	ctx := c.Request.Context()
+
+	tx, err := r.DB.BeginTx(ctx, nil)
+	if err != nil {
+		dbErrorResponse(c, err)
+		return
+	}
+
+	// rollback is a no-op when the transaction is successful
+	// nolint:errcheck // TODO(joel): log error
+	defer tx.Rollback()
+
+	dbL, err := models.ServerLeases(models.ServerLeaseWhere.ServerID.EQ(srv.ID), qm.For("UPDATE")).One(ctx, tx)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		dbErrorResponse(c, err)
+		return
+	}
+
+	now := time.Now()
+
+	if err := checkLeaseHolder(dbL, l.Holder, now); err != nil {
+		conflictResponse(c, "unable to renew server lease", err)
+		return
+	}
+
+	dbL.ExpiresAt = now.Add(l.ttl())
+
+	if _, err := dbL.Update(ctx, tx, boil.Whitelist(models.ServerLeaseColumns.ExpiresAt, models.ServerLeaseColumns.UpdatedAt)); err != nil {
+		dbErrorResponse(c, err)
+		return
+	}
+
+	if err := tx.Commit(); err != nil {
+		dbErrorResponse(c, err)
+		return
+	}
+
+	var lease ServerLease
+	if err := lease.fromDBModel(dbL); err != nil {
+		failedConvertingToVersioned(c, err)
+		return
+	}
+
+	itemResponse(c, lease)
+}
+
+// serverLeaseRelease removes the lease of the holder given in the
+// ServerLeaseHolderHeader, a holder may release its lease after it expired
+func (r *Router) serverLeaseRelease(c *gin.Context) {
+	srv, err := r.loadServerFromParams(c)
+	if err != nil {
+		if errors.Is(err, ErrUUIDParse) {
+			badRequestResponse(c, "", err)
+			return
+		}
+
+		dbErrorResponse(c, err)
+
+		return
+	}
+
+	holder := c.GetHeader(ServerLeaseHolderHeader)
+	if holder == "" {
+		badRequestResponse(c, "releasing a server lease requires the "+ServerLeaseHolderHeader+" header", errLeaseNotHeld)
+		return
+	}
+
+	ctx := c.Request.Context()
+
+	var dbL *models.ServerLease
+
+	err = r.auditedTx(c, func(tx boil.ContextExecutor) error {
+		var err error
+
+		dbL, err = models.ServerLeases(models.ServerLeaseWhere.ServerID.EQ(srv.ID), qm.For("UPDATE")).One(ctx, tx)
+		if err != nil {
+			return err
+		}
+
+		if dbL.Holder != holder {
+			return errors.Wrap(errLeaseNotHeld, fmt.Sprintf("the lease is held by %q", dbL.Holder))
+		}
+
+		_, err = dbL.Delete(ctx, tx)
+
+		return err
+	}, func() auditEntry {
+		return auditEntry{
+			action:       AuditActionDelete,
+			resourceType: AuditResourceServerLease,
+			resourceID:   dbL.ID,
+			serverID:     srv.ID,
+			before:       dbL,
+		}
+	})
+	if err != nil {
+		if errors.Is(err, errLeaseNotHeld) {
+			conflictResponse(c, "unable to release server lease", err)
+			return
+		}
+
+		dbErrorResponse(c, err)
+
+		return
+	}
+
+	deletedResponse(c)
+}
@@ -0,0 +1,121 @@
+package serverservice_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+
+	"go.hollow.sh/serverservice/internal/dbtools"
+	"go.hollow.sh/serverservice/internal/models"
+	serverservice "go.hollow.sh/serverservice/pkg/api/v1"
+)
+
+func TestIntegrationServerLease(t *testing.T) {
+	s := serverTest(t)
+	s.Client.SetToken(validToken(adminScopes))
+
+	ctx := context.TODO()
+	srvID := uuid.MustParse(dbtools.FixtureNemo.ID)
+
+	realClientTests(t, func(ctx context.Context, authToken string, respCode int, expectError bool) error {
+		s.Client.SetToken(authToken)
+
+		_, _, err := s.Client.AcquireServerLease(ctx, uuid.MustParse(dbtools.FixtureMarlin.ID), "inventory-collector", 0)
+
+		return err
+	})
+
+	s.Client.SetToken(validToken(adminScopes))
+
+	lease, _, err := s.Client.AcquireServerLease(ctx, srvID, "provisioner", 60)
+	require.NoError(t, err)
+	assert.Equal(t, srvID, lease.ServerUUID)
+	assert.Equal(t, "provisioner", lease.Holder)
+	assert.WithinDuration(t, time.Now().Add(time.Minute), lease.ExpiresAt, 5*time.Second)
+
+	_, _, err = s.Client.AcquireServerLease(ctx, srvID, "firmware-installer", 60)
+	assert.ErrorContains(t, err, `held by "provisioner"`)
+
+	_, _, err = s.Client.RenewServerLease(ctx, srvID, "firmware-installer", 60)
+	assert.ErrorContains(t, err, `held by "provisioner"`)
+
+	renewed, _, err := s.Client.RenewServerLease(ctx, srvID, "provisioner", 600)
+	require.NoError(t, err)
+	assert.True(t, renewed.ExpiresAt.After(lease.ExpiresAt))
+
+	// changes sent by another holder or without a holder are rejected
+	srv := *dbtools.FixtureNemo
+	update := serverservice.Server{Name: srv.Name.String, FacilityCode: srv.FacilityCode.String}
+
+	_, err = s.Client.Update(serverservice.WithServerLeaseHolder(ctx, "firmware-installer"), srvID, update)
+	assert.ErrorContains(t, err, "server lease required")
+
+	_, err = s.Client.Update(serverservice.WithServerLeaseHolder(ctx, "provisioner"), srvID, update)
+	assert.NoError(t, err)
+
+	_, err = s.Client.Update(ctx, srvID, update)
+	assert.ErrorContains(t, err, `held by "provisioner"`)
+
+	// callers without the scope to make the change aren't told the holder
+	s.Client.SetToken(validToken([]string{"read"}))
+
+	_, err = s.Client.Update(ctx, srvID, update)
+	assert.ErrorContains(t, err, "response code: 403")
+	assert.NotContains(t, err.Error(), "provisioner")
+
+	s.Client.SetToken(validToken(adminScopes))
+
+	_, err = s.Client.ReleaseServerLease(ctx, srvID, "firmware-installer")
+	assert.ErrorContains(t, err, `the lease is held by "provisioner"`)
+
+	_, err = s.Client.ReleaseServerLease(ctx, srvID, "provisioner")
+	require.NoError(t, err)
+
+	_, _, err = s.Client.GetServerLease(ctx, srvID)
+	assert.ErrorContains(t, err, "resource not found")
+
+	_, err = s.Client.Update(serverservice.WithServerLeaseHolder(ctx, "provisioner"), srvID, update)
+	assert.ErrorContains(t, err, "the server has no active lease")
+
+	_, err = s.Client.Update(ctx, srvID, update)
+	assert.NoError(t, err)
+}
+
+func TestIntegrationServerLeaseExpired(t *testing.T) {
+	s := serverTest(t)
+	s.Client.SetToken(validToken(adminScopes))
+
+	db := dbtools.DatabaseTest(t)
+	ctx := context.TODO()
+	srvID := uuid.MustParse(dbtools.FixtureDory.ID)
+
+	expired := &models.ServerLease{ServerID: srvID.String(), Holder: "provisioner", ExpiresAt: time.Now().Add(-time.Minute)}
+	require.NoError(t, expired.Insert(ctx, db, boil.Infer()))
+
+	_, _, err := s.Client.GetServerLease(ctx, srvID)
+	assert.ErrorContains(t, err, "resource not found")
+
+	_, _, err = s.Client.RenewServerLease(ctx, srvID, "provisioner", 60)
+	assert.ErrorContains(t, err, "the server has no active lease")
+
+	// another holder takes over the expired lease
+	lease, _, err := s.Client.AcquireServerLease(ctx, srvID, "firmware-installer", 0)
+	require.NoError(t, err)
+	assert.Equal(t, "firmware-installer", lease.Holder)
+
+	current, _, err := s.Client.GetServerLease(ctx, srvID)
+	require.NoError(t, err)
+	assert.Equal(t, "firmware-installer", current.Holder)
+
+	events, _, err := s.Client.ListAuditEvents(ctx, &serverservice.AuditEventListParams{
+		ResourceType: serverservice.AuditResourceServerLease,
+	})
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	assert.Equal(t, serverservice.AuditActionCreate, events[0].Action)
+}
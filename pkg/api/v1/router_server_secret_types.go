@@ -2,6 +2,7 @@ package serverservice
 
 import (
 	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
 	"github.com/volatiletech/sqlboiler/v4/boil"
 
 	"go.hollow.sh/serverservice/internal/models"
@@ -26,7 +27,10 @@ func (r *Router) serverCredentialTypesList(c *gin.Context) {
 
 	for _, dbType := range dbTypes {
 		t := ServerCredentialType{}
-		t.fromDBModel(dbType)
+		if err := t.fromDBModel(dbType); err != nil {
+			failedConvertingToVersioned(c, err)
+			return
+		}
 
 		types = append(types, t)
 	}
@@ -41,22 +45,170 @@ func (r *Router) serverCredentialTypesList(c *gin.Context) {
 }
 
 func (r *Router) serverCredentialTypesCreate(c *gin.Context) {
-	var sType models.ServerCredentialType
-	if err := c.ShouldBindJSON(&sType); err != nil {
+	var t ServerCredentialType
+	if err := c.ShouldBindJSON(&t); err != nil {
 		badRequestResponse(c, "invalid server secret type", err)
 		return
 	}
 
-	sType.Builtin = false
+	sType, err := t.toDBModel()
+	if err != nil {
+		badRequestResponse(c, "invalid server secret type", err)
+		return
+	}
 
-	if err := sType.Insert(
-		c.Request.Context(),
-		r.DB,
-		boil.Blacklist(models.ServerCredentialTypeColumns.ID),
-	); err != nil {
+	err = r.auditedTx(c, func(tx boil.ContextExecutor) error {
+		return sType.Insert(
+			c.Request.Context(),
+			tx,
+			boil.Blacklist(models.ServerCredentialTypeColumns.ID),
+		)
+	}, func() auditEntry {
+		return auditEntry{
+			action:       AuditActionCreate,
+			resourceType: AuditResourceServerCredentialType,
+			resourceID:   sType.Slug,
+			after:        sType,
+		}
+	})
+	if err != nil {
 		dbErrorResponse(c, err)
 		return
 	}
 
 	createdResponse(c, sType.Slug)
 }
+
+func (r *Router) serverCredentialTypeGet(c *gin.Context) {
+	dbT, err := models.ServerCredentialTypes(models.ServerCredentialTypeWhere.Slug.EQ(c.Param("slug"))).One(c.Request.Context(), r.DB)
+	if err != nil {
+		dbErrorResponse(c, err)
+		return
+	}
+
+	t := ServerCredentialType{}
+	if err := t.fromDBModel(dbT); err != nil {
+		failedConvertingToVersioned(c, err)
+		return
+	}
+
+	itemResponse(c, t)
+}
+
+func (r *Router) serverCredentialTypeUpdate(c *gin.Context) {
+	dbT, err := models.ServerCredentialTypes(models.ServerCredentialTypeWhere.Slug.EQ(c.Param("slug"))).One(c.Request.Context(), r.DB)
+	if err != nil {
+		dbErrorResponse(c, err)
+		return
+	}
+
+	// fields left out of the request keep their current value
+	t := ServerCredentialType{}
+	if err := t.fromDBModel(dbT); err != nil {
+		failedConvertingToVersioned(c, err)
+		return
+	}
+
+	if err := c.ShouldBindJSON(&t); err != nil {
+		badRequestResponse(c, "invalid server secret type", err)
+		return
+	}
+
+	// the slug is part of the credential scopes, so it can't be changed
+	if t.Slug != "" && t.Slug != dbT.Slug {
+		badRequestResponse(c, "invalid server secret type", errors.Wrap(errCredentialType, "slug can't be changed"))
+		return
+	}
+
+	if t.Name == "" {
+		badRequestResponse(c, "invalid server secret type", errors.Wrap(errCredentialType, "name is required"))
+		return
+	}
+
+	t.Slug = dbT.Slug
+
+	updated, err := t.toDBModel()
+	if err != nil {
+		badRequestResponse(c, "invalid server secret type", err)
+		return
+	}
+
+	before := *dbT
+
+	dbT.Name = updated.Name
+	dbT.Description = updated.Description
+	dbT.Deprecated = updated.Deprecated
+	dbT.VersionRetention = updated.VersionRetention
+	dbT.PasswordPolicy = updated.PasswordPolicy
+	dbT.RequireJustification = updated.RequireJustification
+
+	err = r.auditedTx(c, func(tx boil.ContextExecutor) error {
+		_, err := dbT.Update(c.Request.Context(), tx, boil.Infer())
+		return err
+	}, func() auditEntry {
+		return auditEntry{
+			action:       AuditActionUpdate,
+			resourceType: AuditResourceServerCredentialType,
+			resourceID:   dbT.Slug,
+			before:       &before,
+			after:        dbT,
+		}
+	})
+	if err != nil {
+		dbErrorResponse(c, err)
+		return
+	}
+
+	updatedResponse(c, dbT.Slug)
+}
+
+func (r *Router) serverCredentialTypeDelete(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	dbT, err := models.ServerCredentialTypes(models.ServerCredentialTypeWhere.Slug.EQ(c.Param("slug"))).One(ctx, r.DB)
+	if err != nil {
+		dbErrorResponse(c, err)
+		return
+	}
+
+	if dbT.Builtin {
+		badRequestResponse(c, "builtin server secret types can't be deleted", errors.Wrap(errCredentialType, dbT.Slug+" is builtin"))
+		return
+	}
+
+	// the check is made in the transaction so a credential can't be added in
+	// between
+	err = r.auditedTx(c, func(tx boil.ContextExecutor) error {
+		inUse, err := models.ServerCredentials(models.ServerCredentialWhere.ServerCredentialTypeID.EQ(dbT.ID)).Exists(ctx, tx)
+		if err != nil {
+			return err
+		}
+
+		if inUse {
+			return errors.Wrap(errCredentialType, "servers have "+dbT.Slug+" credentials, deprecate the type instead")
+		}
+
+		_, err = dbT.Delete(ctx, tx)
+
+		return err
+	}, func() auditEntry {
+		return auditEntry{
+			action:       AuditActionDelete,
+			resourceType: AuditResourceServerCredentialType,
+			resourceID:   dbT.Slug,
+			before:       dbT,
+		}
+	})
+	if err != nil {
+		if errors.Is(err, errCredentialType) {
+			conflictResponse(c, "server secret type is in use", err)
+			return
+		}
+
+		dbErrorResponse(c, err)
+
+		return
+	}
+
+	deletedResponse(c)
+}
@@ -4,9 +4,11 @@ import (
 	"context"
 	"testing"
 
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"go.hollow.sh/serverservice/internal/dbtools"
 	serverservice "go.hollow.sh/serverservice/pkg/api/v1"
 )
 
@@ -60,4 +62,115 @@ func TestIntegrationServerCredentialTypesCreate(t *testing.T) {
 		assert.Error(t, err)
 		require.Contains(t, err.Error(), "duplicate key")
 	})
+
+	t.Run("creating a type with an invalid password policy fails", func(t *testing.T) {
+		_, err := s.Client.CreateServerCredentialType(ctx, &serverservice.ServerCredentialType{
+			Name:           "Short Passwords",
+			PasswordPolicy: &serverservice.PasswordPolicy{Length: 4, Digits: true},
+		})
+		assert.Error(t, err)
+		require.Contains(t, err.Error(), "invalid password policy")
+	})
+}
+
+func TestIntegrationServerCredentialTypesUpdate(t *testing.T) {
+	s := serverTest(t)
+
+	realClientTests(t, func(ctx context.Context, authToken string, respCode int, expectError bool) error {
+		s.Client.SetToken(authToken)
+
+		_, err := s.Client.UpdateServerCredentialType(ctx, serverservice.ServerCredentialTypeBMC, &serverservice.ServerCredentialType{
+			Name:             "BMC",
+			Description:      "the BMC admin account",
+			VersionRetention: 10,
+		})
+		if !expectError {
+			require.NoError(t, err)
+
+			sType, _, err := s.Client.GetServerCredentialType(ctx, serverservice.ServerCredentialTypeBMC)
+			require.NoError(t, err)
+			assert.Equal(t, "BMC", sType.Name)
+			assert.Equal(t, "the BMC admin account", sType.Description)
+			assert.EqualValues(t, 10, sType.VersionRetention)
+			assert.True(t, sType.Builtin)
+		}
+
+		return err
+	})
+
+	ctx := context.TODO()
+	s.Client.SetToken(validToken(adminScopes))
+
+	t.Run("the slug can't be changed", func(t *testing.T) {
+		_, err := s.Client.UpdateServerCredentialType(ctx, serverservice.ServerCredentialTypeBMC, &serverservice.ServerCredentialType{Name: "BMC", Slug: "ipmi"})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "slug can't be changed")
+	})
+
+	t.Run("an invalid password policy is refused", func(t *testing.T) {
+		_, err := s.Client.UpdateServerCredentialType(ctx, serverservice.ServerCredentialTypeBMC, &serverservice.ServerCredentialType{
+			Name:           "BMC",
+			PasswordPolicy: &serverservice.PasswordPolicy{Length: 12},
+		})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid password policy")
+	})
+}
+
+func TestIntegrationServerCredentialTypesDelete(t *testing.T) {
+	s := serverTest(t)
+	s.Client.SetToken(validToken(adminScopes))
+
+	ctx := context.TODO()
+
+	t.Run("builtin types can't be deleted", func(t *testing.T) {
+		_, err := s.Client.DeleteServerCredentialType(ctx, serverservice.ServerCredentialTypeBMC)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "builtin")
+	})
+
+	t.Run("types in use can't be deleted", func(t *testing.T) {
+		_, err := s.Client.CreateServerCredentialType(ctx, &serverservice.ServerCredentialType{Name: "In Use"})
+		require.NoError(t, err)
+
+		_, err = s.Client.SetCredential(ctx, uuid.MustParse(dbtools.FixtureNemo.ID), "in-use", "root", "password")
+		require.NoError(t, err)
+
+		_, err = s.Client.DeleteServerCredentialType(ctx, "in-use")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "409")
+	})
+
+	t.Run("deprecated types can't be used for new credentials", func(t *testing.T) {
+		_, err := s.Client.UpdateServerCredentialType(ctx, "in-use", &serverservice.ServerCredentialType{Name: "In Use", Deprecated: true})
+		require.NoError(t, err)
+
+		_, err = s.Client.SetCredential(ctx, uuid.MustParse(dbtools.FixtureDory.ID), "in-use", "root", "password")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "in-use is deprecated")
+
+		// the credentials already stored can still be rotated
+		_, err = s.Client.SetCredential(ctx, uuid.MustParse(dbtools.FixtureNemo.ID), "in-use", "root", "rotated")
+		require.NoError(t, err)
+	})
+
+	_, err := s.Client.CreateServerCredentialType(ctx, &serverservice.ServerCredentialType{Name: "Unused"})
+	require.NoError(t, err)
+
+	realClientTests(t, func(ctx context.Context, authToken string, respCode int, expectError bool) error {
+		s.Client.SetToken(authToken)
+
+		_, err := s.Client.DeleteServerCredentialType(ctx, "unused")
+		if !expectError {
+			require.NoError(t, err)
+
+			_, _, err = s.Client.GetServerCredentialType(ctx, "unused")
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), "not found")
+
+			return nil
+		}
+
+		return err
+	})
 }
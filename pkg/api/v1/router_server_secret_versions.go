@@ -0,0 +1,296 @@
+package serverservice
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+
+	"go.hollow.sh/serverservice/internal/models"
+)
+
+// writeServerCredential sets the username and encrypted password of the
+// credential of the given type on a server, recording the write as a new
+// version and pruning the versions past the retention of the credential type.
+// It returns the credential as it was before the write, nil when it didn't
+// exist, along with the credential as written.
+func writeServerCredential(ctx context.Context, tx boil.ContextExecutor, credType *models.ServerCredentialType, serverID, username, ciphertext string) (*models.ServerCredential, *models.ServerCredential, error) {
+	cred, err := models.ServerCredentials(
+		models.ServerCredentialWhere.ServerID.EQ(serverID),
+		models.ServerCredentialWhere.ServerCredentialTypeID.EQ(credType.ID),
+		qm.For("UPDATE"),
+	).One(ctx, tx)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, nil, err
+	}
+
+	var before *models.ServerCredential
+
+	if cred == nil {
+		// credentials of a deprecated type can still be rotated, new ones
+		// can't be added
+		if credType.Deprecated {
+			return nil, nil, errors.Wrap(errCredentialType, credType.Slug+" is deprecated")
+		}
+
+		cred = &models.ServerCredential{
+			ServerCredentialTypeID: credType.ID,
+			ServerID:               serverID,
+			Username:               username,
+			Password:               ciphertext,
+			Version:                1,
+		}
+
+		if err := cred.Insert(ctx, tx, boil.Infer()); err != nil {
+			return nil, nil, err
+		}
+	} else {
+		prev := *cred
+		before = &prev
+
+		cred.Username = username
+		cred.Password = ciphertext
+		cred.Version++
+
+		if _, err := cred.Update(ctx, tx, boil.Whitelist(
+			models.ServerCredentialColumns.Username,
+			models.ServerCredentialColumns.Password,
+			models.ServerCredentialColumns.Version,
+			models.ServerCredentialColumns.UpdatedAt,
+		)); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	version := &models.ServerCredentialVersion{
+		ServerCredentialID: cred.ID,
+		Version:            cred.Version,
+		Username:           username,
+		Password:           ciphertext,
+	}
+
+	if err := version.Insert(ctx, tx, boil.Infer()); err != nil {
+		return nil, nil, err
+	}
+
+	// the current version is always kept, along with up to the retention
+	// count of versions before it
+	if _, err := models.ServerCredentialVersions(
+		models.ServerCredentialVersionWhere.ServerCredentialID.EQ(cred.ID),
+		models.ServerCredentialVersionWhere.Version.LT(cred.Version-credType.VersionRetention),
+	).DeleteAll(ctx, tx); err != nil {
+		return nil, nil, err
+	}
+
+	return before, cred, nil
+}
+
+// serverCredentialQueryMods returns the query mods selecting the credential
+// of a server by the slug of its credential type.
+func serverCredentialQueryMods(serverID, slug string) []qm.QueryMod {
+	return []qm.QueryMod{
+		models.ServerCredentialWhere.ServerID.EQ(serverID),
+		qm.InnerJoin(fmt.Sprintf("%s as t on t.%s = %s.%s",
+			models.TableNames.ServerCredentialTypes,
+			models.ServerCredentialTypeColumns.ID,
+			models.TableNames.ServerCredentials,
+			models.ServerCredentialColumns.ServerCredentialTypeID,
+		)),
+		qm.Where(fmt.Sprintf("t.%s=?", models.ServerCredentialTypeColumns.Slug), slug),
+	}
+}
+
+func parseCredentialVersion(c *gin.Context) (int64, error) {
+	v, err := strconv.ParseInt(c.Param("version"), 10, 64)
+	if err != nil || v < 1 {
+		badRequestResponse(c, "invalid credential version", errors.Wrap(errCredentialVersion, "version must be a positive integer: "+c.Param("version")))
+		return 0, errCredentialVersion
+	}
+
+	return v, nil
+}
+
+func (r *Router) serverCredentialVersionsList(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	dbCred, err := models.ServerCredentials(serverCredentialQueryMods(c.Param("uuid"), c.Param("slug"))...).One(ctx, r.DB)
+	if err != nil {
+		dbErrorResponse(c, err)
+		return
+	}
+
+	pager := parsePagination(c)
+
+	mods := []qm.QueryMod{models.ServerCredentialVersionWhere.ServerCredentialID.EQ(dbCred.ID)}
+
+	count, err := models.ServerCredentialVersions(mods...).Count(ctx, r.DB)
+	if err != nil {
+		dbErrorResponse(c, err)
+		return
+	}
+
+	pager.OrderBy = models.ServerCredentialVersionColumns.Version + " DESC"
+	mods = append(mods, pager.queryMods()...)
+
+	dbVersions, err := models.ServerCredentialVersions(mods...).All(ctx, r.DB)
+	if err != nil {
+		dbErrorResponse(c, err)
+		return
+	}
+
+	versions := []ServerCredentialVersion{}
+
+	// passwords are left out of the list, a single version has to be
+	// fetched to read one
+	for _, dbV := range dbVersions {
+		versions = append(versions, ServerCredentialVersion{
+			Version:   dbV.Version,
+			Username:  dbV.Username,
+			Current:   dbV.Version == dbCred.Version,
+			CreatedAt: dbV.CreatedAt,
+		})
+	}
+
+	pd := paginationData{
+		pageCount:  len(versions),
+		totalCount: count,
+		pager:      pager,
+	}
+
+	listResponse(c, versions, pd)
+}
+
+func (r *Router) serverCredentialVersionGet(c *gin.Context) {
+	version, err := parseCredentialVersion(c)
+	if err != nil {
+		return
+	}
+
+	ctx := c.Request.Context()
+
+	dbCred, err := models.ServerCredentials(append(
+		serverCredentialQueryMods(c.Param("uuid"), c.Param("slug")),
+		qm.Load(models.ServerCredentialRels.ServerCredentialType),
+	)...).One(ctx, r.DB)
+	if err != nil {
+		dbErrorResponse(c, err)
+		return
+	}
+
+	justification, ok := credentialReadJustification(c, dbCred.R.ServerCredentialType)
+	if !ok {
+		return
+	}
+
+	dbV, err := models.ServerCredentialVersions(
+		models.ServerCredentialVersionWhere.ServerCredentialID.EQ(dbCred.ID),
+		models.ServerCredentialVersionWhere.Version.EQ(version),
+	).One(ctx, r.DB)
+	if err != nil {
+		dbErrorResponse(c, err)
+		return
+	}
+
+	decryptedValue, err := r.Keyring.Decrypt(ctx, dbV.Password)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, &ServerResponse{Message: "error decrypting value", Error: err.Error()})
+		return
+	}
+
+	if err := r.recordCredentialReads(c, c.Param("slug"), justification, credentialRead{dbCred.ServerID, dbV.Username}); err != nil {
+		dbErrorResponse(c, err)
+		return
+	}
+
+	itemResponse(c, &ServerCredentialVersion{
+		Version:   dbV.Version,
+		Username:  dbV.Username,
+		Password:  decryptedValue,
+		Current:   dbV.Version == dbCred.Version,
+		CreatedAt: dbV.CreatedAt,
+	})
+}
+
+func (r *Router) serverCredentialVersionRestore(c *gin.Context) {
+	version, err := parseCredentialVersion(c)
+	if err != nil {
+		return
+	}
+
+	ctx := c.Request.Context()
+	secretSlug := c.Param("slug")
+
+	credType, err := models.ServerCredentialTypes(models.ServerCredentialTypeWhere.Slug.EQ(secretSlug)).One(ctx, r.DB)
+	if err != nil {
+		dbErrorResponse(c, err)
+		return
+	}
+
+	tx, err := r.DB.BeginTx(ctx, nil)
+	if err != nil {
+		dbErrorResponse(c, err)
+		return
+	}
+
+	// rollback is a no-op when the transaction is successful
+	// nolint:errcheck // TODO(joel): log error
+	defer tx.Rollback()
+
+	dbCred, err := models.ServerCredentials(
+		models.ServerCredentialWhere.ServerID.EQ(c.Param("uuid")),
+		models.ServerCredentialWhere.ServerCredentialTypeID.EQ(credType.ID),
+	).One(ctx, tx)
+	if err != nil {
+		dbErrorResponse(c, err)
+		return
+	}
+
+	dbV, err := models.ServerCredentialVersions(
+		models.ServerCredentialVersionWhere.ServerCredentialID.EQ(dbCred.ID),
+		models.ServerCredentialVersionWhere.Version.EQ(version),
+	).One(ctx, tx)
+	if err != nil {
+		dbErrorResponse(c, err)
+		return
+	}
+
+	// the restored value is written as a new version so the value being
+	// replaced stays available to restore in turn
+	before, _, err := writeServerCredential(ctx, tx, credType, dbCred.ServerID, dbV.Username, dbV.Password)
+	if err != nil {
+		dbErrorResponse(c, err)
+		return
+	}
+
+	if err := r.enqueueEvent(ctx, tx, SubjectServerCredentialUpdate, dbCred.ServerID, func() ([]byte, error) {
+		return NewServerCredentialMessage(dbCred.ServerID, secretSlug, dbV.Username)
+	}); err != nil {
+		dbErrorResponse(c, err)
+		return
+	}
+
+	if err := r.audit(c, tx, auditEntry{
+		action:       AuditActionUpdate,
+		resourceType: AuditResourceServerCredential,
+		resourceID:   secretSlug,
+		serverID:     dbCred.ServerID,
+		before:       &auditCredential{SecretType: secretSlug, Username: before.Username},
+		after:        &auditCredential{SecretType: secretSlug, Username: dbV.Username},
+	}); err != nil {
+		dbErrorResponse(c, err)
+		return
+	}
+
+	if err := tx.Commit(); err != nil {
+		dbErrorResponse(c, err)
+		return
+	}
+
+	updatedResponse(c, secretSlug)
+}
@@ -0,0 +1,129 @@
+package serverservice_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.hollow.sh/serverservice/internal/dbtools"
+	serverservice "go.hollow.sh/serverservice/pkg/api/v1"
+)
+
+func TestIntegrationServerCredentialVersionsList(t *testing.T) {
+	s := serverTest(t)
+
+	realClientTests(t, func(ctx context.Context, authToken string, respCode int, expectError bool) error {
+		s.Client.SetToken(authToken)
+
+		id := uuid.MustParse(dbtools.FixtureNemo.ID)
+
+		versions, _, err := s.Client.ListCredentialVersions(ctx, id, serverservice.ServerCredentialTypeBMC, nil)
+		if !expectError {
+			require.NoError(t, err)
+			require.Len(t, versions, 1)
+			assert.Equal(t, int64(1), versions[0].Version)
+			assert.True(t, versions[0].Current)
+			assert.Empty(t, versions[0].Password)
+		}
+
+		return err
+	})
+}
+
+func TestIntegrationServerCredentialVersionGet(t *testing.T) {
+	s := serverTest(t)
+
+	realClientTests(t, func(ctx context.Context, authToken string, respCode int, expectError bool) error {
+		s.Client.SetToken(authToken)
+
+		id := uuid.MustParse(dbtools.FixtureNemo.ID)
+
+		version, _, err := s.Client.GetCredentialVersion(ctx, id, serverservice.ServerCredentialTypeBMC, 1)
+		if !expectError {
+			require.NoError(t, err)
+			assert.Equal(t, "super-secret-bmc-password", version.Password)
+			assert.True(t, version.Current)
+		}
+
+		return err
+	})
+}
+
+func TestIntegrationServerCredentialVersionRestore(t *testing.T) {
+	s := serverTest(t)
+
+	realClientTests(t, func(ctx context.Context, authToken string, respCode int, expectError bool) error {
+		s.Client.SetToken(authToken)
+
+		id := uuid.MustParse(dbtools.FixtureNemo.ID)
+
+		_, err := s.Client.RestoreCredentialVersion(ctx, id, serverservice.ServerCredentialTypeBMC, 1)
+		if !expectError {
+			require.NoError(t, err)
+		}
+
+		return err
+	})
+
+	ctx := context.TODO()
+	s.Client.SetToken(validToken(adminScopes))
+
+	slug := "rotated-bmc"
+	_, err := s.Client.CreateServerCredentialType(ctx, &serverservice.ServerCredentialType{
+		Name:             "Rotated BMC",
+		Slug:             slug,
+		VersionRetention: 1,
+	})
+	require.NoError(t, err)
+
+	id := uuid.MustParse(dbtools.FixtureMarlin.ID)
+
+	for _, password := range []string{"first", "second", "third"} {
+		_, err := s.Client.SetCredential(ctx, id, slug, "root", password)
+		require.NoError(t, err)
+	}
+
+	t.Run("keeps the current version and the retention count", func(t *testing.T) {
+		versions, _, err := s.Client.ListCredentialVersions(ctx, id, slug, nil)
+		require.NoError(t, err)
+		require.Len(t, versions, 2)
+		assert.Equal(t, int64(3), versions[0].Version)
+		assert.True(t, versions[0].Current)
+		assert.Equal(t, int64(2), versions[1].Version)
+		assert.False(t, versions[1].Current)
+	})
+
+	t.Run("restores a previous version as a new version", func(t *testing.T) {
+		_, err := s.Client.RestoreCredentialVersion(ctx, id, slug, 2)
+		require.NoError(t, err)
+
+		secret, _, err := s.Client.GetCredential(ctx, id, slug)
+		require.NoError(t, err)
+		assert.Equal(t, "second", secret.Password)
+
+		versions, _, err := s.Client.ListCredentialVersions(ctx, id, slug, nil)
+		require.NoError(t, err)
+		require.Len(t, versions, 2)
+		assert.Equal(t, int64(4), versions[0].Version)
+		assert.Equal(t, int64(3), versions[1].Version)
+
+		replaced, _, err := s.Client.GetCredentialVersion(ctx, id, slug, 3)
+		require.NoError(t, err)
+		assert.Equal(t, "third", replaced.Password)
+	})
+
+	t.Run("fails for a pruned version", func(t *testing.T) {
+		_, err := s.Client.RestoreCredentialVersion(ctx, id, slug, 1)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "not found")
+	})
+
+	t.Run("fails for an invalid version", func(t *testing.T) {
+		_, _, err := s.Client.GetCredentialVersion(ctx, id, slug, 0)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid credential version")
+	})
+}
@@ -1,30 +1,24 @@
 package serverservice
 
 import (
-	"fmt"
+	"database/sql"
+	"io"
 	"net/http"
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
-	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/pkg/errors"
 	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"go.hollow.sh/toolbox/ginjwt"
 
-	"go.hollow.sh/serverservice/internal/dbtools"
 	"go.hollow.sh/serverservice/internal/models"
 )
 
 func (r *Router) serverCredentialGet(c *gin.Context) {
-	mods := []qm.QueryMod{
-		models.ServerCredentialWhere.ServerID.EQ(c.Param("uuid")),
-		qm.InnerJoin(fmt.Sprintf("%s as t on t.%s = %s.%s",
-			models.TableNames.ServerCredentialTypes,
-			models.ServerCredentialTypeColumns.ID,
-			models.TableNames.ServerCredentials,
-			models.ServerCredentialColumns.ServerCredentialTypeID,
-		)),
-		qm.Where(fmt.Sprintf("t.%s=?", models.ServerCredentialTypeColumns.Slug), c.Param("slug")),
+	mods := append(
+		serverCredentialQueryMods(c.Param("uuid"), c.Param("slug")),
 		qm.Load(models.ServerCredentialRels.ServerCredentialType),
-	}
+	)
 
 	dbS, err := models.ServerCredentials(mods...).One(c.Request.Context(), r.DB)
 	if err != nil {
@@ -32,12 +26,23 @@ func (r *Router) serverCredentialGet(c *gin.Context) {
 		return
 	}
 
-	decryptedValue, err := dbtools.Decrypt(c.Request.Context(), r.SecretsKeeper, dbS.Password)
+	justification, ok := credentialReadJustification(c, dbS.R.ServerCredentialType)
+	if !ok {
+		return
+	}
+
+	decryptedValue, err := r.Keyring.Decrypt(c.Request.Context(), dbS.Password)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, &ServerResponse{Message: "error decrypting value", Error: err.Error()})
 		return
 	}
 
+	// credentials are only handed out once the read has been recorded
+	if err := r.recordCredentialReads(c, dbS.R.ServerCredentialType.Slug, justification, credentialRead{dbS.ServerID, dbS.Username}); err != nil {
+		dbErrorResponse(c, err)
+		return
+	}
+
 	sID, err := uuid.Parse(dbS.ServerID)
 	if err != nil {
 		failedConvertingToVersioned(c, err)
@@ -56,25 +61,108 @@ func (r *Router) serverCredentialGet(c *gin.Context) {
 	itemResponse(c, secret)
 }
 
-func (r *Router) serverCredentialDelete(c *gin.Context) {
-	mods := []qm.QueryMod{
-		models.ServerCredentialWhere.ServerID.EQ(c.Param("uuid")),
-		qm.InnerJoin(fmt.Sprintf("%s as t on t.%s = %s.%s",
-			models.TableNames.ServerCredentialTypes,
-			models.ServerCredentialTypeColumns.ID,
-			models.TableNames.ServerCredentials,
-			models.ServerCredentialColumns.ServerCredentialTypeID,
-		)),
-		qm.Where(fmt.Sprintf("t.%s=?", models.ServerCredentialTypeColumns.Slug), c.Param("slug")),
+// credentialReadJustification returns the justification given for reading a
+// credential of the type. The error response is written when the type
+// requires a justification and none was given.
+func credentialReadJustification(c *gin.Context, credType *models.ServerCredentialType) (string, bool) {
+	justification := c.GetHeader(AccessJustificationHeader)
+
+	if credType.RequireJustification && justification == "" {
+		badRequestResponse(c, "reading "+credType.Slug+" credentials requires the "+AccessJustificationHeader+" header", errJustificationRequired)
+		return "", false
 	}
 
+	return justification, true
+}
+
+// credentialRead is a credential that was decrypted for the caller
+type credentialRead struct {
+	serverID string
+	username string
+}
+
+// recordCredentialReads records decrypted credentials of a type in the audit
+// log and publishes the reads along with their justification.
+func (r *Router) recordCredentialReads(c *gin.Context, slug, justification string, reads ...credentialRead) error {
+	ctx := c.Request.Context()
+
+	tx, err := r.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	// rollback is a no-op when the transaction is successful
+	// nolint:errcheck // TODO(joel): log error
+	defer tx.Rollback()
+
+	for _, read := range reads {
+		read := read
+
+		if err := r.enqueueEvent(ctx, tx, SubjectServerCredentialRead, read.serverID, func() ([]byte, error) {
+			return NewServerCredentialReadMessage(read.serverID, slug, read.username, ginjwt.GetSubject(c), justification)
+		}); err != nil {
+			return err
+		}
+
+		if err := r.audit(c, tx, auditEntry{
+			action:        AuditActionRead,
+			resourceType:  AuditResourceServerCredential,
+			resourceID:    slug,
+			serverID:      read.serverID,
+			justification: justification,
+		}); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+func (r *Router) serverCredentialDelete(c *gin.Context) {
+	mods := serverCredentialQueryMods(c.Param("uuid"), c.Param("slug"))
+
 	dbS, err := models.ServerCredentials(mods...).One(c.Request.Context(), r.DB)
 	if err != nil {
 		dbErrorResponse(c, err)
 		return
 	}
 
-	if _, err = dbS.Delete(c.Request.Context(), r.DB); err != nil {
+	ctx := c.Request.Context()
+
+	tx, err := r.DB.BeginTx(ctx, nil)
+	if err != nil {
+		dbErrorResponse(c, err)
+		return
+	}
+
+	// rollback is a no-op when the transaction is successful
+	// nolint:errcheck // TODO(joel): log error
+	defer tx.Rollback()
+
+	if _, err = dbS.Delete(ctx, tx); err != nil {
+		dbErrorResponse(c, err)
+		return
+	}
+
+	if err := r.enqueueEvent(ctx, tx, SubjectServerCredentialDelete, dbS.ServerID, func() ([]byte, error) {
+		return NewServerCredentialMessage(dbS.ServerID, c.Param("slug"), dbS.Username)
+	}); err != nil {
+		dbErrorResponse(c, err)
+		return
+	}
+
+	if err := r.audit(c, tx, auditEntry{
+		action:       AuditActionDelete,
+		resourceType: AuditResourceServerCredential,
+		resourceID:   c.Param("slug"),
+		serverID:     dbS.ServerID,
+		before:       &auditCredential{SecretType: c.Param("slug"), Username: dbS.Username},
+	}); err != nil {
+		dbErrorResponse(c, err)
+		return
+	}
+
+	if err := tx.Commit(); err != nil {
 		dbErrorResponse(c, err)
 		return
 	}
@@ -82,28 +170,104 @@ func (r *Router) serverCredentialDelete(c *gin.Context) {
 	deletedResponse(c)
 }
 
-func (r *Router) serverCredentialUpsert(c *gin.Context) {
+// loadServerCredentialType returns the server UUID and the credential type
+// of a request to write a server credential. The error response is written
+// when either doesn't exist.
+func (r *Router) loadServerCredentialType(c *gin.Context) (uuid.UUID, *models.ServerCredentialType, error) {
 	srvUUID, err := r.parseUUID(c)
 	if err != nil {
-		return
+		return uuid.Nil, nil, err
 	}
 
-	secretSlug := c.Param("slug")
-
 	exists, err := models.ServerExists(c.Request.Context(), r.DB, srvUUID.String())
 	if err != nil {
 		dbErrorResponse(c, err)
-		return
+		return uuid.Nil, nil, err
 	}
 
 	if !exists {
 		notFoundResponse(c, "server not found")
-		return
+		return uuid.Nil, nil, sql.ErrNoRows
 	}
 
-	secretType, err := models.ServerCredentialTypes(models.ServerCredentialTypeWhere.Slug.EQ(secretSlug)).One(c.Request.Context(), r.DB)
+	secretType, err := models.ServerCredentialTypes(models.ServerCredentialTypeWhere.Slug.EQ(c.Param("slug"))).One(c.Request.Context(), r.DB)
 	if err != nil {
 		dbErrorResponse(c, err)
+		return uuid.Nil, nil, err
+	}
+
+	return srvUUID, secretType, nil
+}
+
+// storeServerCredential writes the encrypted credential of the type on the
+// server along with its event and audit entry, and returns the credential as
+// written. With keepUsername an empty username keeps the username of the
+// current credential.
+func (r *Router) storeServerCredential(c *gin.Context, credType *models.ServerCredentialType, serverID, username, ciphertext string, keepUsername bool) (*models.ServerCredential, error) {
+	ctx := c.Request.Context()
+
+	tx, err := r.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// rollback is a no-op when the transaction is successful
+	// nolint:errcheck // TODO(joel): log error
+	defer tx.Rollback()
+
+	if keepUsername && username == "" {
+		current, err := models.ServerCredentials(
+			models.ServerCredentialWhere.ServerID.EQ(serverID),
+			models.ServerCredentialWhere.ServerCredentialTypeID.EQ(credType.ID),
+			qm.For("UPDATE"),
+		).One(ctx, tx)
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			return nil, err
+		}
+
+		if current != nil {
+			username = current.Username
+		}
+	}
+
+	before, cred, err := writeServerCredential(ctx, tx, credType, serverID, username, ciphertext)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := r.enqueueEvent(ctx, tx, SubjectServerCredentialUpdate, serverID, func() ([]byte, error) {
+		return NewServerCredentialMessage(serverID, credType.Slug, username)
+	}); err != nil {
+		return nil, err
+	}
+
+	entry := auditEntry{
+		action:       AuditActionCreate,
+		resourceType: AuditResourceServerCredential,
+		resourceID:   credType.Slug,
+		serverID:     serverID,
+		after:        &auditCredential{SecretType: credType.Slug, Username: username},
+	}
+
+	if before != nil {
+		entry.action = AuditActionUpdate
+		entry.before = &auditCredential{SecretType: credType.Slug, Username: before.Username}
+	}
+
+	if err := r.audit(c, tx, entry); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return cred, nil
+}
+
+func (r *Router) serverCredentialUpsert(c *gin.Context) {
+	srvUUID, secretType, err := r.loadServerCredentialType(c)
+	if err != nil {
 		return
 	}
 
@@ -113,44 +277,83 @@ func (r *Router) serverCredentialUpsert(c *gin.Context) {
 		return
 	}
 
-	encryptedValue, err := dbtools.Encrypt(c.Request.Context(), r.SecretsKeeper, newValue.Password)
+	encryptedValue, err := r.Keyring.Encrypt(c.Request.Context(), newValue.Password)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, &ServerResponse{Message: "error encrypting secret value", Error: err.Error()})
 		return
 	}
 
-	secret := models.ServerCredential{
-		ServerCredentialTypeID: secretType.ID,
-		ServerID:               srvUUID.String(),
-		Password:               encryptedValue,
-		Username:               newValue.Username,
-	}
-
-	err = secret.Upsert(
-		c.Request.Context(),
-		r.DB,
-		true,
-		// search for records by server id and type id to see if we need to update or insert
-		[]string{models.ServerCredentialColumns.ServerID, models.ServerCredentialColumns.ServerCredentialTypeID},
-		// For updates only set the new value and updated at
-		boil.Whitelist(
-			models.ServerCredentialColumns.Username,
-			models.ServerCredentialColumns.Password,
-			models.ServerCredentialColumns.UpdatedAt),
-		// For inserts set server id, type id and value
-		boil.Whitelist(
-			models.ServerCredentialColumns.ServerID,
-			models.ServerCredentialColumns.ServerCredentialTypeID,
-			models.ServerCredentialColumns.Username,
-			models.ServerCredentialColumns.Password,
-			models.ServerCredentialColumns.CreatedAt,
-			models.ServerCredentialColumns.UpdatedAt,
-		),
-	)
+	if _, err := r.storeServerCredential(c, secretType, srvUUID.String(), newValue.Username, encryptedValue, false); err != nil {
+		if errors.Is(err, errCredentialType) {
+			badRequestResponse(c, "invalid server secret type", err)
+			return
+		}
+
+		dbErrorResponse(c, err)
+
+		return
+	}
+
+	updatedResponse(c, secretType.Slug)
+}
+
+func (r *Router) serverCredentialGenerate(c *gin.Context) {
+	srvUUID, secretType, err := r.loadServerCredentialType(c)
+	if err != nil {
+		return
+	}
+
+	var req serverCredentialGenerate
+	if err := c.ShouldBindJSON(&req); err != nil && !errors.Is(err, io.EOF) {
+		badRequestResponse(c, "invalid server secret generate request", err)
+		return
+	}
+
+	policy, err := passwordPolicyFromDB(secretType.PasswordPolicy)
+	if err != nil {
+		badRequestResponse(c, "invalid password policy of server secret type "+secretType.Slug, errors.Wrap(errPasswordPolicy, err.Error()))
+		return
+	}
+
+	password, err := policy.generate()
+	if err != nil {
+		if errors.Is(err, errPasswordPolicy) {
+			badRequestResponse(c, "invalid password policy of server secret type "+secretType.Slug, err)
+			return
+		}
+
+		c.JSON(http.StatusInternalServerError, &ServerResponse{Message: "error generating secret value", Error: err.Error()})
+
+		return
+	}
+
+	encryptedValue, err := r.Keyring.Encrypt(c.Request.Context(), password)
 	if err != nil {
+		c.JSON(http.StatusInternalServerError, &ServerResponse{Message: "error encrypting secret value", Error: err.Error()})
+		return
+	}
+
+	// rotations keep the username of the credential unless a new one is given
+	cred, err := r.storeServerCredential(c, secretType, srvUUID.String(), req.Username, encryptedValue, true)
+	if err != nil {
+		if errors.Is(err, errCredentialType) {
+			badRequestResponse(c, "invalid server secret type", err)
+			return
+		}
+
 		dbErrorResponse(c, err)
+
 		return
 	}
 
-	updatedResponse(c, secretSlug)
+	// the generated password is only ever returned here, later reads go
+	// through the audited credential endpoints
+	itemResponse(c, &ServerCredential{
+		ServerID:   srvUUID,
+		SecretType: secretType.Slug,
+		Username:   cred.Username,
+		Password:   password,
+		CreatedAt:  cred.CreatedAt,
+		UpdatedAt:  cred.UpdatedAt,
+	})
 }
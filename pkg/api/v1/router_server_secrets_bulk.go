@@ -0,0 +1,250 @@
+package serverservice
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+
+	"go.hollow.sh/serverservice/internal/models"
+)
+
+// Errors reported for the servers of a bulk credential request
+const (
+	credentialResultServerNotFound = "server not found"
+	credentialResultNotFound       = "credential not found"
+	credentialResultDecryptFailed  = "error decrypting value"
+)
+
+// serverIDBatches returns the next batch of server IDs of a bulk credential
+// request, an empty batch means there are no more.
+type serverIDBatches func(ctx context.Context) ([]string, error)
+
+func (r *Router) serverCredentialsBulkGet(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	credType, err := models.ServerCredentialTypes(models.ServerCredentialTypeWhere.Slug.EQ(c.Param("slug"))).One(ctx, r.DB)
+	if err != nil {
+		dbErrorResponse(c, err)
+		return
+	}
+
+	justification, ok := credentialReadJustification(c, credType)
+	if !ok {
+		return
+	}
+
+	var req ServerCredentialsRequest
+	if err := c.ShouldBindJSON(&req); err != nil && !errors.Is(err, io.EOF) {
+		badRequestResponse(c, "invalid server credentials request", err)
+		return
+	}
+
+	var next serverIDBatches
+
+	if len(req.ServerIDs) > 0 {
+		next = serverIDListBatches(req.ServerIDs)
+	} else {
+		params, err := r.serverListParams(c)
+		if err != nil {
+			return
+		}
+
+		next = r.serverListBatches(params)
+	}
+
+	results, err := r.nextServerCredentialsBatch(c, credType, justification, next)
+	if err != nil {
+		dbErrorResponse(c, err)
+		return
+	}
+
+	c.Header("Content-Type", "application/x-ndjson")
+
+	enc := json.NewEncoder(c.Writer)
+	status := ServerCredentialsStatus{Status: ServerCredentialsStatusComplete}
+
+	// once streaming has started a failure is reported by the status line
+	// that ends the response
+	for len(results) > 0 {
+		for _, result := range results {
+			if err := enc.Encode(result); err != nil {
+				_ = c.Error(err)
+				return
+			}
+
+			status.Count++
+		}
+
+		c.Writer.Flush()
+
+		results, err = r.nextServerCredentialsBatch(c, credType, justification, next)
+		if err != nil {
+			_ = c.Error(err)
+
+			status.Status, status.Error = ServerCredentialsStatusFailed, err.Error()
+
+			break
+		}
+	}
+
+	if err := enc.Encode(status); err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.Writer.Flush()
+}
+
+// nextServerCredentialsBatch returns the credentials of the next batch of
+// servers, none once there are no more servers.
+func (r *Router) nextServerCredentialsBatch(c *gin.Context, credType *models.ServerCredentialType, justification string, next serverIDBatches) ([]ServerCredentialResult, error) {
+	ids, err := next(c.Request.Context())
+	if err != nil || len(ids) == 0 {
+		return nil, err
+	}
+
+	return r.serverCredentialsBatch(c, credType, justification, ids)
+}
+
+// serverIDListBatches splits the server IDs given in a request in batches.
+func serverIDListBatches(serverIDs []uuid.UUID) serverIDBatches {
+	return func(context.Context) ([]string, error) {
+		n := len(serverIDs)
+		if n > maxPaginationSize {
+			n = maxPaginationSize
+		}
+
+		ids := make([]string, 0, n)
+		for _, id := range serverIDs[:n] {
+			ids = append(ids, id.String())
+		}
+
+		serverIDs = serverIDs[n:]
+
+		return ids, nil
+	}
+}
+
+// serverListBatches pages through the IDs of the servers matching params.
+func (r *Router) serverListBatches(params ServerListParams) serverIDBatches {
+	pager := &PaginationParams{Limit: maxPaginationSize}
+	done := false
+
+	return func(ctx context.Context) ([]string, error) {
+		if done {
+			return nil, nil
+		}
+
+		keysetMods, err := pager.keysetQueryMods(models.TableNames.Servers, models.ServerColumns.CreatedAt, "TIMESTAMPTZ")
+		if err != nil {
+			return nil, err
+		}
+
+		mods := append(params.queryMods(), keysetMods...)
+		mods = append(mods, qm.Select(
+			models.TableNames.Servers+"."+models.ServerColumns.ID,
+			models.TableNames.Servers+"."+models.ServerColumns.CreatedAt,
+		))
+
+		dbSRVs, err := models.Servers(mods...).All(ctx, r.DB)
+		if err != nil {
+			return nil, err
+		}
+
+		if pager.hasNextKeysetPage(len(dbSRVs)) {
+			dbSRVs = dbSRVs[:pager.limitUsed()]
+			last := dbSRVs[len(dbSRVs)-1]
+			pager.Cursor = encodeTimeCursor(last.CreatedAt.Time, last.ID)
+		} else {
+			done = true
+		}
+
+		ids := make([]string, 0, len(dbSRVs))
+		for _, dbS := range dbSRVs {
+			ids = append(ids, dbS.ID)
+		}
+
+		return ids, nil
+	}
+}
+
+// serverCredentialsBatch returns the credentials of the type for the servers,
+// in the order of ids. The credentials returned are recorded as read before
+// the results are handed back.
+func (r *Router) serverCredentialsBatch(c *gin.Context, credType *models.ServerCredentialType, justification string, ids []string) ([]ServerCredentialResult, error) {
+	ctx := c.Request.Context()
+
+	dbSRVs, err := models.Servers(
+		models.ServerWhere.ID.IN(ids),
+		qm.Select(models.ServerColumns.ID),
+	).All(ctx, r.DB)
+	if err != nil {
+		return nil, err
+	}
+
+	servers := map[string]bool{}
+	for _, dbS := range dbSRVs {
+		servers[dbS.ID] = true
+	}
+
+	dbCreds, err := models.ServerCredentials(
+		models.ServerCredentialWhere.ServerID.IN(ids),
+		models.ServerCredentialWhere.ServerCredentialTypeID.EQ(credType.ID),
+	).All(ctx, r.DB)
+	if err != nil {
+		return nil, err
+	}
+
+	creds := map[string]*models.ServerCredential{}
+	for _, dbC := range dbCreds {
+		creds[dbC.ServerID] = dbC
+	}
+
+	results := make([]ServerCredentialResult, 0, len(ids))
+	reads := []credentialRead{}
+
+	for _, id := range ids {
+		result := ServerCredentialResult{ServerID: uuid.MustParse(id)}
+
+		dbC, ok := creds[id]
+
+		switch {
+		case !servers[id]:
+			result.Error = credentialResultServerNotFound
+		case !ok:
+			result.Error = credentialResultNotFound
+		default:
+			password, err := r.Keyring.Decrypt(ctx, dbC.Password)
+			if err != nil {
+				result.Error = credentialResultDecryptFailed
+				break
+			}
+
+			result.Credential = &ServerCredential{
+				ServerID:   result.ServerID,
+				SecretType: credType.Slug,
+				Username:   dbC.Username,
+				Password:   password,
+				CreatedAt:  dbC.CreatedAt,
+				UpdatedAt:  dbC.UpdatedAt,
+			}
+
+			reads = append(reads, credentialRead{dbC.ServerID, dbC.Username})
+		}
+
+		results = append(results, result)
+	}
+
+	if len(reads) > 0 {
+		if err := r.recordCredentialReads(c, credType.Slug, justification, reads...); err != nil {
+			return nil, err
+		}
+	}
+
+	return results, nil
+}
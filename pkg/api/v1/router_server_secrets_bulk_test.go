@@ -0,0 +1,92 @@
+package serverservice_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.hollow.sh/serverservice/internal/dbtools"
+	serverservice "go.hollow.sh/serverservice/pkg/api/v1"
+)
+
+func collectCredentials(ctx context.Context, s *integrationServer, ids []uuid.UUID, params *serverservice.ServerListParams) ([]serverservice.ServerCredentialResult, error) {
+	results := []serverservice.ServerCredentialResult{}
+
+	err := s.Client.GetCredentials(ctx, serverservice.ServerCredentialTypeBMC, ids, params, func(r serverservice.ServerCredentialResult) error {
+		results = append(results, r)
+		return nil
+	})
+
+	return results, err
+}
+
+func TestIntegrationServerCredentialsBulkGet(t *testing.T) {
+	s := serverTest(t)
+
+	nemo := uuid.MustParse(dbtools.FixtureNemo.ID)
+	marlin := uuid.MustParse(dbtools.FixtureMarlin.ID)
+	missing := uuid.New()
+
+	realClientTests(t, func(ctx context.Context, authToken string, respCode int, expectError bool) error {
+		s.Client.SetToken(authToken)
+
+		results, err := collectCredentials(ctx, s, []uuid.UUID{nemo, marlin, missing}, nil)
+		if !expectError {
+			require.NoError(t, err)
+			require.Len(t, results, 3)
+
+			assert.Equal(t, nemo, results[0].ServerID)
+			require.NotNil(t, results[0].Credential)
+			assert.Equal(t, "super-secret-bmc-password", results[0].Credential.Password)
+			assert.Empty(t, results[0].Error)
+
+			assert.Equal(t, marlin, results[1].ServerID)
+			assert.Nil(t, results[1].Credential)
+			assert.Equal(t, "credential not found", results[1].Error)
+
+			assert.Equal(t, missing, results[2].ServerID)
+			assert.Nil(t, results[2].Credential)
+			assert.Equal(t, "server not found", results[2].Error)
+		}
+
+		return err
+	})
+
+	ctx := context.TODO()
+	s.Client.SetToken(validToken(adminScopes))
+
+	t.Run("servers matching the list params", func(t *testing.T) {
+		results, err := collectCredentials(ctx, s, nil, &serverservice.ServerListParams{FacilityCode: "Sydney"})
+		require.NoError(t, err)
+		require.Len(t, results, 1)
+		assert.Equal(t, nemo, results[0].ServerID)
+		require.NotNil(t, results[0].Credential)
+	})
+
+	t.Run("no servers matching the list params", func(t *testing.T) {
+		results, err := collectCredentials(ctx, s, nil, &serverservice.ServerListParams{FacilityCode: "Atlantis"})
+		require.NoError(t, err)
+		assert.Empty(t, results)
+	})
+
+	t.Run("only returned credentials are audited", func(t *testing.T) {
+		events, _, err := s.Client.ListAuditEvents(ctx, &serverservice.AuditEventListParams{
+			ResourceType: serverservice.AuditResourceServerCredential,
+			Action:       serverservice.AuditActionRead,
+			ServerID:     marlin.String(),
+		})
+		require.NoError(t, err)
+		assert.Empty(t, events)
+	})
+
+	t.Run("fails if secret type slug not found", func(t *testing.T) {
+		err := s.Client.GetCredentials(ctx, "notfound", []uuid.UUID{nemo}, nil, func(serverservice.ServerCredentialResult) error {
+			return nil
+		})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "not found")
+	})
+}
@@ -3,13 +3,16 @@ package serverservice_test
 import (
 	"context"
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
 
 	"go.hollow.sh/serverservice/internal/dbtools"
+	"go.hollow.sh/serverservice/internal/models"
 	serverservice "go.hollow.sh/serverservice/pkg/api/v1"
 )
 
@@ -118,3 +121,158 @@ func TestIntegrationServerSecretsDelete(t *testing.T) {
 		return err
 	})
 }
+
+func TestIntegrationServerCredentialsGenerate(t *testing.T) {
+	ctx := context.TODO()
+	s := serverTest(t)
+
+	realClientTests(t, func(ctx context.Context, authToken string, respCode int, expectError bool) error {
+		s.Client.SetToken(authToken)
+
+		id := uuid.MustParse(dbtools.FixtureDory.ID)
+
+		secret, _, err := s.Client.GenerateCredential(ctx, id, serverservice.ServerCredentialTypeBMC, "root")
+		if !expectError {
+			require.NoError(t, err)
+			assert.Len(t, secret.Password, serverservice.DefaultPasswordPolicy.Length)
+			assert.Equal(t, "root", secret.Username)
+		}
+
+		return err
+	})
+
+	s.Client.SetToken(validToken(adminScopes))
+
+	t.Run("follows the policy of the type and keeps the username", func(t *testing.T) {
+		slug := "vendor-bmc"
+		_, err := s.Client.CreateServerCredentialType(ctx, &serverservice.ServerCredentialType{
+			Name:           "Vendor BMC",
+			Slug:           slug,
+			PasswordPolicy: &serverservice.PasswordPolicy{Length: 12, Lowercase: true, Digits: true, Symbols: true, ForbiddenChars: "\"'&"},
+		})
+		require.NoError(t, err)
+
+		id := uuid.MustParse(dbtools.FixtureMarlin.ID)
+
+		_, err = s.Client.SetCredential(ctx, id, slug, "admin", "client-side")
+		require.NoError(t, err)
+
+		generated, _, err := s.Client.GenerateCredential(ctx, id, slug, "")
+		require.NoError(t, err)
+		assert.Len(t, generated.Password, 12)
+		assert.False(t, strings.ContainsAny(generated.Password, "\"'&ABCDEFGHIJKLMNOPQRSTUVWXYZ"))
+		assert.Equal(t, "admin", generated.Username)
+
+		stored, _, err := s.Client.GetCredential(ctx, id, slug)
+		require.NoError(t, err)
+		assert.Equal(t, generated.Password, stored.Password)
+	})
+
+	t.Run("fails if secret type slug not found", func(t *testing.T) {
+		_, _, err := s.Client.GenerateCredential(ctx, uuid.MustParse(dbtools.FixtureMarlin.ID), "notfound", "")
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "not found")
+	})
+
+	t.Run("fails on a policy no password can follow", func(t *testing.T) {
+		db := dbtools.DatabaseTest(t)
+
+		_, err := s.Client.CreateServerCredentialType(ctx, &serverservice.ServerCredentialType{Name: "Short", Slug: "short"})
+		require.NoError(t, err)
+
+		// the API refuses such policies, they can only have been stored before
+		// the policy was validated
+		_, err = db.ExecContext(ctx, `UPDATE server_credential_types SET password_policy = '{"length": 4, "lowercase": true}' WHERE slug = 'short'`)
+		require.NoError(t, err)
+
+		_, _, err = s.Client.GenerateCredential(ctx, uuid.MustParse(dbtools.FixtureMarlin.ID), "short", "")
+		assert.ErrorContains(t, err, "response code: 400")
+		assert.ErrorContains(t, err, "invalid password policy")
+	})
+}
+
+func TestIntegrationServerCredentialsJustification(t *testing.T) {
+	s := serverTest(t)
+	s.Client.SetToken(validToken(adminScopes))
+
+	db := dbtools.DatabaseTest(t)
+	ctx := context.TODO()
+
+	slug := "break-glass"
+	_, err := s.Client.CreateServerCredentialType(ctx, &serverservice.ServerCredentialType{
+		Name:                 "Break Glass",
+		Slug:                 slug,
+		RequireJustification: true,
+	})
+	require.NoError(t, err)
+
+	srvID := uuid.MustParse(dbtools.FixtureMarlin.ID)
+
+	_, err = s.Client.SetCredential(ctx, srvID, slug, "root", "break-glass-password")
+	require.NoError(t, err)
+
+	t.Run("reads without a justification fail", func(t *testing.T) {
+		_, _, err := s.Client.GetCredential(ctx, srvID, slug)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), serverservice.AccessJustificationHeader)
+
+		_, _, err = s.Client.GetCredentialVersion(ctx, srvID, slug, 1)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), serverservice.AccessJustificationHeader)
+	})
+
+	t.Run("the justification is recorded and published", func(t *testing.T) {
+		justified := serverservice.WithAccessJustification(ctx, "INC-42 host unreachable")
+
+		secret, _, err := s.Client.GetCredential(justified, srvID, slug)
+		require.NoError(t, err)
+		assert.Equal(t, "break-glass-password", secret.Password)
+
+		events, _, err := s.Client.ListAuditEvents(ctx, &serverservice.AuditEventListParams{
+			ServerID:     srvID.String(),
+			ResourceType: serverservice.AuditResourceServerCredential,
+			Action:       serverservice.AuditActionRead,
+		})
+		require.NoError(t, err)
+		require.Len(t, events, 1)
+		assert.Equal(t, "INC-42 host unreachable", events[0].Justification)
+
+		evts, err := models.EventOutboxes(
+			models.EventOutboxWhere.Subject.EQ(serverservice.SubjectServerCredentialRead),
+			models.EventOutboxWhere.PartitionKey.EQ(srvID.String()),
+			qm.OrderBy("seq ASC"),
+		).All(ctx, db)
+		require.NoError(t, err)
+		require.Len(t, evts, 1)
+
+		msg, err := serverservice.DeserializeServerCredential(evts[0].Payload)
+		require.NoError(t, err)
+		assert.Equal(t, slug, msg.SecretType)
+		assert.Equal(t, "test-user", msg.Actor)
+		assert.Equal(t, "INC-42 host unreachable", msg.Justification)
+	})
+}
+
+func TestIntegrationServerCredentialsSlugScopes(t *testing.T) {
+	s := serverTest(t)
+	s.Client.SetToken(validToken(adminScopes))
+
+	ctx := context.TODO()
+	srvID := uuid.MustParse(dbtools.FixtureNemo.ID)
+
+	slug := "ipmi"
+	_, err := s.Client.CreateServerCredentialType(ctx, &serverservice.ServerCredentialType{Name: "IPMI", Slug: slug})
+	require.NoError(t, err)
+
+	_, err = s.Client.SetCredential(ctx, srvID, slug, "root", "ipmi-password")
+	require.NoError(t, err)
+
+	s.Client.SetToken(validToken([]string{"read:server:credentials:bmc"}))
+
+	_, _, err = s.Client.GetCredential(ctx, srvID, serverservice.ServerCredentialTypeBMC)
+	require.NoError(t, err)
+
+	_, _, err = s.Client.GetCredential(ctx, srvID, slug)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "403")
+}
@@ -0,0 +1,192 @@
+package serverservice
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"go.hollow.sh/toolbox/ginjwt"
+
+	"go.hollow.sh/serverservice/internal/models"
+)
+
+// serverLifecycle returns the configured lifecycle or the default one
+func (r *Router) serverLifecycle() *ServerLifecycle {
+	if r.Lifecycle != nil {
+		return r.Lifecycle
+	}
+
+	return DefaultServerLifecycle()
+}
+
+func (r *Router) serverLifecycleGet(c *gin.Context) {
+	itemResponse(c, r.serverLifecycle())
+}
+
+func (r *Router) serverStateTransitionList(c *gin.Context) {
+	pager := parsePagination(c)
+
+	srv, err := r.loadServerFromParams(c)
+	if err != nil {
+		if errors.Is(err, ErrUUIDParse) {
+			badRequestResponse(c, "", err)
+			return
+		}
+
+		dbErrorResponse(c, err)
+
+		return
+	}
+
+	mods := []qm.QueryMod{models.ServerStateTransitionWhere.ServerID.EQ(srv.ID)}
+
+	count, err := models.ServerStateTransitions(mods...).Count(c.Request.Context(), r.DB)
+	if err != nil {
+		dbErrorResponse(c, err)
+		return
+	}
+
+	// add pagination
+	pager.Preload = false
+	pager.OrderBy = models.ServerStateTransitionTableColumns.CreatedAt + " DESC"
+	mods = append(mods, pager.serverQueryMods()...)
+
+	if pager.keyset() {
+		keysetMods, err := pager.keysetQueryMods(models.TableNames.ServerStateTransitions, models.ServerStateTransitionColumns.CreatedAt, "TIMESTAMPTZ")
+		if err != nil {
+			badRequestResponse(c, "", err)
+			return
+		}
+
+		mods = append(mods, keysetMods...)
+	}
+
+	dbTransitions, err := models.ServerStateTransitions(mods...).All(c.Request.Context(), r.DB)
+	if err != nil {
+		dbErrorResponse(c, err)
+		return
+	}
+
+	nextCursor := ""
+
+	if pager.keyset() && pager.hasNextKeysetPage(len(dbTransitions)) {
+		dbTransitions = dbTransitions[:pager.limitUsed()]
+		last := dbTransitions[len(dbTransitions)-1]
+		nextCursor = encodeTimeCursor(last.CreatedAt, last.ID)
+	}
+
+	transitions := make([]ServerStateTransition, 0, len(dbTransitions))
+
+	for _, dbT := range dbTransitions {
+		t := ServerStateTransition{}
+		if err := t.fromDBModel(dbT); err != nil {
+			failedConvertingToVersioned(c, err)
+			return
+		}
+
+		transitions = append(transitions, t)
+	}
+
+	pd := paginationData{
+		pageCount:  len(transitions),
+		totalCount: count,
+		pager:      pager,
+		nextCursor: nextCursor,
+	}
+
+	listResponse(c, transitions, pd)
+}
+
+// serverStateTransitionCreate moves the server to another state if the
+// lifecycle allows it. The server is locked while its state is checked so
+// concurrent transitions are applied one after the other.
+func (r *Router) serverStateTransitionCreate(c *gin.Context) {
+	u, err := r.parseUUID(c)
+	if err != nil {
+		return
+	}
+
+	var t ServerStateTransition
+	if err := c.ShouldBindJSON(&t); err != nil {
+		badRequestResponse(c, "invalid payload: ServerStateTransition{}", err)
+		return
+	}
+
+	ctx := c.Request.Context()
+
+	tx, err := r.DB.BeginTx(ctx, nil)
+	if err != nil {
+		dbErrorResponse(c, err)
+		return
+	}
+
+	// rollback is a no-op when the transaction is successful
+	// nolint:errcheck // TODO(joel): log error
+	defer tx.Rollback()
+
+	srv, err := models.Servers(models.ServerWhere.ID.EQ(u.String()), qm.For("UPDATE")).One(ctx, tx)
+	if err != nil {
+		dbErrorResponse(c, err)
+		return
+	}
+
+	if err := r.serverLifecycle().checkTransition(srv.State, t.ToState); err != nil {
+		conflictResponse(c, "invalid server state transition", err)
+		return
+	}
+
+	before := *srv
+
+	dbT := &models.ServerStateTransition{
+		ServerID:     srv.ID,
+		FromState:    srv.State,
+		ToState:      t.ToState,
+		Reason:       t.Reason,
+		ActorSubject: null.NewString(ginjwt.GetSubject(c), ginjwt.GetSubject(c) != ""),
+		ActorUser:    null.NewString(ginjwt.GetUser(c), ginjwt.GetUser(c) != ""),
+	}
+
+	srv.State = t.ToState
+
+	if _, err := srv.Update(ctx, tx, boil.Whitelist(models.ServerColumns.State, models.ServerColumns.UpdatedAt)); err != nil {
+		dbErrorResponse(c, err)
+		return
+	}
+
+	if err := dbT.Insert(ctx, tx, boil.Infer()); err != nil {
+		dbErrorResponse(c, err)
+		return
+	}
+
+	if err := r.recordHistory(ctx, tx, serverHistory(&before, srv)); err != nil {
+		dbErrorResponse(c, err)
+		return
+	}
+
+	if err := r.enqueueEvent(ctx, tx, SubjectServerStateUpdate, srv.ID, func() ([]byte, error) {
+		return NewServerStateMessage(dbT)
+	}); err != nil {
+		dbErrorResponse(c, err)
+		return
+	}
+
+	if err := r.audit(c, tx, auditEntry{
+		action:       AuditActionCreate,
+		resourceType: AuditResourceServerStateTransition,
+		resourceID:   dbT.ID,
+		serverID:     srv.ID,
+		before:       &before,
+		after:        srv,
+	}); err != nil {
+		dbErrorResponse(c, err)
+		return
+	}
+
+	if err := tx.Commit(); err != nil {
+		dbErrorResponse(c, err)
+		return
+	}
+
+	createdResponse(c, dbT.ID)
+}
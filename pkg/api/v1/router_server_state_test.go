@@ -0,0 +1,123 @@
+package serverservice_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+
+	"go.hollow.sh/serverservice/internal/dbtools"
+	"go.hollow.sh/serverservice/internal/models"
+	serverservice "go.hollow.sh/serverservice/pkg/api/v1"
+)
+
+func TestIntegrationServerStateTransitions(t *testing.T) {
+	s := serverTest(t)
+	s.Client.SetToken(validToken(adminScopes))
+
+	db := dbtools.DatabaseTest(t)
+	ctx := context.TODO()
+
+	// the fixtures predate lifecycle states and are in service
+	srvID := uuid.MustParse(dbtools.FixtureNemo.ID)
+
+	realClientTests(t, func(ctx context.Context, authToken string, respCode int, expectError bool) error {
+		s.Client.SetToken(authToken)
+
+		_, _, err := s.Client.ListServerStateTransitions(ctx, srvID, nil)
+
+		return err
+	})
+
+	s.Client.SetToken(validToken(adminScopes))
+
+	_, err := s.Client.TransitionServerState(ctx, srvID, serverservice.ServerStateMaintenance, "replacing a fan")
+	require.NoError(t, err)
+
+	var testCases = []struct {
+		testName     string
+		state        string
+		reason       string
+		errorMessage string
+	}{
+		{
+			"illegal transition",
+			serverservice.ServerStateProvisioning,
+			"reinstalling",
+			`a server can't move from "maintenance" to "provisioning"`,
+		},
+		{
+			"unknown state",
+			"retired",
+			"end of life",
+			`unknown state "retired"`,
+		},
+		{
+			"reason is required",
+			serverservice.ServerStateInService,
+			"",
+			"Field validation for 'Reason' failed on the 'required' tag",
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.testName, func(t *testing.T) {
+			_, err := s.Client.TransitionServerState(ctx, srvID, tt.state, tt.reason)
+			assert.ErrorContains(t, err, tt.errorMessage)
+		})
+	}
+
+	srv, _, err := s.Client.Get(ctx, srvID)
+	require.NoError(t, err)
+	assert.Equal(t, serverservice.ServerStateMaintenance, srv.State)
+
+	srvs, _, err := s.Client.List(ctx, &serverservice.ServerListParams{State: serverservice.ServerStateMaintenance})
+	require.NoError(t, err)
+	require.Len(t, srvs, 1)
+	assert.Equal(t, srvID, srvs[0].UUID)
+
+	_, err = s.Client.TransitionServerState(ctx, srvID, serverservice.ServerStateInService, "fan replaced")
+	require.NoError(t, err)
+
+	transitions, _, err := s.Client.ListServerStateTransitions(ctx, srvID, nil)
+	require.NoError(t, err)
+	require.Len(t, transitions, 2)
+	assert.Equal(t, serverservice.ServerStateMaintenance, transitions[0].FromState)
+	assert.Equal(t, serverservice.ServerStateInService, transitions[0].ToState)
+	assert.Equal(t, "fan replaced", transitions[0].Reason)
+	assert.Equal(t, serverservice.ServerStateInService, transitions[1].FromState)
+
+	evts, err := models.EventOutboxes(
+		models.EventOutboxWhere.Subject.EQ(serverservice.SubjectServerStateUpdate),
+		models.EventOutboxWhere.PartitionKey.EQ(srvID.String()),
+		qm.OrderBy("seq ASC"),
+	).All(ctx, db)
+	require.NoError(t, err)
+	require.Len(t, evts, 2)
+
+	msg, err := serverservice.DeserializeServerState(evts[0].Payload)
+	require.NoError(t, err)
+	assert.Equal(t, serverservice.ServerStateMaintenance, msg.ToState)
+	assert.Equal(t, "replacing a fan", msg.Reason)
+}
+
+func TestIntegrationServerCreatedInInitialState(t *testing.T) {
+	s := serverTest(t)
+	s.Client.SetToken(validToken(adminScopes))
+
+	ctx := context.TODO()
+
+	id, _, err := s.Client.Create(ctx, serverservice.Server{Name: "Squirt", FacilityCode: "Ocean"})
+	require.NoError(t, err)
+
+	srv, _, err := s.Client.Get(ctx, *id)
+	require.NoError(t, err)
+	assert.Equal(t, serverservice.ServerStateProvisioning, srv.State)
+
+	lifecycle, _, err := s.Client.GetServerLifecycle(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, serverservice.DefaultServerLifecycle(), lifecycle)
+}
@@ -10,8 +10,10 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
 
 	"go.hollow.sh/serverservice/internal/dbtools"
+	"go.hollow.sh/serverservice/internal/models"
 	serverservice "go.hollow.sh/serverservice/pkg/api/v1"
 )
 
@@ -545,6 +547,41 @@ func TestIntegrationServerListPagination(t *testing.T) {
 	assert.False(t, resp.HasNextPage())
 }
 
+func TestIntegrationServerListCursorPagination(t *testing.T) {
+	s := serverTest(t)
+	s.Client.SetToken(validToken(adminScopes))
+
+	p := &serverservice.ServerListParams{PaginationParams: &serverservice.PaginationParams{Limit: 2, Keyset: true}}
+	r, resp, err := s.Client.List(context.TODO(), p)
+
+	require.NoError(t, err)
+	assert.Len(t, r, 2)
+	assert.Equal(t, dbtools.FixtureServers[2].ID, r[0].UUID.String())
+	assert.Equal(t, dbtools.FixtureServers[1].ID, r[1].UUID.String())
+
+	assert.EqualValues(t, 1, resp.Page)
+	assert.EqualValues(t, 3, resp.TotalRecordCount)
+	require.NotNil(t, resp.Links.Next)
+	assert.Contains(t, resp.Links.Next.Href, "cursor=")
+	assert.NotContains(t, resp.Links.Next.Href, "page=")
+	assert.Nil(t, resp.Links.Previous)
+
+	resp, err = s.Client.NextPage(context.TODO(), *resp, &r)
+
+	require.NoError(t, err)
+	assert.Len(t, r, 1)
+	assert.Equal(t, dbtools.FixtureServers[0].ID, r[0].UUID.String())
+	assert.EqualValues(t, 3, resp.TotalRecordCount)
+	assert.NotNil(t, resp.Links.First)
+	assert.Nil(t, resp.Links.Previous)
+	assert.False(t, resp.HasNextPage())
+
+	p.PaginationParams.Cursor = "bogus"
+	_, _, err = s.Client.List(context.TODO(), p)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid pagination cursor")
+}
+
 func TestIntegrationServerGetPreload(t *testing.T) {
 	s := serverTest(t)
 	s.Client.SetToken(validToken(adminScopes))
@@ -593,6 +630,10 @@ func TestIntegrationServerListPreload(t *testing.T) {
 func TestIntegrationServerCreate(t *testing.T) {
 	s := serverTest(t)
 
+	s.Client.SetToken(validToken(adminScopes))
+	testFacility(t, s, "int")
+	testFacility(t, s, "int-test")
+
 	realClientTests(t, func(ctx context.Context, authToken string, respCode int, expectError bool) error {
 		s.Client.SetToken(authToken)
 
@@ -627,6 +668,14 @@ func TestIntegrationServerCreate(t *testing.T) {
 			},
 			"duplicate key",
 		},
+		{
+			"fails on an unknown facility",
+			&serverservice.Server{
+				UUID:         uuid.New(),
+				FacilityCode: "Atlantis",
+			},
+			"facility Atlantis not found",
+		},
 	}
 
 	for _, tt := range testCases {
@@ -712,6 +761,36 @@ func TestIntegrationServerUpdate(t *testing.T) {
 	})
 }
 
+func TestIntegrationServerEventsEnqueued(t *testing.T) {
+	s := serverTest(t)
+	s.Client.SetToken(validToken(adminScopes))
+
+	db := dbtools.DatabaseTest(t)
+	ctx := context.TODO()
+
+	testFacility(t, s, "int")
+
+	id, _, err := s.Client.Create(ctx, serverservice.Server{UUID: uuid.New(), Name: "outbox-server", FacilityCode: "int"})
+	require.NoError(t, err)
+
+	_, err = s.Client.Update(ctx, *id, serverservice.Server{Name: "outbox-server-renamed"})
+	require.NoError(t, err)
+
+	_, err = s.Client.Delete(ctx, serverservice.Server{UUID: *id})
+	require.NoError(t, err)
+
+	evts, err := models.EventOutboxes(models.EventOutboxWhere.PartitionKey.EQ(id.String()), qm.OrderBy("seq ASC")).All(ctx, db)
+	require.NoError(t, err)
+	require.Len(t, evts, 3)
+	assert.Equal(t, serverservice.SubjectServerCreate, evts[0].Subject)
+	assert.Equal(t, serverservice.SubjectServerUpdate, evts[1].Subject)
+	assert.Equal(t, serverservice.SubjectServerDelete, evts[2].Subject)
+
+	us, err := serverservice.DeserializeUpdateServer(evts[1].Payload)
+	require.NoError(t, err)
+	assert.Equal(t, "outbox-server-renamed", us.Name.String)
+}
+
 func TestIntegrationServerServiceCreateVersionedAttributes(t *testing.T) {
 	s := serverTest(t)
 
@@ -787,3 +866,66 @@ func TestIntegrationServerServiceListVersionedAttributes(t *testing.T) {
 		return err
 	})
 }
+
+func TestIntegrationServerListLabelSelector(t *testing.T) {
+	s := serverTest(t)
+	s.Client.SetToken(validToken(adminScopes))
+
+	ctx := context.TODO()
+
+	testFacility(t, s, "int")
+
+	create := func(name string, labels map[string]string) uuid.UUID {
+		id, _, err := s.Client.Create(ctx, serverservice.Server{UUID: uuid.New(), Name: name, FacilityCode: "int", Labels: labels})
+		require.NoError(t, err)
+
+		return *id
+	}
+
+	storage := create("storage-1", map[string]string{"env": "prod", "role": "storage"})
+	compute := create("compute-1", map[string]string{"env": "prod", "role": "compute", "quarantined": ""})
+	staging := create("storage-2", map[string]string{"env": "staging", "role": "storage"})
+
+	var testCases = []struct {
+		testName string
+		selector string
+		expected []uuid.UUID
+		errorMsg string
+	}{
+		{"equals", "env=prod", []uuid.UUID{storage, compute}, ""},
+		{"not equals", "env!=prod,role", []uuid.UUID{staging}, ""},
+		{"in and not exists", "role in (storage,compute),!quarantined", []uuid.UUID{storage, staging}, ""},
+		{"not in", "role notin (storage),env", []uuid.UUID{compute}, ""},
+		{"invalid selector", "role in (storage", nil, "unbalanced parentheses"},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.testName, func(t *testing.T) {
+			r, _, err := s.Client.List(ctx, &serverservice.ServerListParams{FacilityCode: "int", Selector: tt.selector})
+			if tt.errorMsg != "" {
+				assert.ErrorContains(t, err, tt.errorMsg)
+				return
+			}
+
+			require.NoError(t, err)
+
+			ids := []uuid.UUID{}
+			for _, srv := range r {
+				ids = append(ids, srv.UUID)
+			}
+
+			assert.ElementsMatch(t, tt.expected, ids)
+		})
+	}
+
+	// updating a server without labels leaves them as they are
+	_, err := s.Client.Update(ctx, storage, serverservice.Server{Name: "storage-1-renamed", FacilityCode: "int"})
+	require.NoError(t, err)
+
+	srv, _, err := s.Client.Get(ctx, storage)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"env": "prod", "role": "storage"}, srv.Labels)
+
+	_, err = s.Client.Update(ctx, storage, serverservice.Server{Name: "storage-1", FacilityCode: "int", Labels: map[string]string{"env": "prod!"}})
+	assert.ErrorContains(t, err, "invalid label")
+}
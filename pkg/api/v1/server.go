@@ -10,11 +10,17 @@ import (
 	"go.hollow.sh/serverservice/internal/models"
 )
 
-// Server represents a server in a facility
+// Server represents a server in a facility. Labels are flat key/value pairs
+// used to group servers, see ServerListParams, updating a server without
+// labels leaves them as they are.
 type Server struct {
-	UUID                uuid.UUID             `json:"uuid"`
-	Name                string                `json:"name"`
-	FacilityCode        string                `json:"facility"`
+	UUID         uuid.UUID `json:"uuid"`
+	Name         string    `json:"name"`
+	FacilityCode string    `json:"facility"`
+	// State is the server's lifecycle state, it's only changed by a state
+	// transition
+	State               string                `json:"state,omitempty"`
+	Labels              map[string]string     `json:"labels,omitempty"`
 	Attributes          []Attributes          `json:"attributes"`
 	Components          []ServerComponent     `json:"components"`
 	VersionedAttributes []VersionedAttributes `json:"versioned_attributes"`
@@ -37,6 +43,15 @@ func (r *Router) getServers(c *gin.Context, params ServerListParams) (models.Ser
 	params.PaginationParams.OrderBy = models.ServerTableColumns.CreatedAt + " DESC"
 	mods = append(mods, params.PaginationParams.serverQueryMods()...)
 
+	if params.PaginationParams.keyset() {
+		keysetMods, err := params.PaginationParams.keysetQueryMods(models.TableNames.Servers, models.ServerColumns.CreatedAt, "TIMESTAMPTZ")
+		if err != nil {
+			return nil, 0, err
+		}
+
+		mods = append(mods, keysetMods...)
+	}
+
 	s, err := models.Servers(mods...).All(c.Request.Context(), r.DB)
 	if err != nil {
 		return s, 0, err
@@ -55,6 +70,7 @@ func (s *Server) fromDBModel(dbS *models.Server) error {
 
 	s.Name = dbS.Name.String
 	s.FacilityCode = dbS.FacilityCode.String
+	s.State = dbS.State
 	s.CreatedAt = dbS.CreatedAt.Time
 	s.UpdatedAt = dbS.UpdatedAt.Time
 
@@ -62,6 +78,11 @@ func (s *Server) fromDBModel(dbS *models.Server) error {
 		s.DeletedAt = &dbS.DeletedAt.Time
 	}
 
+	s.Labels, err = labelsFromDBModel(dbS.Labels)
+	if err != nil {
+		return err
+	}
+
 	if dbS.R != nil {
 		if dbS.R.Attributes != nil {
 			s.Attributes, err = convertFromDBAttributes(dbS.R.Attributes)
@@ -94,6 +115,13 @@ func (s *Server) toDBModel() (*models.Server, error) {
 		FacilityCode: null.StringFrom(s.FacilityCode),
 	}
 
+	labels, err := labelsToDBModel(s.Labels)
+	if err != nil {
+		return nil, err
+	}
+
+	dbS.Labels = labels
+
 	if s.UUID.String() != uuid.Nil.String() {
 		dbS.ID = s.UUID.String()
 	}
@@ -24,6 +24,7 @@ type ServerComponent struct {
 	Vendor              string                `json:"vendor"`
 	Model               string                `json:"model"`
 	Serial              string                `json:"serial" binding:"required"`
+	Labels              map[string]string     `json:"labels,omitempty"`
 	Attributes          []Attributes          `json:"attributes"`
 	VersionedAttributes []VersionedAttributes `json:"versioned_attributes"`
 	ComponentTypeID     string                `json:"component_type_id" binding:"required"`
@@ -73,6 +74,15 @@ func (r *Router) getServerComponents(c *gin.Context, params []ServerComponentLis
 	// add pagination
 	mods = append(mods, pagination.serverComponentsQueryMods()...)
 
+	if pagination.keyset() {
+		keysetMods, err := pagination.keysetQueryMods(tableName, models.ServerComponentColumns.CreatedAt, "TIMESTAMPTZ")
+		if err != nil {
+			return nil, 0, err
+		}
+
+		mods = append(mods, keysetMods...)
+	}
+
 	sc, err := models.ServerComponents(mods...).All(c.Request.Context(), r.DB)
 	if err != nil {
 		return sc, 0, err
@@ -102,6 +112,11 @@ func (c *ServerComponent) fromDBModel(dbC *models.ServerComponent) error {
 	c.CreatedAt = dbC.CreatedAt.Time
 	c.UpdatedAt = dbC.UpdatedAt.Time
 
+	c.Labels, err = labelsFromDBModel(dbC.Labels)
+	if err != nil {
+		return err
+	}
+
 	if dbC.R != nil && dbC.R.ServerComponentType != nil {
 		c.ComponentTypeID = dbC.R.ServerComponentType.ID
 		c.ComponentTypeName = dbC.R.ServerComponentType.Name
@@ -128,7 +143,20 @@ func (c *ServerComponent) fromDBModel(dbC *models.ServerComponent) error {
 }
 
 // toDBModel converts a ServerComponent object to a model.ServerComponent object
-func (c *ServerComponent) toDBModel(serverID string) *models.ServerComponent {
+func (c *ServerComponent) toDBModel(serverID string) (*models.ServerComponent, error) {
+	serial := c.Serial
+
+	// the serial of a bmc or nic is its MAC address, it's stored in the
+	// canonical form so it matches the bom of the server
+	if c.ComponentTypeSlug == bomBMCComponentType || c.ComponentTypeSlug == bomNICComponentType {
+		serial = observedMACAddress(serial)
+	}
+
+	labels, err := labelsToDBModel(c.Labels)
+	if err != nil {
+		return nil, err
+	}
+
 	return &models.ServerComponent{
 		ID:                    c.UUID.String(),
 		ServerID:              serverID,
@@ -136,6 +164,7 @@ func (c *ServerComponent) toDBModel(serverID string) *models.ServerComponent {
 		Name:                  null.StringFrom(c.Name),
 		Vendor:                null.StringFrom(c.Vendor),
 		Model:                 null.StringFrom(c.Model),
-		Serial:                null.StringFrom(c.Serial),
-	}
+		Serial:                null.StringFrom(serial),
+		Labels:                labels,
+	}, nil
 }
@@ -0,0 +1,36 @@
+package serverservice
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// ServerComponentChanges describes how the components of a server changed
+// since a point in time. Components are matched by their component type slug
+// and serial, components without a serial are matched by their UUID.
+type ServerComponentChanges struct {
+	Since    time.Time               `json:"since"`
+	Added    []ServerComponent       `json:"added"`
+	Removed  []ServerComponent       `json:"removed"`
+	Modified []ServerComponentChange `json:"modified"`
+}
+
+// ServerComponentChange is a component that exists both before and after,
+// with the fields and versioned attributes that changed.
+type ServerComponentChange struct {
+	ComponentTypeSlug   string                      `json:"component_type_slug"`
+	Serial              string                      `json:"serial"`
+	Before              ServerComponent             `json:"before"`
+	After               ServerComponent             `json:"after"`
+	Fields              []string                    `json:"fields,omitempty"`
+	VersionedAttributes []VersionedAttributesChange `json:"versioned_attributes,omitempty"`
+}
+
+// VersionedAttributesChange is the versioned attribute data of a namespace
+// before and after a change, Before is empty when the namespace is new.
+type VersionedAttributesChange struct {
+	Namespace string          `json:"namespace"`
+	Before    json.RawMessage `json:"before,omitempty"`
+	After     json.RawMessage `json:"after,omitempty"`
+	ChangedAt *time.Time      `json:"changed_at,omitempty"`
+}
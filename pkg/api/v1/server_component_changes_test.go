@@ -0,0 +1,86 @@
+package serverservice
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/types"
+
+	"go.hollow.sh/serverservice/internal/models"
+)
+
+func testComponent(serverID, serial, vendor string, typ *models.ServerComponentType) *models.ServerComponent {
+	sc := &models.ServerComponent{
+		ID:                    uuid.NewString(),
+		ServerID:              serverID,
+		ServerComponentTypeID: typ.ID,
+		Serial:                null.StringFrom(serial),
+		Vendor:                null.StringFrom(vendor),
+	}
+
+	sc.R = sc.R.NewStruct()
+	sc.R.ServerComponentType = typ
+
+	return sc
+}
+
+func TestDiffServerComponents(t *testing.T) {
+	serverID := uuid.NewString()
+	dimm := &models.ServerComponentType{ID: uuid.NewString(), Slug: "dimm"}
+	nic := &models.ServerComponentType{ID: uuid.NewString(), Slug: "nic"}
+
+	since := time.Now().Add(-time.Hour)
+
+	kept := testComponent(serverID, "dimm-1", "acme", dimm)
+	swapped := testComponent(serverID, "dimm-2", "acme", dimm)
+	removed := testComponent(serverID, "nic-1", "acme", nic)
+
+	// dimm-2 was reported again as a new component with a different vendor
+	swappedNow := testComponent(serverID, "dimm-2", "other", dimm)
+	added := testComponent(serverID, "nic-2", "acme", nic)
+
+	versioned := componentVersionedAttributes{}
+	versioned.add(&models.VersionedAttribute{
+		ServerComponentID: null.StringFrom(kept.ID),
+		Namespace:         "firmware",
+		Data:              types.JSON(`{"version":"1.0"}`),
+		CreatedAt:         null.TimeFrom(since.Add(-time.Hour)),
+	})
+	versioned.add(&models.VersionedAttribute{
+		ServerComponentID: null.StringFrom(kept.ID),
+		Namespace:         "firmware",
+		Data:              types.JSON(`{"version":"1.1"}`),
+		CreatedAt:         null.TimeFrom(since.Add(time.Minute)),
+	})
+
+	changes, err := diffServerComponents(
+		since,
+		models.ServerComponentSlice{kept, swapped, removed},
+		models.ServerComponentSlice{kept, swappedNow, added},
+		versioned,
+	)
+	require.NoError(t, err)
+
+	require.Len(t, changes.Added, 1)
+	assert.Equal(t, "nic-2", changes.Added[0].Serial)
+
+	require.Len(t, changes.Removed, 1)
+	assert.Equal(t, "nic-1", changes.Removed[0].Serial)
+
+	require.Len(t, changes.Modified, 2)
+
+	assert.Equal(t, "dimm-1", changes.Modified[0].Serial)
+	assert.Empty(t, changes.Modified[0].Fields)
+	require.Len(t, changes.Modified[0].VersionedAttributes, 1)
+	assert.JSONEq(t, `{"version":"1.0"}`, string(changes.Modified[0].VersionedAttributes[0].Before))
+	assert.JSONEq(t, `{"version":"1.1"}`, string(changes.Modified[0].VersionedAttributes[0].After))
+
+	assert.Equal(t, "dimm-2", changes.Modified[1].Serial)
+	assert.Equal(t, []string{models.ServerComponentColumns.Vendor}, changes.Modified[1].Fields)
+	assert.Equal(t, "acme", changes.Modified[1].Before.Vendor)
+	assert.Equal(t, "other", changes.Modified[1].After.Vendor)
+}
@@ -8,16 +8,22 @@ import (
 	"github.com/volatiletech/sqlboiler/v4/queries/qm"
 )
 
-// ServerComponentListParams allows you to filter the results by server components
+// ServerComponentListParams allows you to filter the results by server
+// components. Selector is a label selector the components must match, written
+// like ServerListParams.Selector.
 type ServerComponentListParams struct {
 	Name                         string
 	Vendor                       string
 	Model                        string
 	Serial                       string
 	ServerComponentType          string
+	Selector                     string
 	AttributeListParams          []AttributeListParams
 	VersionedAttributeListParams []AttributeListParams
 	Pagination                   *PaginationParams
+
+	// labelSelector is the parsed Selector, set when reading the query
+	labelSelector []labelRequirement
 }
 
 // setQuery implements the queryParams interface
@@ -60,6 +66,8 @@ func (p *ServerComponentListParams) queryMods(tblName string) qm.QueryMod {
 		mods = append(mods, qm.Where(fmt.Sprintf("%s.slug = ?", joinTblName), p.ServerComponentType))
 	}
 
+	mods = append(mods, labelSelectorQueryMods(tblName+".labels", p.labelSelector)...)
+
 	for i, lp := range p.AttributeListParams {
 		tableName := fmt.Sprintf("%s_attr_%d", tblName, i)
 		whereStmt := fmt.Sprintf("attributes as %s on %s.server_component_id = %s.id", tableName, tableName, tblName)
@@ -102,6 +110,10 @@ func encodeServerComponentListParams(sclp []ServerComponentListParams, q url.Val
 			q.Set(keyPrefix+"[type]", sp.ServerComponentType)
 		}
 
+		if sp.Selector != "" {
+			q.Set(keyPrefix+"[selector]", sp.Selector)
+		}
+
 		encodeAttributesListParams(sp.AttributeListParams, keyPrefix+"_attr", q)
 		encodeAttributesListParams(sp.VersionedAttributeListParams, keyPrefix+"_ver_attr", q)
 	}
@@ -130,8 +142,16 @@ func parseQueryServerComponentsListParams(c *gin.Context) ([]ServerComponentList
 			Model:               queryMap["model"],
 			Serial:              queryMap["serial"],
 			ServerComponentType: queryMap["type"],
+			Selector:            queryMap["selector"],
 		}
 
+		labelSelector, err := parseLabelSelector(p.Selector)
+		if err != nil {
+			return nil, err
+		}
+
+		p.labelSelector = labelSelector
+
 		if len(aListParams) > 0 {
 			p.AttributeListParams = aListParams
 		}
@@ -113,6 +113,19 @@ func Test_parseQueryServerComponentsListParams(t *testing.T) {
 			},
 			"versioned attribute attribute query",
 		},
+		{
+			"sc_0[type]=nic&sc_0[selector]=role%20in%20(storage,compute)",
+			[]ServerComponentListParams{
+				{
+					ServerComponentType: "nic",
+					Selector:            "role in (storage,compute)",
+					labelSelector: []labelRequirement{
+						{key: "role", operator: labelOpIn, values: []string{"storage", "compute"}},
+					},
+				},
+			},
+			"map query with label selector",
+		},
 	}
 
 	setupGinCtx := func(queryParam string) *gin.Context {
@@ -1,29 +1,42 @@
 package serverservice
 
 import (
+	"github.com/pkg/errors"
+	"github.com/volatiletech/null/v8"
+
 	"go.hollow.sh/serverservice/internal/models"
 )
 
+var errComponentType = errors.New("error in server component type")
+
 // ServerComponentType provides a way to group server components by the type
 type ServerComponentType struct {
-	ID   string `json:"id"`
-	Name string `json:"name"`
-	Slug string `json:"slug"`
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Slug        string `json:"slug"`
+	Description string `json:"description,omitempty"`
+	// Deprecated marks a type that shouldn't be used for new components,
+	// the components already stored keep it
+	Deprecated bool `json:"deprecated"`
 }
 
 func (t *ServerComponentType) fromDBModel(dbT *models.ServerComponentType) error {
 	t.ID = dbT.ID
 	t.Name = dbT.Name
 	t.Slug = dbT.Slug
+	t.Description = dbT.Description.String
+	t.Deprecated = dbT.Deprecated
 
 	return nil
 }
 
 func (t *ServerComponentType) toDBModel() (*models.ServerComponentType, error) {
 	dbT := &models.ServerComponentType{
-		ID:   t.ID,
-		Name: t.Name,
-		Slug: t.Slug,
+		ID:          t.ID,
+		Name:        t.Name,
+		Slug:        t.Slug,
+		Description: null.NewString(t.Description, t.Description != ""),
+		Deprecated:  t.Deprecated,
 	}
 
 	return dbT, nil
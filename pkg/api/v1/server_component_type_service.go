@@ -2,6 +2,7 @@ package serverservice
 
 import (
 	"context"
+	"path"
 )
 
 const (
@@ -24,3 +25,27 @@ func (c *Client) ListServerComponentTypes(ctx context.Context, params *ServerCom
 
 	return *cts, &resp, nil
 }
+
+// GetServerComponentType will return the server component type with the slug
+func (c *Client) GetServerComponentType(ctx context.Context, slug string) (*ServerComponentType, *ServerResponse, error) {
+	t := &ServerComponentType{}
+	resp := ServerResponse{Record: t}
+
+	if err := c.get(ctx, path.Join(serverComponentTypeEndpoint, slug), &resp); err != nil {
+		return nil, nil, err
+	}
+
+	return t, &resp, nil
+}
+
+// UpdateServerComponentType will update the server component type with the
+// slug, its ID and slug can't be changed
+func (c *Client) UpdateServerComponentType(ctx context.Context, slug string, t ServerComponentType) (*ServerResponse, error) {
+	return c.put(ctx, path.Join(serverComponentTypeEndpoint, slug), t)
+}
+
+// DeleteServerComponentType will delete the server component type with the
+// slug, it fails while servers still have components of the type
+func (c *Client) DeleteServerComponentType(ctx context.Context, slug string) (*ServerResponse, error) {
+	return c.delete(ctx, path.Join(serverComponentTypeEndpoint, slug))
+}
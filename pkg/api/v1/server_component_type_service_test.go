@@ -41,3 +41,44 @@ func TestServerComponentTypeServiceList(t *testing.T) {
 		return err
 	})
 }
+
+func TestServerComponentTypeServiceGet(t *testing.T) {
+	mockClientTests(t, func(ctx context.Context, respCode int, expectError bool) error {
+		hct := hollow.ServerComponentType{Slug: "slug-1", Name: "unit-test-1", Description: "a test type", Deprecated: true}
+		jsonResponse, err := json.Marshal(hollow.ServerResponse{Record: hct})
+		require.Nil(t, err)
+
+		c := mockClient(string(jsonResponse), respCode)
+		res, _, err := c.GetServerComponentType(ctx, "slug-1")
+		if !expectError {
+			assert.Equal(t, hct, *res)
+		}
+
+		return err
+	})
+}
+
+func TestServerComponentTypeServiceUpdate(t *testing.T) {
+	mockClientTests(t, func(ctx context.Context, respCode int, expectError bool) error {
+		jsonResponse := json.RawMessage([]byte(`{"message": "resource updated", "slug":"slug-1"}`))
+
+		c := mockClient(string(jsonResponse), respCode)
+		resp, err := c.UpdateServerComponentType(ctx, "slug-1", hollow.ServerComponentType{Name: "renamed"})
+		if !expectError {
+			assert.Equal(t, "slug-1", resp.Slug)
+		}
+
+		return err
+	})
+}
+
+func TestServerComponentTypeServiceDelete(t *testing.T) {
+	mockClientTests(t, func(ctx context.Context, respCode int, expectError bool) error {
+		jsonResponse := json.RawMessage([]byte(`{"message": "resource deleted"}`))
+
+		c := mockClient(string(jsonResponse), respCode)
+		_, err := c.DeleteServerComponentType(ctx, "slug-1")
+
+		return err
+	})
+}
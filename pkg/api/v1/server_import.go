@@ -0,0 +1,206 @@
+package serverservice
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+
+	"go.hollow.sh/serverservice/internal/models"
+)
+
+// Formats supported by the server export
+const (
+	ExportFormatNDJSON = "ndjson"
+	ExportFormatCSV    = "csv"
+)
+
+// Statuses of a server export, given by the record that ends it
+const (
+	ServerExportStatusComplete = "complete"
+	ServerExportStatusFailed   = "failed"
+)
+
+// Statuses of a line in a server import
+const (
+	ServerImportStatusCreated = "created"
+	ServerImportStatusFailed  = "failed"
+)
+
+var (
+	// defaultImportBatchSize is the number of servers written per transaction
+	// when the import doesn't ask for a batch size
+	defaultImportBatchSize = 100
+	// maxImportLineSize is the largest server document accepted in an import
+	maxImportLineSize = 8 << 20
+
+	errServerImport = errors.New("error in server import")
+)
+
+// ServerImportResult is the outcome of importing one line of an NDJSON server
+// import, Line is numbered from 1.
+type ServerImportResult struct {
+	Line   int        `json:"line"`
+	UUID   *uuid.UUID `json:"uuid,omitempty"`
+	Status string     `json:"status"`
+	Error  string     `json:"error,omitempty"`
+}
+
+// ServerImportReport is returned by a server import with the result of each
+// non empty line
+type ServerImportReport struct {
+	Created int                  `json:"created"`
+	Failed  int                  `json:"failed"`
+	Results []ServerImportResult `json:"results"`
+}
+
+func (r *ServerImportReport) add(line int, id string, err error) {
+	result := ServerImportResult{Line: line, Status: ServerImportStatusCreated}
+
+	if u, perr := uuid.Parse(id); perr == nil {
+		result.UUID = &u
+	}
+
+	if err != nil {
+		result.Status = ServerImportStatusFailed
+		result.Error = err.Error()
+		r.Failed++
+	} else {
+		r.Created++
+	}
+
+	r.Results = append(r.Results, result)
+}
+
+// ServerExportStatus is the record that ends a server export, an export that
+// doesn't end with one was cut short. In NDJSON exports it's the last line, in
+// CSV exports the last row, starting with serverExportCSVStatusField.
+type ServerExportStatus struct {
+	Status string `json:"export_status"`
+	Count  int    `json:"count"`
+	Error  string `json:"error,omitempty"`
+}
+
+// serverExportCSVStatusField is the first field of the row that ends a CSV
+// server export
+const serverExportCSVStatusField = "#export_status"
+
+// parseServerExportStatus returns the status held by the last line of an
+// export, false when the line isn't a status record.
+func parseServerExportStatus(format string, line []byte) (*ServerExportStatus, bool) {
+	if format == ExportFormatCSV {
+		row, err := csv.NewReader(bytes.NewReader(line)).Read()
+		if err != nil || len(row) < 4 || row[0] != serverExportCSVStatusField {
+			return nil, false
+		}
+
+		count, err := strconv.Atoi(row[2])
+		if err != nil {
+			return nil, false
+		}
+
+		return &ServerExportStatus{Status: row[1], Count: count, Error: row[3]}, true
+	}
+
+	status := &ServerExportStatus{}
+	if err := json.Unmarshal(line, status); err != nil || status.Status == "" {
+		return nil, false
+	}
+
+	return status, true
+}
+
+// serverExportCSVHeader is the header row of the CSV server export, which
+// only carries the flat server fields
+var serverExportCSVHeader = []string{"uuid", "name", "facility", "created_at", "updated_at", "deleted_at"}
+
+// serverExporter writes servers in one of the export formats
+type serverExporter interface {
+	contentType() string
+	write(*models.Server) error
+	flush() error
+	end(ServerExportStatus) error
+}
+
+func newServerExporter(format string, w io.Writer) (serverExporter, error) {
+	switch format {
+	case "", ExportFormatNDJSON:
+		enc := json.NewEncoder(w)
+		enc.SetEscapeHTML(false)
+
+		return &ndjsonServerExporter{enc: enc}, nil
+	case ExportFormatCSV:
+		cw := csv.NewWriter(w)
+		if err := cw.Write(serverExportCSVHeader); err != nil {
+			return nil, err
+		}
+
+		return &csvServerExporter{w: cw}, nil
+	default:
+		return nil, errors.Wrap(errServerImport, "unsupported export format: "+format)
+	}
+}
+
+type ndjsonServerExporter struct {
+	enc *json.Encoder
+}
+
+func (e *ndjsonServerExporter) contentType() string { return "application/x-ndjson" }
+
+func (e *ndjsonServerExporter) write(dbS *models.Server) error {
+	var s Server
+	if err := s.fromDBModel(dbS); err != nil {
+		return err
+	}
+
+	return e.enc.Encode(s)
+}
+
+func (e *ndjsonServerExporter) flush() error { return nil }
+
+func (e *ndjsonServerExporter) end(status ServerExportStatus) error { return e.enc.Encode(status) }
+
+type csvServerExporter struct {
+	w *csv.Writer
+}
+
+func (e *csvServerExporter) contentType() string { return "text/csv" }
+
+func (e *csvServerExporter) write(dbS *models.Server) error {
+	deletedAt := ""
+	if !dbS.DeletedAt.IsZero() {
+		deletedAt = dbS.DeletedAt.Time.Format(time.RFC3339)
+	}
+
+	return e.w.Write([]string{
+		dbS.ID,
+		dbS.Name.String,
+		dbS.FacilityCode.String,
+		dbS.CreatedAt.Time.Format(time.RFC3339),
+		dbS.UpdatedAt.Time.Format(time.RFC3339),
+		deletedAt,
+	})
+}
+
+func (e *csvServerExporter) flush() error {
+	e.w.Flush()
+	return e.w.Error()
+}
+
+// end writes the status row, padded to the width of the header so the export
+// stays readable by CSV readers that expect rows of the same length
+func (e *csvServerExporter) end(status ServerExportStatus) error {
+	row := make([]string, len(serverExportCSVHeader))
+	row[0], row[1], row[2], row[3] = serverExportCSVStatusField, status.Status, strconv.Itoa(status.Count), status.Error
+
+	if err := e.w.Write(row); err != nil {
+		return err
+	}
+
+	return e.flush()
+}
@@ -0,0 +1,98 @@
+package serverservice
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+
+	"go.hollow.sh/serverservice/internal/models"
+)
+
+const (
+	// ServerLeaseHolderHeader identifies the holder of a server lease. Requests
+	// that change a leased server are rejected unless they send the holder of
+	// the lease, and requests that send it are rejected unless the holder has
+	// an active lease on the server. It also identifies the holder releasing a
+	// lease.
+	ServerLeaseHolderHeader = "X-Server-Lease-Holder"
+
+	// defaultLeaseTTL is how long a lease lasts when no TTL is requested
+	defaultLeaseTTL = 5 * time.Minute
+)
+
+var (
+	// errLeaseHeld is returned when another holder has an active lease
+	errLeaseHeld = errors.New("server is leased by another holder")
+	// errLeaseNotHeld is returned when the holder doesn't have an active lease
+	errLeaseNotHeld = errors.New("server lease isn't held")
+)
+
+type serverLeaseHolderKey struct{}
+
+// WithServerLeaseHolder returns a context that makes the client send the
+// holder with requests made with it, so changes to a server are only made
+// while the holder has a lease on it.
+func WithServerLeaseHolder(ctx context.Context, holder string) context.Context {
+	return context.WithValue(ctx, serverLeaseHolderKey{}, holder)
+}
+
+func serverLeaseHolder(ctx context.Context) string {
+	holder, _ := ctx.Value(serverLeaseHolderKey{}).(string)
+
+	return holder
+}
+
+// ServerLease gives a holder, usually a controller acting on the server,
+// exclusive use of a server until ExpiresAt. A lease is acquired and renewed
+// for TTLSeconds, five minutes when it isn't set and at most a day so a holder
+// that goes away without releasing its lease doesn't keep the server for long.
+type ServerLease struct {
+	ServerUUID uuid.UUID `json:"server_uuid"`
+	Holder     string    `json:"holder" binding:"required"`
+	TTLSeconds int       `json:"ttl_seconds,omitempty" binding:"omitempty,gte=1,lte=86400"`
+	ExpiresAt  time.Time `json:"expires_at"`
+	CreatedAt  time.Time `json:"created_at"`
+	UpdatedAt  time.Time `json:"updated_at"`
+}
+
+func (l *ServerLease) fromDBModel(dbL *models.ServerLease) error {
+	var err error
+
+	l.ServerUUID, err = uuid.Parse(dbL.ServerID)
+	if err != nil {
+		return err
+	}
+
+	l.Holder = dbL.Holder
+	l.ExpiresAt = dbL.ExpiresAt
+	l.CreatedAt = dbL.CreatedAt.Time
+	l.UpdatedAt = dbL.UpdatedAt.Time
+
+	return nil
+}
+
+// ttl returns how long the lease lasts from when it's acquired or renewed
+func (l *ServerLease) ttl() time.Duration {
+	if l.TTLSeconds <= 0 {
+		return defaultLeaseTTL
+	}
+
+	return time.Duration(l.TTLSeconds) * time.Second
+}
+
+// checkLeaseHolder returns an error unless the lease is active at now and held
+// by holder, lease may be nil when the server isn't leased
+func checkLeaseHolder(lease *models.ServerLease, holder string, now time.Time) error {
+	if lease == nil || !lease.ExpiresAt.After(now) {
+		return errors.Wrap(errLeaseNotHeld, "the server has no active lease")
+	}
+
+	if lease.Holder != holder {
+		return errors.Wrap(errLeaseHeld, fmt.Sprintf("held by %q until %s", lease.Holder, lease.ExpiresAt.Format(time.RFC3339)))
+	}
+
+	return nil
+}
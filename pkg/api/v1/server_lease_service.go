@@ -0,0 +1,65 @@
+package serverservice
+
+import (
+	"context"
+	"net/http"
+	"path"
+
+	"github.com/google/uuid"
+)
+
+const serverLeaseEndpoint = "lease"
+
+// GetServerLease will return the active lease on the server
+func (c *Client) GetServerLease(ctx context.Context, srvUUID uuid.UUID) (*ServerLease, *ServerResponse, error) {
+	l := &ServerLease{}
+	resp := ServerResponse{Record: l}
+
+	if err := c.get(ctx, path.Join(serversEndpoint, srvUUID.String(), serverLeaseEndpoint), &resp); err != nil {
+		return nil, nil, err
+	}
+
+	return l, &resp, nil
+}
+
+// AcquireServerLease will give the holder a lease on the server for ttlSeconds,
+// or the default TTL when it's zero. It fails while another holder has an
+// active lease, a holder acquiring its own lease renews it.
+func (c *Client) AcquireServerLease(ctx context.Context, srvUUID uuid.UUID, holder string, ttlSeconds int) (*ServerLease, *ServerResponse, error) {
+	return c.sendServerLease(ctx, http.MethodPost, srvUUID, holder, ttlSeconds)
+}
+
+// RenewServerLease will extend the lease of the holder by ttlSeconds from now,
+// it fails once the lease expired
+func (c *Client) RenewServerLease(ctx context.Context, srvUUID uuid.UUID, holder string, ttlSeconds int) (*ServerLease, *ServerResponse, error) {
+	return c.sendServerLease(ctx, http.MethodPut, srvUUID, holder, ttlSeconds)
+}
+
+// ReleaseServerLease will remove the lease of the holder on the server
+func (c *Client) ReleaseServerLease(ctx context.Context, srvUUID uuid.UUID, holder string) (*ServerResponse, error) {
+	return c.delete(WithServerLeaseHolder(ctx, holder), path.Join(serversEndpoint, srvUUID.String(), serverLeaseEndpoint))
+}
+
+func (c *Client) sendServerLease(ctx context.Context, method string, srvUUID uuid.UUID, holder string, ttlSeconds int) (*ServerLease, *ServerResponse, error) {
+	p := path.Join(serversEndpoint, srvUUID.String(), serverLeaseEndpoint)
+	body := ServerLease{Holder: holder, TTLSeconds: ttlSeconds}
+
+	newRequest := newPostRequest
+	if method == http.MethodPut {
+		newRequest = newPutRequest
+	}
+
+	request, err := newRequest(ctx, c.url, p, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	l := &ServerLease{}
+	r := ServerResponse{Record: l}
+
+	if err := c.do(request, &r); err != nil {
+		return nil, nil, err
+	}
+
+	return l, &r, nil
+}
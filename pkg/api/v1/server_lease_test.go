@@ -0,0 +1,63 @@
+package serverservice
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"go.hollow.sh/serverservice/internal/models"
+)
+
+func TestCheckLeaseHolder(t *testing.T) {
+	now := time.Now()
+
+	testCases := []struct {
+		testName string
+		lease    *models.ServerLease
+		err      error
+	}{
+		{
+			"no lease",
+			nil,
+			errLeaseNotHeld,
+		},
+		{
+			"active lease of the holder",
+			&models.ServerLease{Holder: "provisioner", ExpiresAt: now.Add(time.Minute)},
+			nil,
+		},
+		{
+			"expired lease of the holder",
+			&models.ServerLease{Holder: "provisioner", ExpiresAt: now.Add(-time.Minute)},
+			errLeaseNotHeld,
+		},
+		{
+			"active lease of another holder",
+			&models.ServerLease{Holder: "firmware-installer", ExpiresAt: now.Add(time.Minute)},
+			errLeaseHeld,
+		},
+		{
+			"expired lease of another holder",
+			&models.ServerLease{Holder: "firmware-installer", ExpiresAt: now},
+			errLeaseNotHeld,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.testName, func(t *testing.T) {
+			err := checkLeaseHolder(tt.lease, "provisioner", now)
+			if tt.err == nil {
+				assert.NoError(t, err)
+				return
+			}
+
+			assert.ErrorIs(t, err, tt.err)
+		})
+	}
+}
+
+func TestServerLeaseTTL(t *testing.T) {
+	assert.Equal(t, defaultLeaseTTL, (&ServerLease{}).ttl())
+	assert.Equal(t, 90*time.Second, (&ServerLease{TTLSeconds: 90}).ttl())
+}
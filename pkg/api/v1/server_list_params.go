@@ -10,14 +10,24 @@ import (
 	"go.hollow.sh/serverservice/internal/models"
 )
 
-// ServerListParams allows you to filter the results
+// ServerListParams allows you to filter the results. Selector is a label
+// selector the servers must match, like
+// "env=prod,role in (storage,compute),!quarantined".
 type ServerListParams struct {
 	FacilityCode                 string `form:"facility-code"`
+	RoomUUID                     string `form:"room-uuid" binding:"omitempty,uuid"`
+	RowUUID                      string `form:"row-uuid" binding:"omitempty,uuid"`
+	RackUUID                     string `form:"rack-uuid" binding:"omitempty,uuid"`
+	State                        string `form:"state"`
+	Selector                     string `form:"selector"`
 	ComponentListParams          []ServerComponentListParams
 	AttributeListParams          []AttributeListParams
 	IncludeDeleted               bool `form:"include-deleted"`
 	VersionedAttributeListParams []AttributeListParams
 	PaginationParams             *PaginationParams
+
+	// labelSelector is the parsed Selector, set when reading the query
+	labelSelector []labelRequirement
 }
 
 func (p *ServerListParams) setQuery(q url.Values) {
@@ -29,6 +39,26 @@ func (p *ServerListParams) setQuery(q url.Values) {
 		q.Set("facility-code", p.FacilityCode)
 	}
 
+	if p.RoomUUID != "" {
+		q.Set("room-uuid", p.RoomUUID)
+	}
+
+	if p.RowUUID != "" {
+		q.Set("row-uuid", p.RowUUID)
+	}
+
+	if p.RackUUID != "" {
+		q.Set("rack-uuid", p.RackUUID)
+	}
+
+	if p.State != "" {
+		q.Set("state", p.State)
+	}
+
+	if p.Selector != "" {
+		q.Set("selector", p.Selector)
+	}
+
 	if p.IncludeDeleted {
 		q.Set("include-deleted", "true")
 	}
@@ -49,6 +79,25 @@ func (p *ServerListParams) queryMods() []qm.QueryMod {
 		mods = append(mods, m)
 	}
 
+	if p.State != "" {
+		mods = append(mods, models.ServerWhere.State.EQ(p.State))
+	}
+
+	// servers in a room, row or rack are the ones placed in its racks
+	if p.RoomUUID != "" {
+		mods = append(mods, qm.Where("servers.id IN (SELECT server_placements.server_id FROM server_placements JOIN racks ON racks.id = server_placements.rack_id JOIN rack_rows ON rack_rows.id = racks.row_id WHERE rack_rows.room_id = ?)", p.RoomUUID))
+	}
+
+	if p.RowUUID != "" {
+		mods = append(mods, qm.Where("servers.id IN (SELECT server_placements.server_id FROM server_placements JOIN racks ON racks.id = server_placements.rack_id WHERE racks.row_id = ?)", p.RowUUID))
+	}
+
+	if p.RackUUID != "" {
+		mods = append(mods, qm.Where("servers.id IN (SELECT server_id FROM server_placements WHERE rack_id = ?)", p.RackUUID))
+	}
+
+	mods = append(mods, labelSelectorQueryMods(models.ServerTableColumns.Labels, p.labelSelector)...)
+
 	mods = append(mods, qm.Distinct("servers.*"))
 
 	for i, lp := range p.AttributeListParams {
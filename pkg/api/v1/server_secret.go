@@ -1,11 +1,36 @@
 package serverservice
 
 import (
+	"context"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/pkg/errors"
 )
 
+var (
+	errCredentialVersion     = errors.New("error in server credential version")
+	errJustificationRequired = errors.New("access justification required")
+)
+
+// AccessJustificationHeader is the header giving the reason a credential is
+// read, it's required by credential types with RequireJustification set.
+const AccessJustificationHeader = "X-Access-Justification"
+
+type accessJustificationKey struct{}
+
+// WithAccessJustification returns a context that makes the client send the
+// justification with requests made with it.
+func WithAccessJustification(ctx context.Context, justification string) context.Context {
+	return context.WithValue(ctx, accessJustificationKey{}, justification)
+}
+
+func accessJustification(ctx context.Context) string {
+	justification, _ := ctx.Value(accessJustificationKey{}).(string)
+
+	return justification
+}
+
 // ServerCredential provides a way to encrypt secrets about a server in the database
 type ServerCredential struct {
 	ServerID   uuid.UUID `json:"uuid,omitempty"`
@@ -20,3 +45,48 @@ type serverCredentialValues struct {
 	Password string `json:"password"`
 	Username string `json:"username"`
 }
+
+type serverCredentialGenerate struct {
+	Username string `json:"username,omitempty"`
+}
+
+// ServerCredentialVersion is a version of a server credential, every write of
+// the credential adds a version. The password is only returned when a single
+// version is fetched.
+type ServerCredentialVersion struct {
+	Version   int64     `json:"version"`
+	Username  string    `json:"username"`
+	Password  string    `json:"password,omitempty"`
+	Current   bool      `json:"current"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// ServerCredentialsRequest selects the servers to return credentials for in
+// a bulk credential request. When ServerIDs is empty the servers matching the
+// ServerListParams of the request are used.
+type ServerCredentialsRequest struct {
+	ServerIDs []uuid.UUID `json:"server_ids,omitempty"`
+}
+
+// ServerCredentialResult is a line of a bulk credential response, it holds
+// either the credential of the server or the reason it isn't returned.
+type ServerCredentialResult struct {
+	ServerID   uuid.UUID         `json:"uuid"`
+	Credential *ServerCredential `json:"credential,omitempty"`
+	Error      string            `json:"error,omitempty"`
+}
+
+// Statuses of a bulk credential response, given by the line that ends it
+const (
+	ServerCredentialsStatusComplete = "complete"
+	ServerCredentialsStatusFailed   = "failed"
+)
+
+// ServerCredentialsStatus is the last line of a bulk credential response, a
+// response that doesn't end with one was cut short. Count is the number of
+// results sent before it.
+type ServerCredentialsStatus struct {
+	Status string `json:"credentials_status"`
+	Count  int    `json:"count"`
+	Error  string `json:"error,omitempty"`
+}
@@ -0,0 +1,164 @@
+package serverservice
+
+import (
+	"crypto/rand"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/volatiletech/null/v8"
+)
+
+const (
+	passwordLowercase = "abcdefghijklmnopqrstuvwxyz"
+	passwordUppercase = "ABCDEFGHIJKLMNOPQRSTUVWXYZ"
+	passwordDigits    = "0123456789"
+	passwordSymbols   = "!#$%&()*+,-./:;<=>?@[]^_{|}~"
+
+	minPasswordLength = 8
+	maxPasswordLength = 256
+)
+
+var errPasswordPolicy = errors.New("invalid password policy")
+
+// DefaultPasswordPolicy is the policy passwords are generated with for
+// credential types that don't set one
+var DefaultPasswordPolicy = PasswordPolicy{
+	Length:    24,
+	Lowercase: true,
+	Uppercase: true,
+	Digits:    true,
+	Symbols:   true,
+}
+
+// PasswordPolicy describes the passwords the service generates for a
+// credential type. Every enabled character class is used, with at least one
+// character from each, and ForbiddenChars are never used, for example
+// characters a BMC vendor doesn't accept.
+type PasswordPolicy struct {
+	Length         int    `json:"length"`
+	Lowercase      bool   `json:"lowercase"`
+	Uppercase      bool   `json:"uppercase"`
+	Digits         bool   `json:"digits"`
+	Symbols        bool   `json:"symbols"`
+	ForbiddenChars string `json:"forbidden_chars,omitempty"`
+}
+
+// classes returns the enabled character classes without the forbidden
+// characters.
+func (p *PasswordPolicy) classes() []string {
+	classes := []string{}
+
+	for _, class := range []struct {
+		enabled bool
+		chars   string
+	}{
+		{p.Lowercase, passwordLowercase},
+		{p.Uppercase, passwordUppercase},
+		{p.Digits, passwordDigits},
+		{p.Symbols, passwordSymbols},
+	} {
+		if !class.enabled {
+			continue
+		}
+
+		classes = append(classes, strings.Map(func(r rune) rune {
+			if strings.ContainsRune(p.ForbiddenChars, r) {
+				return -1
+			}
+
+			return r
+		}, class.chars))
+	}
+
+	return classes
+}
+
+func (p *PasswordPolicy) validate() error {
+	if p.Length < minPasswordLength || p.Length > maxPasswordLength {
+		return errors.Wrap(errPasswordPolicy, fmt.Sprintf("length must be between %d and %d", minPasswordLength, maxPasswordLength))
+	}
+
+	classes := p.classes()
+	if len(classes) == 0 {
+		return errors.Wrap(errPasswordPolicy, "at least one character class must be enabled")
+	}
+
+	for _, class := range classes {
+		if class == "" {
+			return errors.Wrap(errPasswordPolicy, "forbidden_chars can't exclude a whole character class")
+		}
+	}
+
+	return nil
+}
+
+// generate returns a random password following the policy.
+func (p *PasswordPolicy) generate() (string, error) {
+	if err := p.validate(); err != nil {
+		return "", err
+	}
+
+	classes := p.classes()
+	password := make([]byte, 0, p.Length)
+
+	// one character of each class first so every class is present, the rest
+	// are drawn from all of them and the whole password is shuffled after
+	for _, class := range classes {
+		c, err := randomChar(class)
+		if err != nil {
+			return "", err
+		}
+
+		password = append(password, c)
+	}
+
+	all := strings.Join(classes, "")
+
+	for len(password) < p.Length {
+		c, err := randomChar(all)
+		if err != nil {
+			return "", err
+		}
+
+		password = append(password, c)
+	}
+
+	for i := len(password) - 1; i > 0; i-- {
+		j, err := rand.Int(rand.Reader, big.NewInt(int64(i+1)))
+		if err != nil {
+			return "", err
+		}
+
+		password[i], password[j.Int64()] = password[j.Int64()], password[i]
+	}
+
+	return string(password), nil
+}
+
+func randomChar(chars string) (byte, error) {
+	n, err := rand.Int(rand.Reader, big.NewInt(int64(len(chars))))
+	if err != nil {
+		return 0, err
+	}
+
+	return chars[n.Int64()], nil
+}
+
+// passwordPolicyFromDB returns the policy stored on a credential type, or the
+// default policy when it doesn't have one.
+func passwordPolicyFromDB(policy null.JSON) (*PasswordPolicy, error) {
+	p := DefaultPasswordPolicy
+
+	if !policy.Valid {
+		return &p, nil
+	}
+
+	if err := json.Unmarshal(policy.JSON, &p); err != nil {
+		return nil, err
+	}
+
+	return &p, nil
+}
@@ -0,0 +1,82 @@
+package serverservice
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
+)
+
+func TestPasswordPolicyGenerate(t *testing.T) {
+	testCases := []struct {
+		testName string
+		policy   PasswordPolicy
+		err      string
+	}{
+		{
+			"default policy",
+			DefaultPasswordPolicy,
+			"",
+		},
+		{
+			"forbidden characters",
+			PasswordPolicy{Length: 16, Lowercase: true, Digits: true, Symbols: true, ForbiddenChars: "!#$%&()*+,-./:;<=>?@[]^_{|}o0l1"},
+			"",
+		},
+		{
+			"too short",
+			PasswordPolicy{Length: 4, Lowercase: true},
+			"length must be between",
+		},
+		{
+			"no character class",
+			PasswordPolicy{Length: 16},
+			"at least one character class",
+		},
+		{
+			"forbidden characters exclude a class",
+			PasswordPolicy{Length: 16, Lowercase: true, Digits: true, ForbiddenChars: passwordDigits},
+			"can't exclude a whole character class",
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.testName, func(t *testing.T) {
+			password, err := tt.policy.generate()
+			if tt.err != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.err)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Len(t, password, tt.policy.Length)
+			assert.False(t, strings.ContainsAny(password, tt.policy.ForbiddenChars))
+
+			for _, class := range tt.policy.classes() {
+				assert.True(t, strings.ContainsAny(password, class), "missing a character of %q", class)
+			}
+
+			allowed := strings.Join(tt.policy.classes(), "")
+			for _, r := range password {
+				assert.True(t, strings.ContainsRune(allowed, r), "unexpected character %q", r)
+			}
+		})
+	}
+}
+
+func TestPasswordPolicyFromDB(t *testing.T) {
+	policy, err := passwordPolicyFromDB(null.JSON{})
+	require.NoError(t, err)
+	assert.Equal(t, DefaultPasswordPolicy, *policy)
+
+	policy, err = passwordPolicyFromDB(null.JSONFrom([]byte(`{"length":12,"digits":true}`)))
+	require.NoError(t, err)
+	assert.Equal(t, 12, policy.Length)
+	assert.True(t, policy.Digits)
+	// fields left out keep the default
+	assert.True(t, policy.Lowercase)
+}
@@ -3,9 +3,18 @@ package serverservice
 import (
 	"time"
 
+	"github.com/pkg/errors"
+	"github.com/volatiletech/null/v8"
+
 	"go.hollow.sh/serverservice/internal/models"
 )
 
+var errCredentialType = errors.New("error in server credential type")
+
+// defaultCredentialVersionRetention is the number of previous versions of a
+// credential kept when its type is created without a retention
+var defaultCredentialVersionRetention int64 = 5
+
 const (
 	// ServerCredentialTypeBMC returns the slug for the builtin ServerCredentialType used
 	// to store BMC passwords
@@ -15,17 +24,76 @@ const (
 // ServerCredentialType represents a type of server secret. There are some built in
 // default secret types, for example a type exists for BMC passwords.
 type ServerCredentialType struct {
-	Name      string    `json:"name"`
-	Slug      string    `json:"slug"`
-	Builtin   bool      `json:"builtin"`
-	CreatedAt time.Time `json:"created_at"`
-	UpdatedAt time.Time `json:"updated_at"`
+	Name        string `json:"name"`
+	Slug        string `json:"slug"`
+	Description string `json:"description,omitempty"`
+	Builtin     bool   `json:"builtin"`
+	// Deprecated marks a type that shouldn't be used for new credentials,
+	// the credentials already stored keep working
+	Deprecated bool `json:"deprecated"`
+	// VersionRetention is the number of previous versions of a credential of
+	// this type that are kept, the server default is used when it's left out
+	VersionRetention int64 `json:"version_retention,omitempty"`
+	// PasswordPolicy sets the passwords generated for credentials of this
+	// type, DefaultPasswordPolicy is used when it's nil
+	PasswordPolicy *PasswordPolicy `json:"password_policy,omitempty"`
+	// RequireJustification makes reads of credentials of this type fail
+	// unless the request gives a reason in the AccessJustificationHeader
+	RequireJustification bool      `json:"require_justification"`
+	CreatedAt            time.Time `json:"created_at"`
+	UpdatedAt            time.Time `json:"updated_at"`
 }
 
-func (t *ServerCredentialType) fromDBModel(dbT *models.ServerCredentialType) {
+func (t *ServerCredentialType) fromDBModel(dbT *models.ServerCredentialType) error {
 	t.Name = dbT.Name
 	t.Slug = dbT.Slug
+	t.Description = dbT.Description.String
 	t.Builtin = dbT.Builtin
+	t.Deprecated = dbT.Deprecated
+	t.VersionRetention = dbT.VersionRetention
+	t.RequireJustification = dbT.RequireJustification
 	t.CreatedAt = dbT.CreatedAt
 	t.UpdatedAt = dbT.UpdatedAt
+
+	if dbT.PasswordPolicy.Valid {
+		policy, err := passwordPolicyFromDB(dbT.PasswordPolicy)
+		if err != nil {
+			return err
+		}
+
+		t.PasswordPolicy = policy
+	}
+
+	return nil
+}
+
+func (t *ServerCredentialType) toDBModel() (*models.ServerCredentialType, error) {
+	dbT := &models.ServerCredentialType{
+		Name:                 t.Name,
+		Slug:                 t.Slug,
+		Description:          null.NewString(t.Description, t.Description != ""),
+		Deprecated:           t.Deprecated,
+		VersionRetention:     t.VersionRetention,
+		RequireJustification: t.RequireJustification,
+	}
+
+	if dbT.VersionRetention == 0 {
+		dbT.VersionRetention = defaultCredentialVersionRetention
+	}
+
+	if dbT.VersionRetention < 0 {
+		return nil, errors.Wrap(errCredentialVersion, "version_retention can't be negative")
+	}
+
+	if t.PasswordPolicy != nil {
+		if err := t.PasswordPolicy.validate(); err != nil {
+			return nil, err
+		}
+
+		if err := dbT.PasswordPolicy.Marshal(t.PasswordPolicy); err != nil {
+			return nil, err
+		}
+	}
+
+	return dbT, nil
 }
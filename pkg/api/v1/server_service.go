@@ -1,10 +1,17 @@
 package serverservice
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
+	"mime/multipart"
+	"net/url"
 	"path"
+	"strconv"
+	"time"
 
 	"github.com/google/uuid"
 )
@@ -20,8 +27,22 @@ const (
 	serverComponentFirmwareSetsEndpoint = "server-component-firmware-sets"
 	bomInfoEndpoint                     = "bill-of-materials"
 	uploadFileEndpoint                  = "batch-upload"
+	uploadCSVEndpoint                   = "csv"
 	bomByMacAOCAddressEndpoint          = "aoc-mac-address"
 	bomByMacBMCAddressEndpoint          = "bmc-mac-address"
+	bomMismatchesEndpoint               = "mismatches"
+	serverBomEndpoint                   = "bom"
+	auditEndpoint                       = "audit"
+	serverHistoryEndpoint               = "history"
+	serverComponentChangesEndpoint      = "changes"
+	firmwareComplianceEndpoint          = "firmware-compliance"
+	firmwareSetAssignmentsEndpoint      = "assignments"
+	serverFirmwareSetEndpoint           = "firmware-set"
+	serversBulkEndpoint                 = "bulk"
+	serversExportEndpoint               = "export"
+	serverCredentialVersionsEndpoint    = "versions"
+	serverCredentialRestoreEndpoint     = "restore"
+	serverCredentialGenerateEndpoint    = "generate"
 )
 
 // ClientInterface provides an interface for the expected calls to interact with a server service api
@@ -29,7 +50,11 @@ type ClientInterface interface {
 	Create(context.Context, Server) (*uuid.UUID, *ServerResponse, error)
 	Delete(context.Context, Server) (*ServerResponse, error)
 	Get(context.Context, uuid.UUID) (*Server, *ServerResponse, error)
+	GetAsOf(context.Context, uuid.UUID, time.Time) (*Server, *ServerResponse, error)
+	ListServerHistory(context.Context, uuid.UUID, *PaginationParams) ([]ServerHistoryRecord, *ServerResponse, error)
 	List(context.Context, *ServerListParams) ([]Server, *ServerResponse, error)
+	ImportServers(context.Context, io.Reader, int) (*ServerImportReport, *ServerResponse, error)
+	ExportServers(context.Context, *ServerListParams, string, io.Writer) error
 	Update(context.Context, uuid.UUID, Server) (*ServerResponse, error)
 	CreateAttributes(context.Context, uuid.UUID, Attributes) (*ServerResponse, error)
 	DeleteAttributes(ctx context.Context, u uuid.UUID, ns string) (*ServerResponse, error)
@@ -41,6 +66,7 @@ type ClientInterface interface {
 	CreateComponents(context.Context, uuid.UUID, ServerComponentSlice) (*ServerResponse, error)
 	UpdateComponents(context.Context, uuid.UUID, ServerComponentSlice) (*ServerResponse, error)
 	DeleteServerComponents(context.Context, uuid.UUID) (*ServerResponse, error)
+	GetComponentChanges(context.Context, uuid.UUID, time.Time) (*ServerComponentChanges, *ServerResponse, error)
 	CreateVersionedAttributes(context.Context, uuid.UUID, VersionedAttributes) (*ServerResponse, error)
 	GetVersionedAttributes(context.Context, uuid.UUID, string) ([]VersionedAttributes, *ServerResponse, error)
 	ListVersionedAttributes(context.Context, uuid.UUID) ([]VersionedAttributes, *ServerResponse, error)
@@ -54,13 +80,78 @@ type ClientInterface interface {
 	GetServerComponentFirmwareSet(context.Context, uuid.UUID) (*ComponentFirmwareSet, *ServerResponse, error)
 	ListServerComponentFirmwareSet(context.Context, *ComponentFirmwareSetListParams) ([]ComponentFirmwareSet, *ServerResponse, error)
 	DeleteServerComponentFirmwareSet(context.Context, uuid.UUID) (*ServerResponse, error)
+	CreateFirmwareSetAssignment(context.Context, uuid.UUID, FirmwareSetAssignment) (*uuid.UUID, *ServerResponse, error)
+	ListFirmwareSetAssignments(context.Context, uuid.UUID, *PaginationParams) ([]FirmwareSetAssignment, *ServerResponse, error)
+	DeleteFirmwareSetAssignment(context.Context, uuid.UUID, uuid.UUID) (*ServerResponse, error)
+	GetServerFirmwareSet(context.Context, uuid.UUID) (*EffectiveFirmwareSet, *ServerResponse, error)
+	GetServerFirmwareCompliance(context.Context, uuid.UUID, *FirmwareComplianceParams) (*ServerFirmwareCompliance, *ServerResponse, error)
+	ListFirmwareCompliance(context.Context, *FirmwareComplianceParams) ([]ServerFirmwareCompliance, *ServerResponse, error)
 	GetCredential(context.Context, uuid.UUID, string) (*ServerCredential, *ServerResponse, error)
 	SetCredential(context.Context, uuid.UUID, string, string) (*ServerResponse, error)
 	DeleteCredential(context.Context, uuid.UUID, string) (*ServerResponse, error)
-	ListServerCredentialTypes(context.Context) (*ServerResponse, error)
+	GenerateCredential(context.Context, uuid.UUID, string, string) (*ServerCredential, *ServerResponse, error)
+	GetCredentials(context.Context, string, []uuid.UUID, *ServerListParams, func(ServerCredentialResult) error) error
+	ListCredentialVersions(context.Context, uuid.UUID, string, *PaginationParams) ([]ServerCredentialVersion, *ServerResponse, error)
+	GetCredentialVersion(context.Context, uuid.UUID, string, int64) (*ServerCredentialVersion, *ServerResponse, error)
+	RestoreCredentialVersion(context.Context, uuid.UUID, string, int64) (*ServerResponse, error)
+	ListServerCredentialTypes(context.Context, *PaginationParams) ([]ServerCredentialType, *ServerResponse, error)
+	GetServerCredentialType(context.Context, string) (*ServerCredentialType, *ServerResponse, error)
+	CreateServerCredentialType(context.Context, *ServerCredentialType) (*ServerResponse, error)
+	UpdateServerCredentialType(context.Context, string, *ServerCredentialType) (*ServerResponse, error)
+	DeleteServerCredentialType(context.Context, string) (*ServerResponse, error)
+	ListServerComponentTypes(context.Context, *ServerComponentTypeListParams) (ServerComponentTypeSlice, *ServerResponse, error)
+	GetServerComponentType(context.Context, string) (*ServerComponentType, *ServerResponse, error)
+	CreateServerComponentType(context.Context, ServerComponentType) (*ServerResponse, error)
+	UpdateServerComponentType(context.Context, string, ServerComponentType) (*ServerResponse, error)
+	DeleteServerComponentType(context.Context, string) (*ServerResponse, error)
 	BillOfMaterialsBatchUpload(context.Context, []Bom) (*ServerResponse, error)
+	BillOfMaterialsCSVUpload(context.Context, string, io.Reader) (*BomUploadReport, *ServerResponse, error)
 	GetBomInfoByAOCMacAddr(context.Context, string) (*Bom, *ServerResponse, error)
 	GetBomInfoByBMCMacAddr(context.Context, string) (*Bom, *ServerResponse, error)
+	ListBoms(context.Context, *BomListParams) ([]Bom, *ServerResponse, error)
+	GetBom(context.Context, string) (*Bom, *ServerResponse, error)
+	UpdateBom(context.Context, string, Bom) (*ServerResponse, error)
+	DeleteBom(context.Context, string) (*ServerResponse, error)
+	GetServerBom(context.Context, uuid.UUID) (*Bom, *ServerResponse, error)
+	ListBomMismatches(context.Context, *BomListParams) ([]BomMismatch, *ServerResponse, error)
+	CreateFacility(context.Context, Facility) (*uuid.UUID, *ServerResponse, error)
+	ListFacilities(context.Context, *PaginationParams) ([]Facility, *ServerResponse, error)
+	GetFacility(context.Context, uuid.UUID) (*Facility, *ServerResponse, error)
+	UpdateFacility(context.Context, uuid.UUID, Facility) (*ServerResponse, error)
+	DeleteFacility(context.Context, uuid.UUID) (*ServerResponse, error)
+	CreateRoom(context.Context, Room) (*uuid.UUID, *ServerResponse, error)
+	ListRooms(context.Context, *LocationListParams) ([]Room, *ServerResponse, error)
+	GetRoom(context.Context, uuid.UUID) (*Room, *ServerResponse, error)
+	UpdateRoom(context.Context, uuid.UUID, Room) (*ServerResponse, error)
+	DeleteRoom(context.Context, uuid.UUID) (*ServerResponse, error)
+	CreateRow(context.Context, Row) (*uuid.UUID, *ServerResponse, error)
+	ListRows(context.Context, *LocationListParams) ([]Row, *ServerResponse, error)
+	GetRow(context.Context, uuid.UUID) (*Row, *ServerResponse, error)
+	UpdateRow(context.Context, uuid.UUID, Row) (*ServerResponse, error)
+	DeleteRow(context.Context, uuid.UUID) (*ServerResponse, error)
+	CreateRack(context.Context, Rack) (*uuid.UUID, *ServerResponse, error)
+	ListRacks(context.Context, *LocationListParams) ([]Rack, *ServerResponse, error)
+	GetRack(context.Context, uuid.UUID) (*Rack, *ServerResponse, error)
+	UpdateRack(context.Context, uuid.UUID, Rack) (*ServerResponse, error)
+	DeleteRack(context.Context, uuid.UUID) (*ServerResponse, error)
+	GetRackElevation(context.Context, uuid.UUID) (*RackElevation, *ServerResponse, error)
+	GetServerPlacement(context.Context, uuid.UUID) (*ServerPlacement, *ServerResponse, error)
+	SetServerPlacement(context.Context, uuid.UUID, ServerPlacement) (*ServerResponse, error)
+	DeleteServerPlacement(context.Context, uuid.UUID) (*ServerResponse, error)
+	GetServerLifecycle(context.Context) (*ServerLifecycle, *ServerResponse, error)
+	TransitionServerState(context.Context, uuid.UUID, string, string) (*ServerResponse, error)
+	ListServerStateTransitions(context.Context, uuid.UUID, *PaginationParams) ([]ServerStateTransition, *ServerResponse, error)
+	GetServerLease(context.Context, uuid.UUID) (*ServerLease, *ServerResponse, error)
+	AcquireServerLease(context.Context, uuid.UUID, string, int) (*ServerLease, *ServerResponse, error)
+	RenewServerLease(context.Context, uuid.UUID, string, int) (*ServerLease, *ServerResponse, error)
+	ReleaseServerLease(context.Context, uuid.UUID, string) (*ServerResponse, error)
+	CreateMaintenanceWindow(context.Context, MaintenanceWindow) (*uuid.UUID, *ServerResponse, error)
+	ListMaintenanceWindows(context.Context, *MaintenanceWindowListParams) ([]MaintenanceWindow, *ServerResponse, error)
+	GetMaintenanceWindow(context.Context, uuid.UUID) (*MaintenanceWindow, *ServerResponse, error)
+	UpdateMaintenanceWindow(context.Context, uuid.UUID, MaintenanceWindow) (*ServerResponse, error)
+	DeleteMaintenanceWindow(context.Context, uuid.UUID) (*ServerResponse, error)
+	GetServerMaintenance(context.Context, uuid.UUID) (*ServerMaintenance, *ServerResponse, error)
+	ListAuditEvents(context.Context, *AuditEventListParams) ([]AuditEvent, *ServerResponse, error)
 }
 
 // Create will attempt to create a server in Hollow and return the new server's UUID
@@ -96,6 +187,37 @@ func (c *Client) Get(ctx context.Context, srvUUID uuid.UUID) (*Server, *ServerRe
 	return srv, &r, nil
 }
 
+// GetAsOf will return the server as it was at the given time, this requires
+// the server service to be recording history for changes to be reflected
+func (c *Client) GetAsOf(ctx context.Context, srvUUID uuid.UUID, asOf time.Time) (*Server, *ServerResponse, error) {
+	q := url.Values{}
+	q.Set("as_of", asOf.Format(time.RFC3339Nano))
+
+	path := fmt.Sprintf("%s/%s?%s", serversEndpoint, srvUUID, q.Encode())
+	srv := &Server{}
+	r := ServerResponse{Record: srv}
+
+	if err := c.get(ctx, path, &r); err != nil {
+		return nil, nil, err
+	}
+
+	return srv, &r, nil
+}
+
+// ListServerHistory will return the recorded changes to a server, its
+// attributes and components, most recent first
+func (c *Client) ListServerHistory(ctx context.Context, srvUUID uuid.UUID, params *PaginationParams) ([]ServerHistoryRecord, *ServerResponse, error) {
+	records := &[]ServerHistoryRecord{}
+	r := ServerResponse{Records: records}
+
+	path := fmt.Sprintf("%s/%s/%s", serversEndpoint, srvUUID, serverHistoryEndpoint)
+	if err := c.list(ctx, path, params, &r); err != nil {
+		return nil, nil, err
+	}
+
+	return *records, &r, nil
+}
+
 // List will return all servers with optional params to filter the results
 func (c *Client) List(ctx context.Context, params *ServerListParams) ([]Server, *ServerResponse, error) {
 	servers := &[]Server{}
@@ -108,6 +230,57 @@ func (c *Client) List(ctx context.Context, params *ServerListParams) ([]Server,
 	return *servers, &r, nil
 }
 
+// ImportServers will create the servers read from the NDJSON stream, one
+// server document per line, and return the result of each line. A batchSize
+// of 0 uses the server's default number of servers per transaction.
+func (c *Client) ImportServers(ctx context.Context, ndjson io.Reader, batchSize int) (*ServerImportReport, *ServerResponse, error) {
+	request, err := newRawPostRequest(ctx, c.url, fmt.Sprintf("%s/%s", serversEndpoint, serversBulkEndpoint), "application/x-ndjson", ndjson)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if batchSize > 0 {
+		q := request.URL.Query()
+		q.Set("batch_size", strconv.Itoa(batchSize))
+		request.URL.RawQuery = q.Encode()
+	}
+
+	report := &ServerImportReport{}
+	r := ServerResponse{Record: report}
+
+	if err := c.do(request, &r); err != nil {
+		return nil, nil, err
+	}
+
+	return report, &r, nil
+}
+
+// ExportServers will write the servers matching the params to w, as NDJSON
+// server documents or as CSV when format is ExportFormatCSV. Pagination params
+// are ignored, every matching server is exported. ErrExportFailed or
+// ErrExportIncomplete is returned when the export doesn't complete, the
+// servers received until then are still written.
+func (c *Client) ExportServers(ctx context.Context, params *ServerListParams, format string, w io.Writer) error {
+	request, err := newGetRequest(ctx, c.url, fmt.Sprintf("%s/%s", serversEndpoint, serversExportEndpoint))
+	if err != nil {
+		return err
+	}
+
+	q := request.URL.Query()
+	params.setQuery(q)
+	q.Del("page")
+	q.Del("limit")
+	q.Del("cursor")
+
+	if format != "" {
+		q.Set("format", format)
+	}
+
+	request.URL.RawQuery = q.Encode()
+
+	return c.streamExport(request, format, w)
+}
+
 // Update will to update a server with the new values passed in
 func (c *Client) Update(ctx context.Context, srvUUID uuid.UUID, srv Server) (*ServerResponse, error) {
 	path := fmt.Sprintf("%s/%s", serversEndpoint, srvUUID)
@@ -201,6 +374,23 @@ func (c *Client) DeleteServerComponents(ctx context.Context, srvUUID uuid.UUID)
 	return c.delete(ctx, fmt.Sprintf("%s/%s/%s", serversEndpoint, srvUUID, serverComponentsEndpoint))
 }
 
+// GetComponentChanges will return the components added, removed and modified
+// on a server since the given time
+func (c *Client) GetComponentChanges(ctx context.Context, srvUUID uuid.UUID, since time.Time) (*ServerComponentChanges, *ServerResponse, error) {
+	q := url.Values{}
+	q.Set("since", since.Format(time.RFC3339Nano))
+
+	path := fmt.Sprintf("%s/%s/%s/%s?%s", serversEndpoint, srvUUID, serverComponentsEndpoint, serverComponentChangesEndpoint, q.Encode())
+	changes := &ServerComponentChanges{}
+	r := ServerResponse{Record: changes}
+
+	if err := c.get(ctx, path, &r); err != nil {
+		return nil, nil, err
+	}
+
+	return changes, &r, nil
+}
+
 // CreateVersionedAttributes will create a new versioned attribute for a given server
 func (c *Client) CreateVersionedAttributes(ctx context.Context, srvUUID uuid.UUID, va VersionedAttributes) (*ServerResponse, error) {
 	path := fmt.Sprintf("%s/%s/%s", serversEndpoint, srvUUID, serverVersionedAttributesEndpoint)
@@ -342,6 +532,82 @@ func (c *Client) RemoveServerComponentFirmwareSetFirmware(ctx context.Context, f
 	return c.post(ctx, path, firmwareSet)
 }
 
+// CreateFirmwareSetAssignment will assign the firmware set to a server or to
+// the servers matching the assignment selectors and return the assignment UUID
+func (c *Client) CreateFirmwareSetAssignment(ctx context.Context, firmwareSetID uuid.UUID, assignment FirmwareSetAssignment) (*uuid.UUID, *ServerResponse, error) {
+	path := fmt.Sprintf("%s/%s/%s", serverComponentFirmwareSetsEndpoint, firmwareSetID, firmwareSetAssignmentsEndpoint)
+
+	resp, err := c.post(ctx, path, assignment)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	u, err := uuid.Parse(resp.Slug)
+	if err != nil {
+		return nil, resp, nil
+	}
+
+	return &u, resp, nil
+}
+
+// ListFirmwareSetAssignments will return the assignments of a firmware set
+func (c *Client) ListFirmwareSetAssignments(ctx context.Context, firmwareSetID uuid.UUID, params *PaginationParams) ([]FirmwareSetAssignment, *ServerResponse, error) {
+	assignments := &[]FirmwareSetAssignment{}
+	r := ServerResponse{Records: assignments}
+
+	path := fmt.Sprintf("%s/%s/%s", serverComponentFirmwareSetsEndpoint, firmwareSetID, firmwareSetAssignmentsEndpoint)
+	if err := c.list(ctx, path, params, &r); err != nil {
+		return nil, nil, err
+	}
+
+	return *assignments, &r, nil
+}
+
+// DeleteFirmwareSetAssignment will remove an assignment of a firmware set
+func (c *Client) DeleteFirmwareSetAssignment(ctx context.Context, firmwareSetID, assignmentID uuid.UUID) (*ServerResponse, error) {
+	return c.delete(ctx, fmt.Sprintf("%s/%s/%s/%s", serverComponentFirmwareSetsEndpoint, firmwareSetID, firmwareSetAssignmentsEndpoint, assignmentID))
+}
+
+// GetServerFirmwareSet will return the firmware set that applies to a server
+func (c *Client) GetServerFirmwareSet(ctx context.Context, srvUUID uuid.UUID) (*EffectiveFirmwareSet, *ServerResponse, error) {
+	path := fmt.Sprintf("%s/%s/%s", serversEndpoint, srvUUID, serverFirmwareSetEndpoint)
+	effective := &EffectiveFirmwareSet{}
+	r := ServerResponse{Record: effective}
+
+	if err := c.get(ctx, path, &r); err != nil {
+		return nil, nil, err
+	}
+
+	return effective, &r, nil
+}
+
+// GetServerFirmwareCompliance will return how the firmware installed on the
+// server components compares with the firmware set in params
+func (c *Client) GetServerFirmwareCompliance(ctx context.Context, srvUUID uuid.UUID, params *FirmwareComplianceParams) (*ServerFirmwareCompliance, *ServerResponse, error) {
+	report := &ServerFirmwareCompliance{}
+	r := ServerResponse{Record: report}
+
+	path := fmt.Sprintf("%s/%s/%s", serversEndpoint, srvUUID, firmwareComplianceEndpoint)
+	if err := c.list(ctx, path, params, &r); err != nil {
+		return nil, nil, err
+	}
+
+	return report, &r, nil
+}
+
+// ListFirmwareCompliance will return the firmware compliance of each server
+// matching the params against the firmware set in params
+func (c *Client) ListFirmwareCompliance(ctx context.Context, params *FirmwareComplianceParams) ([]ServerFirmwareCompliance, *ServerResponse, error) {
+	reports := &[]ServerFirmwareCompliance{}
+	r := ServerResponse{Records: reports}
+
+	if err := c.list(ctx, firmwareComplianceEndpoint, params, &r); err != nil {
+		return nil, nil, err
+	}
+
+	return *reports, &r, nil
+}
+
 // GetCredential will return the secret for the secret type for the given server UUID
 func (c *Client) GetCredential(ctx context.Context, srvUUID uuid.UUID, secretSlug string) (*ServerCredential, *ServerResponse, error) {
 	p := path.Join(serversEndpoint, srvUUID.String(), serverCredentialsEndpoint, secretSlug)
@@ -373,6 +639,127 @@ func (c *Client) DeleteCredential(ctx context.Context, srvUUID uuid.UUID, secret
 	return c.delete(ctx, p)
 }
 
+// GetCredentials will return the secrets for the secret type of the given
+// server UUIDs, or of the servers matching params when no UUIDs are given.
+// Results are passed to fn as they are received, servers without a secret
+// are reported with the Error of their result set. ErrCredentialsFailed or
+// ErrCredentialsIncomplete is returned when not all the results were sent.
+func (c *Client) GetCredentials(ctx context.Context, secretSlug string, srvUUIDs []uuid.UUID, params *ServerListParams, fn func(ServerCredentialResult) error) error {
+	request, err := newPostRequest(ctx, c.url, path.Join(serversEndpoint, serverCredentialsEndpoint, secretSlug), &ServerCredentialsRequest{ServerIDs: srvUUIDs})
+	if err != nil {
+		return err
+	}
+
+	q := request.URL.Query()
+	params.setQuery(q)
+	q.Del("page")
+	q.Del("limit")
+	q.Del("cursor")
+	request.URL.RawQuery = q.Encode()
+
+	body, err := c.open(request)
+	if err != nil {
+		return err
+	}
+
+	defer body.Close()
+
+	dec := json.NewDecoder(body)
+
+	for {
+		var line json.RawMessage
+
+		if err := dec.Decode(&line); err != nil {
+			if errors.Is(err, io.EOF) {
+				return ErrCredentialsIncomplete
+			}
+
+			return err
+		}
+
+		var status ServerCredentialsStatus
+		if err := json.Unmarshal(line, &status); err != nil {
+			return err
+		}
+
+		if status.Status != "" {
+			if status.Status != ServerCredentialsStatusComplete {
+				return fmt.Errorf("%w: %s", ErrCredentialsFailed, status.Error)
+			}
+
+			return nil
+		}
+
+		var result ServerCredentialResult
+		if err := json.Unmarshal(line, &result); err != nil {
+			return err
+		}
+
+		if err := fn(result); err != nil {
+			return err
+		}
+	}
+}
+
+// GenerateCredential will have the server generate a password following the
+// policy of the secret type and set it as the secret for the given server UUID.
+// The generated password is returned, the username of an existing secret is
+// kept when username is empty.
+func (c *Client) GenerateCredential(ctx context.Context, srvUUID uuid.UUID, secretSlug, username string) (*ServerCredential, *ServerResponse, error) {
+	p := path.Join(serversEndpoint, srvUUID.String(), serverCredentialsEndpoint, secretSlug, serverCredentialGenerateEndpoint)
+
+	request, err := newPostRequest(ctx, c.url, p, &serverCredentialGenerate{Username: username})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	secret := &ServerCredential{}
+	r := ServerResponse{Record: secret}
+
+	if err := c.do(request, &r); err != nil {
+		return nil, nil, err
+	}
+
+	return secret, &r, nil
+}
+
+// ListCredentialVersions will return the stored versions of the secret for the
+// secret type for the given server UUID, without their passwords.
+func (c *Client) ListCredentialVersions(ctx context.Context, srvUUID uuid.UUID, secretSlug string, params *PaginationParams) ([]ServerCredentialVersion, *ServerResponse, error) {
+	p := path.Join(serversEndpoint, srvUUID.String(), serverCredentialsEndpoint, secretSlug, serverCredentialVersionsEndpoint)
+	versions := &[]ServerCredentialVersion{}
+	r := ServerResponse{Records: versions}
+
+	if err := c.list(ctx, p, params, &r); err != nil {
+		return nil, nil, err
+	}
+
+	return *versions, &r, nil
+}
+
+// GetCredentialVersion will return a version of the secret for the secret type
+// for the given server UUID
+func (c *Client) GetCredentialVersion(ctx context.Context, srvUUID uuid.UUID, secretSlug string, version int64) (*ServerCredentialVersion, *ServerResponse, error) {
+	p := path.Join(serversEndpoint, srvUUID.String(), serverCredentialsEndpoint, secretSlug, serverCredentialVersionsEndpoint, strconv.FormatInt(version, 10))
+	secret := &ServerCredentialVersion{}
+	r := ServerResponse{Record: secret}
+
+	if err := c.get(ctx, p, &r); err != nil {
+		return nil, nil, err
+	}
+
+	return secret, &r, nil
+}
+
+// RestoreCredentialVersion will set the secret for the secret type for the
+// given server UUID back to a previous version. The restored value is stored as
+// a new version.
+func (c *Client) RestoreCredentialVersion(ctx context.Context, srvUUID uuid.UUID, secretSlug string, version int64) (*ServerResponse, error) {
+	p := path.Join(serversEndpoint, srvUUID.String(), serverCredentialsEndpoint, secretSlug, serverCredentialVersionsEndpoint, strconv.FormatInt(version, 10), serverCredentialRestoreEndpoint)
+
+	return c.post(ctx, p, nil)
+}
+
 // ListServerCredentialTypes will return all server secret types
 func (c *Client) ListServerCredentialTypes(ctx context.Context, params *PaginationParams) ([]ServerCredentialType, *ServerResponse, error) {
 	types := &[]ServerCredentialType{}
@@ -385,11 +772,35 @@ func (c *Client) ListServerCredentialTypes(ctx context.Context, params *Paginati
 	return *types, &r, nil
 }
 
+// GetServerCredentialType will return the server secret type with the slug
+func (c *Client) GetServerCredentialType(ctx context.Context, slug string) (*ServerCredentialType, *ServerResponse, error) {
+	sType := &ServerCredentialType{}
+	r := ServerResponse{Record: sType}
+
+	if err := c.get(ctx, path.Join(serverCredentialTypeEndpoint, slug), &r); err != nil {
+		return nil, nil, err
+	}
+
+	return sType, &r, nil
+}
+
 // CreateServerCredentialType will create a new server secret type
 func (c *Client) CreateServerCredentialType(ctx context.Context, sType *ServerCredentialType) (*ServerResponse, error) {
 	return c.post(ctx, serverCredentialTypeEndpoint, sType)
 }
 
+// UpdateServerCredentialType will update the server secret type with the
+// slug, the slug itself can't be changed
+func (c *Client) UpdateServerCredentialType(ctx context.Context, slug string, sType *ServerCredentialType) (*ServerResponse, error) {
+	return c.put(ctx, path.Join(serverCredentialTypeEndpoint, slug), sType)
+}
+
+// DeleteServerCredentialType will delete the server secret type with the
+// slug, it fails while servers still have secrets of the type
+func (c *Client) DeleteServerCredentialType(ctx context.Context, slug string) (*ServerResponse, error) {
+	return c.delete(ctx, path.Join(serverCredentialTypeEndpoint, slug))
+}
+
 // BillOfMaterialsBatchUpload will attempt to write multiple boms to database.
 func (c *Client) BillOfMaterialsBatchUpload(ctx context.Context, boms []Bom) (*ServerResponse, error) {
 	path := fmt.Sprintf("%s/%s", bomInfoEndpoint, uploadFileEndpoint)
@@ -402,6 +813,43 @@ func (c *Client) BillOfMaterialsBatchUpload(ctx context.Context, boms []Bom) (*S
 	return resp, nil
 }
 
+// BillOfMaterialsCSVUpload will upload a vendor bom manifest in CSV or tab
+// separated form and return the result of each row. Rows are written on their
+// own, a row that fails doesn't prevent the others from being written.
+func (c *Client) BillOfMaterialsCSVUpload(ctx context.Context, filename string, csv io.Reader) (*BomUploadReport, *ServerResponse, error) {
+	body := &bytes.Buffer{}
+	mw := multipart.NewWriter(body)
+
+	part, err := mw.CreateFormFile("file", filename)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if _, err := io.Copy(part, csv); err != nil {
+		return nil, nil, err
+	}
+
+	if err := mw.Close(); err != nil {
+		return nil, nil, err
+	}
+
+	path := fmt.Sprintf("%s/%s/%s", bomInfoEndpoint, uploadFileEndpoint, uploadCSVEndpoint)
+
+	request, err := newRawPostRequest(ctx, c.url, path, mw.FormDataContentType(), body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	report := &BomUploadReport{}
+	r := ServerResponse{Record: report}
+
+	if err := c.do(request, &r); err != nil {
+		return nil, nil, err
+	}
+
+	return report, &r, nil
+}
+
 // GetBomInfoByAOCMacAddr will return the bom info object by the aoc mac address.
 func (c *Client) GetBomInfoByAOCMacAddr(ctx context.Context, aocMacAddr string) (*Bom, *ServerResponse, error) {
 	path := fmt.Sprintf("%s/%s/%s", bomInfoEndpoint, bomByMacAOCAddressEndpoint, aocMacAddr)
@@ -427,3 +875,79 @@ func (c *Client) GetBomInfoByBMCMacAddr(ctx context.Context, bmcMacAddr string)
 
 	return bom, &r, nil
 }
+
+// ListBoms will return the boms with optional params to filter the results
+func (c *Client) ListBoms(ctx context.Context, params *BomListParams) ([]Bom, *ServerResponse, error) {
+	boms := &[]Bom{}
+	r := ServerResponse{Records: boms}
+
+	if err := c.list(ctx, bomInfoEndpoint, params, &r); err != nil {
+		return nil, nil, err
+	}
+
+	return *boms, &r, nil
+}
+
+// GetBom will return the bom with the serial number
+func (c *Client) GetBom(ctx context.Context, serialNum string) (*Bom, *ServerResponse, error) {
+	path := fmt.Sprintf("%s/%s", bomInfoEndpoint, serialNum)
+	bom := &Bom{}
+	r := ServerResponse{Record: bom}
+
+	if err := c.get(ctx, path, &r); err != nil {
+		return nil, nil, err
+	}
+
+	return bom, &r, nil
+}
+
+// UpdateBom will replace the bom with the serial number, MAC addresses no
+// longer listed are removed from it
+func (c *Client) UpdateBom(ctx context.Context, serialNum string, bom Bom) (*ServerResponse, error) {
+	return c.put(ctx, fmt.Sprintf("%s/%s", bomInfoEndpoint, serialNum), bom)
+}
+
+// DeleteBom will delete the bom with the serial number along with its MAC
+// addresses
+func (c *Client) DeleteBom(ctx context.Context, serialNum string) (*ServerResponse, error) {
+	return c.delete(ctx, fmt.Sprintf("%s/%s", bomInfoEndpoint, serialNum))
+}
+
+// GetServerBom will return the bom linked to the server
+func (c *Client) GetServerBom(ctx context.Context, srvUUID uuid.UUID) (*Bom, *ServerResponse, error) {
+	path := fmt.Sprintf("%s/%s/%s", serversEndpoint, srvUUID, serverBomEndpoint)
+	bom := &Bom{}
+	r := ServerResponse{Record: bom}
+
+	if err := c.get(ctx, path, &r); err != nil {
+		return nil, nil, err
+	}
+
+	return bom, &r, nil
+}
+
+// ListBomMismatches will return the boms linked to a server whose MAC
+// addresses differ from the ones observed in the server's components. Pages
+// are cut from the linked boms, a page may hold fewer mismatches than its size.
+func (c *Client) ListBomMismatches(ctx context.Context, params *BomListParams) ([]BomMismatch, *ServerResponse, error) {
+	mismatches := &[]BomMismatch{}
+	r := ServerResponse{Records: mismatches}
+
+	if err := c.list(ctx, fmt.Sprintf("%s/%s", bomInfoEndpoint, bomMismatchesEndpoint), params, &r); err != nil {
+		return nil, nil, err
+	}
+
+	return *mismatches, &r, nil
+}
+
+// ListAuditEvents will return the audit log entries matching the given params
+func (c *Client) ListAuditEvents(ctx context.Context, params *AuditEventListParams) ([]AuditEvent, *ServerResponse, error) {
+	events := &[]AuditEvent{}
+	r := ServerResponse{Records: events}
+
+	if err := c.list(ctx, auditEndpoint, params, &r); err != nil {
+		return nil, nil, err
+	}
+
+	return *events, &r, nil
+}
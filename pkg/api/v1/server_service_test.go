@@ -1,9 +1,13 @@
 package serverservice_test
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
+	"net/http"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
@@ -397,3 +401,542 @@ func TestGetBomInfoByBMCMacAddr(t *testing.T) {
 		return err
 	})
 }
+
+func TestListAuditEvents(t *testing.T) {
+	mockClientTests(t, func(ctx context.Context, respCode int, expectError bool) error {
+		events := []hollow.AuditEvent{{ID: uuid.New(), Action: hollow.AuditActionUpdate, ResourceType: hollow.AuditResourceServer}}
+		jsonResponse, err := json.Marshal(hollow.ServerResponse{Records: events})
+		require.Nil(t, err)
+
+		c := mockClient(string(jsonResponse), respCode)
+		res, _, err := c.ListAuditEvents(ctx, &hollow.AuditEventListParams{ResourceType: hollow.AuditResourceServer})
+		if !expectError {
+			assert.Len(t, res, 1)
+			assert.Equal(t, events[0].ID, res[0].ID)
+		}
+
+		return err
+	})
+}
+
+func TestServerServiceGetAsOf(t *testing.T) {
+	mockClientTests(t, func(ctx context.Context, respCode int, expectError bool) error {
+		srv := hollow.Server{UUID: uuid.New(), Name: "before", FacilityCode: "Test1"}
+		jsonResponse, err := json.Marshal(hollow.ServerResponse{Record: srv})
+		require.Nil(t, err)
+
+		c := mockClient(string(jsonResponse), respCode)
+		res, _, err := c.GetAsOf(ctx, srv.UUID, time.Now())
+		if !expectError {
+			assert.Equal(t, srv.Name, res.Name)
+		}
+
+		return err
+	})
+}
+
+func TestServerServiceListServerHistory(t *testing.T) {
+	mockClientTests(t, func(ctx context.Context, respCode int, expectError bool) error {
+		records := []hollow.ServerHistoryRecord{{Entity: hollow.HistoryEntityServer, EntityID: uuid.New()}}
+		jsonResponse, err := json.Marshal(hollow.ServerResponse{Records: records})
+		require.Nil(t, err)
+
+		c := mockClient(string(jsonResponse), respCode)
+		res, _, err := c.ListServerHistory(ctx, uuid.New(), nil)
+		if !expectError {
+			assert.Len(t, res, 1)
+			assert.Equal(t, records[0].EntityID, res[0].EntityID)
+		}
+
+		return err
+	})
+}
+
+func TestServerServiceCreateFirmwareSetAssignment(t *testing.T) {
+	mockClientTests(t, func(ctx context.Context, respCode int, expectError bool) error {
+		id := uuid.New()
+		jsonResponse, err := json.Marshal(hollow.ServerResponse{Slug: id.String()})
+		require.Nil(t, err)
+
+		c := mockClient(string(jsonResponse), respCode)
+		res, _, err := c.CreateFirmwareSetAssignment(ctx, uuid.New(), hollow.FirmwareSetAssignment{FacilityCode: "ams1"})
+		if !expectError {
+			assert.Equal(t, id, *res)
+		}
+
+		return err
+	})
+}
+
+func TestServerServiceListFirmwareSetAssignments(t *testing.T) {
+	mockClientTests(t, func(ctx context.Context, respCode int, expectError bool) error {
+		records := []hollow.FirmwareSetAssignment{{UUID: uuid.New(), FacilityCode: "ams1"}}
+		jsonResponse, err := json.Marshal(hollow.ServerResponse{Records: records})
+		require.Nil(t, err)
+
+		c := mockClient(string(jsonResponse), respCode)
+		res, _, err := c.ListFirmwareSetAssignments(ctx, uuid.New(), nil)
+		if !expectError {
+			assert.Equal(t, records, res)
+		}
+
+		return err
+	})
+}
+
+func TestServerServiceDeleteFirmwareSetAssignment(t *testing.T) {
+	mockClientTests(t, func(ctx context.Context, respCode int, expectError bool) error {
+		jsonResponse, err := json.Marshal(hollow.ServerResponse{Message: "resource deleted"})
+		require.Nil(t, err)
+
+		c := mockClient(string(jsonResponse), respCode)
+		_, err = c.DeleteFirmwareSetAssignment(ctx, uuid.New(), uuid.New())
+
+		return err
+	})
+}
+
+func TestServerServiceGetServerFirmwareSet(t *testing.T) {
+	mockClientTests(t, func(ctx context.Context, respCode int, expectError bool) error {
+		effective := hollow.EffectiveFirmwareSet{ServerUUID: uuid.New(), Source: hollow.FirmwareSetSourceDirect}
+		jsonResponse, err := json.Marshal(hollow.ServerResponse{Record: effective})
+		require.Nil(t, err)
+
+		c := mockClient(string(jsonResponse), respCode)
+		res, _, err := c.GetServerFirmwareSet(ctx, effective.ServerUUID)
+		if !expectError {
+			assert.Equal(t, effective.ServerUUID, res.ServerUUID)
+			assert.Equal(t, effective.Source, res.Source)
+		}
+
+		return err
+	})
+}
+
+func TestServerServiceImportServers(t *testing.T) {
+	mockClientTests(t, func(ctx context.Context, respCode int, expectError bool) error {
+		id := uuid.New()
+		report := hollow.ServerImportReport{
+			Created: 1,
+			Results: []hollow.ServerImportResult{{Line: 1, UUID: &id, Status: hollow.ServerImportStatusCreated}},
+		}
+		jsonResponse, err := json.Marshal(hollow.ServerResponse{Record: report})
+		require.Nil(t, err)
+
+		c := mockClient(string(jsonResponse), respCode)
+		res, _, err := c.ImportServers(ctx, strings.NewReader(`{"name":"imported"}`), 0)
+		if !expectError {
+			assert.Equal(t, report.Created, res.Created)
+			assert.Equal(t, id, *res.Results[0].UUID)
+		}
+
+		return err
+	})
+}
+
+func TestServerServiceGetCredentials(t *testing.T) {
+	mockClientTests(t, func(ctx context.Context, respCode int, expectError bool) error {
+		found, missing := uuid.New(), uuid.New()
+		body := `{"uuid":"` + found.String() + `","credential":{"secret_type":"bmc","username":"root","password":"secret"}}` + "\n" +
+			`{"uuid":"` + missing.String() + `","error":"server not found"}` + "\n" +
+			`{"credentials_status":"complete","count":2}` + "\n"
+
+		results := []hollow.ServerCredentialResult{}
+
+		c := mockClient(body, respCode)
+		err := c.GetCredentials(ctx, "bmc", []uuid.UUID{found, missing}, nil, func(r hollow.ServerCredentialResult) error {
+			results = append(results, r)
+			return nil
+		})
+		if !expectError {
+			require.Len(t, results, 2)
+			assert.Equal(t, "secret", results[0].Credential.Password)
+			assert.Equal(t, missing, results[1].ServerID)
+			assert.Equal(t, "server not found", results[1].Error)
+		}
+
+		return err
+	})
+}
+
+func TestServerServiceGetCredentialsIncomplete(t *testing.T) {
+	result := `{"uuid":"` + uuid.NewString() + `","error":"server not found"}` + "\n"
+
+	testCases := []struct {
+		name     string
+		body     string
+		expected error
+	}{
+		{"cut short", result, hollow.ErrCredentialsIncomplete},
+		{"failed", result + `{"credentials_status":"failed","count":1,"error":"datastore error"}` + "\n", hollow.ErrCredentialsFailed},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			results := 0
+
+			c := mockClient(tt.body, http.StatusOK)
+			err := c.GetCredentials(context.TODO(), "bmc", []uuid.UUID{uuid.New()}, nil, func(hollow.ServerCredentialResult) error {
+				results++
+				return nil
+			})
+			assert.ErrorIs(t, err, tt.expected)
+			assert.Equal(t, 1, results)
+		})
+	}
+}
+
+func TestServerServiceExportServers(t *testing.T) {
+	mockClientTests(t, func(ctx context.Context, respCode int, expectError bool) error {
+		body := `{"uuid":"` + uuid.NewString() + `","name":"exported"}` + "\n"
+
+		var buf bytes.Buffer
+
+		c := mockClient(body+`{"export_status":"complete","count":1}`+"\n", respCode)
+		err := c.ExportServers(ctx, &hollow.ServerListParams{FacilityCode: "Ocean"}, hollow.ExportFormatNDJSON, &buf)
+		if !expectError {
+			assert.Equal(t, body, buf.String())
+		}
+
+		return err
+	})
+}
+
+func TestServerServiceExportServersIncomplete(t *testing.T) {
+	row := uuid.NewString() + ",exported,Ocean,,,\n"
+
+	testCases := []struct {
+		name     string
+		format   string
+		body     string
+		expected error
+	}{
+		{"ndjson cut short", hollow.ExportFormatNDJSON, `{"uuid":"` + uuid.NewString() + `","name":"exported"}` + "\n", hollow.ErrExportIncomplete},
+		{"ndjson failed", hollow.ExportFormatNDJSON, `{"export_status":"failed","count":0,"error":"datastore error"}` + "\n", hollow.ErrExportFailed},
+		{"csv cut short", hollow.ExportFormatCSV, "uuid,name,facility,created_at,updated_at,deleted_at\n" + row, hollow.ErrExportIncomplete},
+		{"csv failed", hollow.ExportFormatCSV, "uuid,name,facility,created_at,updated_at,deleted_at\n" + row + "#export_status,failed,1,datastore error,,\n", hollow.ErrExportFailed},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+
+			c := mockClient(tt.body, http.StatusOK)
+			err := c.ExportServers(context.TODO(), nil, tt.format, &buf)
+			assert.ErrorIs(t, err, tt.expected)
+			assert.NotContains(t, buf.String(), "export_status")
+		})
+	}
+}
+
+func TestServerServiceBillOfMaterialsCSVUpload(t *testing.T) {
+	mockClientTests(t, func(ctx context.Context, respCode int, expectError bool) error {
+		report := hollow.BomUploadReport{
+			Created: 1,
+			Results: []hollow.BomUploadResult{{Row: 2, SerialNum: "fakeSerialNum1", Status: hollow.BomUploadStatusCreated}},
+		}
+		jsonResponse, err := json.Marshal(hollow.ServerResponse{Record: report})
+		require.Nil(t, err)
+
+		c := mockClient(string(jsonResponse), respCode)
+		res, _, err := c.BillOfMaterialsCSVUpload(ctx, "manifest.csv", strings.NewReader("serial_num,aoc_mac_address,bmc_mac_address\nfakeSerialNum1,aoc1,bmc1\n"))
+		if !expectError {
+			assert.Equal(t, report.Created, res.Created)
+			assert.Equal(t, report.Results, res.Results)
+		}
+
+		return err
+	})
+}
+
+func TestServerServiceListBoms(t *testing.T) {
+	mockClientTests(t, func(ctx context.Context, respCode int, expectError bool) error {
+		boms := []hollow.Bom{{SerialNum: "fakeSerialNum1", AocMacAddress: "aoc1", BmcMacAddress: "bmc1", Metro: "da"}}
+		jsonResponse, err := json.Marshal(hollow.ServerResponse{Records: boms})
+		require.Nil(t, err)
+
+		c := mockClient(string(jsonResponse), respCode)
+		res, _, err := c.ListBoms(ctx, &hollow.BomListParams{Metro: "da", SerialNumPrefix: "fake"})
+		if !expectError {
+			assert.Equal(t, boms, res)
+		}
+
+		return err
+	})
+}
+
+func TestServerServiceGetBom(t *testing.T) {
+	mockClientTests(t, func(ctx context.Context, respCode int, expectError bool) error {
+		bom := hollow.Bom{SerialNum: "fakeSerialNum1", AocMacAddress: "aoc1", BmcMacAddress: "bmc1", Metro: "da"}
+		jsonResponse, err := json.Marshal(hollow.ServerResponse{Record: bom})
+		require.Nil(t, err)
+
+		c := mockClient(string(jsonResponse), respCode)
+		res, _, err := c.GetBom(ctx, bom.SerialNum)
+		if !expectError {
+			assert.Equal(t, bom, *res)
+		}
+
+		return err
+	})
+}
+
+func TestServerServiceUpdateBom(t *testing.T) {
+	mockClientTests(t, func(ctx context.Context, respCode int, expectError bool) error {
+		jsonResponse := json.RawMessage([]byte(`{"message": "resource updated", "slug": "fakeSerialNum1"}`))
+
+		c := mockClient(string(jsonResponse), respCode)
+		_, err := c.UpdateBom(ctx, "fakeSerialNum1", hollow.Bom{AocMacAddress: "aoc1", BmcMacAddress: "bmc1"})
+
+		return err
+	})
+}
+
+func TestServerServiceDeleteBom(t *testing.T) {
+	mockClientTests(t, func(ctx context.Context, respCode int, expectError bool) error {
+		jsonResponse := json.RawMessage([]byte(`{"message": "resource deleted"}`))
+
+		c := mockClient(string(jsonResponse), respCode)
+		_, err := c.DeleteBom(ctx, "fakeSerialNum1")
+
+		return err
+	})
+}
+
+func TestServerServiceGetServerBom(t *testing.T) {
+	mockClientTests(t, func(ctx context.Context, respCode int, expectError bool) error {
+		id := uuid.New()
+		bom := hollow.Bom{SerialNum: "fakeSerialNum1", AocMacAddress: "aoc1", BmcMacAddress: "bmc1", ServerUUID: &id}
+		jsonResponse, err := json.Marshal(hollow.ServerResponse{Record: bom})
+		require.Nil(t, err)
+
+		c := mockClient(string(jsonResponse), respCode)
+		res, _, err := c.GetServerBom(ctx, id)
+		if !expectError {
+			assert.Equal(t, bom, *res)
+		}
+
+		return err
+	})
+}
+
+func TestServerServiceListBomMismatches(t *testing.T) {
+	mockClientTests(t, func(ctx context.Context, respCode int, expectError bool) error {
+		mismatches := []hollow.BomMismatch{{SerialNum: "fakeSerialNum1", ServerUUID: uuid.New(), MissingBmcMacAddresses: []string{"bmc1"}}}
+		jsonResponse, err := json.Marshal(hollow.ServerResponse{Records: mismatches})
+		require.Nil(t, err)
+
+		c := mockClient(string(jsonResponse), respCode)
+		res, _, err := c.ListBomMismatches(ctx, nil)
+		if !expectError {
+			assert.Equal(t, mismatches, res)
+		}
+
+		return err
+	})
+}
+
+func TestServerServiceCreateRack(t *testing.T) {
+	mockClientTests(t, func(ctx context.Context, respCode int, expectError bool) error {
+		id := uuid.New()
+		jsonResponse := json.RawMessage([]byte(`{"message": "resource created", "slug":"` + id.String() + `"}`))
+
+		c := mockClient(string(jsonResponse), respCode)
+		res, _, err := c.CreateRack(ctx, hollow.Rack{RowUUID: uuid.New(), Name: "r01"})
+		if !expectError {
+			assert.Equal(t, id, *res)
+		}
+
+		return err
+	})
+}
+
+func TestServerServiceListRacks(t *testing.T) {
+	mockClientTests(t, func(ctx context.Context, respCode int, expectError bool) error {
+		racks := []hollow.Rack{{UUID: uuid.New(), RowUUID: uuid.New(), Name: "r01", HeightU: 42}}
+		jsonResponse, err := json.Marshal(hollow.ServerResponse{Records: racks})
+		require.Nil(t, err)
+
+		c := mockClient(string(jsonResponse), respCode)
+		res, _, err := c.ListRacks(ctx, &hollow.LocationListParams{FacilityCode: "da1"})
+		if !expectError {
+			assert.Equal(t, racks, res)
+		}
+
+		return err
+	})
+}
+
+func TestServerServiceGetRackElevation(t *testing.T) {
+	mockClientTests(t, func(ctx context.Context, respCode int, expectError bool) error {
+		id := uuid.New()
+		elevation := hollow.RackElevation{
+			Rack:  hollow.Rack{UUID: uuid.New(), Name: "r01", HeightU: 2},
+			Units: []hollow.RackUnit{{Position: 2, ServerUUID: &id, ServerName: "nemo"}, {Position: 1}},
+		}
+		jsonResponse, err := json.Marshal(hollow.ServerResponse{Record: elevation})
+		require.Nil(t, err)
+
+		c := mockClient(string(jsonResponse), respCode)
+		res, _, err := c.GetRackElevation(ctx, elevation.Rack.UUID)
+		if !expectError {
+			assert.Equal(t, elevation, *res)
+		}
+
+		return err
+	})
+}
+
+func TestServerServiceSetServerPlacement(t *testing.T) {
+	mockClientTests(t, func(ctx context.Context, respCode int, expectError bool) error {
+		jsonResponse := json.RawMessage([]byte(`{"message": "resource updated"}`))
+
+		c := mockClient(string(jsonResponse), respCode)
+		_, err := c.SetServerPlacement(ctx, uuid.New(), hollow.ServerPlacement{RackUUID: uuid.New(), PositionU: 10, HeightU: 2})
+
+		return err
+	})
+}
+
+func TestServerServiceTransitionServerState(t *testing.T) {
+	mockClientTests(t, func(ctx context.Context, respCode int, expectError bool) error {
+		jsonResponse := json.RawMessage([]byte(`{"message": "resource created", "slug":"00000000-0000-0000-0000-000000001234"}`))
+
+		c := mockClient(string(jsonResponse), respCode)
+		_, err := c.TransitionServerState(ctx, uuid.New(), hollow.ServerStateMaintenance, "replacing a fan")
+
+		return err
+	})
+}
+
+func TestServerServiceListServerStateTransitions(t *testing.T) {
+	mockClientTests(t, func(ctx context.Context, respCode int, expectError bool) error {
+		transitions := []hollow.ServerStateTransition{{
+			ID:         uuid.New(),
+			ServerUUID: uuid.New(),
+			FromState:  hollow.ServerStateInService,
+			ToState:    hollow.ServerStateMaintenance,
+			Reason:     "replacing a fan",
+		}}
+		jsonResponse, err := json.Marshal(hollow.ServerResponse{Records: transitions})
+		require.Nil(t, err)
+
+		c := mockClient(string(jsonResponse), respCode)
+		res, _, err := c.ListServerStateTransitions(ctx, transitions[0].ServerUUID, nil)
+		if !expectError {
+			assert.Equal(t, transitions, res)
+		}
+
+		return err
+	})
+}
+
+func TestServerServiceAcquireServerLease(t *testing.T) {
+	mockClientTests(t, func(ctx context.Context, respCode int, expectError bool) error {
+		lease := hollow.ServerLease{ServerUUID: uuid.New(), Holder: "provisioner", ExpiresAt: time.Now().Add(time.Minute).UTC()}
+		jsonResponse, err := json.Marshal(hollow.ServerResponse{Record: lease})
+		require.Nil(t, err)
+
+		c := mockClient(string(jsonResponse), respCode)
+		res, _, err := c.AcquireServerLease(ctx, lease.ServerUUID, lease.Holder, 60)
+		if !expectError {
+			assert.Equal(t, lease, *res)
+		}
+
+		return err
+	})
+}
+
+func TestServerServiceRenewServerLease(t *testing.T) {
+	mockClientTests(t, func(ctx context.Context, respCode int, expectError bool) error {
+		lease := hollow.ServerLease{ServerUUID: uuid.New(), Holder: "provisioner", ExpiresAt: time.Now().Add(time.Minute).UTC()}
+		jsonResponse, err := json.Marshal(hollow.ServerResponse{Record: lease})
+		require.Nil(t, err)
+
+		c := mockClient(string(jsonResponse), respCode)
+		res, _, err := c.RenewServerLease(ctx, lease.ServerUUID, lease.Holder, 0)
+		if !expectError {
+			assert.Equal(t, lease, *res)
+		}
+
+		return err
+	})
+}
+
+func TestServerServiceReleaseServerLease(t *testing.T) {
+	mockClientTests(t, func(ctx context.Context, respCode int, expectError bool) error {
+		jsonResponse := json.RawMessage([]byte(`{"message": "resource deleted"}`))
+
+		c := mockClient(string(jsonResponse), respCode)
+		_, err := c.ReleaseServerLease(ctx, uuid.New(), "provisioner")
+
+		return err
+	})
+}
+
+func TestServerServiceCreateMaintenanceWindow(t *testing.T) {
+	mockClientTests(t, func(ctx context.Context, respCode int, expectError bool) error {
+		jsonResponse := json.RawMessage([]byte(`{"message": "resource created", "slug":"00000000-0000-0000-0000-000000001234"}`))
+
+		c := mockClient(string(jsonResponse), respCode)
+		w := hollow.MaintenanceWindow{
+			Reason:       "BIOS update",
+			StartsAt:     time.Now().Add(time.Hour),
+			EndsAt:       time.Now().Add(2 * time.Hour),
+			FacilityCode: "Ocean",
+		}
+		res, _, err := c.CreateMaintenanceWindow(ctx, w)
+		if !expectError {
+			assert.Equal(t, "00000000-0000-0000-0000-000000001234", res.String())
+		}
+
+		return err
+	})
+}
+
+func TestServerServiceListMaintenanceWindows(t *testing.T) {
+	mockClientTests(t, func(ctx context.Context, respCode int, expectError bool) error {
+		windows := []hollow.MaintenanceWindow{{
+			UUID:         uuid.New(),
+			Reason:       "BIOS update",
+			StartsAt:     time.Now().Add(time.Hour).UTC(),
+			EndsAt:       time.Now().Add(2 * time.Hour).UTC(),
+			FacilityCode: "Ocean",
+		}}
+		jsonResponse, err := json.Marshal(hollow.ServerResponse{Records: windows})
+		require.Nil(t, err)
+
+		c := mockClient(string(jsonResponse), respCode)
+		res, _, err := c.ListMaintenanceWindows(ctx, &hollow.MaintenanceWindowListParams{FacilityCode: "Ocean"})
+		if !expectError {
+			assert.Equal(t, windows, res)
+		}
+
+		return err
+	})
+}
+
+func TestServerServiceGetServerMaintenance(t *testing.T) {
+	mockClientTests(t, func(ctx context.Context, respCode int, expectError bool) error {
+		sm := hollow.ServerMaintenance{
+			ServerUUID:    uuid.New(),
+			InMaintenance: true,
+			Current: &hollow.MaintenanceWindow{
+				UUID:     uuid.New(),
+				Reason:   "BMC update",
+				StartsAt: time.Now().Add(-time.Hour).UTC(),
+				EndsAt:   time.Now().Add(time.Hour).UTC(),
+			},
+		}
+		jsonResponse, err := json.Marshal(hollow.ServerResponse{Record: sm})
+		require.Nil(t, err)
+
+		c := mockClient(string(jsonResponse), respCode)
+		res, _, err := c.GetServerMaintenance(ctx, sm.ServerUUID)
+		if !expectError {
+			assert.Equal(t, sm, *res)
+		}
+
+		return err
+	})
+}
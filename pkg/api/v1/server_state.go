@@ -0,0 +1,127 @@
+package serverservice
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+
+	"go.hollow.sh/serverservice/internal/models"
+)
+
+// The states of the default server lifecycle
+const (
+	ServerStateProvisioning   = "provisioning"
+	ServerStateInService      = "in-service"
+	ServerStateMaintenance    = "maintenance"
+	ServerStateDecommissioned = "decommissioned"
+)
+
+var errStateTransition = errors.New("invalid server state transition")
+
+// ServerLifecycle is the graph of the states a server moves through. Every
+// state is a key of Transitions, mapped to the states a server in it can move
+// to. A state with no transitions is final.
+type ServerLifecycle struct {
+	InitialState string              `json:"initial_state" mapstructure:"initial_state"`
+	Transitions  map[string][]string `json:"transitions" mapstructure:"transitions"`
+}
+
+// DefaultServerLifecycle returns the lifecycle used unless another one is
+// configured. Servers are provisioned, then move between service and
+// maintenance until they're decommissioned.
+func DefaultServerLifecycle() *ServerLifecycle {
+	return &ServerLifecycle{
+		InitialState: ServerStateProvisioning,
+		Transitions: map[string][]string{
+			ServerStateProvisioning:   {ServerStateInService, ServerStateDecommissioned},
+			ServerStateInService:      {ServerStateMaintenance, ServerStateDecommissioned},
+			ServerStateMaintenance:    {ServerStateInService, ServerStateDecommissioned},
+			ServerStateDecommissioned: {},
+		},
+	}
+}
+
+// Validate returns an error when the initial state or the target of a
+// transition isn't a state of the lifecycle, or when the lifecycle doesn't have
+// the in-service state servers stored before lifecycles were introduced are in
+func (l *ServerLifecycle) Validate() error {
+	if _, ok := l.Transitions[l.InitialState]; !ok {
+		return errors.Wrap(ErrInvalidServerLifecycle, fmt.Sprintf("initial state %q isn't defined", l.InitialState))
+	}
+
+	states := make([]string, 0, len(l.Transitions))
+	for state := range l.Transitions {
+		states = append(states, state)
+	}
+
+	sort.Strings(states)
+
+	for _, from := range states {
+		for _, to := range l.Transitions[from] {
+			if _, ok := l.Transitions[to]; !ok {
+				return errors.Wrap(ErrInvalidServerLifecycle, fmt.Sprintf("state %q transitions to undefined state %q", from, to))
+			}
+		}
+	}
+
+	if _, ok := l.Transitions[ServerStateInService]; !ok {
+		return errors.Wrap(ErrInvalidServerLifecycle, fmt.Sprintf("state %q isn't defined, existing servers are in it", ServerStateInService))
+	}
+
+	return nil
+}
+
+// checkTransition returns an error when a server can't move from one state to
+// the other
+func (l *ServerLifecycle) checkTransition(from, to string) error {
+	if _, ok := l.Transitions[to]; !ok {
+		return errors.Wrap(errStateTransition, fmt.Sprintf("unknown state %q", to))
+	}
+
+	for _, allowed := range l.Transitions[from] {
+		if allowed == to {
+			return nil
+		}
+	}
+
+	return errors.Wrap(errStateTransition, fmt.Sprintf("a server can't move from %q to %q", from, to))
+}
+
+// ServerStateTransition records a server moving from one state to another and
+// why. To request a transition only ToState and Reason are set.
+type ServerStateTransition struct {
+	ID           uuid.UUID `json:"id"`
+	ServerUUID   uuid.UUID `json:"server_uuid"`
+	FromState    string    `json:"from_state"`
+	ToState      string    `json:"to_state" binding:"required"`
+	Reason       string    `json:"reason" binding:"required"`
+	ActorSubject string    `json:"actor_subject,omitempty"`
+	ActorUser    string    `json:"actor_user,omitempty"`
+	CreatedAt    time.Time `json:"created_at"`
+}
+
+func (t *ServerStateTransition) fromDBModel(dbT *models.ServerStateTransition) error {
+	var err error
+
+	t.ID, err = uuid.Parse(dbT.ID)
+	if err != nil {
+		return err
+	}
+
+	t.ServerUUID, err = uuid.Parse(dbT.ServerID)
+	if err != nil {
+		return err
+	}
+
+	t.FromState = dbT.FromState
+	t.ToState = dbT.ToState
+	t.Reason = dbT.Reason
+	t.ActorSubject = dbT.ActorSubject.String
+	t.ActorUser = dbT.ActorUser.String
+	t.CreatedAt = dbT.CreatedAt
+
+	return nil
+}
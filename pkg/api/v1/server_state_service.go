@@ -0,0 +1,47 @@
+package serverservice
+
+import (
+	"context"
+	"path"
+
+	"github.com/google/uuid"
+)
+
+const (
+	serverLifecycleEndpoint   = "server-lifecycle"
+	serverTransitionsEndpoint = "transitions"
+)
+
+// GetServerLifecycle will return the states servers move through and the
+// transitions allowed between them
+func (c *Client) GetServerLifecycle(ctx context.Context) (*ServerLifecycle, *ServerResponse, error) {
+	l := &ServerLifecycle{}
+	resp := ServerResponse{Record: l}
+
+	if err := c.get(ctx, serverLifecycleEndpoint, &resp); err != nil {
+		return nil, nil, err
+	}
+
+	return l, &resp, nil
+}
+
+// TransitionServerState will move the server to the state, it fails when the
+// lifecycle doesn't allow the server to move there from its current state
+func (c *Client) TransitionServerState(ctx context.Context, srvUUID uuid.UUID, state, reason string) (*ServerResponse, error) {
+	t := ServerStateTransition{ToState: state, Reason: reason}
+
+	return c.post(ctx, path.Join(serversEndpoint, srvUUID.String(), serverTransitionsEndpoint), t)
+}
+
+// ListServerStateTransitions will return the state transitions of the server,
+// most recent first
+func (c *Client) ListServerStateTransitions(ctx context.Context, srvUUID uuid.UUID, params *PaginationParams) ([]ServerStateTransition, *ServerResponse, error) {
+	transitions := &[]ServerStateTransition{}
+	resp := ServerResponse{Records: transitions}
+
+	if err := c.list(ctx, path.Join(serversEndpoint, srvUUID.String(), serverTransitionsEndpoint), params, &resp); err != nil {
+		return nil, nil, err
+	}
+
+	return *transitions, &resp, nil
+}
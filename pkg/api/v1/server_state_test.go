@@ -0,0 +1,85 @@
+package serverservice
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestServerLifecycleValidate(t *testing.T) {
+	testCases := []struct {
+		testName     string
+		lifecycle    ServerLifecycle
+		errorMessage string
+	}{
+		{
+			"default lifecycle",
+			*DefaultServerLifecycle(),
+			"",
+		},
+		{
+			"undefined initial state",
+			ServerLifecycle{InitialState: "racked", Transitions: map[string][]string{"live": {}}},
+			`initial state "racked" isn't defined`,
+		},
+		{
+			"transition to an undefined state",
+			ServerLifecycle{InitialState: "racked", Transitions: map[string][]string{"racked": {"live"}}},
+			`state "racked" transitions to undefined state "live"`,
+		},
+		{
+			"no in-service state",
+			ServerLifecycle{InitialState: "racked", Transitions: map[string][]string{"racked": {"live"}, "live": {}}},
+			`state "in-service" isn't defined`,
+		},
+		{
+			"custom lifecycle",
+			ServerLifecycle{InitialState: "racked", Transitions: map[string][]string{"racked": {"in-service"}, "in-service": {}}},
+			"",
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.testName, func(t *testing.T) {
+			err := tt.lifecycle.Validate()
+			if tt.errorMessage == "" {
+				assert.NoError(t, err)
+				return
+			}
+
+			assert.ErrorIs(t, err, ErrInvalidServerLifecycle)
+			assert.ErrorContains(t, err, tt.errorMessage)
+		})
+	}
+}
+
+func TestServerLifecycleCheckTransition(t *testing.T) {
+	l := DefaultServerLifecycle()
+
+	testCases := []struct {
+		from  string
+		to    string
+		valid bool
+	}{
+		{ServerStateProvisioning, ServerStateInService, true},
+		{ServerStateInService, ServerStateMaintenance, true},
+		{ServerStateMaintenance, ServerStateInService, true},
+		{ServerStateMaintenance, ServerStateDecommissioned, true},
+		{ServerStateInService, ServerStateInService, false},
+		{ServerStateMaintenance, ServerStateProvisioning, false},
+		{ServerStateDecommissioned, ServerStateInService, false},
+		{ServerStateInService, "retired", false},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.from+" to "+tt.to, func(t *testing.T) {
+			err := l.checkTransition(tt.from, tt.to)
+			if tt.valid {
+				assert.NoError(t, err)
+				return
+			}
+
+			assert.ErrorIs(t, err, errStateTransition)
+		})
+	}
+}